@@ -0,0 +1,199 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+type (
+	// A ColorMode is the colours a terminal can show.
+	ColorMode int
+
+	// ANSIOptions control the output of WriteANSI.
+	ANSIOptions struct {
+		// The colours the terminal can show, which colours of the
+		// theme are reduced to.
+		Mode ColorMode
+		// Paint the theme's background, instead of leaving the
+		// terminal's own where the theme's would be.
+		Background bool
+	}
+)
+
+const (
+	TrueColor ColorMode = iota // 24 bit colours
+	Color256                   // The xterm palette of 256 colours
+	Color16                    // The 16 standard and bright colours
+)
+
+func (m ColorMode) String() string {
+	switch m {
+	case TrueColor:
+		return "truecolor"
+	case Color256:
+		return "256"
+	case Color16:
+		return "16"
+	}
+	return "unknown"
+}
+
+// The default xterm colours of the 16 colour palette.
+var ansi16 = [16]Color{
+	{0x00, 0x00, 0x00, 0xff}, {0xcd, 0x00, 0x00, 0xff}, {0x00, 0xcd, 0x00, 0xff}, {0xcd, 0xcd, 0x00, 0xff},
+	{0x00, 0x00, 0xee, 0xff}, {0xcd, 0x00, 0xcd, 0xff}, {0x00, 0xcd, 0xcd, 0xff}, {0xe5, 0xe5, 0xe5, 0xff},
+	{0x7f, 0x7f, 0x7f, 0xff}, {0xff, 0x00, 0x00, 0xff}, {0x00, 0xff, 0x00, 0xff}, {0xff, 0xff, 0x00, 0xff},
+	{0x5c, 0x5c, 0xff, 0xff}, {0xff, 0x00, 0xff, 0xff}, {0x00, 0xff, 0xff, 0xff}, {0xff, 0xff, 0xff, 0xff},
+}
+
+// The levels of the red, green and blue of the 6x6x6 colour cube of the
+// xterm palette.
+var cubeLevels = [6]uint8{0, 95, 135, 175, 215, 255}
+
+// WriteANSI writes the text of the parse tree root, as returned by
+// LanguageParser.Parse, highlighted by t with ANSI escape sequences.
+//
+// Colours are reduced to those of opts.Mode, and font styles become bold,
+// italic, underline and strikethrough. The style is reset at the end of
+// every line, so lines can be shown on their own, such as by a pager. If
+// opts is nil, true colours are written without the theme's background.
+func WriteANSI(w io.Writer, root *parser.Node, t *Theme, opts *ANSIOptions) error {
+	if opts == nil {
+		opts = &ANSIOptions{}
+	}
+	var (
+		bw     = bufio.NewWriter(w)
+		h      = highlight(root)
+		global = t.OpaqueStyle("")
+		codes  = make(map[Style]string)
+	)
+	for i := range h.lines {
+		var prev string
+		for _, r := range h.runs(i, t.OpaqueStyle) {
+			c, ok := codes[r.style]
+			if !ok {
+				c = opts.sgr(r.style, global)
+				codes[r.style] = c
+			}
+			if c != prev {
+				bw.WriteString(c)
+				prev = c
+			}
+			bw.WriteString(r.text)
+		}
+		if prev != "" {
+			bw.WriteString("\x1b[0m")
+		}
+		bw.WriteString("\n")
+	}
+	return bw.Flush()
+}
+
+// sgr returns the escape sequence setting the style s, which first
+// resets the style of the terminal.
+func (o *ANSIOptions) sgr(s, global Style) string {
+	params := []string{"0"}
+	params = append(params, o.color(s.Foreground, false)...)
+	if o.Background || s.Background != global.Background {
+		params = append(params, o.color(s.Background, true)...)
+	}
+	for _, f := range []struct {
+		f    FontStyle
+		code string
+	}{{Bold, "1"}, {Italic, "3"}, {Underline, "4"}, {Strikethrough, "9"}} {
+		if s.Font&f.f != 0 {
+			params = append(params, f.code)
+		}
+	}
+	return "\x1b[" + strings.Join(params, ";") + "m"
+}
+
+// color returns the parameters of the escape sequence setting the
+// foreground, or background if bg is set, to c.
+func (o *ANSIOptions) color(c Color, bg bool) []string {
+	switch o.Mode {
+	case Color256:
+		if bg {
+			return []string{"48", "5", fmt.Sprint(xterm256(c))}
+		}
+		return []string{"38", "5", fmt.Sprint(xterm256(c))}
+	case Color16:
+		i := nearest(c, ansi16[:])
+		code := 30 + i
+		if i >= 8 {
+			code = 90 + i - 8
+		}
+		if bg {
+			code += 10
+		}
+		return []string{fmt.Sprint(code)}
+	}
+	if bg {
+		return []string{"48", "2", fmt.Sprint(c.R), fmt.Sprint(c.G), fmt.Sprint(c.B)}
+	}
+	return []string{"38", "2", fmt.Sprint(c.R), fmt.Sprint(c.G), fmt.Sprint(c.B)}
+}
+
+// xterm256 returns the colour of the xterm palette closest to c, of those
+// in its colour cube and grey ramp, which unlike the first 16 don't vary
+// between terminals.
+func xterm256(c Color) int {
+	var cube [3]int
+	for i, v := range []uint8{c.R, c.G, c.B} {
+		cube[i] = nearestLevel(v)
+	}
+	cc := Color{cubeLevels[cube[0]], cubeLevels[cube[1]], cubeLevels[cube[2]], 0xff}
+
+	// The greys are 8, 18, ... 238
+	avg := (int(c.R) + int(c.G) + int(c.B)) / 3
+	g := (avg - 3) / 10
+	if g < 0 {
+		g = 0
+	} else if g > 23 {
+		g = 23
+	}
+	gv := uint8(8 + 10*g)
+	gc := Color{gv, gv, gv, 0xff}
+
+	if ColorDistance(c, gc) < ColorDistance(c, cc) {
+		return 232 + g
+	}
+	return 16 + 36*cube[0] + 6*cube[1] + cube[2]
+}
+
+// nearestLevel returns the index of the level of cubeLevels closest to v.
+func nearestLevel(v uint8) int {
+	best := 0
+	for i, l := range cubeLevels {
+		if abs(int(v)-int(l)) < abs(int(v)-int(cubeLevels[best])) {
+			best = i
+		}
+	}
+	return best
+}
+
+// nearest returns the index of the colour of palette closest to c.
+func nearest(c Color, palette []Color) int {
+	best, dist := 0, -1.0
+	for i, p := range palette {
+		if d := ColorDistance(c, p); dist < 0 || d < dist {
+			best, dist = i, d
+		}
+	}
+	return best
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
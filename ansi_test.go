@@ -0,0 +1,94 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriteANSI(t *testing.T) {
+	th, err := LoadTheme("testdata/Monokai.tmTheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A comment across lines, and a storage type after it
+	root := parseString(t, "testdata/Go.tmLanguage", "/* a\nb */ int\n\nvar\n")
+	tests := []struct {
+		opts *ANSIOptions
+		exp  string
+	}{
+		{
+			nil,
+			"\x1b[0;38;2;117;113;94m/* a\x1b[0m\n" +
+				"\x1b[0;38;2;117;113;94mb */\x1b[0;38;2;248;248;242m \x1b[0;38;2;102;217;239;3mint\x1b[0m\n" +
+				"\n" +
+				"\x1b[0;38;2;249;38;114mvar\x1b[0m\n",
+		},
+		{
+			&ANSIOptions{Mode: Color256, Background: true},
+			"\x1b[0;38;5;242;48;5;235m/* a\x1b[0m\n" +
+				"\x1b[0;38;5;242;48;5;235mb */\x1b[0;38;5;231;48;5;235m \x1b[0;38;5;81;48;5;235;3mint\x1b[0m\n" +
+				"\n" +
+				"\x1b[0;38;5;197;48;5;235mvar\x1b[0m\n",
+		},
+		{
+			&ANSIOptions{Mode: Color16},
+			"\x1b[0;90m/* a\x1b[0m\n" +
+				"\x1b[0;90mb */\x1b[0;97m \x1b[0;36;3mint\x1b[0m\n" +
+				"\n" +
+				"\x1b[0;31mvar\x1b[0m\n",
+		},
+	}
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := WriteANSI(&buf, root, th, test.opts); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != test.exp {
+			t.Errorf("%v: Expected %q, but got %q", test.opts, test.exp, got)
+		}
+	}
+}
+
+func TestANSIStyles(t *testing.T) {
+	global := Style{Foreground: Color{0xff, 0xff, 0xff, 0xff}}
+	s := Style{Foreground: Color{0xff, 0, 0, 0xff}, Background: Color{0, 0, 0xff, 0xff}, Font: Bold | Italic | Underline | Strikethrough}
+	tests := []struct {
+		mode ColorMode
+		exp  string
+	}{
+		{TrueColor, "\x1b[0;38;2;255;0;0;48;2;0;0;255;1;3;4;9m"},
+		{Color256, "\x1b[0;38;5;196;48;5;21;1;3;4;9m"},
+		{Color16, "\x1b[0;91;44;1;3;4;9m"},
+	}
+	for _, test := range tests {
+		if got := (&ANSIOptions{Mode: test.mode}).sgr(s, global); got != test.exp {
+			t.Errorf("%s: Expected %q, but got %q", test.mode, test.exp, got)
+		}
+	}
+
+	colors := []struct {
+		c   string
+		exp int
+	}{
+		{"#000000", 16},
+		{"#FFFFFF", 231},
+		{"#808080", 244},
+		{"#5F87AF", 67},
+		{"#F92672", 197},
+		{"#272822", 235},
+	}
+	for _, test := range colors {
+		c, _ := ParseColor(test.c)
+		if got := xterm256(c); got != test.exp {
+			t.Errorf("%s: Expected %d, but got %d", test.c, test.exp, got)
+		}
+	}
+	if !strings.Contains((&ANSIOptions{Mode: Color16}).sgr(Style{}, Style{}), "30") {
+		t.Error("Expected black to be colour 30")
+	}
+}
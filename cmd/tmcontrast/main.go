@@ -0,0 +1,70 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+// Command tmcontrast checks the contrast of colour themes.
+//
+// Usage:
+//
+//	tmcontrast [-level AA|AAA] [-distance d] theme...
+//
+// For every theme, which can be a tmTheme, a VS Code theme or a Sublime
+// Text colour scheme, it prints the foregrounds whose WCAG contrast
+// against the backgrounds they're shown on is below the level, and the
+// foregrounds too similar to tell apart. It exits with status 1 if any
+// contrast is below the level.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/gbbr/textmate"
+)
+
+var (
+	level    = flag.String("level", "AA", "the WCAG level to check for, AA or AAA")
+	distance = flag.Float64("distance", 10, "the CIE76 distance below which foregrounds are too similar")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("tmcontrast: ")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: tmcontrast [flags] theme...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	var min float64
+	switch strings.ToUpper(*level) {
+	case "AA":
+		min = textmate.AA
+	case "AAA":
+		min = textmate.AAA
+	default:
+		log.Fatalf("Unknown level %q", *level)
+	}
+
+	failed := false
+	for _, fn := range flag.Args() {
+		t, err := textmate.LoadTheme(fn)
+		if err != nil {
+			log.Fatal(err)
+		}
+		r := t.CheckContrast(*distance)
+		fmt.Printf("%s:\n%s", fn, r.Summary(min))
+		if len(r.Failures(min)) != 0 {
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
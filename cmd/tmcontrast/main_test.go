@@ -0,0 +1,114 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestMain runs the command instead of the tests when the test binary is
+// run by tmcontrast.
+func TestMain(m *testing.M) {
+	if os.Getenv("TMCONTRAST_TEST_MAIN") == "1" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// tmcontrast runs the command with args, and returns its standard output
+// and error, and its exit status.
+func tmcontrast(t *testing.T, args ...string) (stdout, stderr string, status int) {
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Env = append(os.Environ(), "TMCONTRAST_TEST_MAIN=1")
+	var out, errs bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &out, &errs
+	if err := cmd.Run(); err != nil {
+		exit, ok := err.(*exec.ExitError)
+		if !ok {
+			t.Fatal(err)
+		}
+		status = exit.ExitCode()
+	}
+	return out.String(), errs.String(), status
+}
+
+func TestContrast(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tmcontrast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	themes := map[string]string{
+		"Low-color-theme.json": `{
+	"colors": {"editor.background": "#000000", "editor.foreground": "#FFFFFF"},
+	"tokenColors": [
+		{"name": "Comment", "scope": "comment", "settings": {"foreground": "#555555"}},
+		{"name": "String", "scope": "string", "settings": {"foreground": "#E6DB74"}},
+		{"name": "Number", "scope": "constant.numeric", "settings": {"foreground": "#E8DC70"}}
+	]
+}`,
+		"High-color-theme.json": `{
+	"colors": {"editor.background": "#000000", "editor.foreground": "#FFFFFF"},
+	"tokenColors": [
+		{"name": "String", "scope": "string", "settings": {"foreground": "#E6DB74"}}
+	]
+}`,
+	}
+	for fn, data := range themes {
+		if err := ioutil.WriteFile(filepath.Join(dir, fn), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	low, high := filepath.Join(dir, "Low-color-theme.json"), filepath.Join(dir, "High-color-theme.json")
+
+	stdout, stderr, status := tmcontrast(t, low)
+	if status != 1 {
+		t.Errorf("Expected the exit status 1, but got %d: %s", status, stderr)
+	}
+	for _, exp := range []string{
+		low + ":\n1 of 4 checks below 4.5:1\n",
+		"\tComment [comment]: #555555 on #000000 (background): 2.82:1 fail\n",
+		"1 pairs of similar colours\n",
+	} {
+		if !strings.Contains(stdout, exp) {
+			t.Errorf("Expected %q in %s", exp, stdout)
+		}
+	}
+
+	if stdout, stderr, status = tmcontrast(t, high); status != 0 {
+		t.Errorf("Expected the exit status 0, but got %d: %s", status, stderr)
+	} else if exp := high + ":\n0 of 2 checks below 4.5:1\n0 pairs of similar colours\n"; stdout != exp {
+		t.Errorf("Expected %q, but got %q", exp, stdout)
+	}
+	// Levels are case insensitive
+	if _, _, status = tmcontrast(t, "-level", "aaa", high); status != 0 {
+		t.Errorf("Expected the exit status 0 at AAA, but got %d", status)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		args   []string
+		status int
+		exp    string
+	}{
+		{nil, 2, "usage: tmcontrast"},
+		{[]string{"-level", "B", "../../testdata/Monokai.tmTheme"}, 1, `tmcontrast: Unknown level "B"`},
+		{[]string{"../../testdata/Missing.tmTheme"}, 1, "tmcontrast: Unable to load"},
+	}
+	for _, test := range tests {
+		_, stderr, status := tmcontrast(t, test.args...)
+		if status != test.status || !strings.Contains(stderr, test.exp) {
+			t.Errorf("%v: Expected the exit status %d and an error with %q, but got %d and %q", test.args, test.status, test.exp, status, stderr)
+		}
+	}
+}
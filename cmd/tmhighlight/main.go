@@ -0,0 +1,185 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+// Command tmhighlight highlights source files with TextMate grammars.
+//
+// Usage:
+//
+//	tmhighlight [flags] [file...]
+//
+// It highlights every file, or its standard input if there are none, and
+// writes the result to its standard output as ANSI escape sequences, HTML
+// or JSON tokens. The tokens of every file are a document in the format of
+// textmate.EncodeTokens.
+//
+// Grammars, *.tmLanguage files, are loaded from the directories of the
+// -path flag, or else of the TMHIGHLIGHT_PATH environment variable, which
+// are lists like PATH. The grammar of a file is the one given by the
+// -grammar flag, a scope name or the file of a grammar, or else the one
+// whose file types match the file's name, or whose first line match
+// matches its first line. The theme, a tmTheme, VS Code theme or Sublime
+// Text colour scheme, is given by the -theme flag or the TMHIGHLIGHT_THEME
+// environment variable. JSON tokens need no theme.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gbbr/textmate"
+)
+
+var (
+	grammar = flag.String("grammar", "", "the scope name or file of the grammar to use")
+	path    = flag.String("path", os.Getenv("TMHIGHLIGHT_PATH"), "the directories to load grammars from")
+	theme   = flag.String("theme", os.Getenv("TMHIGHLIGHT_THEME"), "the theme file")
+	format  = flag.String("format", "ansi", "the output format: ansi, html or json")
+	colors  = flag.String("colors", defaultColors(), "the colours of the terminal: truecolor, 256 or 16")
+	numbers = flag.Bool("n", false, "number the lines of HTML")
+	classes = flag.Bool("classes", false, "write HTML with classes and a stylesheet instead of inline styles")
+)
+
+// defaultColors returns the colours the terminal is said to support.
+func defaultColors() string {
+	switch os.Getenv("COLORTERM") {
+	case "truecolor", "24bit":
+		return "truecolor"
+	}
+	return "256"
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("tmhighlight: ")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: tmhighlight [flags] [file...]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	for _, dir := range filepath.SplitList(*path) {
+		if dir == "" {
+			continue
+		}
+		if _, err := os.Stat(dir); err != nil {
+			log.Fatalf("Grammar directory: %s", err)
+		}
+		if _, err := textmate.Provider.LoadDir(dir); err != nil {
+			log.Fatal(err)
+		}
+	}
+	var th *textmate.Theme
+	switch *format {
+	case "json":
+	case "ansi", "html":
+		if *theme == "" {
+			log.Fatal("No theme: use -theme or set TMHIGHLIGHT_THEME")
+		}
+		var err error
+		if th, err = textmate.LoadTheme(*theme); err != nil {
+			log.Fatal(err)
+		}
+	default:
+		log.Fatalf("Unknown format %q", *format)
+	}
+	var mode textmate.ColorMode
+	switch *colors {
+	case "truecolor":
+		mode = textmate.TrueColor
+	case "256":
+		mode = textmate.Color256
+	case "16":
+		mode = textmate.Color16
+	default:
+		log.Fatalf("Unknown colours %q", *colors)
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	if *format == "html" && *classes {
+		w.WriteString("<style>\n")
+		if err := th.WriteCSS(w, ""); err != nil {
+			log.Fatal(err)
+		}
+		w.WriteString("</style>\n")
+	}
+	files := flag.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+	for _, fn := range files {
+		if err := highlight(w, fn, th, mode); err != nil {
+			w.Flush()
+			log.Fatal(err)
+		}
+	}
+}
+
+// highlight writes the file fn, or the standard input if it's "-",
+// highlighted by th.
+func highlight(w io.Writer, fn string, th *textmate.Theme, mode textmate.ColorMode) error {
+	var (
+		data []byte
+		err  error
+	)
+	if fn == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(fn)
+	}
+	if err != nil {
+		return err
+	}
+	scope, err := language(fn, data)
+	if err != nil {
+		return err
+	}
+
+	lp, err := textmate.NewLanguageParserBytes(scope, data)
+	if err != nil {
+		return err
+	}
+	root, err := lp.Parse()
+	if err != nil {
+		return err
+	}
+	switch *format {
+	case "json":
+		return textmate.EncodeTokens(w, textmate.Tokens(root), &textmate.JSONOptions{Intern: true})
+	case "html":
+		return textmate.WriteHTML(w, root, th, &textmate.HTMLOptions{Classes: *classes, LineNumbers: *numbers})
+	}
+	return textmate.WriteANSI(w, root, th, &textmate.ANSIOptions{Mode: mode})
+}
+
+// language returns the scope name, or file, of the grammar of the file fn
+// holding data.
+func language(fn string, data []byte) (string, error) {
+	if *grammar != "" {
+		if _, err := textmate.Provider.GetLanguage(*grammar); err != nil {
+			return "", fmt.Errorf("Grammar %s: %s", *grammar, err)
+		}
+		return *grammar, nil
+	}
+	first := string(data)
+	if i := strings.IndexByte(first, '\n'); i != -1 {
+		first = first[:i+1]
+	}
+	name := fn
+	if fn == "-" {
+		name = ""
+	}
+	l, err := textmate.Provider.LanguageForFile(name, first)
+	if err != nil {
+		return "", fmt.Errorf("%s: No grammar found; use -grammar, or -path or TMHIGHLIGHT_PATH to load more", fn)
+	}
+	return l.ScopeName, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/gbbr/textmate"
+)
+
+// TestMain runs the command instead of the tests when the test binary is
+// run by tmhighlight.
+func TestMain(m *testing.M) {
+	if os.Getenv("TMHIGHLIGHT_TEST_MAIN") == "1" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// tmhighlight runs the command with args, and returns its standard output
+// and error, and whether it succeeded.
+func tmhighlight(t *testing.T, args ...string) (stdout, stderr string, ok bool) {
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Env = append(os.Environ(), "TMHIGHLIGHT_TEST_MAIN=1", "TMHIGHLIGHT_PATH=", "TMHIGHLIGHT_THEME=")
+	var out, errs bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &out, &errs
+	err := cmd.Run()
+	if _, exit := err.(*exec.ExitError); err != nil && !exit {
+		t.Fatal(err)
+	}
+	return out.String(), errs.String(), err == nil
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		args []string
+		exp  string
+	}{
+		{[]string{"-path", "../../testdata", "../../testdata/main.go"}, "No theme: use -theme or set TMHIGHLIGHT_THEME"},
+		{[]string{"-path", "../../testdata", "-theme", "../../testdata/Missing.tmTheme", "../../testdata/main.go"}, "Unable to load"},
+		{[]string{"-path", "../../testdata", "-format", "json", "-grammar", "source.missing", "../../testdata/main.go"}, "Grammar source.missing: "},
+		{[]string{"-path", "../../testdata", "-format", "json", "../../testdata/Monokai.tmTheme.res"}, "Monokai.tmTheme.res: No grammar found"},
+		{[]string{"-format", "json", "../../testdata/main.go"}, "main.go: No grammar found"},
+		{[]string{"-path", "../../missing", "../../testdata/main.go"}, "Grammar directory: "},
+		{[]string{"-path", "../../testdata", "-format", "svg", "../../testdata/main.go"}, `Unknown format "svg"`},
+		{[]string{"-path", "../../testdata", "-format", "json", "../../testdata/missing.go"}, "missing.go"},
+	}
+	for _, test := range tests {
+		_, stderr, ok := tmhighlight(t, test.args...)
+		if ok {
+			t.Errorf("%v: Expected tmhighlight to fail", test.args)
+		} else if !strings.HasPrefix(stderr, "tmhighlight: ") || !strings.Contains(stderr, test.exp) {
+			t.Errorf("%v: Expected an error with %q, but got %q", test.args, test.exp, stderr)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	stdout, stderr, ok := tmhighlight(t, "-path", "../../testdata", "-format", "json", "../../testdata/go2.go")
+	if !ok {
+		t.Fatal(stderr)
+	}
+	lines, err := textmate.DecodeTokens(strings.NewReader(stdout))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) == 0 || len(lines[0]) == 0 || lines[0][0].Scopes[0] != "source.go" {
+		t.Errorf("Expected the tokens of go2.go, but got %v", lines)
+	}
+}
+
+// scopes returns the scopes of the JSON tokens tmhighlight writes for fn.
+func scopes(t *testing.T, fn string) []string {
+	stdout, stderr, ok := tmhighlight(t, "-path", "../../testdata", "-format", "json", fn)
+	if !ok {
+		t.Fatal(stderr)
+	}
+	lines, err := textmate.DecodeTokens(strings.NewReader(stdout))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ret []string
+	for _, toks := range lines {
+		for _, tok := range toks {
+			ret = append(ret, strings.Join(tok.Scopes, " "))
+		}
+	}
+	return ret
+}
+
+func TestJSONUTF16(t *testing.T) {
+	d, err := ioutil.ReadFile("../../testdata/go2.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "tmhighlight")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// JSON is decoded like the other formats are
+	u16 := []byte{0xff, 0xfe}
+	for _, c := range utf16.Encode([]rune(string(d))) {
+		u16 = append(u16, byte(c), byte(c>>8))
+	}
+	fn := filepath.Join(dir, "go2.go")
+	if err := ioutil.WriteFile(fn, u16, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if exp, got := scopes(t, "../../testdata/go2.go"), scopes(t, fn); !reflect.DeepEqual(exp, got) {
+		t.Errorf("Expected the scopes\n%q\nbut got\n%q", exp, got)
+	}
+}
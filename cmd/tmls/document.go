@@ -0,0 +1,346 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gbbr/textmate"
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+type (
+	// A document is an open text document. It is parsed when one of its
+	// features is first asked for, and every offset of the parse is in
+	// UTF-16 code units, like LSP positions.
+	document struct {
+		uri     string
+		version int
+		text    string
+		// The scope name, or file, of the grammar, or "" if there's none
+		scope string
+		// The offsets of the starts of the lines, and of the end of the
+		// text
+		lines  []int
+		root   *parser.Node
+		parsed bool
+	}
+
+	position struct {
+		Line      int `json:"line"`
+		Character int `json:"character"`
+	}
+
+	lspRange struct {
+		Start position `json:"start"`
+		End   position `json:"end"`
+	}
+
+	foldingRange struct {
+		StartLine int    `json:"startLine"`
+		EndLine   int    `json:"endLine"`
+		Kind      string `json:"kind,omitempty"`
+	}
+
+	documentSymbol struct {
+		Name           string            `json:"name"`
+		Kind           int               `json:"kind"`
+		Range          lspRange          `json:"range"`
+		SelectionRange lspRange          `json:"selectionRange"`
+		Children       []*documentSymbol `json:"children,omitempty"`
+		// The offsets of Range
+		start, end int
+	}
+
+	selectionRange struct {
+		Range  lspRange        `json:"range"`
+		Parent *selectionRange `json:"parent,omitempty"`
+	}
+)
+
+// The LSP symbol kinds of the scopes of names, the most specific first.
+var symbolKinds = []struct {
+	scope string
+	kind  int
+}{
+	{"entity.name.function.constructor", 9},
+	{"entity.name.function", 12},
+	{"entity.name.method", 6},
+	{"entity.name.type.class", 5},
+	{"entity.name.type.struct", 23},
+	{"entity.name.type.enum", 10},
+	{"entity.name.type.interface", 11},
+	{"entity.name.type", 5},
+	{"entity.name.class", 5},
+	{"entity.name.struct", 23},
+	{"entity.name.enum", 10},
+	{"entity.name.interface", 11},
+	{"entity.name.namespace", 3},
+	{"entity.name.module", 2},
+	{"entity.name.package", 4},
+	{"entity.name.constant", 14},
+	{"entity.name.variable", 13},
+	{"entity.name.section", 15},
+}
+
+// uriPath returns the path of a file URI, or the URI itself if it isn't
+// one.
+func uriPath(uri string) string {
+	if u, err := url.Parse(uri); err == nil && u.Scheme == "file" {
+		return u.Path
+	}
+	return uri
+}
+
+// hasScope returns whether the scope name, or any of the scope names
+// separated by spaces, starts with the scope prefix.
+func hasScope(name, prefix string) bool {
+	for _, s := range strings.Fields(name) {
+		if s == prefix || strings.HasPrefix(s, prefix+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *document) setText(text string) {
+	d.text, d.root, d.parsed = text, nil, false
+	d.lines = d.lines[:0]
+	d.lines = append(d.lines, 0)
+	n, prev := 0, rune(0)
+	for _, r := range text {
+		if r >= 0x10000 && r <= utf8.MaxRune {
+			n += 2
+		} else {
+			n++
+		}
+		// Lines end with "\r\n", "\n" or "\r", like in LSP
+		switch {
+		case r == '\n' && prev == '\r':
+			d.lines[len(d.lines)-1] = n
+		case r == '\n', r == '\r':
+			d.lines = append(d.lines, n)
+		}
+		prev = r
+	}
+	d.lines = append(d.lines, n)
+}
+
+// parse returns the parse of d, or nil if it has no grammar.
+func (d *document) parse() *parser.Node {
+	if d.parsed {
+		return d.root
+	}
+	d.parsed = true
+	if d.scope == "" {
+		return nil
+	}
+	lp, err := textmate.NewLanguageParserBytes(d.scope, []byte(d.text))
+	if err != nil {
+		log.Printf("%s: %s", d.uri, err)
+		return nil
+	}
+	lp.Offsets = textmate.UTF16Offsets
+	if d.root, err = lp.Parse(); err != nil {
+		log.Printf("%s: %s", d.uri, err)
+	}
+	return d.root
+}
+
+// end returns the offset of the end of the text.
+func (d *document) end() int {
+	return d.lines[len(d.lines)-1]
+}
+
+// line returns the line of offset off.
+func (d *document) line(off int) int {
+	lines := d.lines[:len(d.lines)-1]
+	return sort.Search(len(lines), func(i int) bool { return lines[i] > off }) - 1
+}
+
+func (d *document) position(off int) position {
+	l := d.line(off)
+	return position{l, off - d.lines[l]}
+}
+
+// offset returns the offset of pos, clamped to its line and the text.
+func (d *document) offset(pos position) int {
+	switch {
+	case pos.Line < 0:
+		return 0
+	case pos.Line >= len(d.lines)-1:
+		return d.end()
+	}
+	off := d.lines[pos.Line] + pos.Character
+	if pos.Character < 0 {
+		off = d.lines[pos.Line]
+	} else if next := d.lines[pos.Line+1]; off > next {
+		off = next
+	}
+	return off
+}
+
+func (d *document) rangeOf(a, b int) lspRange {
+	return lspRange{d.position(a), d.position(b)}
+}
+
+// foldingRanges returns a folding range for every node spanning lines,
+// which keeps its last line visible, and for every run of comments
+// spanning lines, which doesn't. Of the ranges starting on the same line,
+// only the first one is kept.
+func (d *document) foldingRanges() []foldingRange {
+	ret := []foldingRange{}
+	root := d.parse()
+	if root == nil {
+		return ret
+	}
+	var (
+		comments []foldingRange
+		starts   = make(map[int]bool)
+		walk     func(n *parser.Node)
+	)
+	walk = func(n *parser.Node) {
+		for _, c := range n.Children {
+			if c.Range.B <= c.Range.A {
+				continue
+			}
+			start, end := d.line(c.Range.A), d.line(c.Range.B-1)
+			if hasScope(c.Name, "comment") {
+				if k := len(comments) - 1; k >= 0 && start <= comments[k].EndLine+1 {
+					if end > comments[k].EndLine {
+						comments[k].EndLine = end
+					}
+				} else {
+					comments = append(comments, foldingRange{start, end, "comment"})
+				}
+				continue
+			}
+			if end-1 > start && !starts[start] {
+				starts[start] = true
+				fr := foldingRange{StartLine: start, EndLine: end - 1}
+				if strings.Contains(c.Name, "import") {
+					fr.Kind = "imports"
+				}
+				ret = append(ret, fr)
+			}
+			walk(c)
+		}
+	}
+	walk(root)
+	for _, c := range comments {
+		if c.EndLine > c.StartLine {
+			ret = append(ret, c)
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool { return ret[i].StartLine < ret[j].StartLine })
+	return ret
+}
+
+// symbolKind returns the LSP symbol kind of a node with the scope name,
+// or false if it doesn't name a symbol.
+func symbolKind(name string) (int, bool) {
+	for _, k := range symbolKinds {
+		if hasScope(name, k.scope) {
+			return k.kind, true
+		}
+	}
+	return 0, false
+}
+
+// hasSymbol returns whether any of the descendants of n names a symbol.
+func hasSymbol(n *parser.Node) bool {
+	for _, c := range n.Children {
+		if _, ok := symbolKind(c.Name); ok || hasSymbol(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// symbols returns a symbol for every innermost node whose scope is a
+// name, such as entity.name.function. The range of a symbol is that of the
+// innermost meta scope containing its name, other than that of a
+// declaration, as that's usually only its heading, and symbols are the
+// children of the symbols whose ranges contain theirs.
+func (d *document) symbols() []*documentSymbol {
+	ret := []*documentSymbol{}
+	root := d.parse()
+	if root == nil {
+		return ret
+	}
+	var (
+		open  []*documentSymbol
+		metas []*parser.Node
+		walk  func(n *parser.Node)
+	)
+	walk = func(n *parser.Node) {
+		for _, c := range n.Children {
+			kind, ok := symbolKind(c.Name)
+			if !ok || hasSymbol(c) {
+				meta := hasScope(c.Name, "meta") && !strings.Contains(c.Name, "declaration")
+				if meta {
+					metas = append(metas, c)
+				}
+				walk(c)
+				if meta {
+					metas = metas[:len(metas)-1]
+				}
+				continue
+			}
+			sym := &documentSymbol{
+				Name:           strings.TrimSpace(c.Data()),
+				Kind:           kind,
+				SelectionRange: d.rangeOf(c.Range.A, c.Range.B),
+				start:          c.Range.A,
+				end:            c.Range.B,
+			}
+			if k := len(metas) - 1; k >= 0 {
+				sym.start, sym.end = metas[k].Range.A, metas[k].Range.B
+			}
+			sym.Range = d.rangeOf(sym.start, sym.end)
+			for len(open) != 0 && (open[len(open)-1].end < sym.end || open[len(open)-1].start > sym.start) {
+				open = open[:len(open)-1]
+			}
+			if len(open) == 0 {
+				ret = append(ret, sym)
+			} else {
+				parent := open[len(open)-1]
+				parent.Children = append(parent.Children, sym)
+			}
+			open = append(open, sym)
+		}
+	}
+	walk(root)
+	return ret
+}
+
+// selectionRange returns the ranges of the nodes containing pos, from the
+// innermost one to the whole document.
+func (d *document) selectionRange(pos position) *selectionRange {
+	off := d.offset(pos)
+	ret := &selectionRange{Range: d.rangeOf(0, d.end())}
+	n := d.parse()
+	for n != nil {
+		var next *parser.Node
+		for _, c := range n.Children {
+			if c.Range.A <= off && off < c.Range.B {
+				next = c
+				break
+			}
+		}
+		if next == nil {
+			break
+		}
+		if r := d.rangeOf(next.Range.A, next.Range.B); r != ret.Range {
+			ret = &selectionRange{r, ret}
+		}
+		n = next
+	}
+	return ret
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+// Command tmls is a language server built on TextMate grammars.
+//
+// Usage:
+//
+//	tmls [flags]
+//
+// It speaks the Language Server Protocol, JSON-RPC over its standard input
+// and output, and provides semantic tokens, folding ranges, document
+// symbols and selection ranges for the documents of any grammar it loads.
+// This brings grammar based highlighting and structure to editors without
+// grammar support of their own.
+//
+// Grammars, *.tmLanguage files, are loaded from the directories of the
+// -path flag, or else of the TMLS_PATH environment variable, which are
+// lists like PATH. The grammar of a document is the one given by the
+// -grammar flag, a scope name or the file of a grammar, or else the one
+// whose file types match the document's name, or whose first line match
+// matches its first line, or else the one with the scope name "source."
+// or "text." followed by the document's language id.
+//
+// Messages are logged to the standard error.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/gbbr/textmate"
+)
+
+var (
+	grammar = flag.String("grammar", "", "the scope name or file of the grammar to use for every document")
+	path    = flag.String("path", os.Getenv("TMLS_PATH"), "the directories to load grammars from")
+	_       = flag.Bool("stdio", true, "communicate over the standard input and output, the only way supported")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("tmls: ")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: tmls [flags]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	for _, dir := range filepath.SplitList(*path) {
+		if dir == "" {
+			continue
+		}
+		if _, err := os.Stat(dir); err != nil {
+			log.Fatalf("Grammar directory: %s", err)
+		}
+		if _, err := textmate.Provider.LoadDir(dir); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *grammar != "" {
+		if _, err := textmate.Provider.GetLanguage(*grammar); err != nil {
+			log.Fatalf("Grammar %s: %s", *grammar, err)
+		}
+	}
+
+	s := newServer(bufio.NewReader(os.Stdin), os.Stdout, *grammar)
+	if err := s.serve(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,426 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gbbr/textmate"
+)
+
+// JSON-RPC and LSP error codes.
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeNotInitialized = -32002
+)
+
+type (
+	// A server serves a single client, handling one message at a time.
+	server struct {
+		r       *bufio.Reader
+		w       io.Writer
+		grammar string
+		docs    map[string]*document
+		legend  *textmate.SemanticLegend
+		results textmate.SemanticResults
+
+		initialized bool
+		shutdown    bool
+	}
+
+	// A request is a request or, without an id, a notification.
+	request struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id,omitempty"`
+		Method  string          `json:"method"`
+		Params  json.RawMessage `json:"params,omitempty"`
+	}
+
+	response struct {
+		JSONRPC string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Result  json.RawMessage `json:"result,omitempty"`
+		Error   *rpcError       `json:"error,omitempty"`
+	}
+
+	rpcError struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+
+	// A handler handles the params of a method, and returns its result.
+	handler func(s *server, params json.RawMessage) (interface{}, *rpcError)
+)
+
+var errExit = errors.New("Exit without a shutdown request")
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("%s (%d)", e.Message, e.Code)
+}
+
+func newServer(r *bufio.Reader, w io.Writer, grammar string) *server {
+	return &server{
+		r:       r,
+		w:       w,
+		grammar: grammar,
+		docs:    make(map[string]*document),
+		legend:  textmate.DefaultSemanticLegend(),
+	}
+}
+
+var handlers = map[string]handler{
+	"initialize":                             (*server).initialize,
+	"initialized":                            nop,
+	"shutdown":                               (*server).shutdownRequest,
+	"$/cancelRequest":                        nop,
+	"$/setTrace":                             nop,
+	"workspace/didChangeConfiguration":       nop,
+	"textDocument/didOpen":                   (*server).didOpen,
+	"textDocument/didChange":                 (*server).didChange,
+	"textDocument/didClose":                  (*server).didClose,
+	"textDocument/didSave":                   nop,
+	"textDocument/semanticTokens/full":       (*server).semanticTokens,
+	"textDocument/semanticTokens/full/delta": (*server).semanticTokensDelta,
+	"textDocument/foldingRange":              (*server).foldingRange,
+	"textDocument/documentSymbol":            (*server).documentSymbol,
+	"textDocument/selectionRange":            (*server).selectionRange,
+}
+
+func nop(s *server, params json.RawMessage) (interface{}, *rpcError) {
+	return nil, nil
+}
+
+// serve handles messages until the exit notification or the end of the
+// input. It returns nil if the client asked the server to shut down first.
+func (s *server) serve() error {
+	for {
+		data, err := readMessage(s.r)
+		if err == io.EOF && s.shutdown {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		var req request
+		if err := json.Unmarshal(data, &req); err != nil {
+			if err := s.reply(json.RawMessage("null"), nil, &rpcError{codeParseError, err.Error()}); err != nil {
+				return err
+			}
+			continue
+		}
+		if req.Method == "exit" {
+			if !s.shutdown {
+				return errExit
+			}
+			return nil
+		}
+		result, rerr := s.handle(&req)
+		if len(req.ID) == 0 {
+			if rerr != nil {
+				log.Printf("%s: %s", req.Method, rerr)
+			}
+			continue
+		}
+		if err := s.reply(req.ID, result, rerr); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *server) handle(req *request) (interface{}, *rpcError) {
+	h, ok := handlers[req.Method]
+	switch {
+	case req.Method == "":
+		return nil, &rpcError{codeInvalidRequest, "No method"}
+	case !s.initialized && req.Method != "initialize":
+		return nil, &rpcError{codeNotInitialized, "The server isn't initialized"}
+	case s.shutdown:
+		return nil, &rpcError{codeInvalidRequest, "The server is shut down"}
+	case !ok:
+		return nil, &rpcError{codeMethodNotFound, fmt.Sprintf("Unknown method %s", req.Method)}
+	}
+	return h(s, req.Params)
+}
+
+// readMessage reads the content of the next message from r.
+func readMessage(r *bufio.Reader) ([]byte, error) {
+	length := -1
+	for {
+		line, err := r.ReadString('\n')
+		if err == io.EOF && line == "" && length == -1 {
+			return nil, io.EOF
+		} else if err != nil {
+			return nil, fmt.Errorf("Unable to read message header: %s", err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			break
+		}
+		i := strings.IndexByte(line, ':')
+		if i == -1 {
+			return nil, fmt.Errorf("Invalid message header %q", line)
+		}
+		if strings.EqualFold(strings.TrimSpace(line[:i]), "Content-Length") {
+			if length, err = strconv.Atoi(strings.TrimSpace(line[i+1:])); err != nil || length < 0 {
+				return nil, fmt.Errorf("Invalid message header %q", line)
+			}
+		}
+	}
+	if length == -1 {
+		return nil, errors.New("Message without a Content-Length")
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("Unable to read message: %s", err)
+	}
+	return data, nil
+}
+
+func (s *server) reply(id json.RawMessage, result interface{}, rerr *rpcError) error {
+	resp := response{JSONRPC: "2.0", ID: id, Error: rerr}
+	if rerr == nil {
+		data, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		resp.Result = data
+	}
+	return writeMessage(s.w, &resp)
+}
+
+// writeMessage writes v as the content of a message to w.
+func writeMessage(w io.Writer, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "Content-Length: %d\r\n\r\n%s", len(data), data)
+	return err
+}
+
+// params decodes the params of a method into v.
+func params(data json.RawMessage, v interface{}) *rpcError {
+	if err := json.Unmarshal(data, v); err != nil {
+		return &rpcError{codeInvalidParams, err.Error()}
+	}
+	return nil
+}
+
+type textDocumentIdentifier struct {
+	URI string `json:"uri"`
+}
+
+// document returns the open document identified by id.
+func (s *server) document(id textDocumentIdentifier) (*document, *rpcError) {
+	d, ok := s.docs[id.URI]
+	if !ok {
+		return nil, &rpcError{codeInvalidParams, fmt.Sprintf("Unknown document %s", id.URI)}
+	}
+	return d, nil
+}
+
+func (s *server) initialize(data json.RawMessage) (interface{}, *rpcError) {
+	s.initialized = true
+	type options struct {
+		Delta bool `json:"delta"`
+	}
+	return map[string]interface{}{
+		"capabilities": map[string]interface{}{
+			// The whole document is sent on every change
+			"textDocumentSync": 1,
+			"semanticTokensProvider": map[string]interface{}{
+				"legend": s.legend,
+				"full":   options{Delta: true},
+			},
+			"foldingRangeProvider":   true,
+			"documentSymbolProvider": true,
+			"selectionRangeProvider": true,
+		},
+		"serverInfo": map[string]string{"name": "tmls"},
+	}, nil
+}
+
+func (s *server) shutdownRequest(data json.RawMessage) (interface{}, *rpcError) {
+	s.shutdown = true
+	return nil, nil
+}
+
+func (s *server) didOpen(data json.RawMessage) (interface{}, *rpcError) {
+	var p struct {
+		TextDocument struct {
+			URI        string `json:"uri"`
+			LanguageID string `json:"languageId"`
+			Version    int    `json:"version"`
+			Text       string `json:"text"`
+		} `json:"textDocument"`
+	}
+	if err := params(data, &p); err != nil {
+		return nil, err
+	}
+	td := p.TextDocument
+	d := &document{uri: td.URI, version: td.Version}
+	d.scope = s.scope(td.URI, td.LanguageID, td.Text)
+	d.setText(td.Text)
+	s.docs[td.URI] = d
+	return nil, nil
+}
+
+func (s *server) didChange(data json.RawMessage) (interface{}, *rpcError) {
+	var p struct {
+		TextDocument struct {
+			URI     string `json:"uri"`
+			Version int    `json:"version"`
+		} `json:"textDocument"`
+		ContentChanges []struct {
+			Range *json.RawMessage `json:"range"`
+			Text  string           `json:"text"`
+		} `json:"contentChanges"`
+	}
+	if err := params(data, &p); err != nil {
+		return nil, err
+	}
+	d, err := s.document(textDocumentIdentifier{p.TextDocument.URI})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range p.ContentChanges {
+		if c.Range != nil {
+			return nil, &rpcError{codeInvalidParams, "Incremental changes aren't supported"}
+		}
+		d.setText(c.Text)
+	}
+	d.version = p.TextDocument.Version
+	return nil, nil
+}
+
+func (s *server) didClose(data json.RawMessage) (interface{}, *rpcError) {
+	var p struct {
+		TextDocument textDocumentIdentifier `json:"textDocument"`
+	}
+	if err := params(data, &p); err != nil {
+		return nil, err
+	}
+	delete(s.docs, p.TextDocument.URI)
+	s.results.Forget(p.TextDocument.URI)
+	return nil, nil
+}
+
+// scope returns the scope name, or file, of the grammar of a document, or
+// "" if there's none.
+func (s *server) scope(uri, languageID, text string) string {
+	if s.grammar != "" {
+		return s.grammar
+	}
+	first := text
+	if i := strings.IndexByte(first, '\n'); i != -1 {
+		first = first[:i+1]
+	}
+	if l, err := textmate.Provider.LanguageForFile(uriPath(uri), first); err == nil {
+		return l.ScopeName
+	}
+	for _, prefix := range []string{"source.", "text."} {
+		if l, err := textmate.Provider.LanguageFromScope(prefix + languageID); err == nil {
+			return l.ScopeName
+		}
+	}
+	log.Printf("No grammar for %s", uri)
+	return ""
+}
+
+func (s *server) semanticTokens(data json.RawMessage) (interface{}, *rpcError) {
+	var p struct {
+		TextDocument textDocumentIdentifier `json:"textDocument"`
+	}
+	if err := params(data, &p); err != nil {
+		return nil, err
+	}
+	d, err := s.document(p.TextDocument)
+	if err != nil {
+		return nil, err
+	}
+	return s.results.Full(d.uri, s.encode(d)), nil
+}
+
+func (s *server) semanticTokensDelta(data json.RawMessage) (interface{}, *rpcError) {
+	var p struct {
+		TextDocument     textDocumentIdentifier `json:"textDocument"`
+		PreviousResultID string                 `json:"previousResultId"`
+	}
+	if err := params(data, &p); err != nil {
+		return nil, err
+	}
+	d, err := s.document(p.TextDocument)
+	if err != nil {
+		return nil, err
+	}
+	return s.results.Delta(d.uri, p.PreviousResultID, s.encode(d)), nil
+}
+
+// encode returns the data of the semantic tokens of d.
+func (s *server) encode(d *document) []uint32 {
+	ret := []uint32{}
+	if root := d.parse(); root != nil {
+		if data := s.legend.EncodeTree(root); data != nil {
+			ret = data
+		}
+	}
+	return ret
+}
+
+func (s *server) foldingRange(data json.RawMessage) (interface{}, *rpcError) {
+	var p struct {
+		TextDocument textDocumentIdentifier `json:"textDocument"`
+	}
+	if err := params(data, &p); err != nil {
+		return nil, err
+	}
+	d, err := s.document(p.TextDocument)
+	if err != nil {
+		return nil, err
+	}
+	return d.foldingRanges(), nil
+}
+
+func (s *server) documentSymbol(data json.RawMessage) (interface{}, *rpcError) {
+	var p struct {
+		TextDocument textDocumentIdentifier `json:"textDocument"`
+	}
+	if err := params(data, &p); err != nil {
+		return nil, err
+	}
+	d, err := s.document(p.TextDocument)
+	if err != nil {
+		return nil, err
+	}
+	return d.symbols(), nil
+}
+
+func (s *server) selectionRange(data json.RawMessage) (interface{}, *rpcError) {
+	var p struct {
+		TextDocument textDocumentIdentifier `json:"textDocument"`
+		Positions    []position             `json:"positions"`
+	}
+	if err := params(data, &p); err != nil {
+		return nil, err
+	}
+	d, err := s.document(p.TextDocument)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*selectionRange, len(p.Positions))
+	for i, pos := range p.Positions {
+		ret[i] = d.selectionRange(pos)
+	}
+	return ret, nil
+}
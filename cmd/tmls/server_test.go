@@ -0,0 +1,330 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gbbr/textmate"
+)
+
+// A client is a fake LSP client, talking to a server over pipes.
+type client struct {
+	t    *testing.T
+	w    io.WriteCloser
+	r    *bufio.Reader
+	id   int
+	done chan error
+}
+
+func newClient(t *testing.T) *client {
+	if _, err := textmate.Provider.LanguageFromFile("../../testdata/Go.tmLanguage"); err != nil {
+		t.Fatal(err)
+	}
+	inr, inw := io.Pipe()
+	outr, outw := io.Pipe()
+	c := &client{t: t, w: inw, r: bufio.NewReader(outr), done: make(chan error, 1)}
+	s := newServer(bufio.NewReader(inr), outw, "")
+	go func() {
+		c.done <- s.serve()
+		outw.Close()
+	}()
+	return c
+}
+
+func (c *client) send(v interface{}) {
+	if err := writeMessage(c.w, v); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *client) notify(method string, params interface{}) {
+	c.send(map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params})
+}
+
+// call sends a request, and decodes the result of its response into
+// result, or returns its error.
+func (c *client) call(method string, params, result interface{}) *rpcError {
+	c.id++
+	c.send(map[string]interface{}{"jsonrpc": "2.0", "id": c.id, "method": method, "params": params})
+	data, err := readMessage(c.r)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	var resp struct {
+		ID     int             `json:"id"`
+		Result json.RawMessage `json:"result"`
+		Error  *rpcError       `json:"error"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		c.t.Fatal(err)
+	}
+	if resp.ID != c.id {
+		c.t.Fatalf("Expected a response to %d, but got one to %d", c.id, resp.ID)
+	}
+	if resp.Error != nil {
+		return resp.Error
+	}
+	if err := json.Unmarshal(resp.Result, result); err != nil {
+		c.t.Fatal(err)
+	}
+	return nil
+}
+
+func (c *client) mustCall(method string, params, result interface{}) {
+	if err := c.call(method, params, result); err != nil {
+		c.t.Fatalf("%s: %s", method, err)
+	}
+}
+
+// exit shuts the server down, and returns what serve returned.
+func (c *client) exit() error {
+	var null interface{}
+	c.mustCall("shutdown", nil, &null)
+	c.notify("exit", nil)
+	return <-c.done
+}
+
+func doc(uri string) map[string]interface{} {
+	return map[string]interface{}{"textDocument": map[string]string{"uri": uri}}
+}
+
+func open(c *client, uri, text string) {
+	c.notify("textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri, "languageId": "go", "version": 1, "text": text},
+	})
+}
+
+const src = `package main
+
+// A comment
+// on two lines
+func f() {
+	x := "😀"
+}
+`
+
+func TestInitialize(t *testing.T) {
+	c := newClient(t)
+	if err := c.call("textDocument/foldingRange", doc("file:///a.go"), nil); err == nil || err.Code != codeNotInitialized {
+		t.Errorf("Expected a not initialized error, but got %v", err)
+	}
+	var init struct {
+		Capabilities struct {
+			TextDocumentSync       int `json:"textDocumentSync"`
+			SemanticTokensProvider struct {
+				Legend struct {
+					TokenTypes []string `json:"tokenTypes"`
+				} `json:"legend"`
+				Full struct {
+					Delta bool `json:"delta"`
+				} `json:"full"`
+			} `json:"semanticTokensProvider"`
+			FoldingRangeProvider bool `json:"foldingRangeProvider"`
+		} `json:"capabilities"`
+	}
+	c.mustCall("initialize", map[string]interface{}{}, &init)
+	c.notify("initialized", map[string]interface{}{})
+	caps := init.Capabilities
+	if caps.TextDocumentSync != 1 || !caps.SemanticTokensProvider.Full.Delta || !caps.FoldingRangeProvider {
+		t.Errorf("Unexpected capabilities %+v", caps)
+	}
+	if exp := textmate.SemanticTokenTypes; !reflect.DeepEqual(caps.SemanticTokensProvider.Legend.TokenTypes, exp) {
+		t.Errorf("Expected the token types %v, but got %v", exp, caps.SemanticTokensProvider.Legend.TokenTypes)
+	}
+	if err := c.call("textDocument/hover", doc("file:///a.go"), nil); err == nil || err.Code != codeMethodNotFound {
+		t.Errorf("Expected a method not found error, but got %v", err)
+	}
+	if err := c.call("textDocument/foldingRange", doc("file:///a.go"), nil); err == nil || err.Code != codeInvalidParams {
+		t.Errorf("Expected an invalid params error, but got %v", err)
+	}
+	if err := c.exit(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestExitWithoutShutdown(t *testing.T) {
+	c := newClient(t)
+	c.notify("exit", nil)
+	if err := <-c.done; err != errExit {
+		t.Errorf("Expected %v, but got %v", errExit, err)
+	}
+}
+
+func TestRequestAfterShutdown(t *testing.T) {
+	c := newClient(t)
+	c.mustCall("initialize", map[string]interface{}{}, new(interface{}))
+	c.mustCall("shutdown", nil, new(interface{}))
+	for _, method := range []string{"textDocument/foldingRange", "shutdown"} {
+		if err := c.call(method, doc("file:///a.go"), nil); err == nil || err.Code != codeInvalidRequest {
+			t.Errorf("%s: Expected an invalid request error, but got %v", method, err)
+		}
+	}
+	c.notify("exit", nil)
+	if err := <-c.done; err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLineEndings(t *testing.T) {
+	var d document
+	d.setText("a\r\nb\rc\n\r\n😀")
+	if exp := []int{0, 3, 5, 7, 9, 11}; !reflect.DeepEqual(d.lines, exp) {
+		t.Errorf("Expected the lines %v, but got %v", exp, d.lines)
+	}
+	if got, exp := d.position(4), (position{1, 1}); got != exp {
+		t.Errorf("Expected %v, but got %v", exp, got)
+	}
+	// Characters past the end of a line are clamped to the next line
+	if got := d.offset(position{0, 5}); got != 3 {
+		t.Errorf("Expected the offset 3, but got %d", got)
+	}
+
+	c := newClient(t)
+	c.mustCall("initialize", map[string]interface{}{}, new(interface{}))
+	open(c, "file:///a.go", strings.Replace(src, "\n", "\r\n", -1))
+	var got []foldingRange
+	c.mustCall("textDocument/foldingRange", doc("file:///a.go"), &got)
+	if exp := []foldingRange{{2, 3, "comment"}, {4, 5, ""}}; !reflect.DeepEqual(got, exp) {
+		t.Errorf("Expected %v, but got %v", exp, got)
+	}
+	if err := c.exit(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSemanticTokens(t *testing.T) {
+	c := newClient(t)
+	c.mustCall("initialize", map[string]interface{}{}, new(interface{}))
+	open(c, "file:///a.go", src)
+
+	var full textmate.SemanticTokens
+	c.mustCall("textDocument/semanticTokens/full", doc("file:///a.go"), &full)
+	if full.ResultID == "" || len(full.Data)%5 != 0 {
+		t.Fatalf("Unexpected semantic tokens %+v", full)
+	}
+	// The string on line 5 follows the := and holds a surrogate pair
+	if exp := []uint32{0, 3, 4, 18, 0}; !reflect.DeepEqual(full.Data[len(full.Data)-5:], exp) {
+		t.Errorf("Expected the last token %v, but got %v", exp, full.Data[len(full.Data)-5:])
+	}
+
+	changed := strings.Replace(src, "x :=", "xy :=", 1)
+	c.notify("textDocument/didChange", map[string]interface{}{
+		"textDocument":   map[string]interface{}{"uri": "file:///a.go", "version": 2},
+		"contentChanges": []map[string]string{{"text": changed}},
+	})
+	params := doc("file:///a.go")
+	params["previousResultId"] = full.ResultID
+	var delta textmate.SemanticTokensDelta
+	c.mustCall("textDocument/semanticTokens/full/delta", params, &delta)
+	if delta.ResultID == "" || delta.ResultID == full.ResultID || len(delta.Edits) != 1 {
+		t.Fatalf("Unexpected semantic tokens delta %+v", delta)
+	}
+	got, err := textmate.ApplySemanticEdits(full.Data, delta.Edits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exp textmate.SemanticTokens
+	c.mustCall("textDocument/semanticTokens/full", doc("file:///a.go"), &exp)
+	if !reflect.DeepEqual(got, exp.Data) {
+		t.Errorf("Expected %v, but got %v", exp.Data, got)
+	}
+
+	// An outdated result id gets the full tokens
+	params["previousResultId"] = full.ResultID
+	var again struct {
+		Data  []uint32                      `json:"data"`
+		Edits []textmate.SemanticTokensEdit `json:"edits"`
+	}
+	c.mustCall("textDocument/semanticTokens/full/delta", params, &again)
+	if again.Edits != nil || !reflect.DeepEqual(again.Data, exp.Data) {
+		t.Errorf("Expected full tokens, but got %+v", again)
+	}
+
+	c.notify("textDocument/didClose", doc("file:///a.go"))
+	if err := c.call("textDocument/semanticTokens/full", doc("file:///a.go"), nil); err == nil {
+		t.Error("Expected an error for a closed document")
+	}
+	if err := c.exit(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFoldingRanges(t *testing.T) {
+	c := newClient(t)
+	c.mustCall("initialize", map[string]interface{}{}, new(interface{}))
+	open(c, "file:///a.go", src)
+	var got []foldingRange
+	c.mustCall("textDocument/foldingRange", doc("file:///a.go"), &got)
+	exp := []foldingRange{
+		{2, 3, "comment"},
+		{4, 5, ""},
+	}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf("Expected %v, but got %v", exp, got)
+	}
+	if err := c.exit(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDocumentSymbols(t *testing.T) {
+	c := newClient(t)
+	c.mustCall("initialize", map[string]interface{}{}, new(interface{}))
+	open(c, "file:///a.go", src)
+	var got []*documentSymbol
+	c.mustCall("textDocument/documentSymbol", doc("file:///a.go"), &got)
+	exp := []*documentSymbol{{
+		Name:           "f",
+		Kind:           12,
+		Range:          lspRange{position{4, 0}, position{6, 1}},
+		SelectionRange: lspRange{position{4, 5}, position{4, 6}},
+	}}
+	if !reflect.DeepEqual(got, exp) {
+		data, _ := json.Marshal(got)
+		t.Errorf("Expected %+v, but got %s", exp[0], data)
+	}
+	if err := c.exit(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSelectionRanges(t *testing.T) {
+	c := newClient(t)
+	c.mustCall("initialize", map[string]interface{}{}, new(interface{}))
+	open(c, "file:///a.go", src)
+	params := doc("file:///a.go")
+	params["positions"] = []position{{5, 8}, {1, 0}}
+	var got []*selectionRange
+	c.mustCall("textDocument/selectionRange", params, &got)
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 selection ranges, but got %d", len(got))
+	}
+	// The string, the block and the function, and the whole document
+	exp := []lspRange{
+		{position{5, 6}, position{5, 10}},
+		{position{4, 9}, position{6, 1}},
+		{position{4, 0}, position{6, 1}},
+		{position{0, 0}, position{7, 0}},
+	}
+	var ranges []lspRange
+	for r := got[0]; r != nil; r = r.Parent {
+		ranges = append(ranges, r.Range)
+	}
+	if !reflect.DeepEqual(ranges, exp) {
+		t.Errorf("Expected %v, but got %v", exp, ranges)
+	}
+	if got[1].Parent != nil || got[1].Range != exp[3] {
+		t.Errorf("Expected only the whole document on an empty line, but got %v", got[1])
+	}
+	if err := c.exit(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/gbbr/textmate"
+)
+
+// The idle copies of a grammar a grammarCache keeps at most.
+const maxIdle = 8
+
+type (
+	// A grammarCache keeps the grammars loaded at startup for reuse. As
+	// a grammar can only be used by one parse at a time, the cache keeps
+	// a list of idle copies of every grammar, and loads another copy
+	// when all of them are in use. It is safe for concurrent use.
+	grammarCache struct {
+		mu    sync.Mutex
+		known map[string]*grammar
+		// The scope names of the grammars, in the order they were
+		// loaded in
+		scopes []string
+		loads  func(scope string)
+	}
+
+	grammar struct {
+		file      string
+		fileTypes []string
+		// The compiled first line match, if there's one
+		firstLine textmate.CompiledRegex
+		idle      []*textmate.Language
+	}
+
+	// A themeCache loads the themes of a directory by name the first
+	// time they're asked for. It is safe for concurrent use.
+	themeCache struct {
+		mu     sync.Mutex
+		files  map[string]string
+		themes map[string]*loadedTheme
+	}
+
+	// A loadedTheme is loaded once, by the first request for it,
+	// while other requests for it wait.
+	loadedTheme struct {
+		once sync.Once
+		t    *textmate.Theme
+		err  error
+	}
+)
+
+// The extensions of theme files, longest first.
+var themeExts = []string{"-color-theme.json", ".sublime-color-scheme", ".tmTheme", ".json"}
+
+func newGrammarCache(loads func(scope string)) *grammarCache {
+	return &grammarCache{known: make(map[string]*grammar), loads: loads}
+}
+
+// loadDir loads the grammars of the directory dir into the cache.
+func (c *grammarCache) loadDir(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.tmLanguage"))
+	if err != nil {
+		return err
+	}
+	for _, fn := range files {
+		l, err := textmate.Provider.LanguageFromFile(fn)
+		if err != nil {
+			return err
+		}
+		g := &grammar{
+			file:      fn,
+			fileTypes: l.FileTypes,
+			idle:      []*textmate.Language{l},
+		}
+		if l.FirstLineMatch != "" {
+			if g.firstLine, err = textmate.Engine.Compile(l.FirstLineMatch); err != nil {
+				return fmt.Errorf("%s: first line match: %s", fn, err)
+			}
+		}
+		c.mu.Lock()
+		if _, ok := c.known[l.ScopeName]; !ok {
+			c.scopes = append(c.scopes, l.ScopeName)
+		}
+		c.known[l.ScopeName] = g
+		c.mu.Unlock()
+	}
+	return nil
+}
+
+// resolve returns the scope name of the grammar for a request, given the
+// language, a scope name or the last part of one such as "go" for
+// "source.go", or else the file name fn and its data. Only grammars in
+// the cache are ever returned.
+func (c *grammarCache) resolve(language, fn string, data []byte) (string, error) {
+	c.mu.Lock()
+	if language != "" {
+		for _, s := range []string{language, "source." + language, "text." + language} {
+			if _, ok := c.known[s]; ok {
+				c.mu.Unlock()
+				return s, nil
+			}
+		}
+		c.mu.Unlock()
+		return "", fmt.Errorf("Unknown language %q", language)
+	}
+	// The file types and first line matches of grammars don't change
+	// once they're loaded, so they're matched without holding c.mu
+	grammars := make([]*grammar, len(c.scopes))
+	for i, s := range c.scopes {
+		grammars[i] = c.known[s]
+	}
+	scopes := c.scopes
+	c.mu.Unlock()
+
+	if fn == "" {
+		return "", fmt.Errorf("Neither a language nor a file name")
+	}
+	// Like LanguageProvider.LanguageForFile, the longest file type fn
+	// ends in, or else the first first line match matching
+	var (
+		base    = filepath.Base(fn)
+		best    string
+		bestLen int
+	)
+	for i, g := range grammars {
+		for _, ft := range g.fileTypes {
+			if (base == ft || strings.HasSuffix(base, "."+ft)) && len(ft) > bestLen {
+				best, bestLen = scopes[i], len(ft)
+			}
+		}
+	}
+	if best != "" {
+		return best, nil
+	}
+	first := string(data)
+	if i := strings.IndexByte(first, '\n'); i != -1 {
+		first = first[:i+1]
+	}
+	for i, g := range grammars {
+		if g.firstLine != nil && first != "" && g.firstLine.Find(first, 0) != nil {
+			return scopes[i], nil
+		}
+	}
+	return "", fmt.Errorf("No language for %s", fn)
+}
+
+// get returns an idle copy of the grammar scope, which is given back by
+// put once it's no longer used.
+func (c *grammarCache) get(scope string) (*textmate.Language, error) {
+	c.mu.Lock()
+	g, ok := c.known[scope]
+	if !ok {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("Unknown language %q", scope)
+	}
+	if n := len(g.idle); n != 0 {
+		l := g.idle[n-1]
+		g.idle = g.idle[:n-1]
+		c.mu.Unlock()
+		return l, nil
+	}
+	c.mu.Unlock()
+	if c.loads != nil {
+		c.loads(scope)
+	}
+	return textmate.Provider.LanguageFromFile(g.file)
+}
+
+func (c *grammarCache) put(l *textmate.Language) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if g, ok := c.known[l.ScopeName]; ok && len(g.idle) < maxIdle {
+		g.idle = append(g.idle, l)
+	}
+}
+
+// newThemeCache creates a themeCache for the themes of the directory dir,
+// named after their files without the extension.
+func newThemeCache(dir string) (*themeCache, error) {
+	c := &themeCache{files: make(map[string]string), themes: make(map[string]*loadedTheme)}
+	if dir == "" {
+		return c, nil
+	}
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, fi := range fis {
+		for _, ext := range themeExts {
+			if name := fi.Name(); !fi.IsDir() && strings.HasSuffix(name, ext) {
+				if key := strings.TrimSuffix(name, ext); c.files[key] == "" {
+					c.files[key] = filepath.Join(dir, name)
+				}
+				break
+			}
+		}
+	}
+	return c, nil
+}
+
+// get returns the theme name, loading it if it isn't loaded yet. The
+// error of loading a theme is returned for every request for it.
+func (c *themeCache) get(name string) (*textmate.Theme, error) {
+	c.mu.Lock()
+	fn, ok := c.files[name]
+	if !ok {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("Unknown theme %q", name)
+	}
+	t, ok := c.themes[name]
+	if !ok {
+		t = &loadedTheme{}
+		c.themes[name] = t
+	}
+	c.mu.Unlock()
+	// Loaded without holding c.mu so that other themes can be got
+	// meanwhile
+	t.once.Do(func() { t.t, t.err = textmate.LoadTheme(fn) })
+	return t.t, t.err
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+// Command tmserve is an HTTP service highlighting source with TextMate
+// grammars.
+//
+// Usage:
+//
+//	tmserve [flags]
+//
+// Source POSTed to /highlight is answered with it highlighted as HTML,
+// ANSI escape sequences or JSON tokens, as chosen by the query parameters:
+//
+//	language  the scope name of the grammar, or its last part such as "go"
+//	filename  the name of the file, to find the grammar by if there's no language
+//	format    html, the default, ansi or json
+//	theme     the name of the theme, for html and ansi
+//	colors    truecolor, the default, 256 or 16, for ansi
+//	n         number the lines of html if it's 1 or true
+//	budget    the longest the parse may take, such as 500ms
+//
+// Parse times and request counts are served in the Prometheus text format
+// at /metrics.
+//
+// Grammars, *.tmLanguage files, are loaded at startup from the
+// directories of the -path flag, or else of the TMSERVE_PATH environment
+// variable, which are lists like PATH. Themes are loaded when first asked
+// for from the directory of the -themes flag, or else of the
+// TMSERVE_THEMES environment variable, and are named after their files:
+// the theme "Monokai" is Monokai.tmTheme, Monokai-color-theme.json or
+// Monokai.sublime-color-scheme. Grammars and themes are shared by all
+// requests.
+//
+// Every parse has a budget, the longest it may take, which a request can
+// lower but not raise. Parses running out of their budget are given up on.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var (
+	addr    = flag.String("addr", "localhost:8080", "the address to listen on")
+	path    = flag.String("path", os.Getenv("TMSERVE_PATH"), "the directories to load grammars from")
+	themes  = flag.String("themes", os.Getenv("TMSERVE_THEMES"), "the directory to load themes from")
+	theme   = flag.String("theme", "", "the name of the theme of requests without one")
+	budget  = flag.Duration("budget", 2*time.Second, "the longest a parse may take")
+	maxSize = flag.Int64("max-size", 1<<20, "the largest source in bytes")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("tmserve: ")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: tmserve [flags]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	m := newMetrics()
+	grammars := newGrammarCache(m.load)
+	for _, dir := range filepath.SplitList(*path) {
+		if dir == "" {
+			continue
+		}
+		if _, err := os.Stat(dir); err != nil {
+			log.Fatalf("Grammar directory: %s", err)
+		}
+		if err := grammars.loadDir(dir); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if len(grammars.scopes) == 0 {
+		log.Fatal("No grammars: use -path or set TMSERVE_PATH")
+	}
+	tc, err := newThemeCache(*themes)
+	if err != nil {
+		log.Fatalf("Theme directory: %s", err)
+	}
+	if *theme != "" {
+		if _, err := tc.get(*theme); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	s := &server{
+		grammars: grammars,
+		themes:   tc,
+		metrics:  m,
+		theme:    *theme,
+		maxSize:  *maxSize,
+		budget:   *budget,
+	}
+	log.Printf("Listening on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, s.handler()))
+}
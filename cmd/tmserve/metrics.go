@@ -0,0 +1,142 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// The upper bounds of the buckets of parse times, in seconds.
+var buckets = []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+type (
+	// metrics are counters and parse times by language, written in the
+	// Prometheus text format. They are safe for concurrent use.
+	metrics struct {
+		mu       sync.Mutex
+		requests map[int]uint64
+		parses   map[string]*histogram
+		exceeded map[string]uint64
+		loads    map[string]uint64
+	}
+
+	histogram struct {
+		// The number of parses of every bucket, and of those slower
+		// than the last one
+		counts []uint64
+		sum    float64
+		count  uint64
+	}
+)
+
+func newMetrics() *metrics {
+	return &metrics{
+		requests: make(map[int]uint64),
+		parses:   make(map[string]*histogram),
+		exceeded: make(map[string]uint64),
+		loads:    make(map[string]uint64),
+	}
+}
+
+// request counts a request answered with the HTTP status code.
+func (m *metrics) request(code int) {
+	m.mu.Lock()
+	m.requests[code]++
+	m.mu.Unlock()
+}
+
+// parse records a parse of the language scope which took d, and whether
+// it ran out of its budget.
+func (m *metrics) parse(scope string, d time.Duration, exceeded bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	h, ok := m.parses[scope]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(buckets)+1)}
+		m.parses[scope] = h
+	}
+	s := d.Seconds()
+	h.counts[sort.SearchFloat64s(buckets, s)]++
+	h.sum += s
+	h.count++
+	if exceeded {
+		m.exceeded[scope]++
+	}
+}
+
+// load counts the loading of another copy of the grammar scope.
+func (m *metrics) load(scope string) {
+	m.mu.Lock()
+	m.loads[scope]++
+	m.mu.Unlock()
+}
+
+func (m *metrics) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	m.mu.Lock()
+
+	buf.WriteString("# HELP tmserve_requests_total Highlighting requests by HTTP status code.\n")
+	buf.WriteString("# TYPE tmserve_requests_total counter\n")
+	var codes []int
+	for c := range m.requests {
+		codes = append(codes, c)
+	}
+	sort.Ints(codes)
+	for _, c := range codes {
+		fmt.Fprintf(&buf, "tmserve_requests_total{code=\"%d\"} %d\n", c, m.requests[c])
+	}
+
+	buf.WriteString("# HELP tmserve_parse_seconds Time taken by parses by language.\n")
+	buf.WriteString("# TYPE tmserve_parse_seconds histogram\n")
+	for _, s := range keys(m.parses) {
+		h := m.parses[s]
+		var n uint64
+		for i, b := range buckets {
+			n += h.counts[i]
+			fmt.Fprintf(&buf, "tmserve_parse_seconds_bucket{language=%q,le=\"%s\"} %d\n", s, strconv.FormatFloat(b, 'g', -1, 64), n)
+		}
+		fmt.Fprintf(&buf, "tmserve_parse_seconds_bucket{language=%q,le=\"+Inf\"} %d\n", s, h.count)
+		fmt.Fprintf(&buf, "tmserve_parse_seconds_sum{language=%q} %s\n", s, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(&buf, "tmserve_parse_seconds_count{language=%q} %d\n", s, h.count)
+	}
+
+	for _, c := range []struct {
+		name, help string
+		values     map[string]uint64
+	}{
+		{"tmserve_parse_budget_exceeded_total", "Parses given up on for running out of their budget, by language.", m.exceeded},
+		{"tmserve_grammar_loads_total", "Copies of grammars loaded because all of them were in use, by language.", m.loads},
+	} {
+		fmt.Fprintf(&buf, "# HELP %s %s\n# TYPE %s counter\n", c.name, c.help, c.name)
+		for _, s := range keys(c.values) {
+			fmt.Fprintf(&buf, "%s{language=%q} %d\n", c.name, s, c.values[s])
+		}
+	}
+	m.mu.Unlock()
+	return buf.WriteTo(w)
+}
+
+// keys returns the sorted keys of m, a map with string keys.
+func keys(m interface{}) []string {
+	var ret []string
+	switch m := m.(type) {
+	case map[string]*histogram:
+		for k := range m {
+			ret = append(ret, k)
+		}
+	case map[string]uint64:
+		for k := range m {
+			ret = append(ret, k)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
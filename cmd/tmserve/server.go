@@ -0,0 +1,171 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gbbr/textmate"
+)
+
+type (
+	server struct {
+		grammars *grammarCache
+		themes   *themeCache
+		metrics  *metrics
+		// The theme of requests without one
+		theme string
+		// The largest source and parse budget of a request
+		maxSize int64
+		budget  time.Duration
+	}
+
+	// A statusWriter remembers the status code of a response.
+	statusWriter struct {
+		http.ResponseWriter
+		code int
+	}
+)
+
+func (w *statusWriter) WriteHeader(code int) {
+	w.code = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (s *server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/highlight", func(w http.ResponseWriter, r *http.Request) {
+		sw := &statusWriter{w, http.StatusOK}
+		s.highlight(sw, r)
+		s.metrics.request(sw.code)
+	})
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		s.metrics.WriteTo(w)
+	})
+	return mux
+}
+
+// highlight answers a POST of source with it highlighted, as described
+// in the documentation of the command.
+func (s *server) highlight(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "Only POST is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	q := r.URL.Query()
+	data, err := ioutil.ReadAll(io.LimitReader(r.Body, s.maxSize+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if int64(len(data)) > s.maxSize {
+		http.Error(w, fmt.Sprintf("The source is larger than %d bytes", s.maxSize), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	scope, err := s.grammars.resolve(q.Get("language"), q.Get("filename"), data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	budget := s.budget
+	if b := q.Get("budget"); b != "" {
+		d, err := time.ParseDuration(b)
+		if err != nil || d <= 0 {
+			http.Error(w, fmt.Sprintf("Invalid budget %q", b), http.StatusBadRequest)
+			return
+		}
+		if d < budget {
+			budget = d
+		}
+	}
+
+	format := q.Get("format")
+	var (
+		th   *textmate.Theme
+		mode = textmate.TrueColor
+	)
+	switch format {
+	case "", "html", "ansi":
+		name := q.Get("theme")
+		if name == "" {
+			name = s.theme
+		}
+		if th, err = s.themes.get(name); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+	case "json":
+	default:
+		http.Error(w, fmt.Sprintf("Unknown format %q", format), http.StatusBadRequest)
+		return
+	}
+	switch c := q.Get("colors"); c {
+	case "", "truecolor":
+	case "256":
+		mode = textmate.Color256
+	case "16":
+		mode = textmate.Color16
+	default:
+		http.Error(w, fmt.Sprintf("Unknown colours %q", c), http.StatusBadRequest)
+		return
+	}
+
+	l, err := s.grammars.get(scope)
+	if err != nil {
+		log.Printf("Grammar %s: %s", scope, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	start := time.Now()
+	lp := textmate.NewLanguageParserFor(l, data)
+	lp.Deadline = start.Add(budget)
+	root, err := lp.Parse()
+	elapsed := time.Since(start)
+	s.grammars.put(l)
+	s.metrics.parse(scope, elapsed, err == textmate.ErrDeadline)
+	switch {
+	case err == textmate.ErrDeadline:
+		http.Error(w, fmt.Sprintf("The source couldn't be parsed within %s", budget), http.StatusUnprocessableEntity)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	case root == nil:
+		http.Error(w, "The source couldn't be parsed", http.StatusInternalServerError)
+		return
+	}
+
+	var (
+		buf   bytes.Buffer
+		ctype string
+	)
+	switch format {
+	case "json":
+		ctype = "application/json"
+		err = textmate.EncodeTokens(&buf, textmate.Tokens(root), &textmate.JSONOptions{Intern: true})
+	case "ansi":
+		ctype = "text/plain; charset=utf-8"
+		err = textmate.WriteANSI(&buf, root, th, &textmate.ANSIOptions{Mode: mode})
+	default:
+		ctype = "text/html; charset=utf-8"
+		n := q.Get("n")
+		err = textmate.WriteHTML(&buf, root, th, &textmate.HTMLOptions{LineNumbers: n == "1" || n == "true"})
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", ctype)
+	w.Header().Set("Server-Timing", fmt.Sprintf("parse;dur=%.3f", elapsed.Seconds()*1000))
+	buf.WriteTo(w)
+}
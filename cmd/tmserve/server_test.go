@@ -0,0 +1,327 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gbbr/textmate"
+	"github.com/gbbr/textmate/vendor/limetext/lime-backend/lib/util"
+)
+
+func newTestServer(t *testing.T) *server {
+	m := newMetrics()
+	grammars := newGrammarCache(m.load)
+	if err := grammars.loadDir("../../testdata"); err != nil {
+		t.Fatal(err)
+	}
+	themes, err := newThemeCache("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &server{
+		grammars: grammars,
+		themes:   themes,
+		metrics:  m,
+		theme:    "Monokai",
+		maxSize:  1 << 20,
+		// Long enough for parses run with the race detector
+		budget: time.Minute,
+	}
+}
+
+// post sends data to /highlight with the query, and returns the response.
+func post(s *server, query string, data []byte) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "/highlight?"+query, bytes.NewReader(data))
+	s.handler().ServeHTTP(w, r)
+	return w
+}
+
+func readFile(t *testing.T, fn string) []byte {
+	data, err := ioutil.ReadFile(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestHighlight(t *testing.T) {
+	s := newTestServer(t)
+	src := readFile(t, "../../testdata/main.go")
+	tests := []struct {
+		method, query string
+		data          []byte
+		code          int
+		ctype         string
+	}{
+		{"POST", "language=go", src, 200, "text/html; charset=utf-8"},
+		{"POST", "filename=main.go&format=ansi&colors=256", src, 200, "text/plain; charset=utf-8"},
+		{"POST", "language=source.go&format=json", src, 200, "application/json"},
+		{"POST", "language=go&theme=Mariana&n=1", src, 200, "text/html; charset=utf-8"},
+		{"GET", "language=go", nil, 405, ""},
+		{"POST", "language=rust", src, 400, ""},
+		{"POST", "filename=main.rs", src, 400, ""},
+		{"POST", "", src, 400, ""},
+		{"POST", "language=go&format=pdf", src, 400, ""},
+		{"POST", "language=go&format=ansi&colors=8", src, 400, ""},
+		{"POST", "language=go&budget=soon", src, 400, ""},
+		{"POST", "language=go&budget=-1s", src, 400, ""},
+		{"POST", "language=go&theme=Solarized", src, 404, ""},
+		{"POST", "language=go", make([]byte, 1<<20+1), 413, ""},
+	}
+	for i, test := range tests {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(test.method, "/highlight?"+test.query, bytes.NewReader(test.data))
+		s.handler().ServeHTTP(w, r)
+		if w.Code != test.code {
+			t.Errorf("Test %d: Expected the status %d, but got %d: %s", i, test.code, w.Code, w.Body)
+		} else if ct := w.Header().Get("Content-Type"); test.ctype != "" && ct != test.ctype {
+			t.Errorf("Test %d: Expected the content type %q, but got %q", i, test.ctype, ct)
+		}
+	}
+
+	// The tokens are those of the highlighting package
+	w := post(s, "language=go&format=json", src)
+	toks, err := textmate.DecodeTokens(w.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := textmate.Provider.LanguageFromFile("../../testdata/Go.tmLanguage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := textmate.NewLanguageParserFor(l, src).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := textmate.Tokens(root); !reflect.DeepEqual(toks, exp) {
+		t.Errorf("Expected the tokens %v, but got %v", exp, toks)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	s := newTestServer(t)
+	tests := []struct {
+		language, filename, data string
+		exp                      string
+	}{
+		{"go", "", "", "source.go"},
+		{"source.go", "main.txt", "", "source.go"},
+		{"text.xml.plist", "", "", "text.xml.plist"},
+		{"plist", "", "", ""},
+		{"", "main.go", "", "source.go"},
+		{"", "dir/main.go", "", "source.go"},
+		{"", "script", "// -*- mode: go -*-\npackage main\n", "source.go"},
+		{"", "notgo", "", ""},
+		{"", "script", "package main\n// -*- go -*-\n", ""},
+		{"xml", "main.go", "", ""},
+		{"", "", "package main\n", ""},
+	}
+	for i, test := range tests {
+		scope, err := s.grammars.resolve(test.language, test.filename, []byte(test.data))
+		if test.exp == "" {
+			if err == nil {
+				t.Errorf("Test %d: Expected an error, but got %q", i, scope)
+			}
+		} else if err != nil {
+			t.Errorf("Test %d: %s", i, err)
+		} else if scope != test.exp {
+			t.Errorf("Test %d: Expected %q, but got %q", i, test.exp, scope)
+		}
+	}
+}
+
+func TestBudget(t *testing.T) {
+	s := newTestServer(t)
+	src := readFile(t, "../../testdata/main.go")
+	w := post(s, "language=go&format=json", src)
+	if w.Code != 200 {
+		t.Fatalf("Expected the status 200, but got %d: %s", w.Code, w.Body)
+	}
+	exp := w.Body.String()
+
+	if w := post(s, "language=go&format=json&budget=1ns", src); w.Code != 422 {
+		t.Errorf("Expected the status 422, but got %d: %s", w.Code, w.Body)
+	}
+	// The grammar given up on is back in the cache, and still works
+	if n := len(s.grammars.known["source.go"].idle); n != 1 {
+		t.Errorf("Expected 1 idle copy of the grammar, but got %d", n)
+	}
+	w = post(s, "language=go&format=json", src)
+	if w.Code != 200 {
+		t.Fatalf("Expected the status 200, but got %d: %s", w.Code, w.Body)
+	}
+	if diff := util.Diff(exp, w.Body.String()); diff != "" {
+		t.Error(diff)
+	}
+	if n := s.metrics.loads["source.go"]; n != 0 {
+		t.Errorf("Expected no more copies of the grammar to be loaded, but got %d", n)
+	}
+	if n := s.metrics.exceeded["source.go"]; n != 1 {
+		t.Errorf("Expected 1 parse over budget, but got %d", n)
+	}
+}
+
+func TestConcurrentRequests(t *testing.T) {
+	s := newTestServer(t)
+	var (
+		files = []string{"main.go", "utf.go", "go2.go"}
+		srcs  = make([][]byte, len(files))
+		exp   = make([]string, len(files))
+	)
+	for i, fn := range files {
+		srcs[i] = readFile(t, "../../testdata/"+fn)
+		exp[i] = post(s, "filename="+fn+"&format=json", srcs[i]).Body.String()
+	}
+
+	const n = 12
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []string
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			j := i % len(files)
+			format := "json"
+			if i%2 == 1 {
+				format = "html"
+			}
+			w := post(s, "filename="+files[j]+"&format="+format, srcs[j])
+			mu.Lock()
+			defer mu.Unlock()
+			if w.Code != 200 {
+				errs = append(errs, w.Body.String())
+			} else if format == "json" && w.Body.String() != exp[j] {
+				errs = append(errs, "Unexpected tokens of "+files[j])
+			}
+		}(i)
+	}
+	wg.Wait()
+	if len(errs) != 0 {
+		t.Errorf("Expected no errors, but got %s", strings.Join(errs, "\n"))
+	}
+	// All copies of the grammars loaded are kept, up to maxIdle of them
+	if n, loads := len(s.grammars.known["source.go"].idle), s.metrics.loads["source.go"]; uint64(n) != loads+1 && n != maxIdle {
+		t.Errorf("Expected %d idle copies of the grammar, but got %d", loads+1, n)
+	}
+	if c := s.metrics.requests[200]; c != n+uint64(len(files)) {
+		t.Errorf("Expected %d requests, but got %d", n+len(files), c)
+	}
+}
+
+func TestThemeCache(t *testing.T) {
+	c, err := newThemeCache("../../testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		wg     sync.WaitGroup
+		themes = make([]*textmate.Theme, 4)
+	)
+	for i := range themes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			th, err := c.get("Monokai")
+			if err != nil {
+				t.Error(err)
+			}
+			themes[i] = th
+		}(i)
+	}
+	wg.Wait()
+	for i := range themes {
+		if themes[i] == nil || themes[i] != themes[0] {
+			t.Errorf("Expected a single copy of the theme, but got %p and %p", themes[0], themes[i])
+		}
+	}
+	if _, err := c.get("Solarized"); err == nil {
+		t.Error("Expected an error for an unknown theme")
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	m := newMetrics()
+	m.request(http.StatusOK)
+	m.request(http.StatusUnprocessableEntity)
+	m.request(http.StatusOK)
+	m.parse("source.go", 500*time.Millisecond, false)
+	m.parse("source.go", 2*time.Second, true)
+	m.parse("text.xml.plist", 20*time.Millisecond, false)
+	m.load("source.go")
+
+	var buf bytes.Buffer
+	if _, err := m.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	const exp = `# HELP tmserve_requests_total Highlighting requests by HTTP status code.
+# TYPE tmserve_requests_total counter
+tmserve_requests_total{code="200"} 2
+tmserve_requests_total{code="422"} 1
+# HELP tmserve_parse_seconds Time taken by parses by language.
+# TYPE tmserve_parse_seconds histogram
+tmserve_parse_seconds_bucket{language="source.go",le="0.001"} 0
+tmserve_parse_seconds_bucket{language="source.go",le="0.0025"} 0
+tmserve_parse_seconds_bucket{language="source.go",le="0.005"} 0
+tmserve_parse_seconds_bucket{language="source.go",le="0.01"} 0
+tmserve_parse_seconds_bucket{language="source.go",le="0.025"} 0
+tmserve_parse_seconds_bucket{language="source.go",le="0.05"} 0
+tmserve_parse_seconds_bucket{language="source.go",le="0.1"} 0
+tmserve_parse_seconds_bucket{language="source.go",le="0.25"} 0
+tmserve_parse_seconds_bucket{language="source.go",le="0.5"} 1
+tmserve_parse_seconds_bucket{language="source.go",le="1"} 1
+tmserve_parse_seconds_bucket{language="source.go",le="2.5"} 2
+tmserve_parse_seconds_bucket{language="source.go",le="5"} 2
+tmserve_parse_seconds_bucket{language="source.go",le="10"} 2
+tmserve_parse_seconds_bucket{language="source.go",le="+Inf"} 2
+tmserve_parse_seconds_sum{language="source.go"} 2.5
+tmserve_parse_seconds_count{language="source.go"} 2
+tmserve_parse_seconds_bucket{language="text.xml.plist",le="0.001"} 0
+tmserve_parse_seconds_bucket{language="text.xml.plist",le="0.0025"} 0
+tmserve_parse_seconds_bucket{language="text.xml.plist",le="0.005"} 0
+tmserve_parse_seconds_bucket{language="text.xml.plist",le="0.01"} 0
+tmserve_parse_seconds_bucket{language="text.xml.plist",le="0.025"} 1
+tmserve_parse_seconds_bucket{language="text.xml.plist",le="0.05"} 1
+tmserve_parse_seconds_bucket{language="text.xml.plist",le="0.1"} 1
+tmserve_parse_seconds_bucket{language="text.xml.plist",le="0.25"} 1
+tmserve_parse_seconds_bucket{language="text.xml.plist",le="0.5"} 1
+tmserve_parse_seconds_bucket{language="text.xml.plist",le="1"} 1
+tmserve_parse_seconds_bucket{language="text.xml.plist",le="2.5"} 1
+tmserve_parse_seconds_bucket{language="text.xml.plist",le="5"} 1
+tmserve_parse_seconds_bucket{language="text.xml.plist",le="10"} 1
+tmserve_parse_seconds_bucket{language="text.xml.plist",le="+Inf"} 1
+tmserve_parse_seconds_sum{language="text.xml.plist"} 0.02
+tmserve_parse_seconds_count{language="text.xml.plist"} 1
+# HELP tmserve_parse_budget_exceeded_total Parses given up on for running out of their budget, by language.
+# TYPE tmserve_parse_budget_exceeded_total counter
+tmserve_parse_budget_exceeded_total{language="source.go"} 1
+# HELP tmserve_grammar_loads_total Copies of grammars loaded because all of them were in use, by language.
+# TYPE tmserve_grammar_loads_total counter
+tmserve_grammar_loads_total{language="source.go"} 1
+`
+	if diff := util.Diff(exp, buf.String()); diff != "" {
+		t.Error(diff)
+	}
+
+	// And served at /metrics
+	s := &server{metrics: m}
+	w := httptest.NewRecorder()
+	s.handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	if w.Body.String() != exp {
+		t.Errorf("Expected the metrics to be served, but got %s", w.Body)
+	}
+}
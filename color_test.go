@@ -0,0 +1,91 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		in  string
+		exp Color
+		str string
+	}{
+		{"#F80", Color{0xff, 0x88, 0x00, 0xff}, "#FF8800"},
+		{"#f808", Color{0xff, 0x88, 0x00, 0x88}, "#FF880088"},
+		{"#272822", Color{0x27, 0x28, 0x22, 0xff}, "#272822"},
+		{"#FFFFFF40", Color{0xff, 0xff, 0xff, 0x40}, "#FFFFFF40"},
+		{"red", Color{0xff, 0x00, 0x00, 0xff}, "#FF0000"},
+		{"RebeccaPurple", Color{0x66, 0x33, 0x99, 0xff}, "#663399"},
+		{"transparent", Color{}, "#00000000"},
+	}
+	for _, test := range tests {
+		if c, err := ParseColor(test.in); err != nil {
+			t.Errorf("%s: %s", test.in, err)
+		} else if c != test.exp || c.String() != test.str {
+			t.Errorf("%s: Expected %v (%s), but got %v (%s)", test.in, test.exp, test.str, c, c.String())
+		}
+	}
+	for _, in := range []string{"", "#", "#12", "#12345", "#1234567", "#GGHHII", "reddish", "0x00FF0000"} {
+		if _, err := ParseColor(in); err == nil {
+			t.Errorf("%q: Expected an error", in)
+		}
+	}
+}
+
+func TestColorOver(t *testing.T) {
+	tests := []struct {
+		fg, bg, exp string
+	}{
+		{"#FF0000", "#0000FF", "#FF0000"},
+		{"#FF000080", "#0000FF", "#80007F"},
+		{"#FFFFFF40", "#000000", "#404040"},
+		{"#00000000", "#123456", "#123456"},
+		{"#FF000080", "#0000FF00", "#FF000080"},
+	}
+	for _, test := range tests {
+		fg, _ := ParseColor(test.fg)
+		bg, _ := ParseColor(test.bg)
+		if got := fg.Over(bg).String(); got != test.exp {
+			t.Errorf("%s over %s: Expected %s, but got %s", test.fg, test.bg, test.exp, got)
+		}
+	}
+}
+
+func TestThemeOpaqueStyle(t *testing.T) {
+	const js = `{"settings": [
+		{"settings": {"foreground": "#FFFFFF", "background": "#000000"}},
+		{"scope": "comment", "settings": {"foreground": "#FFFFFF80"}},
+		{"scope": "invalid", "settings": {"background": "#FF000080"}}
+	]}`
+	var th Theme
+	if err := json.Unmarshal([]byte(js), &th); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		scope, fg, bg string
+	}{
+		{"source comment", "#808080", "#000000"},
+		{"source invalid", "#FFFFFF", "#800000"},
+		{"source invalid comment", "#C08080", "#800000"},
+	}
+	for _, test := range tests {
+		s := th.OpaqueStyle(test.scope)
+		if fg, bg := s.Foreground.String(), s.Background.String(); fg != test.fg || bg != test.bg {
+			t.Errorf("%s: Expected %s on %s, but got %s on %s", test.scope, test.fg, test.bg, fg, bg)
+		}
+	}
+	if s := th.Style("source comment"); s.Foreground.String() != "#FFFFFF80" {
+		t.Errorf("Expected Style to keep the alpha, but got %s", s.Foreground)
+	}
+
+	err := json.Unmarshal([]byte(`{"settings": [{"settings": {"foreground": "#12345"}}]}`), &th)
+	if err == nil || !strings.Contains(err.Error(), "foreground") {
+		t.Errorf("Expected an error about the foreground, but got %v", err)
+	}
+}
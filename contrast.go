@@ -0,0 +1,233 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// The WCAG contrast ratios normal text needs to meet levels AA and AAA.
+const (
+	AA  = 4.5
+	AAA = 7.0
+)
+
+type (
+	// A ContrastReport is the result of checking the contrast of a
+	// Theme.
+	ContrastReport struct {
+		// The contrast of every resolved foreground against the
+		// backgrounds it's shown on, in the order of the theme's rules
+		Checks []ContrastCheck
+		// The pairs of rules whose foregrounds differ, but are too
+		// similar to tell apart
+		Similar []SimilarColors
+	}
+
+	// A ContrastCheck is the contrast of the foreground of text styled
+	// by a rule against a background it's shown on.
+	ContrastCheck struct {
+		// The rule, or the theme's global settings for plain text
+		Rule *ScopeSetting
+		// The scope stack the rule was resolved for
+		Scope string
+		// Which background it's shown on: "background", "selection"
+		// or "lineHighlight"
+		On string
+		// The colours, composited so they're opaque
+		Foreground, Background Color
+		// The WCAG contrast ratio of the colours, from 1 to 21
+		Ratio float64
+	}
+
+	// SimilarColors are the foregrounds of two rules which are too
+	// similar to tell apart.
+	SimilarColors struct {
+		A, B *ScopeSetting
+		// The colours, composited so they're opaque
+		ColorA, ColorB Color
+		// The CIE76 distance of the colours
+		Distance float64
+	}
+)
+
+// Luminance returns the relative luminance of c as WCAG defines it, from 0
+// for black to 1 for white. Its alpha is ignored.
+func (c Color) Luminance() float64 {
+	return 0.2126*linear(c.R) + 0.7152*linear(c.G) + 0.0722*linear(c.B)
+}
+
+// Contrast returns the WCAG contrast ratio of a and b, from 1 to 21. Their
+// alphas are ignored.
+func Contrast(a, b Color) float64 {
+	la, lb := a.Luminance(), b.Luminance()
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// ColorDistance returns the CIE76 distance of a and b, the distance of
+// their CIELAB coordinates, where about 2.3 is just noticeable. Their
+// alphas are ignored.
+func ColorDistance(a, b Color) float64 {
+	la, aa, ba := a.lab()
+	lb, ab, bb := b.lab()
+	return math.Sqrt((la-lb)*(la-lb) + (aa-ab)*(aa-ab) + (ba-bb)*(ba-bb))
+}
+
+// linear returns the sRGB component v as linear light.
+func linear(v uint8) float64 {
+	f := float64(v) / 255
+	if f <= 0.04045 {
+		return f / 12.92
+	}
+	return math.Pow((f+0.055)/1.055, 2.4)
+}
+
+// lab returns c in CIELAB, relative to the D65 white point.
+func (c Color) lab() (l, a, b float64) {
+	r, g, bl := linear(c.R), linear(c.G), linear(c.B)
+	x := (0.4124*r + 0.3576*g + 0.1805*bl) / 0.95047
+	y := 0.2126*r + 0.7152*g + 0.0722*bl
+	z := (0.0193*r + 0.1192*g + 0.9505*bl) / 1.08883
+	f := func(t float64) float64 {
+		if t > 216.0/24389 {
+			return math.Cbrt(t)
+		}
+		return (24389.0/27*t + 16) / 116
+	}
+	x, y, z = f(x), f(y), f(z)
+	return 116*y - 16, 500 * (x - y), 200 * (y - z)
+}
+
+// CheckContrast checks the contrast of the text t styles.
+//
+// Every rule is resolved for the scope stacks its selector names, and the
+// global settings for plain text. The foreground of each is checked against
+// its background, and against the selection and line highlight of the
+// theme composited onto that. Of the foregrounds, those closer than
+// distance to each other, but not equal, are reported as too similar.
+func (t *Theme) CheckContrast(distance float64) *ContrastReport {
+	var (
+		ret    = &ContrastReport{}
+		global = t.ClosestMatchingSetting("")
+		fgs    []SimilarColors
+	)
+	check := func(rule *ScopeSetting, scope string) {
+		s := t.OpaqueStyle(scope)
+		// The foreground of s is composited onto its background, so
+		// the translucent one is composited onto each background
+		fg := t.Style(scope).Foreground
+		add := func(on string, fg, bg Color) {
+			fg, bg = fg.Over(bg), bg.Over(Color{A: 0xff})
+			ret.Checks = append(ret.Checks, ContrastCheck{rule, scope, on, fg, bg, Contrast(fg, bg)})
+		}
+		add("background", fg, s.Background)
+		if c := t.Global.LineHighlight; c != nil {
+			add("lineHighlight", fg, c.Over(s.Background))
+		}
+		if c := t.Global.Selection; c != nil {
+			sfg := fg
+			if sf := t.Global.SelectionForeground; sf != nil {
+				sfg = *sf
+			}
+			add("selection", sfg, c.Over(s.Background))
+		}
+		fgs = append(fgs, SimilarColors{A: rule, ColorA: s.Foreground})
+	}
+	if global != nil && global.selector == nil {
+		check(global, "")
+	}
+	for i := range t.Settings {
+		s := &t.Settings[i]
+		if s.selector == nil {
+			continue
+		}
+		for _, stack := range s.selector.examples() {
+			check(s, strings.Join(stack, " "))
+		}
+	}
+
+	seen := make(map[[2]Color]bool)
+	for i, a := range fgs {
+		for _, b := range fgs[i+1:] {
+			// Either way round, as a pair is only reported once
+			pair := [2]Color{a.ColorA, b.ColorA}
+			if a.A == b.A || a.ColorA == b.ColorA || seen[pair] || seen[[2]Color{b.ColorA, a.ColorA}] {
+				continue
+			}
+			if d := ColorDistance(a.ColorA, b.ColorA); d < distance {
+				seen[pair] = true
+				ret.Similar = append(ret.Similar, SimilarColors{a.A, b.A, a.ColorA, b.ColorA, d})
+			}
+		}
+	}
+	return ret
+}
+
+// Failures returns the checks of r whose ratio is below min, such as AA.
+func (r *ContrastReport) Failures(min float64) (ret []ContrastCheck) {
+	for _, c := range r.Checks {
+		if c.Ratio < min {
+			ret = append(ret, c)
+		}
+	}
+	return
+}
+
+// Level returns the highest WCAG level the contrast of c meets, "AAA" or
+// "AA", or "fail" if it meets neither.
+func (c ContrastCheck) Level() string {
+	switch {
+	case c.Ratio >= AAA:
+		return "AAA"
+	case c.Ratio >= AA:
+		return "AA"
+	}
+	return "fail"
+}
+
+func (c ContrastCheck) String() string {
+	name := ruleName(c.Rule)
+	if c.Rule.selector != nil {
+		name = strings.TrimSpace(fmt.Sprintf("%s [%s]", c.Rule.Name, c.Scope))
+	}
+	return fmt.Sprintf("%s: %s on %s (%s): %.2f:1 %s", name, c.Foreground, c.Background, c.On, c.Ratio, c.Level())
+}
+
+func (s SimilarColors) String() string {
+	return fmt.Sprintf("%s (%s) and %s (%s): distance %.1f", ruleName(s.A), s.ColorA, ruleName(s.B), s.ColorB, s.Distance)
+}
+
+// Summary returns the checks of r whose contrast is below min, and the
+// similar colours, one per line.
+func (r *ContrastReport) Summary(min float64) string {
+	var buf bytes.Buffer
+	fails := r.Failures(min)
+	fmt.Fprintf(&buf, "%d of %d checks below %.1f:1\n", len(fails), len(r.Checks), min)
+	for _, c := range fails {
+		fmt.Fprintf(&buf, "\t%s\n", c)
+	}
+	fmt.Fprintf(&buf, "%d pairs of similar colours\n", len(r.Similar))
+	for _, s := range r.Similar {
+		fmt.Fprintf(&buf, "\t%s\n", s)
+	}
+	return buf.String()
+}
+
+// ruleName returns how s is shown in reports.
+func ruleName(s *ScopeSetting) string {
+	switch {
+	case s.selector == nil:
+		return "global settings"
+	case s.Name == "":
+		return s.Scope
+	}
+	return fmt.Sprintf("%s (%s)", s.Name, s.Scope)
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestContrast(t *testing.T) {
+	tests := []struct {
+		a, b string
+		exp  float64
+	}{
+		{"#000000", "#FFFFFF", 21},
+		{"#FFFFFF", "#000000", 21},
+		{"#777777", "#777777", 1},
+		{"#777777", "#FFFFFF", 4.48},
+		{"#F8F8F2", "#272822", 13.94},
+	}
+	for _, test := range tests {
+		a, _ := ParseColor(test.a)
+		b, _ := ParseColor(test.b)
+		if got := Contrast(a, b); math.Abs(got-test.exp) > 0.01 {
+			t.Errorf("%s on %s: Expected %.2f, but got %.2f", test.a, test.b, test.exp, got)
+		}
+	}
+
+	red, _ := ParseColor("red")
+	if d := ColorDistance(red, red); d != 0 {
+		t.Errorf("Expected no distance, but got %f", d)
+	}
+	if d := ColorDistance(Color{A: 0xff}, Color{0xff, 0xff, 0xff, 0xff}); math.Abs(d-100) > 0.01 {
+		t.Errorf("Expected black and white to be 100 apart, but got %f", d)
+	}
+}
+
+func TestCheckContrast(t *testing.T) {
+	const js = `{
+		"name": "Contrast",
+		"settings": [
+			{"settings": {"foreground": "#FFFFFF", "background": "#000000", "selection": "#FFFFFF80", "lineHighlight": "#333333"}},
+			{"name": "Comment", "scope": "comment", "settings": {"foreground": "#555555"}},
+			{"name": "String", "scope": "string, constant.character", "settings": {"foreground": "#E6DB74"}},
+			{"name": "Number", "scope": "constant.numeric", "settings": {"foreground": "#E8DC70"}},
+			{"name": "Keyword", "scope": "source keyword - keyword.operator", "settings": {"foreground": "#888888"}}
+		]
+	}`
+	var th Theme
+	if err := json.Unmarshal([]byte(js), &th); err != nil {
+		t.Fatal(err)
+	}
+	r := th.CheckContrast(10)
+	var scopes []string
+	for _, c := range r.Checks {
+		if c.On == "background" {
+			scopes = append(scopes, c.Scope)
+		}
+	}
+	if exp, got := "|comment|string|constant.character|constant.numeric|source keyword", strings.Join(scopes, "|"); got != exp {
+		t.Errorf("Expected the scopes %s, but got %s", exp, got)
+	}
+	if len(r.Checks) != 3*len(scopes) {
+		t.Errorf("Expected %d checks, but got %d", 3*len(scopes), len(r.Checks))
+	}
+
+	fails := make(map[string]string)
+	for _, c := range r.Failures(AA) {
+		fails[c.Rule.Name+" "+c.On] = c.Level()
+	}
+	for _, exp := range []string{"Comment background", "Comment selection", "Keyword selection", " selection"} {
+		if fails[exp] != "fail" {
+			t.Errorf("Expected %q to fail, but got %v", exp, fails)
+		}
+	}
+	if _, ok := fails["String background"]; ok {
+		t.Errorf("Expected the strings to pass, but got %v", fails)
+	}
+	for _, c := range r.Checks {
+		if c.Rule.Name == "" && c.On == "selection" && c.Background.String() != "#808080" {
+			t.Errorf("Expected the selection to be composited onto the background, but got %s", c.Background)
+		}
+	}
+
+	if len(r.Similar) != 1 {
+		t.Fatalf("Expected one pair of similar colours, but got %v", r.Similar)
+	} else if s := r.Similar[0]; s.A.Name != "String" || s.B.Name != "Number" {
+		t.Errorf("Expected the strings and numbers to be too similar, but got %s", s)
+	}
+	if s := r.Summary(AAA); !strings.Contains(s, "1 pairs of similar colours") || !strings.Contains(s, "Comment [comment]: #555555 on #000000 (background)") {
+		t.Errorf("Unexpected summary %s", s)
+	}
+}
+
+func TestCheckContrastComposite(t *testing.T) {
+	const js = `{
+		"settings": [
+			{"settings": {"foreground": "#FFFFFF80", "background": "#000000", "lineHighlight": "#FFFFFF"}},
+			{"name": "String", "scope": "string", "settings": {"foreground": "#E6DB74"}},
+			{"name": "Number", "scope": "constant.numeric", "settings": {"foreground": "#E8DC70"}},
+			{"name": "Character", "scope": "constant.character", "settings": {"foreground": "#E6DB74"}}
+		]
+	}`
+	var th Theme
+	if err := json.Unmarshal([]byte(js), &th); err != nil {
+		t.Fatal(err)
+	}
+	r := th.CheckContrast(10)
+	// The translucent foreground is composited onto the line highlight
+	// rather than the background
+	for _, c := range r.Checks {
+		if c.Rule.selector == nil && c.On == "lineHighlight" && (c.Foreground.String() != "#FFFFFF" || c.Ratio != 1) {
+			t.Errorf("Expected white on white, but got %s", c)
+		}
+	}
+	// The characters and numbers are the strings and numbers the
+	// other way round
+	if len(r.Similar) != 1 {
+		t.Errorf("Expected one pair of similar colours, but got %v", r.Similar)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bytes"
+	"encoding/binary"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+type encoding int
+
+const (
+	encUTF8 encoding = iota
+	encUTF16LE
+	encUTF16BE
+)
+
+var (
+	bomUTF8    = []byte{0xef, 0xbb, 0xbf}
+	bomUTF16LE = []byte{0xff, 0xfe}
+	bomUTF16BE = []byte{0xfe, 0xff}
+)
+
+// A source is raw input decoded into the UTF-8 text the parser
+// works on, along with what is needed to map offsets in the text
+// back to offsets in the original input.
+type source struct {
+	text string
+	// The byte offset in the original input of every byte in text,
+	// plus one entry for len(text). Nil if text is the original input.
+	orig []int
+	// Offsets in text of the '\n' of every "\r\n" in the input.
+	// The '\r' is removed from text, but still counted when
+	// offsets are given in runes or UTF-16 code units.
+	crlf []int
+}
+
+// detect returns the encoding of data and the length of its byte order mark.
+// Input without a BOM is taken to be UTF-16 if it looks like ASCII text with
+// every other byte zero, and UTF-8 otherwise.
+func detect(data []byte) (encoding, int) {
+	switch {
+	case bytes.HasPrefix(data, bomUTF8):
+		return encUTF8, len(bomUTF8)
+	case bytes.HasPrefix(data, bomUTF16LE):
+		return encUTF16LE, len(bomUTF16LE)
+	case bytes.HasPrefix(data, bomUTF16BE):
+		return encUTF16BE, len(bomUTF16BE)
+	}
+	n := len(data) &^ 1
+	if n > 512 {
+		n = 512
+	}
+	if n == 0 {
+		return encUTF8, 0
+	}
+	var even, odd int
+	for i := 0; i < n; i += 2 {
+		if data[i] == 0 {
+			even++
+		}
+		if data[i+1] == 0 {
+			odd++
+		}
+	}
+	switch half := n / 2; {
+	case odd == half && even == 0:
+		return encUTF16LE, 0
+	case even == half && odd == 0:
+		return encUTF16BE, 0
+	}
+	return encUTF8, 0
+}
+
+// decode converts data into a source. A UTF-8 or UTF-16 byte order mark is
+// stripped, UTF-16 input is converted to UTF-8 and "\r\n" line endings are
+// turned into "\n". Every byte of invalid UTF-8 and every unpaired UTF-16
+// surrogate is replaced by one utf8.RuneError.
+func decode(data []byte) *source {
+	enc, bom := detect(data)
+	return decodeAs(data, enc, bom)
+}
+
+// decodeAs is like decode, but for data in the encoding enc, starting with
+// a byte order mark of bom bytes.
+func decodeAs(data []byte, enc encoding, bom int) *source {
+	if enc == encUTF8 && bom == 0 && utf8.Valid(data) && bytes.IndexByte(data, '\r') == -1 {
+		return &source{text: string(data)}
+	}
+	var (
+		s    source
+		buf  = make([]byte, 0, len(data))
+		orig = make([]int, 0, len(data)+1)
+		emit = func(r rune, at int) {
+			buf = utf8.AppendRune(buf, r)
+			for len(orig) < len(buf) {
+				orig = append(orig, at)
+			}
+		}
+		order binary.ByteOrder = binary.LittleEndian
+	)
+	if enc == encUTF16BE {
+		order = binary.BigEndian
+	}
+	for i := bom; i < len(data); {
+		var (
+			r    rune
+			size int
+		)
+		if enc == encUTF8 {
+			r, size = utf8.DecodeRune(data[i:])
+		} else if i+1 == len(data) {
+			// A dangling odd byte can't be a code unit
+			r, size = utf8.RuneError, 1
+		} else {
+			r, size = rune(order.Uint16(data[i:])), 2
+			if utf16.IsSurrogate(r) {
+				r = utf8.RuneError
+				if i+3 < len(data) {
+					if r2 := utf16.DecodeRune(rune(order.Uint16(data[i:])), rune(order.Uint16(data[i+2:]))); r2 != utf8.RuneError {
+						r, size = r2, 4
+					}
+				}
+			}
+		}
+		if r == '\r' {
+			if peek(data, i+size, enc, order) == '\n' {
+				s.crlf = append(s.crlf, len(buf))
+				emit('\n', i)
+				i += 2 * size
+				continue
+			}
+		}
+		emit(r, i)
+		i += size
+	}
+	s.text = string(buf)
+	s.orig = append(orig, len(data))
+	return &s
+}
+
+// peek returns the code unit at offset i of data, or -1 if there is none.
+func peek(data []byte, i int, enc encoding, order binary.ByteOrder) rune {
+	if enc == encUTF8 {
+		if i < len(data) {
+			return rune(data[i])
+		}
+	} else if i+1 < len(data) {
+		return rune(order.Uint16(data[i:]))
+	}
+	return -1
+}
+
+// table returns the lookup table mapping byte offsets of s.text to offsets
+// in unit u of the original input. See offsetTable.
+func (s *source) table(u OffsetUnit) []int {
+	if u == ByteOffsets {
+		return s.orig
+	}
+	lut := offsetTable(s.text, u)
+	if len(s.crlf) == 0 {
+		return lut
+	}
+	// Every removed '\r' shifts the offsets following it by one
+	shift := 0
+	for i := range lut {
+		lut[i] += shift
+		if shift < len(s.crlf) && s.crlf[shift] == i {
+			shift++
+		}
+	}
+	return lut
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		in   string
+		text string
+		orig []int
+		crlf []int
+	}{
+		{"abc", "abc", nil, nil},
+		{"\xef\xbb\xbfab", "ab", []int{3, 4, 5}, nil},
+		{"a\r\nb", "a\nb", []int{0, 1, 3, 4}, []int{1}},
+		{"a\rb", "a\rb", []int{0, 1, 2, 3}, nil},
+		{"a\xffb", "a�b", []int{0, 1, 1, 1, 2, 3}, nil},
+		{"\xff\xfea\x00\r\x00\n\x00", "a\n", []int{2, 4, 8}, []int{1}},
+		{"\xfe\xff\x00a\xd8\x34\xdd\x1e", "a\U0001d11e", []int{2, 4, 4, 4, 4, 8}, nil},
+		{"a\x00b\x00", "ab", []int{0, 2, 4}, nil},
+		{"\xff\xfe\x00\xd8a\x00", "�a", []int{2, 2, 2, 4, 6}, nil},
+	}
+	for i, test := range tests {
+		s := decode([]byte(test.in))
+		if s.text != test.text {
+			t.Errorf("Test %d: Expected text %q, but got %q", i, test.text, s.text)
+		}
+		if !reflect.DeepEqual(s.orig, test.orig) {
+			t.Errorf("Test %d: Expected offsets %v, but got %v", i, test.orig, s.orig)
+		}
+		if !reflect.DeepEqual(s.crlf, test.crlf) {
+			t.Errorf("Test %d: Expected crlf %v, but got %v", i, test.crlf, s.crlf)
+		}
+	}
+}
+
+func TestSourceTable(t *testing.T) {
+	s := decode([]byte("\xef\xbb\xbfa\r\n\U0001d11e\r\nb"))
+	tests := []struct {
+		unit OffsetUnit
+		exp  []int
+	}{
+		{ByteOffsets, []int{3, 4, 6, 6, 6, 6, 10, 12, 13}},
+		{RuneOffsets, []int{0, 1, 3, 3, 3, 3, 4, 6, 7}},
+		{UTF16Offsets, []int{0, 1, 3, 3, 3, 3, 5, 7, 8}},
+	}
+	for _, test := range tests {
+		if lut := s.table(test.unit); !reflect.DeepEqual(lut, test.exp) {
+			t.Errorf("%s: Expected %v, but got %v", test.unit, test.exp, lut)
+		}
+	}
+}
+
+func TestLanguageParserBytes(t *testing.T) {
+	const str = "\"x\""
+	in := []byte("\xef\xbb\xbfpackage main\r\n\r\nvar s = \"x\"\r\n")
+	tests := []struct {
+		unit OffsetUnit
+		a, b int
+	}{
+		{ByteOffsets, 27, 30},
+		{RuneOffsets, 24, 27},
+		{UTF16Offsets, 24, 27},
+	}
+	for _, test := range tests {
+		lp, err := NewLanguageParserBytes("testdata/Go.tmLanguage", in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lp.Offsets = test.unit
+		root, err := lp.Parse()
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := findNode(root, "string.quoted.double.go")
+		if n == nil {
+			t.Fatalf("%s: No string node in %s", test.unit, root)
+		}
+		if n.Range.A != test.a || n.Range.B != test.b {
+			t.Errorf("%s: Expected range %d-%d, but got %s", test.unit, test.a, test.b, n.Range)
+		}
+		if d := n.Data(); d != str {
+			t.Errorf("%s: Expected data %q, but got %q", test.unit, str, d)
+		}
+		if test.unit != ByteOffsets {
+			continue
+		}
+		if d := string(in[n.Range.A:n.Range.B]); d != str {
+			t.Errorf("Expected the original input to contain %q at %s, but got %q", str, n.Range, d)
+		}
+	}
+}
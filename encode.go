@@ -0,0 +1,170 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Themes are encoded from a generic form, in which dictionaries are
+// map[string]interface{}, arrays []interface{}, and other values strings
+// or, for JSON only, json.RawMessage.
+
+// An entity, which the plist loader leaves as it is unless it's &lt; or
+// &gt;, so it's written out as it is too.
+var entity = regexp.MustCompile(`^&(?:#[0-9]+|#[xX][0-9a-fA-F]+|[a-zA-Z][a-zA-Z0-9]*);`)
+
+// writePlist writes v as an XML property list, with the keys of
+// dictionaries sorted.
+func writePlist(w io.Writer, v interface{}) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+`)
+	if err := writePlistValue(bw, v, ""); err != nil {
+		return err
+	}
+	bw.WriteString("</plist>\n")
+	return bw.Flush()
+}
+
+func writePlistValue(w *bufio.Writer, v interface{}, indent string) error {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		w.WriteString(indent + "<dict>\n")
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(w, "%s\t<key>%s</key>\n", indent, plistEscape(k))
+			if err := writePlistValue(w, v[k], indent+"\t"); err != nil {
+				return err
+			}
+		}
+		w.WriteString(indent + "</dict>\n")
+	case []interface{}:
+		w.WriteString(indent + "<array>\n")
+		for _, e := range v {
+			if err := writePlistValue(w, e, indent+"\t"); err != nil {
+				return err
+			}
+		}
+		w.WriteString(indent + "</array>\n")
+	case string:
+		fmt.Fprintf(w, "%s<string>%s</string>\n", indent, plistEscape(v))
+	case json.RawMessage:
+		// JSON arrays and objects are written as the plist values
+		// they're loaded from
+		d := json.NewDecoder(bytes.NewReader(v))
+		d.UseNumber()
+		var jv interface{}
+		if err := d.Decode(&jv); err != nil {
+			return err
+		}
+		return writePlistValue(w, jv, indent)
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			fmt.Fprintf(w, "%s<integer>%s</integer>\n", indent, v)
+		} else {
+			fmt.Fprintf(w, "%s<real>%s</real>\n", indent, v)
+		}
+	case bool:
+		fmt.Fprintf(w, "%s<%t/>\n", indent, v)
+	default:
+		return fmt.Errorf("Can't write %T to a property list", v)
+	}
+	return nil
+}
+
+func plistEscape(s string) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '&' && !entity.MatchString(s[i:]):
+			buf.WriteString("&amp;")
+		case c == '<':
+			buf.WriteString("&lt;")
+		case c == '>':
+			buf.WriteString("&gt;")
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	return buf.String()
+}
+
+// writeJSON writes v as indented JSON.
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "\t")
+	return enc.Encode(v)
+}
+
+// jsonValue returns the value v of Settings.Other or Theme.Other in the
+// generic form, which is v itself unless it holds a JSON array or object.
+func jsonValue(v string) interface{} {
+	if (strings.HasPrefix(v, "[") || strings.HasPrefix(v, "{")) && json.Valid([]byte(v)) {
+		return json.RawMessage(v)
+	}
+	return v
+}
+
+// otherValues returns the values of the JSON object data with keys other
+// than known, as the strings they're set to, or as compact JSON if they
+// aren't strings. Keys are matched without regard to case, like encoding/json does.
+func otherValues(data []byte, known ...string) (map[string]string, error) {
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	ret := make(map[string]string)
+next:
+	for k, v := range all {
+		for _, kn := range known {
+			if strings.EqualFold(k, kn) {
+				continue next
+			}
+		}
+		var s string
+		if err := json.Unmarshal(v, &s); err != nil {
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, v); err != nil {
+				return nil, err
+			}
+			s = buf.String()
+		}
+		ret[k] = s
+	}
+	return ret, nil
+}
+
+// snakeKeys returns m with keys like "lineHighlight" converted to
+// "line_highlight", the reverse of camelCase.
+func snakeKeys(m map[string]interface{}) map[string]interface{} {
+	ret := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		var buf []byte
+		for i := 0; i < len(k); i++ {
+			if c := k[i]; c >= 'A' && c <= 'Z' {
+				buf = append(buf, '_', c-'A'+'a')
+			} else {
+				buf = append(buf, c)
+			}
+		}
+		ret[string(buf)] = v
+	}
+	return ret
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gbbr/textmate/vendor/limetext/lime-backend/lib/util"
+)
+
+// update makes the tests write their golden files instead of comparing
+// with them.
+var update = flag.Bool("update", false, "write the golden files of the tests")
+
+func TestWriteTheme(t *testing.T) {
+	const in = "testdata/Monokai.tmTheme"
+	th, err := LoadTheme(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		ext   string
+		write func(io.Writer) error
+	}{
+		{".tmTheme", th.WriteTmTheme},
+		{"-color-theme.json", th.WriteVSCodeTheme},
+		{".sublime-color-scheme", th.WriteSublimeColorScheme},
+	}
+	for _, test := range tests {
+		out := in + test.ext + ".res"
+		var buf bytes.Buffer
+		if err := test.write(&buf); err != nil {
+			t.Errorf("%s: %s", out, err)
+			continue
+		}
+		if *update {
+			if err := ioutil.WriteFile(out, buf.Bytes(), 0644); err != nil {
+				t.Error(err)
+			}
+		} else if d, err := ioutil.ReadFile(out); err != nil {
+			t.Errorf("%s; run the tests with -update to write it", err)
+		} else if diff := util.Diff(string(d), buf.String()); diff != "" {
+			t.Errorf("%s: %s", out, diff)
+		}
+	}
+}
+
+func TestWriteTmThemeOther(t *testing.T) {
+	th, err := LoadTheme("testdata/Monokai.tmTheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	th.Other = map[string]string{
+		"colorSpaceName": "sRGB",
+		"tags":           `["dark","warm"]`,
+	}
+	var buf bytes.Buffer
+	if err := th.WriteTmTheme(&buf); err != nil {
+		t.Fatal(err)
+	}
+	// Values holding JSON are written as plist values, like those of rules
+	for _, exp := range []string{"<string>sRGB</string>", "<key>tags</key>\n\t<array>\n\t\t<string>dark</string>\n\t\t<string>warm</string>\n\t</array>"} {
+		if !strings.Contains(buf.String(), exp) {
+			t.Errorf("Expected %q in %s", exp, buf.String())
+		}
+	}
+
+	// And are loaded back as they were
+	dir, err := ioutil.TempDir("", "textmate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fn := filepath.Join(dir, "Other.tmTheme")
+	if err := ioutil.WriteFile(fn, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	th2, err := LoadTheme(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(th.Other, th2.Other) {
+		t.Errorf("Expected the other values %v, but got %v", th.Other, th2.Other)
+	}
+}
+
+func TestConvertTheme(t *testing.T) {
+	const in = "testdata/Monokai.tmTheme"
+	mk, err := LoadTheme(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "textmate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Each theme is converted from the previous one, ending where it began
+	from := in
+	for _, name := range []string{"Monokai-color-theme.json", "Monokai.sublime-color-scheme", "Monokai.tmTheme"} {
+		to := filepath.Join(dir, name)
+		if err := ConvertTheme(from, to); err != nil {
+			t.Fatalf("%s: %s", to, err)
+		}
+		th, err := LoadTheme(to)
+		if err != nil {
+			t.Fatalf("%s: %s", to, err)
+		}
+		if exp, got := mk.String(), th.String(); exp != got {
+			t.Errorf("%s: %s", name, util.Diff(exp, got))
+		}
+		if exp, got := fmt.Sprint(mk.GutterSettings.values()), fmt.Sprint(th.GutterSettings.values()); exp != got {
+			t.Errorf("%s: Expected the gutter settings %s, but got %s", name, exp, got)
+		}
+		if !reflect.DeepEqual(mk.Other, th.Other) {
+			t.Errorf("%s: Expected the other values %v, but got %v", name, mk.Other, th.Other)
+		}
+		for _, scope := range []string{"source.go", "source.go storage.type.go", "source.go entity.other.inherited-class.go", "text.xml invalid.deprecated.xml"} {
+			if exp, got := mk.Style(scope), th.Style(scope); exp != got {
+				t.Errorf("%s: %s: Expected %v, but got %v", name, scope, exp, got)
+			}
+		}
+		from = to
+	}
+
+	var exp, got bytes.Buffer
+	if err := mk.WriteTmTheme(&exp); err != nil {
+		t.Fatal(err)
+	}
+	if d, err := ioutil.ReadFile(from); err != nil {
+		t.Fatal(err)
+	} else if got.Write(d); exp.String() != got.String() {
+		t.Errorf("Expected the converted tmTheme to be unchanged: %s", util.Diff(exp.String(), got.String()))
+	}
+}
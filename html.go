@@ -0,0 +1,283 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+type (
+	// HTMLOptions control the markup written by WriteHTML.
+	HTMLOptions struct {
+		// Write class names instead of inline styles. The classes are
+		// styled by the stylesheet Theme.WriteCSS writes.
+		Classes bool
+		// The prefix of the class names, "tm-" if empty.
+		ClassPrefix string
+		// Number the lines.
+		LineNumbers bool
+		// If not empty, every line gets the id AnchorPrefix followed by
+		// its number, and its line number links to it.
+		AnchorPrefix string
+		// The lines to highlight.
+		Highlight []LineRange
+		// Write characters that aren't ASCII as character references.
+		EscapeNonASCII bool
+	}
+
+	// A LineRange is the lines From to To, inclusive, numbered from 1.
+	LineRange struct {
+		From, To int
+	}
+)
+
+func (o *HTMLOptions) prefix() string {
+	if o.ClassPrefix == "" {
+		return "tm-"
+	}
+	return o.ClassPrefix
+}
+
+func (o *HTMLOptions) highlighted(line int) bool {
+	for _, r := range o.Highlight {
+		if line >= r.From && line <= r.To {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteHTML writes the text of the parse tree root, as returned by
+// LanguageParser.Parse, as HTML highlighted by t.
+//
+// The text is written as a <pre> element, in which every line is a <span>,
+// and every run of text styled differently from the theme's foreground
+// and background a nested <span>. Spans never cross lines, so every line
+// can be styled, or copied, on its own. If opts is nil, inline styles are
+// written without line numbers.
+func WriteHTML(w io.Writer, root *parser.Node, t *Theme, opts *HTMLOptions) error {
+	if opts == nil {
+		opts = &HTMLOptions{}
+	}
+	var (
+		bw     = bufio.NewWriter(w)
+		h      = highlight(root)
+		p      = opts.prefix()
+		global = t.Style("")
+		width  = len(fmt.Sprint(len(h.lines)))
+	)
+	if opts.Classes {
+		fmt.Fprintf(bw, `<pre class="%scode"><code>`, p)
+	} else {
+		fmt.Fprintf(bw, `<pre style="%s"><code>`, globalCSS(global))
+	}
+	for i := range h.lines {
+		n := i + 1
+		bw.WriteString("<span")
+		if opts.AnchorPrefix != "" {
+			fmt.Fprintf(bw, ` id="%s%d"`, html.EscapeString(opts.AnchorPrefix), n)
+		}
+		hl := opts.highlighted(n)
+		switch {
+		case opts.Classes && hl:
+			fmt.Fprintf(bw, ` class="%sline %shl"`, p, p)
+		case opts.Classes:
+			fmt.Fprintf(bw, ` class="%sline"`, p)
+		case hl:
+			if c := t.Global.LineHighlight; c != nil {
+				fmt.Fprintf(bw, ` style="background-color:%s"`, c)
+			}
+		}
+		bw.WriteString(">")
+		if opts.LineNumbers {
+			tag, attrs := "span", ""
+			if opts.AnchorPrefix != "" {
+				tag, attrs = "a", fmt.Sprintf(` href="#%s%d"`, html.EscapeString(opts.AnchorPrefix), n)
+			}
+			if opts.Classes {
+				attrs += fmt.Sprintf(` class="%sln"`, p)
+			} else {
+				attrs += fmt.Sprintf(` style="%s"`, t.gutterCSS())
+			}
+			fmt.Fprintf(bw, "<%s%s>%*d</%s>", tag, attrs, width, n, tag)
+		}
+		for _, r := range h.runs(i, t.Style) {
+			s, data := r.style, htmlEscape(r.text, opts.EscapeNonASCII)
+			var attr string
+			if opts.Classes {
+				attr = strings.Join(styleClasses(s, global, p), " ")
+				if attr != "" {
+					attr = fmt.Sprintf(` class="%s"`, attr)
+				}
+			} else if css := cssStyle(s, global); css != "" {
+				attr = fmt.Sprintf(` style="%s"`, css)
+			}
+			if attr == "" {
+				bw.WriteString(data)
+			} else {
+				fmt.Fprintf(bw, "<span%s>%s</span>", attr, data)
+			}
+		}
+		bw.WriteString("</span>\n")
+	}
+	bw.WriteString("</code></pre>\n")
+	return bw.Flush()
+}
+
+// WriteCSS writes the stylesheet for the markup WriteHTML writes with
+// HTMLOptions.Classes set and the class names prefixed by prefix, or "tm-"
+// if it's empty.
+//
+// Every foreground and background of a resolved style is set by one of
+// the rules of t, so the stylesheet has a class for every colour of the
+// rules, and one for every font style, and the markup of any text uses
+// only those.
+func (t *Theme) WriteCSS(w io.Writer, prefix string) error {
+	p := (&HTMLOptions{ClassPrefix: prefix}).prefix()
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, ".%scode { %s; }\n", p, globalCSS(t.Style("")))
+	fmt.Fprintf(bw, ".%sln { %s; user-select: none; }\n", p, t.gutterCSS())
+	if c := t.Global.LineHighlight; c != nil {
+		fmt.Fprintf(bw, ".%shl { background-color: %s; }\n", p, c)
+	}
+	var fgs, bgs []string
+	seen := make(map[string]bool)
+	for _, s := range t.Settings {
+		for k, c := range s.Settings.Colors {
+			if name := colorClass(k, c); name != "" && !seen[name] {
+				seen[name] = true
+				if k == "foreground" {
+					fgs = append(fgs, name)
+				} else {
+					bgs = append(bgs, name)
+				}
+			}
+		}
+	}
+	sort.Strings(fgs)
+	sort.Strings(bgs)
+	for _, name := range fgs {
+		fmt.Fprintf(bw, ".%s%s { color: #%s; }\n", p, name, name[3:])
+	}
+	for _, name := range bgs {
+		fmt.Fprintf(bw, ".%s%s { background-color: #%s; }\n", p, name, name[3:])
+	}
+	fmt.Fprintf(bw, ".%sb { font-weight: bold; }\n", p)
+	fmt.Fprintf(bw, ".%si { font-style: italic; }\n", p)
+	fmt.Fprintf(bw, ".%su { text-decoration: underline; }\n", p)
+	fmt.Fprintf(bw, ".%ss { text-decoration: line-through; }\n", p)
+	fmt.Fprintf(bw, ".%su.%ss { text-decoration: underline line-through; }\n", p, p)
+	return bw.Flush()
+}
+
+// gutterCSS returns the inline style of line numbers.
+func (t *Theme) gutterCSS() string {
+	css := "padding-right:1em"
+	fg, bg := t.gutterColors()
+	if c := fg; c != nil {
+		css = fmt.Sprintf("color:%s;%s", c, css)
+	}
+	if c := bg; c != nil {
+		css = fmt.Sprintf("background-color:%s;%s", c, css)
+	}
+	return css
+}
+
+// colorClass returns the class name of the colour c of the setting key,
+// without a prefix, or "" if it's neither a foreground nor a background.
+func colorClass(key string, c Color) string {
+	hex := strings.ToLower(strings.TrimPrefix(c.String(), "#"))
+	switch key {
+	case "foreground":
+		return "fg-" + hex
+	case "background":
+		return "bg-" + hex
+	}
+	return ""
+}
+
+// styleClasses returns the class names of the parts of s that differ from
+// global.
+func styleClasses(s, global Style, prefix string) (ret []string) {
+	if s.Foreground != global.Foreground {
+		ret = append(ret, prefix+colorClass("foreground", s.Foreground))
+	}
+	if s.Background != global.Background {
+		ret = append(ret, prefix+colorClass("background", s.Background))
+	}
+	for _, f := range []struct {
+		f    FontStyle
+		name string
+	}{{Bold, "b"}, {Italic, "i"}, {Underline, "u"}, {Strikethrough, "s"}} {
+		if s.Font&f.f != 0 {
+			ret = append(ret, prefix+f.name)
+		}
+	}
+	return
+}
+
+// globalCSS returns the inline style of the code element, which is styled
+// by the global style s.
+func globalCSS(s Style) string {
+	css := fmt.Sprintf("color:%s;background-color:%s", s.Foreground, s.Background)
+	if font := cssStyle(Style{Font: s.Font}, Style{}); font != "" {
+		css += ";" + font
+	}
+	return css
+}
+
+// cssStyle returns the inline style of the parts of s that differ from
+// global.
+func cssStyle(s, global Style) string {
+	var decls []string
+	if s.Foreground != global.Foreground {
+		decls = append(decls, "color:"+s.Foreground.String())
+	}
+	if s.Background != global.Background {
+		decls = append(decls, "background-color:"+s.Background.String())
+	}
+	if s.Font&Bold != 0 {
+		decls = append(decls, "font-weight:bold")
+	}
+	if s.Font&Italic != 0 {
+		decls = append(decls, "font-style:italic")
+	}
+	switch s.Font & (Underline | Strikethrough) {
+	case Underline:
+		decls = append(decls, "text-decoration:underline")
+	case Strikethrough:
+		decls = append(decls, "text-decoration:line-through")
+	case Underline | Strikethrough:
+		decls = append(decls, "text-decoration:underline line-through")
+	}
+	return strings.Join(decls, ";")
+}
+
+// htmlEscape escapes s for HTML text, and the characters of s that aren't
+// ASCII too if all is set.
+func htmlEscape(s string, all bool) string {
+	s = html.EscapeString(s)
+	if !all {
+		return s
+	}
+	var buf bytes.Buffer
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			buf.WriteRune(r)
+		} else {
+			fmt.Fprintf(&buf, "&#x%X;", r)
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bytes"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gbbr/textmate/vendor/limetext/lime-backend/lib/util"
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+// parseFile parses the file fn with the language scope.
+func parseFile(t *testing.T, scope, fn string) *parser.Node {
+	d, err := ioutil.ReadFile(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parseString(t, scope, string(d))
+}
+
+func parseString(t *testing.T, scope, data string) *parser.Node {
+	lp, err := NewLanguageParser(scope, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := lp.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
+func TestWriteHTML(t *testing.T) {
+	th, err := LoadTheme("testdata/Monokai.tmTheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := parseString(t, "testdata/Go.tmLanguage", "// <a> & é\nvar s = \"'\"\n")
+	tests := []struct {
+		opts *HTMLOptions
+		exp  string
+	}{
+		{
+			nil,
+			`<pre style="color:#F8F8F2;background-color:#272822"><code>` +
+				`<span><span style="color:#75715E">// &lt;a&gt; &amp; é</span></span>
+<span><span style="color:#F92672">var</span> s = <span style="color:#E6DB74">&#34;&#39;&#34;</span></span>
+</code></pre>
+`,
+		},
+		{
+			&HTMLOptions{Classes: true, ClassPrefix: "x-", LineNumbers: true, AnchorPrefix: "L", Highlight: []LineRange{{2, 2}}, EscapeNonASCII: true},
+			`<pre class="x-code"><code>` +
+				`<span id="L1" class="x-line"><a href="#L1" class="x-ln">1</a><span class="x-fg-75715e">// &lt;a&gt; &amp; &#xE9;</span></span>
+<span id="L2" class="x-line x-hl"><a href="#L2" class="x-ln">2</a><span class="x-fg-f92672">var</span> s = <span class="x-fg-e6db74">&#34;&#39;&#34;</span></span>
+</code></pre>
+`,
+		},
+	}
+	for i, test := range tests {
+		var buf bytes.Buffer
+		if err := WriteHTML(&buf, root, th, test.opts); err != nil {
+			t.Fatal(err)
+		}
+		if diff := util.Diff(test.exp, buf.String()); diff != "" {
+			t.Errorf("%d: %s", i, diff)
+		}
+	}
+}
+
+func TestWriteHTMLClasses(t *testing.T) {
+	const (
+		in  = "testdata/main.go"
+		out = "testdata/main.go.html.res"
+	)
+	th, err := LoadTheme("testdata/Monokai.tmTheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteHTML(&buf, parseFile(t, "testdata/Go.tmLanguage", in), th, &HTMLOptions{Classes: true, LineNumbers: true}); err != nil {
+		t.Fatal(err)
+	}
+	str := buf.String()
+	if d, err := ioutil.ReadFile(out); err != nil {
+		if err := ioutil.WriteFile(out, []byte(str), 0644); err != nil {
+			t.Error(err)
+		}
+	} else if diff := util.Diff(string(d), str); diff != "" {
+		t.Error(diff)
+	}
+
+	// Every class used is styled by the stylesheet
+	var css bytes.Buffer
+	if err := th.WriteCSS(&css, ""); err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range regexp.MustCompile(`class="([^"]*)"`).FindAllStringSubmatch(str, -1) {
+		for _, c := range strings.Fields(m[1]) {
+			if c != "tm-line" && !strings.Contains(css.String(), "."+c+" ") {
+				t.Errorf("Expected the stylesheet to style %s", c)
+			}
+		}
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gbbr/textmate/vendor/limetext/text"
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+// The version of the JSON encoding of parse trees and tokens written by
+// EncodeTree and EncodeTokens. Decoding fails for later versions.
+const JSONVersion = 1
+
+type (
+	// JSONOptions control the JSON EncodeTree and EncodeTokens write.
+	JSONOptions struct {
+		// Write every scope name once, in a table, and refer to it by
+		// its index in the table.
+		Intern bool
+		// Write the text of every node of a tree.
+		Data bool
+		// Indent the JSON by this string for every level.
+		Indent string
+	}
+
+	jsonDoc struct {
+		Version int    `json:"version"`
+		Type    string `json:"type"`
+		// The interned scope names
+		Scopes []string    `json:"scopes,omitempty"`
+		Root   *jsonNode   `json:"root,omitempty"`
+		Lines  [][]jsonTok `json:"lines,omitempty"`
+	}
+
+	jsonNode struct {
+		// A scope name, or the index of an interned one
+		Name     json.RawMessage `json:"name,omitempty"`
+		Start    int             `json:"start"`
+		End      int             `json:"end"`
+		Data     *string         `json:"data,omitempty"`
+		Children []*jsonNode     `json:"children,omitempty"`
+	}
+
+	jsonTok struct {
+		Start int `json:"start"`
+		End   int `json:"end"`
+		// Scope names, or the indexes of interned ones
+		Scopes []json.RawMessage `json:"scopes"`
+	}
+
+	// Interns or resolves scope names
+	scopeTable struct {
+		intern bool
+		names  []string
+		index  map[string]int
+	}
+)
+
+func (o *JSONOptions) table() *scopeTable {
+	return &scopeTable{intern: o.Intern, index: make(map[string]int)}
+}
+
+// encode returns the scope name s as JSON.
+func (t *scopeTable) encode(s string) json.RawMessage {
+	if !t.intern {
+		d, _ := json.Marshal(s)
+		return d
+	}
+	i, ok := t.index[s]
+	if !ok {
+		i = len(t.names)
+		t.index[s] = i
+		t.names = append(t.names, s)
+	}
+	return json.RawMessage(fmt.Sprint(i))
+}
+
+// decode returns the scope name d, a string or the index of an interned
+// one.
+func (t *scopeTable) decode(d json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(d, &s); err == nil {
+		return s, nil
+	}
+	var i int
+	if err := json.Unmarshal(d, &i); err != nil || i < 0 || i >= len(t.names) {
+		return "", fmt.Errorf("Invalid scope %s", d)
+	}
+	return t.names[i], nil
+}
+
+func (o *JSONOptions) encode(w io.Writer, doc *jsonDoc) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	if o.Indent != "" {
+		enc.SetIndent("", o.Indent)
+	}
+	return enc.Encode(doc)
+}
+
+func decodeDoc(r io.Reader, typ string) (*jsonDoc, error) {
+	var doc jsonDoc
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	switch {
+	case doc.Version < 1 || doc.Version > JSONVersion:
+		return nil, fmt.Errorf("Unsupported version %d", doc.Version)
+	case doc.Type != typ:
+		return nil, fmt.Errorf("Expected %s, but got %q", typ, doc.Type)
+	}
+	return &doc, nil
+}
+
+// EncodeTree writes the parse tree root as JSON. If opts is nil, the names
+// of nodes are written as they are, without their text.
+func EncodeTree(w io.Writer, root *parser.Node, opts *JSONOptions) error {
+	if opts == nil {
+		opts = &JSONOptions{}
+	}
+	t := opts.table()
+	var node func(n *parser.Node) *jsonNode
+	node = func(n *parser.Node) *jsonNode {
+		ret := &jsonNode{Start: n.Range.A, End: n.Range.B}
+		if n.Name != "" {
+			ret.Name = t.encode(n.Name)
+		}
+		if opts.Data && n.P != nil {
+			d := n.Data()
+			ret.Data = &d
+		}
+		for _, c := range n.Children {
+			ret.Children = append(ret.Children, node(c))
+		}
+		return ret
+	}
+	doc := &jsonDoc{Version: JSONVersion, Type: "tree", Root: node(root)}
+	doc.Scopes = t.names
+	return opts.encode(w, doc)
+}
+
+// DecodeTree reads a parse tree written by EncodeTree. The nodes of the
+// tree have no data source, so their text isn't available.
+func DecodeTree(r io.Reader) (*parser.Node, error) {
+	doc, err := decodeDoc(r, "tree")
+	if err != nil {
+		return nil, err
+	}
+	if doc.Root == nil {
+		return nil, fmt.Errorf("No root")
+	}
+	t := &scopeTable{names: doc.Scopes}
+	var node func(n *jsonNode) (*parser.Node, error)
+	node = func(n *jsonNode) (*parser.Node, error) {
+		ret := &parser.Node{Range: text.Region{A: n.Start, B: n.End}}
+		if len(n.Name) != 0 {
+			name, err := t.decode(n.Name)
+			if err != nil {
+				return nil, err
+			}
+			ret.Name = name
+		}
+		for _, c := range n.Children {
+			cn, err := node(c)
+			if err != nil {
+				return nil, err
+			}
+			ret.Children = append(ret.Children, cn)
+		}
+		return ret, nil
+	}
+	return node(doc.Root)
+}
+
+// EncodeTokens writes the tokens of lines, as returned by Tokens, as JSON.
+// If opts is nil, the scope names are written as they are.
+func EncodeTokens(w io.Writer, lines [][]Token, opts *JSONOptions) error {
+	if opts == nil {
+		opts = &JSONOptions{}
+	}
+	t := opts.table()
+	doc := &jsonDoc{Version: JSONVersion, Type: "tokens", Lines: make([][]jsonTok, len(lines))}
+	for i, toks := range lines {
+		doc.Lines[i] = make([]jsonTok, len(toks))
+		for j, tok := range toks {
+			jt := jsonTok{Start: tok.Start, End: tok.End, Scopes: make([]json.RawMessage, len(tok.Scopes))}
+			for k, s := range tok.Scopes {
+				jt.Scopes[k] = t.encode(s)
+			}
+			doc.Lines[i][j] = jt
+		}
+	}
+	doc.Scopes = t.names
+	return opts.encode(w, doc)
+}
+
+// DecodeTokens reads the tokens of lines written by EncodeTokens.
+func DecodeTokens(r io.Reader) ([][]Token, error) {
+	doc, err := decodeDoc(r, "tokens")
+	if err != nil {
+		return nil, err
+	}
+	t := &scopeTable{names: doc.Scopes}
+	ret := make([][]Token, len(doc.Lines))
+	for i, toks := range doc.Lines {
+		if len(toks) == 0 {
+			// Like Tokens returns lines without tokens
+			continue
+		}
+		ret[i] = make([]Token, len(toks))
+		for j, jt := range toks {
+			tok := Token{Start: jt.Start, End: jt.End, Scopes: make([]string, len(jt.Scopes))}
+			for k, s := range jt.Scopes {
+				if tok.Scopes[k], err = t.decode(s); err != nil {
+					return nil, fmt.Errorf("Line %d: %s", i, err)
+				}
+			}
+			ret[i][j] = tok
+		}
+	}
+	return ret, nil
+}
+
+// DiffTrees compares the names and ranges of the nodes of the parse trees
+// exp and got, and returns the first difference, or "" if there's none.
+func DiffTrees(exp, got *parser.Node) string {
+	return diffNodes(exp, got, nil)
+}
+
+func diffNodes(exp, got *parser.Node, path []string) string {
+	path = append(path, fmt.Sprintf("%q %d-%d", exp.Name, exp.Range.A, exp.Range.B))
+	where := strings.Join(path, " > ")
+	switch {
+	case exp.Name != got.Name:
+		return fmt.Sprintf("%s: Expected the name %q, but got %q", where, exp.Name, got.Name)
+	case exp.Range.A != got.Range.A || exp.Range.B != got.Range.B:
+		return fmt.Sprintf("%s: Expected the range %d-%d, but got %d-%d", where, exp.Range.A, exp.Range.B, got.Range.A, got.Range.B)
+	}
+	for i, c := range exp.Children {
+		if i >= len(got.Children) {
+			return fmt.Sprintf("%s: Expected %d children, but got %d", where, len(exp.Children), len(got.Children))
+		}
+		if d := diffNodes(c, got.Children[i], path); d != "" {
+			return d
+		}
+	}
+	if len(got.Children) > len(exp.Children) {
+		extra := got.Children[len(exp.Children)]
+		return fmt.Sprintf("%s: Expected %d children, but got %d, the first extra %q %d-%d", where, len(exp.Children), len(got.Children), extra.Name, extra.Range.A, extra.Range.B)
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gbbr/textmate/vendor/limetext/text"
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+func TestEncodeTree(t *testing.T) {
+	root := parseString(t, "testdata/Go.tmLanguage", "// <é>\nvar x\n")
+	for _, opts := range []*JSONOptions{nil, {Intern: true}, {Data: true, Indent: "  "}} {
+		var buf bytes.Buffer
+		if err := EncodeTree(&buf, root, opts); err != nil {
+			t.Fatal(err)
+		}
+		got, err := DecodeTree(&buf)
+		if err != nil {
+			t.Fatalf("%+v: %s", opts, err)
+		}
+		if diff := DiffTrees(root, got); diff != "" {
+			t.Errorf("%+v: %s", opts, diff)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := EncodeTree(&buf, root, &JSONOptions{Intern: true, Data: true}); err != nil {
+		t.Fatal(err)
+	}
+	const exp = `{"version":1,"type":"tree","scopes":["source.go","comment.line.double-slash.go","punctuation.definition.comment.go","meta.initialization.explicit.go","variable.other.go","keyword.control.go"],"root":{"name":0,"start":0,"end":12,"data":"// <é>\nvar x","children":[{"start":0,"end":7,"data":"// <é>\n","children":[{"name":1,"start":0,"end":7,"data":"// <é>\n","children":[{"name":2,"start":0,"end":2,"data":"//"}]}]},{"name":3,"start":7,"end":12,"data":"var x","children":[{"name":4,"start":7,"end":12,"data":"var x","children":[{"name":5,"start":7,"end":10,"data":"var"}]}]}]}}`
+	if got := strings.TrimSpace(buf.String()); got != exp {
+		t.Errorf("Expected\n%s\nbut got\n%s", exp, got)
+	}
+}
+
+func TestEncodeTokens(t *testing.T) {
+	lp, err := NewLanguageParser("testdata/Go.tmLanguage", "package main\n\n// x\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	toks, err := lp.TokenizeLines()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, opts := range []*JSONOptions{nil, {Intern: true}} {
+		var buf bytes.Buffer
+		if err := EncodeTokens(&buf, toks, opts); err != nil {
+			t.Fatal(err)
+		}
+		if opts != nil && !strings.Contains(buf.String(), `"scopes":[0,1]`) {
+			t.Errorf("Expected the scopes to be interned, but got %s", buf.String())
+		}
+		got, err := DecodeTokens(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(toks, got) {
+			t.Errorf("%+v: Expected %v, but got %v", opts, toks, got)
+		}
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		in, err string
+	}{
+		{`{"version":2,"type":"tree","root":{}}`, "Unsupported version 2"},
+		{`{"version":1,"type":"tokens","lines":[]}`, "Expected tree"},
+		{`{"version":1,"type":"tree"}`, "No root"},
+		{`{"version":1,"type":"tree","scopes":["a"],"root":{"name":1}}`, "Invalid scope 1"},
+		{`{"version":1,`, "EOF"},
+	}
+	for _, test := range tests {
+		if _, err := DecodeTree(strings.NewReader(test.in)); err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: Expected the error %q, but got %v", test.in, test.err, err)
+		}
+	}
+	if _, err := DecodeTokens(strings.NewReader(`{"version":1,"type":"tokens","lines":[[{"scopes":[true]}]]}`)); err == nil {
+		t.Error("Expected an error for an invalid scope")
+	}
+}
+
+func TestDiffTrees(t *testing.T) {
+	node := func(name string, a, b int, children ...*parser.Node) *parser.Node {
+		return &parser.Node{Name: name, Range: text.Region{A: a, B: b}, Children: children}
+	}
+	exp := node("a", 0, 10, node("b", 0, 5), node("c", 5, 10))
+	tests := []struct {
+		got  *parser.Node
+		diff string
+	}{
+		{node("a", 0, 10, node("b", 0, 5), node("c", 5, 10)), ""},
+		{node("a", 0, 10, node("b", 0, 5), node("c", 5, 9)), `"a" 0-10 > "c" 5-10: Expected the range 5-10, but got 5-9`},
+		{node("a", 0, 10, node("b", 0, 5), node("d", 5, 10)), `"a" 0-10 > "c" 5-10: Expected the name "c", but got "d"`},
+		{node("a", 0, 10, node("b", 0, 5)), `"a" 0-10: Expected 2 children, but got 1`},
+		{node("a", 0, 10, node("b", 0, 5), node("c", 5, 10), node("e", 10, 10)), `"a" 0-10: Expected 2 children, but got 3, the first extra "e" 10-10`},
+	}
+	for _, test := range tests {
+		if diff := DiffTrees(exp, test.got); diff != test.diff {
+			t.Errorf("Expected %q, but got %q", test.diff, diff)
+		}
+	}
+}
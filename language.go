@@ -10,12 +10,14 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
+	"unicode/utf8"
 
-	"github.com/gbbr/rubex"
 	"github.com/gbbr/textmate/vendor/limetext/lime-backend/lib/loaders"
 	"github.com/gbbr/textmate/vendor/limetext/text"
 	"github.com/gbbr/textmate/vendor/quarnster/parser"
@@ -25,18 +27,48 @@ const maxiter = 10000
 
 type (
 	Regex struct {
-		re        *rubex.Regexp
+		re        CompiledRegex
 		lastIndex int
 		lastFound int
+		lookback  lookback
+		// The result of the last search by a Scanner
+		cacheData  string
+		cachePos   int
+		cacheFrom  int
+		cacheMatch MatchObject
+		// The state of the parse, shared by the regexes of a language
+		// and of the languages it includes
+		state *parseState
 	}
 
 	Language struct {
 		UnpatchedLanguage
+		state *parseState
+	}
+
+	// The state of a parse that its searches share.
+	parseState struct {
+		// When searches give up
+		deadline time.Time
+		// The data last searched by GoEngine regexes, as they search it
+		subject *subject
 	}
 
 	LanguageProvider struct {
 		sync.Mutex
 		scope map[string]string
+		// What LanguageForFile matches files with, by grammar file
+		match map[string]*fileMatch
+	}
+
+	// A fileMatch holds the file types and the compiled first line
+	// match of a grammar, so that LanguageForFile needn't load every
+	// grammar to find the one of a file.
+	fileMatch struct {
+		fileTypes      []string
+		firstLineMatch string
+		// Nil if there's no first line match or it doesn't compile
+		firstLine CompiledRegex
 	}
 
 	UnpatchedLanguage struct {
@@ -62,21 +94,16 @@ type (
 
 	Pattern struct {
 		Named
-		Include        string
-		Match          Regex
-		Captures       Captures
-		Begin          Regex
-		BeginCaptures  Captures
-		End            Regex
-		EndCaptures    Captures
-		Patterns       []Pattern
-		owner          *Language // needed for include directives
-		cachedData     string
-		cachedPat      *Pattern
-		cachedPatterns []*Pattern
-		cachedMatch    MatchObject
-		hits           int
-		misses         int
+		Include       string
+		Match         Regex
+		Captures      Captures
+		Begin         Regex
+		BeginCaptures Captures
+		End           Regex
+		EndCaptures   Captures
+		Patterns      []Pattern
+		owner         *Language // needed for include directives
+		scanner       *Scanner
 	}
 
 	RootPattern struct {
@@ -84,18 +111,38 @@ type (
 	}
 
 	LanguageParser struct {
-		l    *Language
-		data []rune
+		// Offsets selects the unit used for the ranges of the nodes
+		// returned by Parse and expected by Data. It must be set before
+		// Parse and left as it is while the parsed nodes are used, as
+		// Data and Tokens read their ranges in the unit it selects at
+		// the time.
+		Offsets OffsetUnit
+		// Deadline, unless it's zero, is when Parse gives up and
+		// returns ErrDeadline. Searches of GoEngine regexes are given
+		// up on as well, but those of OnigurumaEngine can't be
+		// interrupted, so a slow regex delays the end of the parse
+		// until its search is over.
+		Deadline time.Time
+		l        *Language
+		source
+		lut     []int
+		lutUnit OffsetUnit
 	}
 )
 
 var (
 	Provider LanguageProvider
 	failed   = make(map[string]bool)
+	// Guards failed, as includes are resolved while parsing
+	failedMu sync.Mutex
 )
 
+// ErrDeadline is returned by Parse when its Deadline passes.
+var ErrDeadline = errors.New("Parse deadline exceeded")
+
 func init() {
 	Provider.scope = make(map[string]string)
+	Provider.match = make(map[string]*fileMatch)
 }
 
 func (t *LanguageProvider) GetLanguage(id string) (*Language, error) {
@@ -123,15 +170,96 @@ func (t *LanguageProvider) LanguageFromFile(fn string) (*Language, error) {
 		return nil, fmt.Errorf("Couldn't load file %s: %s", fn, err)
 	}
 	var l Language
-	if err := loaders.LoadPlist(d, &l); err != nil {
+	if err := loaders.LoadPlist([]byte(decode(d).text), &l); err != nil {
 		return nil, err
 	}
+	m := &fileMatch{fileTypes: l.FileTypes, firstLineMatch: l.FirstLineMatch}
+	t.Lock()
+	old := t.match[fn]
+	t.Unlock()
+	if old != nil && old.firstLineMatch == m.firstLineMatch {
+		m.firstLine = old.firstLine
+	} else if m.firstLineMatch != "" {
+		m.firstLine, _ = Engine.Compile(m.firstLineMatch)
+	}
 	t.Lock()
 	defer t.Unlock()
 	t.scope[l.ScopeName] = fn
+	t.match[fn] = m
 	return &l, nil
 }
 
+// LoadDir loads the grammars of the directory dir, the files whose names
+// end in ".tmLanguage", so that they can be found by their scope names.
+// It returns the languages loaded, and the first error loading one.
+func (t *LanguageProvider) LoadDir(dir string) ([]*Language, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.tmLanguage"))
+	if err != nil {
+		return nil, err
+	}
+	var (
+		ret  []*Language
+		ferr error
+	)
+	for _, fn := range files {
+		l, err := t.LanguageFromFile(fn)
+		if err != nil {
+			if ferr == nil {
+				ferr = err
+			}
+			continue
+		}
+		ret = append(ret, l)
+	}
+	return ret, ferr
+}
+
+// LanguageForFile returns the language, of those the provider knows, for
+// the file fn whose first line is firstLine. The language is the one with
+// the longest of the file types fn ends in, such as "go" for "main.go" or
+// "Makefile" for "Makefile", or else the first whose first line match
+// matches firstLine. Only the language found is loaded, as the file types
+// and first line matches of languages are kept when they're loaded.
+func (t *LanguageProvider) LanguageForFile(fn, firstLine string) (*Language, error) {
+	t.Lock()
+	files := make([]string, 0, len(t.scope))
+	matches := make(map[string]*fileMatch, len(t.scope))
+	for _, f := range t.scope {
+		files = append(files, f)
+		matches[f] = t.match[f]
+	}
+	t.Unlock()
+	sort.Strings(files)
+
+	var (
+		base      = filepath.Base(fn)
+		best      string
+		bestLen   int
+		firstFile string
+	)
+	for _, f := range files {
+		m := matches[f]
+		if m == nil {
+			continue
+		}
+		for _, ft := range m.fileTypes {
+			if (base == ft || strings.HasSuffix(base, "."+ft)) && len(ft) > bestLen {
+				best, bestLen = f, len(ft)
+			}
+		}
+		if firstFile == "" && m.firstLine != nil && firstLine != "" && m.firstLine.Find(firstLine, 0) != nil {
+			firstFile = f
+		}
+	}
+	if best != "" {
+		return t.LanguageFromFile(best)
+	}
+	if firstFile != "" {
+		return t.LanguageFromFile(firstFile)
+	}
+	return nil, fmt.Errorf("No language for %s", fn)
+}
+
 func (p Pattern) String() (ret string) {
 	ret = fmt.Sprintf(`---------------------------------------
 Name:    %s
@@ -170,12 +298,18 @@ func (s *Language) String() string {
 func (p *Pattern) tweak(l *Language) {
 	p.owner = l
 	p.Name = strings.TrimSpace(p.Name)
+	p.Match.state = l.state
+	p.Begin.state = l.state
+	p.End.state = l.state
 	for i := range p.Patterns {
 		p.Patterns[i].tweak(l)
 	}
 }
 
 func (l *Language) tweak() {
+	if l.state == nil {
+		l.state = new(parseState)
+	}
 	l.RootPattern.tweak(l)
 	for k := range l.Repository {
 		p := l.Repository[k]
@@ -201,10 +335,11 @@ func (r *Regex) UnmarshalJSON(data []byte) error {
 	str = strings.Replace(str, "\\\\", "\\", -1)
 	str = strings.Replace(str, "\\n", "\n", -1)
 	str = strings.Replace(str, "\\t", "\t", -1)
-	if re, err := rubex.Compile(str); err != nil {
+	if re, err := Engine.Compile(str); err != nil {
 		log.Printf("Couldn't compile language pattern %s: %s", str, err)
 	} else {
 		r.re = re
+		r.lookback = lookbackOf(str)
 	}
 	return nil
 }
@@ -247,99 +382,102 @@ func (r *Regex) Find(data string, pos int) MatchObject {
 		r.lastFound = 0
 	}
 	r.lastIndex = pos
-	for r.lastFound < len(data) {
-		ret := r.re.FindStringSubmatchIndex(data[r.lastFound:])
+	var mo MatchObject
+	mo, r.lastFound = r.find(data, pos, r.lastFound)
+	return mo
+}
+
+// find returns the first match in data starting at or after pos, searching
+// from offset from. It also returns the offset the next search for a
+// position after pos can start from.
+func (r *Regex) find(data string, pos, from int) (MatchObject, int) {
+	switch r.lookback {
+	case lookNone:
+		if from < pos {
+			from = pos
+		}
+	case lookLine:
+		if start := strings.LastIndexByte(data[:pos], '\n') + 1; from < start {
+			from = start
+		}
+	case lookSearch:
+		if _, size := utf8.DecodeLastRuneInString(data[:pos]); from < pos-size {
+			from = pos - size
+		}
+	}
+	for from < len(data) {
+		ret := r.search(data, from)
 		if ret == nil {
 			break
-		} else if (ret[0] + r.lastFound) < pos {
-			if ret[0] == 0 {
-				r.lastFound++
+		} else if ret[0] < pos {
+			if ret[0] == from {
+				// Step over a whole rune so that the next search
+				// never starts in the middle of one
+				_, size := utf8.DecodeRuneInString(data[from:])
+				from += size
 			} else {
-				r.lastFound += ret[0]
+				from = ret[0]
 			}
 			continue
 		}
-		mo := MatchObject(ret)
-		mo.fix(r.lastFound)
-		return mo
+		return MatchObject(ret), from
 	}
-	return nil
+	return nil, from
+}
+
+// search returns the first match of r in data starting at or after start,
+// panicking with ErrDeadline if the engine gives up on the search as the
+// deadline of the parse has passed.
+func (r *Regex) search(data string, start int) []int {
+	pr, ok := r.re.(parseRegex)
+	if !ok || r.state == nil {
+		return r.re.Find(data, start)
+	}
+	ret, err := pr.findIn(r.state, data, start)
+	if err != nil {
+		panic(err)
+	}
+	return ret
 }
 
+// FirstMatch returns the earliest match in data at or after pos of
+// the patterns that can be applied inside of p.
 func (p *Pattern) FirstMatch(data string, pos int) (pat *Pattern, ret MatchObject) {
-	startIdx := -1
-	for i := 0; i < len(p.cachedPatterns); {
-		ip, im := p.cachedPatterns[i].Cache(data, pos)
-		if im != nil /* && im[0] != im[1]*/ {
-			if startIdx < 0 || startIdx > im[0] {
-				startIdx, pat, ret = im[0], ip, im
-				// This match is right at the start, we're not going to find a better pattern than this,
-				// so stop the search
-				if im[0] == pos {
-					break
-				}
-			}
-			i++
-		} else {
-			// If it wasn't found now, it'll never be found, so the pattern can be popped from the cache
-			copy(p.cachedPatterns[i:], p.cachedPatterns[i+1:])
-			p.cachedPatterns = p.cachedPatterns[:len(p.cachedPatterns)-1]
-		}
+	return p.firstMatch(data, pos, p.owner)
+}
+
+// firstMatch is like FirstMatch, with "$base" include directives referring
+// to base, the language being parsed.
+func (p *Pattern) firstMatch(data string, pos int, base *Language) (pat *Pattern, ret MatchObject) {
+	if p.scanner == nil {
+		p.scanner = p.newScanner(base)
+	}
+	if i, mo := p.scanner.Scan(data, pos); i != -1 {
+		pat, ret = p.scanner.Patterns()[i], mo
 	}
 	return
 }
 
+// Cache returns the earliest match in data at or after pos of p itself,
+// or of the patterns it includes or contains.
 func (p *Pattern) Cache(data string, pos int) (pat *Pattern, ret MatchObject) {
-	if p.cachedData == data {
-		if p.cachedMatch == nil {
-			return nil, nil
-		}
-		if p.cachedMatch[0] >= pos && p.cachedPat.cachedMatch != nil {
-			p.hits++
-			return p.cachedPat, p.cachedMatch
-		}
-	} else {
-		p.cachedPatterns = nil
-	}
-	if p.cachedPatterns == nil {
-		p.cachedPatterns = make([]*Pattern, len(p.Patterns))
-		for i := range p.cachedPatterns {
-			p.cachedPatterns[i] = &p.Patterns[i]
-		}
-	}
-	p.misses++
+	return p.cache(data, pos, p.owner)
+}
 
+// cache is like Cache, with "$base" include directives referring to base,
+// the language being parsed, in p and in the languages it includes.
+func (p *Pattern) cache(data string, pos int, base *Language) (pat *Pattern, ret MatchObject) {
 	if p.Match.re != nil {
-		pat, ret = p, p.Match.Find(data, pos)
+		return p, p.Match.cached(data, pos)
 	} else if p.Begin.re != nil {
-		pat, ret = p, p.Begin.Find(data, pos)
+		return p, p.Begin.cached(data, pos)
 	} else if p.Include != "" {
-		if z := p.Include[0]; z == '#' {
-			key := p.Include[1:]
-			if p2, ok := p.owner.Repository[key]; ok {
-				pat, ret = p2.Cache(data, pos)
-			} else {
-				log.Printf("Not found in repository: %s", p.Include)
-			}
-		} else if z == '$' {
-			// TODO(q): Implement tmLanguage $ include directives
-			log.Printf("Unhandled include directive: %s", p.Include)
-		} else if l, err := Provider.GetLanguage(p.Include); err != nil {
-			if !failed[p.Include] {
-				log.Printf("Include directive %s failed: %s", p.Include, err)
-			}
-			failed[p.Include] = true
-		} else {
-			return l.RootPattern.Cache(data, pos)
+		if p2 := p.include(base); p2 != nil {
+			return p2.cache(data, pos, base)
 		}
-	} else {
-		pat, ret = p.FirstMatch(data, pos)
+		return nil, nil
 	}
-	p.cachedData = data
-	p.cachedMatch = ret
-	p.cachedPat = pat
-
-	return
+	return p.firstMatch(data, pos, base)
 }
 
 func (p *Pattern) CreateCaptureNodes(data string, pos int, d parser.DataSource, mo MatchObject, parent *parser.Node, capt Captures) {
@@ -403,9 +541,14 @@ func (p *Pattern) CreateNode(data string, pos int, d parser.DataSource, mo Match
 	var (
 		found  = false
 		i, end int
+		// The language "$base" refers to
+		base = p.owner
 	)
+	if lp, ok := d.(*LanguageParser); ok {
+		base = lp.l
+	}
 	for i, end = ret.Range.B, len(data); i < len(data); {
-		endmatch := p.End.Find(data, i)
+		endmatch := p.End.cached(data, i)
 		if endmatch != nil {
 			end = endmatch[1]
 		} else {
@@ -422,9 +565,12 @@ func (p *Pattern) CreateNode(data string, pos int, d parser.DataSource, mo Match
 				break
 			}
 		}
-		if /*(endmatch == nil || (endmatch != nil && endmatch[0] != i)) && */ len(p.cachedPatterns) > 0 {
+		if lp, ok := d.(*LanguageParser); ok {
+			lp.checkDeadline()
+		}
+		if /*(endmatch == nil || (endmatch != nil && endmatch[0] != i)) && */ len(p.Patterns) > 0 {
 			// Might be more recursive patterns to apply BEFORE the end is reached
-			pattern2, match2 := p.FirstMatch(data, i)
+			pattern2, match2 := p.firstMatch(data, i, base)
 			if match2 != nil && ((endmatch == nil && match2[0] < end) || (endmatch != nil && (match2[0] < endmatch[0] || match2[0] == endmatch[0] && ret.Range.A == ret.Range.B))) {
 				found = true
 				r := pattern2.CreateNode(data, i, d, match2)
@@ -447,9 +593,21 @@ func (p *Pattern) CreateNode(data string, pos int, d parser.DataSource, mo Match
 }
 
 func (d *LanguageParser) Data(a, b int) string {
-	a = text.Clamp(0, len(d.data), a)
-	b = text.Clamp(0, len(d.data), b)
-	return string(d.data[a:b])
+	lut := d.offsets()
+	a = text.Clamp(0, len(d.text), byteOffset(lut, a))
+	b = text.Clamp(0, len(d.text), byteOffset(lut, b))
+	if a > b {
+		return ""
+	}
+	return d.text[a:b]
+}
+
+// offsets returns the byte to Offsets lookup table, creating it if needed.
+func (lp *LanguageParser) offsets() []int {
+	if lp.lut == nil || lp.lutUnit != lp.Offsets {
+		lp.lut, lp.lutUnit = lp.table(lp.Offsets), lp.Offsets
+	}
+	return lp.lut
 }
 
 func (lp *LanguageParser) patch(lut []int, node *parser.Node) {
@@ -464,22 +622,63 @@ func NewLanguageParser(scope string, data string) (*LanguageParser, error) {
 	if l, err := Provider.GetLanguage(scope); err != nil {
 		return nil, err
 	} else {
-		return &LanguageParser{l, []rune(data)}, nil
+		return &LanguageParser{l: l, source: source{text: data}}, nil
+	}
+}
+
+// NewLanguageParserBytes creates a parser for raw input such as the contents
+// of a file. A UTF-8 or UTF-16 byte order mark is detected and removed, UTF-16
+// input is converted to UTF-8, and every byte of invalid UTF-8 is replaced
+// by utf8.RuneError.
+//
+// "\r\n" line endings are parsed as "\n", but the ranges of the parsed nodes
+// are still relative to the original input: byte offsets include the byte
+// order mark and every '\r', while rune and UTF-16 offsets count each '\r'
+// but not the byte order mark. Data returns the decoded text.
+func NewLanguageParserBytes(scope string, data []byte) (*LanguageParser, error) {
+	if l, err := Provider.GetLanguage(scope); err != nil {
+		return nil, err
+	} else {
+		return &LanguageParser{l: l, source: *decode(data)}, nil
 	}
 }
 
-func (lp *LanguageParser) Parse() (*parser.Node, error) {
-	sdata := string(lp.data)
+// NewLanguageParserFor is like NewLanguageParserBytes, but parses with the
+// already loaded language l. A language keeps the state of its regexes
+// while parsing, so it can be used by several parsers one after the
+// other, but not by several at the same time.
+func NewLanguageParserFor(l *Language, data []byte) *LanguageParser {
+	return &LanguageParser{l: l, source: *decode(data)}
+}
+
+// checkDeadline panics with ErrDeadline, which Parse recovers from, once
+// lp's Deadline has passed.
+func (lp *LanguageParser) checkDeadline() {
+	if !lp.Deadline.IsZero() && time.Now().After(lp.Deadline) {
+		panic(ErrDeadline)
+	}
+}
+
+func (lp *LanguageParser) Parse() (ret *parser.Node, err error) {
+	sdata := lp.text
 	rn := parser.Node{P: lp, Name: lp.l.ScopeName}
 	defer func() {
-		if r := recover(); r != nil {
+		if r := recover(); r == ErrDeadline {
+			ret, err = nil, ErrDeadline
+		} else if r != nil {
 			log.Printf("Panic during parse: %v\n", r)
 			log.Printf("%v", rn)
 		}
 	}()
+	if ps := lp.l.state; ps != nil {
+		ps.deadline = lp.Deadline
+		// What the searches kept is let go of with the parse
+		defer func() { *ps = parseState{} }()
+	}
 	iter := maxiter
 	for i := 0; i < len(sdata) && iter > 0; iter-- {
-		pat, ret := lp.l.RootPattern.Cache(sdata, i)
+		lp.checkDeadline()
+		pat, ret := lp.l.RootPattern.cache(sdata, i, lp.l)
 		nl := strings.IndexAny(sdata[i:], "\n\r")
 		if nl != -1 {
 			nl += i
@@ -499,14 +698,7 @@ func (lp *LanguageParser) Parse() (*parser.Node, error) {
 		}
 	}
 	rn.UpdateRange()
-	if len(sdata) != 0 {
-		lut := make([]int, len(sdata)+1)
-		j := 0
-		for i := range sdata {
-			lut[i] = j
-			j++
-		}
-		lut[len(sdata)] = len(lp.data)
+	if lut := lp.offsets(); lut != nil {
 		lp.patch(lut, &rn)
 	}
 	if iter == 0 {
@@ -5,11 +5,19 @@
 package textmate
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gbbr/textmate/vendor/limetext/lime-backend/lib/util"
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
 )
 
 func TestLanguageProviderLanguageFromScope(t *testing.T) {
@@ -54,58 +62,85 @@ func TestTmLanguage(t *testing.T) {
 	tests := []test{
 		{
 			"testdata/plist.tmlang",
-			"testdata/plist.tmlang.res",
+			"testdata/plist.tmlang.json",
 			"text.xml.plist",
 		},
 		{
 			"testdata/Property List (XML).tmLanguage",
-			"testdata/Property List (XML).tmLanguage.res",
+			"testdata/Property List (XML).tmLanguage.json",
 			"text.xml.plist",
 		},
 		{
 			"testdata/main.go",
-			"testdata/main.go.res",
+			"testdata/main.go.json",
 			"source.go",
 		},
 		{
 			"testdata/go2.go",
-			"testdata/go2.go.res",
+			"testdata/go2.go.json",
 			"source.go",
 		},
 		{
 			"testdata/utf.go",
-			"testdata/utf.go.res",
+			"testdata/utf.go.json",
 			"source.go",
 		},
 	}
-	for _, t3 := range tests {
+	defer func(e RegexEngine) { Engine = e }(Engine)
+	for _, e := range engines {
+		// The grammars are compiled when they're loaded, which
+		// NewLanguageParser does every time it's called
+		Engine = e
+		for _, t3 := range tests {
 
-		var d0 string
-		if d, err := ioutil.ReadFile(t3.in); err != nil {
-			t.Errorf("Couldn't load file %s: %s", t3.in, err)
-			continue
-		} else {
-			d0 = string(d)
-		}
+			var d0 string
+			if d, err := ioutil.ReadFile(t3.in); err != nil {
+				t.Errorf("Couldn't load file %s: %s", t3.in, err)
+				continue
+			} else {
+				d0 = string(d)
+			}
 
-		if lp, err := NewLanguageParser(t3.syn, d0); err != nil {
-			t.Error(err)
-		} else if root, err := lp.Parse(); err != nil {
-			t.Error(err)
-		} else {
-			//		fmt.Println(lp.RootNode())
-			str := fmt.Sprintf("%s", root)
-			if d, err := ioutil.ReadFile(t3.out); err != nil {
-				if err := ioutil.WriteFile(t3.out, []byte(str), 0644); err != nil {
-					t.Error(err)
+			if lp, err := NewLanguageParser(t3.syn, d0); err != nil {
+				t.Errorf("%T: %s", e, err)
+			} else if root, err := lp.Parse(); err != nil {
+				t.Errorf("%T: %s", e, err)
+			} else {
+				// The golden files are compared structurally, so
+				// that every engine is held to the same tree
+				if *update && e == engines[0] {
+					if err := writeTree(t3.out, root); err != nil {
+						t.Error(err)
+					}
+				} else if f, err := os.Open(t3.out); err != nil {
+					t.Errorf("%s; run the tests with -update to write it", err)
+				} else {
+					exp, err := DecodeTree(f)
+					f.Close()
+					if err != nil {
+						t.Errorf("%s: %s", t3.out, err)
+					} else if diff := DiffTrees(exp, root); diff != "" {
+						t.Errorf("%T: %s: %s", e, t3.in, diff)
+					}
 				}
-			} else if diff := util.Diff(string(d), str); diff != "" {
-				t.Error(diff)
 			}
 		}
 	}
 }
 
+// nodeLines puts every node of a compact JSON tree on a line of its own.
+var nodeLines = strings.NewReplacer("[{", "[\n{", ",{", ",\n{")
+
+// writeTree writes root to the golden file fn as compact JSON with a node
+// per line, which keeps the file small and its diffs readable.
+func writeTree(fn string, root *parser.Node) error {
+	var buf bytes.Buffer
+	if err := EncodeTree(&buf, root, &JSONOptions{Intern: true}); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fn, []byte(nodeLines.Replace(buf.String())), 0644)
+}
+
 func BenchmarkLanguage(b *testing.B) {
 	b.StopTimer()
 	tst := []string{
@@ -135,3 +170,216 @@ func BenchmarkLanguage(b *testing.B) {
 	}
 	fmt.Println(util.Prof)
 }
+
+func TestLanguageProviderLanguageForFile(t *testing.T) {
+	ls, err := Provider.LoadDir("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ls) != 2 {
+		t.Errorf("Expected 2 languages, but got %d", len(ls))
+	}
+	tests := []struct {
+		fn, line, exp string
+	}{
+		{"cmd/main.go", "", "source.go"},
+		{"script", "// -*- mode: go -*-", "source.go"},
+		{"script.go", "<?xml version", "source.go"},
+		{"notes.txt", "nothing", ""},
+	}
+	for _, test := range tests {
+		l, err := Provider.LanguageForFile(test.fn, test.line)
+		if test.exp == "" {
+			if err == nil {
+				t.Errorf("%s: Expected an error, but got %s", test.fn, l.ScopeName)
+			}
+		} else if err != nil {
+			t.Errorf("%s: %s", test.fn, err)
+		} else if l.ScopeName != test.exp {
+			t.Errorf("%s: Expected %s, but got %s", test.fn, test.exp, l.ScopeName)
+		}
+	}
+}
+
+func TestLanguageProviderFileMatches(t *testing.T) {
+	p := &LanguageProvider{scope: make(map[string]string), match: make(map[string]*fileMatch)}
+	if _, err := p.LoadDir("testdata"); err != nil {
+		t.Fatal(err)
+	}
+	const fn = "testdata/Go.tmLanguage"
+	m := p.match[fn]
+	if m == nil || m.firstLine == nil || !reflect.DeepEqual(m.fileTypes, []string{"go"}) {
+		t.Fatalf("Expected the file types and first line match of %s, but got %+v", fn, m)
+	}
+	re := m.firstLine
+	for i := 0; i < 2; i++ {
+		if l, err := p.LanguageForFile("script", "// -*- go -*-"); err != nil {
+			t.Fatal(err)
+		} else if l.ScopeName != "source.go" {
+			t.Errorf("Expected source.go, but got %s", l.ScopeName)
+		}
+	}
+	// Loading a grammar again keeps its compiled first line match
+	if p.match[fn].firstLine != re {
+		t.Error("Expected the first line match to be compiled once")
+	}
+}
+
+func TestIncludeBase(t *testing.T) {
+	dir, err := ioutil.TempDir("", "textmate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// source.inner, and its blocks, contain whatever the language
+	// embedding it, source.outer, has
+	grammars := map[string]string{
+		"Outer.tmLanguage": `<dict>
+	<key>scopeName</key>
+	<string>source.outer</string>
+	<key>patterns</key>
+	<array>
+		<dict>
+			<key>include</key>
+			<string>source.inner</string>
+		</dict>
+		<dict>
+			<key>match</key>
+			<string>outer</string>
+			<key>name</key>
+			<string>keyword.outer</string>
+		</dict>
+	</array>
+</dict>`,
+		"Inner.tmLanguage": `<dict>
+	<key>scopeName</key>
+	<string>source.inner</string>
+	<key>patterns</key>
+	<array>
+		<dict>
+			<key>include</key>
+			<string>$base</string>
+		</dict>
+		<dict>
+			<key>begin</key>
+			<string>\{</string>
+			<key>end</key>
+			<string>\}</string>
+			<key>name</key>
+			<string>meta.block.inner</string>
+			<key>patterns</key>
+			<array>
+				<dict>
+					<key>include</key>
+					<string>$base</string>
+				</dict>
+			</array>
+		</dict>
+	</array>
+</dict>`,
+	}
+	for fn, data := range grammars {
+		data = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+` + data + "\n</plist>\n"
+		if err := ioutil.WriteFile(filepath.Join(dir, fn), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := Provider.LoadDir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	lp, err := NewLanguageParser("source.outer", "{outer}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := lp.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const exp = `0-7: "source.outer"
+	0-7: "meta.block.inner"
+		1-6: "keyword.outer" - Data: "outer"
+`
+	if diff := util.Diff(exp, fmt.Sprintf("%s", root)); diff != "" {
+		t.Error(diff)
+	}
+
+	// Cache resolves "$base" the same way
+	l, err := Provider.LanguageFromScope("source.outer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pat, mo := l.RootPattern.Patterns[0].Cache("outer", 0); mo == nil || pat.Name != "keyword.outer" {
+		t.Errorf("Expected a match of keyword.outer, but got %v", mo)
+	}
+}
+
+func TestLanguageParserDeadline(t *testing.T) {
+	l, err := Provider.LanguageFromFile("testdata/Go.tmLanguage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := ioutil.ReadFile("testdata/main.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lp := NewLanguageParserFor(l, d)
+	lp.Deadline = time.Now().Add(-time.Second)
+	if _, err := lp.Parse(); err != ErrDeadline {
+		t.Errorf("Expected %v, but got %v", ErrDeadline, err)
+	}
+
+	// The language can be used again once the parse is over
+	lp = NewLanguageParserFor(l, d)
+	lp.Deadline = time.Now().Add(time.Minute)
+	root, err := lp.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open("testdata/main.go.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	exp, err := DecodeTree(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := DiffTrees(exp, root); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestLanguageParserDeadlineInSearch(t *testing.T) {
+	// The Go engine is the one whose searches can be given up on
+	defer func(e RegexEngine) { Engine = e }(Engine)
+	Engine = GoEngine
+	var l Language
+	// A search of the pattern backtracks for longer than the test runs
+	// on a line of a's without a b
+	if err := json.Unmarshal([]byte(`{"scopeName": "source.slow", "patterns": [{"match": "(a+)+b", "name": "slow"}]}`), &l); err != nil {
+		t.Fatal(err)
+	}
+	lp := NewLanguageParserFor(&l, []byte(strings.Repeat("a", 64)))
+	lp.Deadline = time.Now().Add(100 * time.Millisecond)
+	start := time.Now()
+	if _, err := lp.Parse(); err != ErrDeadline {
+		t.Errorf("Expected %v, but got %v", ErrDeadline, err)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Errorf("Expected the parse to be given up on, but it took %s", d)
+	}
+
+	// The language can be used again without a deadline
+	lp = NewLanguageParserFor(&l, []byte("aab"))
+	root, err := lp.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(root.Children) != 1 || root.Children[0].Name != "slow" {
+		t.Errorf("Expected a single slow node, but got %s", root)
+	}
+}
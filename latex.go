@@ -0,0 +1,121 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+// LaTeXOptions control the output of WriteLaTeX.
+type LaTeXOptions struct {
+	// Write a whole document, which can be compiled on its own, instead
+	// of a Verbatim environment to be included in one.
+	Standalone bool
+	// Number the lines.
+	LineNumbers bool
+	// The number of columns of a tab stop, 4 if 0.
+	TabWidth int
+}
+
+// The packages the output of WriteLaTeX needs.
+const latexPackages = `\usepackage{fancyvrb}
+\usepackage[dvipsnames]{xcolor}
+\usepackage[normalem]{ulem}
+`
+
+// WriteLaTeX writes the text of the parse tree root, as returned by
+// LanguageParser.Parse, highlighted by t as LaTeX.
+//
+// The text is written as a Verbatim environment of the fancyvrb package,
+// in which backslashes and braces are commands, so the text is coloured
+// by \textcolor of the xcolor package, and struck through by \sout of the
+// ulem package. Unless opts.Standalone is set, the document including it
+// needs to load those packages. If opts is nil, the defaults of
+// LaTeXOptions are used.
+func WriteLaTeX(w io.Writer, root *parser.Node, t *Theme, opts *LaTeXOptions) error {
+	if opts == nil {
+		opts = &LaTeXOptions{}
+	}
+	tab := opts.TabWidth
+	if tab <= 0 {
+		tab = 4
+	}
+	var (
+		bw     = bufio.NewWriter(w)
+		h      = highlight(root)
+		global = t.OpaqueStyle("")
+	)
+	if opts.Standalone {
+		bw.WriteString("\\documentclass{article}\n\\usepackage[utf8]{inputenc}\n\\usepackage[T1]{fontenc}\n")
+		bw.WriteString(latexPackages)
+		fmt.Fprintf(bw, "\\pagecolor[HTML]{%s}\n", latexColor(global.Background))
+		bw.WriteString("\\begin{document}\n")
+	}
+	vopts := []string{`commandchars=\\\{\}`, fmt.Sprintf(`formatcom=\color[HTML]{%s}`, latexColor(global.Foreground))}
+	if opts.LineNumbers {
+		vopts = append(vopts, "numbers=left")
+	}
+	fmt.Fprintf(bw, "\\begin{Verbatim}[%s]\n", strings.Join(vopts, ","))
+	for i := range h.lines {
+		for _, r := range joinCells(h.cells(i, t.OpaqueStyle, tab)) {
+			bw.WriteString(latexRun(r, global))
+		}
+		bw.WriteString("\n")
+	}
+	bw.WriteString("\\end{Verbatim}\n")
+	if opts.Standalone {
+		bw.WriteString("\\end{document}\n")
+	}
+	return bw.Flush()
+}
+
+// latexRun returns the run r as commands of a Verbatim environment, with
+// the parts of its style that differ from global.
+func latexRun(r run, global Style) string {
+	var buf bytes.Buffer
+	for _, c := range r.text {
+		switch c {
+		case '\\', '{', '}':
+			// The character code, as the characters are commands
+			fmt.Fprintf(&buf, "{\\char`\\%c}", c)
+		default:
+			buf.WriteRune(c)
+		}
+	}
+	s, text := r.style, buf.String()
+	if strings.TrimSpace(r.text) == "" && s.Background == global.Background {
+		return text
+	}
+	if s.Font&Bold != 0 {
+		text = `\textbf{` + text + `}`
+	}
+	if s.Font&Italic != 0 {
+		text = `\textit{` + text + `}`
+	}
+	if s.Font&Underline != 0 {
+		text = `\uline{` + text + `}`
+	}
+	if s.Font&Strikethrough != 0 {
+		text = `\sout{` + text + `}`
+	}
+	if s.Foreground != global.Foreground {
+		text = fmt.Sprintf(`\textcolor[HTML]{%s}{%s}`, latexColor(s.Foreground), text)
+	}
+	if s.Background != global.Background {
+		text = fmt.Sprintf(`{\setlength{\fboxsep}{0pt}\colorbox[HTML]{%s}{%s}}`, latexColor(s.Background), text)
+	}
+	return text
+}
+
+// latexColor returns c as the argument of a colour of the HTML model.
+func latexColor(c Color) string {
+	return fmt.Sprintf("%02X%02X%02X", c.R, c.G, c.B)
+}
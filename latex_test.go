@@ -0,0 +1,44 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gbbr/textmate/vendor/limetext/lime-backend/lib/util"
+)
+
+func TestWriteLaTeX(t *testing.T) {
+	th, err := LoadTheme("testdata/Monokai.tmTheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := parseString(t, "testdata/Go.tmLanguage", "// {\\} $%&#_^~ é\n\tint\n")
+	const exp = `\begin{Verbatim}[commandchars=\\\{\},formatcom=\color[HTML]{F8F8F2},numbers=left]
+\textcolor[HTML]{75715E}{// {\char` + "`" + `\{}{\char` + "`" + `\\}{\char` + "`" + `\}} $%&#_^~ é}
+    \textcolor[HTML]{66D9EF}{\textit{int}}
+\end{Verbatim}
+`
+	var buf bytes.Buffer
+	if err := WriteLaTeX(&buf, root, th, &LaTeXOptions{LineNumbers: true}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := util.Diff(exp, buf.String()); diff != "" {
+		t.Error(diff)
+	}
+
+	buf.Reset()
+	if err := WriteLaTeX(&buf, root, th, &LaTeXOptions{Standalone: true, TabWidth: 2}); err != nil {
+		t.Fatal(err)
+	}
+	doc := buf.String()
+	for _, s := range []string{"\\documentclass{article}", "\\usepackage{fancyvrb}", "\\pagecolor[HTML]{272822}", "\n  \\textcolor", "\\end{document}\n"} {
+		if !strings.Contains(doc, s) {
+			t.Errorf("Expected the document to contain %q:\n%s", s, doc)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// OffsetUnit selects how positions in the ranges of parsed nodes are counted.
+type OffsetUnit int
+
+const (
+	RuneOffsets  OffsetUnit = iota // Unicode code points, the default
+	ByteOffsets                    // Bytes of the UTF-8 input
+	UTF16Offsets                   // UTF-16 code units, as used by editor protocols such as LSP
+)
+
+func (u OffsetUnit) String() string {
+	switch u {
+	case RuneOffsets:
+		return "runes"
+	case ByteOffsets:
+		return "bytes"
+	case UTF16Offsets:
+		return "utf-16"
+	}
+	return "unknown"
+}
+
+// width returns the number of units taken up by r.
+func (u OffsetUnit) width(r rune, size int) int {
+	switch u {
+	case ByteOffsets:
+		return size
+	case UTF16Offsets:
+		if r >= 0x10000 && r <= utf8.MaxRune {
+			return 2
+		}
+	}
+	return 1
+}
+
+// offsetTable creates a lookup table mapping every byte offset of data,
+// including len(data), to an offset counted in unit u. Bytes in the middle
+// of an encoded rune map to the offset of the rune they belong to, so the
+// table is non-decreasing and safe to index with any byte offset.
+//
+// A nil table is returned for ByteOffsets, meaning the mapping is the identity.
+func offsetTable(data string, u OffsetUnit) []int {
+	if u == ByteOffsets {
+		return nil
+	}
+	lut := make([]int, len(data)+1)
+	j := 0
+	for i := 0; i < len(data); {
+		r, size := utf8.DecodeRuneInString(data[i:])
+		for k := 0; k < size; k++ {
+			lut[i+k] = j
+		}
+		i += size
+		j += u.width(r, size)
+	}
+	lut[len(data)] = j
+	return lut
+}
+
+// byteOffset is the inverse of offsetTable, returning the byte offset
+// of the first rune that starts at or after unit offset pos.
+func byteOffset(lut []int, pos int) int {
+	if lut == nil {
+		return pos
+	}
+	return sort.SearchInts(lut, pos)
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+func TestOffsetTable(t *testing.T) {
+	const data = "aé😀b"
+	tests := []struct {
+		unit OffsetUnit
+		exp  []int
+	}{
+		{RuneOffsets, []int{0, 1, 1, 2, 2, 2, 2, 3, 4}},
+		{UTF16Offsets, []int{0, 1, 1, 2, 2, 2, 2, 4, 5}},
+		{ByteOffsets, nil},
+	}
+	for _, test := range tests {
+		if lut := offsetTable(data, test.unit); !reflect.DeepEqual(lut, test.exp) {
+			t.Errorf("%s: Expected %v, but got %v", test.unit, test.exp, lut)
+		}
+	}
+}
+
+func findNode(n *parser.Node, name string) *parser.Node {
+	if n.Name == name {
+		return n
+	}
+	for _, c := range n.Children {
+		if r := findNode(c, name); r != nil {
+			return r
+		}
+	}
+	return nil
+}
+
+func TestLanguageParserOffsets(t *testing.T) {
+	const (
+		data = "package é\n\nvar s = \"é😀x\"\n"
+		str  = "\"é😀x\""
+	)
+	tests := []struct {
+		unit OffsetUnit
+		a, b int
+	}{
+		{RuneOffsets, 19, 24},
+		{ByteOffsets, 20, 29},
+		{UTF16Offsets, 19, 25},
+	}
+	for _, test := range tests {
+		lp, err := NewLanguageParser("testdata/Go.tmLanguage", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lp.Offsets = test.unit
+		root, err := lp.Parse()
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := findNode(root, "string.quoted.double.go")
+		if n == nil {
+			t.Fatalf("%s: No string node in %s", test.unit, root)
+		}
+		if n.Range.A != test.a || n.Range.B != test.b {
+			t.Errorf("%s: Expected range %d-%d, but got %s", test.unit, test.a, test.b, n.Range)
+		}
+		if d := n.Data(); d != str {
+			t.Errorf("%s: Expected data %q, but got %q", test.unit, str, d)
+		}
+	}
+}
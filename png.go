@@ -0,0 +1,106 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+// RenderImage draws the text of the parse tree root, as returned by
+// LanguageParser.Parse, highlighted by t.
+//
+// The text is laid out like WriteSVG does, but drawn with a built-in 5x7
+// bitmap font of the printable ASCII characters, so no fonts need to be
+// installed. Other characters are drawn as boxes. Bold text is drawn
+// twice, a pixel apart, and italic text slanted. If opts is nil, the
+// defaults of ImageOptions are used.
+func RenderImage(root *parser.Node, t *Theme, opts *ImageOptions) *image.NRGBA {
+	if opts == nil {
+		opts = &ImageOptions{}
+	}
+	scale := opts.Scale
+	if scale <= 0 {
+		scale = 2
+	}
+	g := layout(root, t, opts)
+	cols, rows := g.size()
+	img := image.NewNRGBA(image.Rect(0, 0, cols*cellWidth*scale, rows*cellHeight*scale))
+	fill := func(r image.Rectangle, c Color) {
+		draw.Draw(img, r, image.NewUniform(color.NRGBA(c)), image.Point{}, draw.Src)
+	}
+	// The rectangle of the cells from col to end of line row, in pixels
+	cells := func(row, col, end int) image.Rectangle {
+		return image.Rect((col+1)*cellWidth*scale, (row+1)*cellHeight*scale, (end+1)*cellWidth*scale, (row+2)*cellHeight*scale)
+	}
+
+	fill(img.Bounds(), g.global.Background)
+	if g.gutter != 0 {
+		fill(image.Rect(0, 0, g.gutter*cellWidth*scale, img.Bounds().Dy()), g.gutterBg)
+	}
+	for i, l := range g.lines {
+		if g.gutter != 0 {
+			for col, r := range g.lineNumber(i) {
+				drawGlyph(img, cells(i, col, col+1).Min, r, g.gutterFg, 0, scale)
+			}
+		}
+		for col, c := range l {
+			r := cells(i, g.gutter+col, g.gutter+col+1)
+			if c.style.Background != g.global.Background {
+				fill(r, c.style.Background)
+			}
+			drawGlyph(img, r.Min, c.r, c.style.Foreground, c.style.Font, scale)
+		}
+	}
+	return img
+}
+
+// WritePNG writes the image RenderImage draws as a PNG.
+func WritePNG(w io.Writer, root *parser.Node, t *Theme, opts *ImageOptions) error {
+	return png.Encode(w, RenderImage(root, t, opts))
+}
+
+// drawGlyph draws the glyph of r in the cell at pt, in the colour c and
+// the font style f, with every pixel of the font scale pixels wide.
+func drawGlyph(img *image.NRGBA, pt image.Point, r rune, c Color, f FontStyle, scale int) {
+	set := func(x, y int) {
+		rect := image.Rect(x*scale, y*scale, (x+1)*scale, (y+1)*scale).Add(pt)
+		draw.Draw(img, rect, image.NewUniform(color.NRGBA(c)), image.Point{}, draw.Src)
+	}
+	// Below the glyphs there's room for descenders and an underline
+	const top = 1
+	if r != ' ' {
+		down := descents[r]
+		gl := glyph(r)
+		for x, bits := range gl {
+			for y := 0; y < glyphHeight; y++ {
+				if bits&(1<<uint(y)) == 0 {
+					continue
+				}
+				px := x
+				if f&Italic != 0 && y < glyphHeight/2 {
+					px++
+				}
+				set(px, top+down+y)
+				if f&Bold != 0 {
+					set(px+1, top+down+y)
+				}
+			}
+		}
+	}
+	for x := 0; x < cellWidth; x++ {
+		if f&Underline != 0 {
+			set(x, cellHeight-1)
+		}
+		if f&Strikethrough != 0 {
+			set(x, top+glyphHeight/2)
+		}
+	}
+}
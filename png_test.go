@@ -0,0 +1,65 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+func TestRenderImage(t *testing.T) {
+	th, err := LoadTheme("testdata/Monokai.tmTheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := parseString(t, "testdata/Go.tmLanguage", "// I\nint\n")
+	img := RenderImage(root, th, &ImageOptions{Scale: 1})
+	if b := img.Bounds(); b.Dx() != (4+2)*cellWidth || b.Dy() != (2+2)*cellHeight {
+		t.Fatalf("Unexpected size %v", b)
+	}
+	at := func(x, y int) Color {
+		return Color(img.NRGBAAt(x, y))
+	}
+	bg := th.Style("").Background
+	if c := at(0, 0); c != bg {
+		t.Errorf("Expected the background %s in the margin, but got %s", bg, c)
+	}
+	// The middle column of the glyph of 'I', the fourth character
+	comment := th.Style("source.go comment.line").Foreground
+	for y := 1; y <= glyphHeight; y++ {
+		if c := at(4*cellWidth+2, cellHeight+y); c != comment {
+			t.Errorf("%d: Expected the comment colour %s, but got %s", y, comment, c)
+		}
+	}
+	// 'i' of the italic "int" is slanted, and the cell after the text
+	// is empty
+	if c := at(cellWidth+2, 2*cellHeight+1+3); c == bg {
+		t.Errorf("Expected the dot of the i to be drawn")
+	}
+	for x := 4 * cellWidth; x < 5*cellWidth; x++ {
+		for y := 2 * cellHeight; y < 3*cellHeight; y++ {
+			if c := at(x, y); c != bg {
+				t.Fatalf("%d,%d: Expected the background, but got %s", x, y, c)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := WritePNG(&buf, root, th, &ImageOptions{LineNumbers: true}); err != nil {
+		t.Fatal(err)
+	}
+	dec, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := dec.Bounds(); b.Dx() != (3+4+2)*cellWidth*2 {
+		t.Errorf("Expected the gutter to widen the image, but got %v", b)
+	}
+	if c := color.NRGBAModel.Convert(dec.At(0, 0)); c != (color.NRGBA{0x49, 0x48, 0x3e, 0xff}) {
+		t.Errorf("Expected the gutter background, but got %v", c)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+type (
+	// A RegexEngine compiles the regexes of language definitions.
+	// Patterns are written in the Oniguruma syntax TextMate uses.
+	RegexEngine interface {
+		Compile(pattern string) (CompiledRegex, error)
+	}
+
+	// A CompiledRegex is a regex compiled by a RegexEngine.
+	CompiledRegex interface {
+		// Find returns the byte offsets in data of the first match
+		// starting at or after start and of its capture groups, in
+		// the same format as regexp.Regexp.FindStringSubmatchIndex,
+		// or nil if there is no match. \G matches at start.
+		Find(data string, start int) []int
+		String() string
+	}
+
+	// A parseRegex is a CompiledRegex whose searches can use the state
+	// of a parse.
+	parseRegex interface {
+		// findIn is like Find, but returns ErrDeadline once the
+		// deadline of ps has passed, even in the middle of a search.
+		findIn(ps *parseState, data string, start int) ([]int, error)
+	}
+)
+
+var (
+	// Engine compiles the regexes of the languages loaded after it's set.
+	// It's OnigurumaEngine when built with cgo and without the purego
+	// build tag, and GoEngine otherwise.
+	Engine RegexEngine = GoEngine
+
+	// The engines this package has been built with.
+	engines = []RegexEngine{GoEngine}
+)
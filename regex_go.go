@@ -0,0 +1,520 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dlclark/regexp2"
+)
+
+type (
+	goEngine struct{}
+
+	goRegex struct {
+		re  *regexp2.Regexp
+		src string
+		// Copies of re for searches with a deadline, which set their
+		// MatchTimeout, as re may be shared by goroutines
+		timed sync.Pool
+	}
+
+	// Data converted to the runes regexp2 works on.
+	subject struct {
+		data  string
+		runes []rune
+		// The byte offset of every rune, plus one for len(data)
+		offsets []int
+	}
+)
+
+// GoEngine compiles regexes with a pure Go regex library. Patterns are
+// translated from the Oniguruma syntax into the .NET syntax the library
+// supports, which covers lookbehind, atomic groups, possessive quantifiers,
+// named groups, \G, \h and POSIX bracket expressions among others.
+var GoEngine RegexEngine = goEngine{}
+
+var namedGroup = regexp.MustCompile(`\(\?(?:<[A-Za-z_]|'[A-Za-z_])`)
+
+func (goEngine) Compile(pattern string) (CompiledRegex, error) {
+	tr, err := translate(pattern)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp2.Compile(tr, regexp2.Multiline)
+	if err != nil {
+		return nil, err
+	}
+	r := &goRegex{re: re, src: pattern}
+	r.timed.New = func() interface{} {
+		// It compiled once already
+		re, _ := regexp2.Compile(tr, regexp2.Multiline)
+		return re
+	}
+	return r, nil
+}
+
+func (r *goRegex) Find(data string, start int) []int {
+	ret, _ := r.find(r.re, newSubject(data), start)
+	return ret
+}
+
+func (r *goRegex) findIn(ps *parseState, data string, start int) ([]int, error) {
+	re := r.re
+	if !ps.deadline.IsZero() {
+		timeout := time.Until(ps.deadline)
+		if timeout <= 0 {
+			return nil, ErrDeadline
+		}
+		re = r.timed.Get().(*regexp2.Regexp)
+		defer r.timed.Put(re)
+		re.MatchTimeout = timeout
+	}
+	// The data of a parse is searched by many regexes in turn
+	if ps.subject == nil || ps.subject.data != data {
+		ps.subject = newSubject(data)
+	}
+	return r.find(re, ps.subject, start)
+}
+
+// find searches s with re, r or one of its copies, from the first rune at
+// or after start.
+func (r *goRegex) find(re *regexp2.Regexp, s *subject, start int) ([]int, error) {
+	at := sort.Search(len(s.offsets), func(i int) bool { return s.offsets[i] >= start })
+	m, err := re.FindRunesMatchStartingAt(s.runes, at)
+	if err != nil {
+		// The only errors of searches are timeouts
+		return nil, ErrDeadline
+	} else if m == nil {
+		return nil, nil
+	}
+	gs := m.Groups()
+	ret := make([]int, 2*len(gs))
+	for i, g := range gs {
+		if len(g.Captures) == 0 {
+			ret[2*i], ret[2*i+1] = -1, -1
+		} else {
+			ret[2*i], ret[2*i+1] = s.offsets[g.Index], s.offsets[g.Index+g.Length]
+		}
+	}
+	return ret, nil
+}
+
+func (r *goRegex) String() string {
+	return r.src
+}
+
+// newSubject converts data to runes.
+func newSubject(data string) *subject {
+	s := &subject{
+		data:    data,
+		runes:   make([]rune, 0, len(data)),
+		offsets: make([]int, 0, len(data)+1),
+	}
+	for i, r := range data {
+		s.runes = append(s.runes, r)
+		s.offsets = append(s.offsets, i)
+	}
+	s.offsets = append(s.offsets, len(data))
+	return s
+}
+
+// The POSIX bracket expressions, as the contents of a .NET character class.
+var posixClasses = map[string]string{
+	"alnum":  `\p{L}\p{M}\p{Nd}`,
+	"alpha":  `\p{L}\p{M}`,
+	"ascii":  `\x00-\x7F`,
+	"blank":  `\p{Zs}\t`,
+	"cntrl":  `\p{Cc}`,
+	"digit":  `\p{Nd}`,
+	"graph":  `\p{L}\p{M}\p{N}\p{P}\p{S}`,
+	"lower":  `\p{Ll}`,
+	"print":  `\p{L}\p{M}\p{N}\p{P}\p{S}\p{Zs}`,
+	"punct":  `\p{P}`,
+	"space":  `\s`,
+	"upper":  `\p{Lu}`,
+	"word":   `\w`,
+	"xdigit": `0-9A-Fa-f`,
+}
+
+// A translator converts a regex from Oniguruma's Ruby syntax to .NET syntax.
+type translator struct {
+	in  string
+	i   int
+	out []byte
+	// Whether whitespace and comments are ignored
+	extended bool
+	// Whether plain groups don't capture, as the regex has named groups
+	named bool
+}
+
+// translate converts pattern from the Oniguruma syntax used by TextMate
+// grammars into the .NET syntax supported by regexp2.
+func translate(pattern string) (string, error) {
+	t := translator{in: pattern, named: namedGroup.MatchString(pattern)}
+	if err := t.sequence(); err != nil {
+		return "", fmt.Errorf("%s in %q", err, pattern)
+	}
+	return string(t.out), nil
+}
+
+func (t *translator) sequence() error {
+	var (
+		atom   = -1 // Where the last atom starts in out, if it can be quantified
+		groups []int
+		// Whether the open groups are extended once they're closed
+		extended []bool
+	)
+	for t.i < len(t.in) {
+		c := t.in[t.i]
+		switch {
+		case c == '\\':
+			atom = len(t.out)
+			if err := t.escape(false); err != nil {
+				return err
+			}
+		case c == '[':
+			atom = len(t.out)
+			t.i++
+			t.out = append(t.out, '[')
+			if err := t.class(); err != nil {
+				return err
+			}
+		case c == '(':
+			groups = append(groups, len(t.out))
+			atom = -1
+			ext := t.extended
+			t.group()
+			if t.i < len(t.in) && t.in[t.i] == ')' {
+				// The options of (?x) hold until the end of the
+				// enclosing group, and those of (?x: until the end
+				// of their own
+				ext = t.extended
+			}
+			extended = append(extended, ext)
+		case c == ')':
+			if len(groups) == 0 {
+				return fmt.Errorf("unmatched ) at %d", t.i)
+			}
+			atom, groups = groups[len(groups)-1], groups[:len(groups)-1]
+			t.extended, extended = extended[len(extended)-1], extended[:len(extended)-1]
+			t.out = append(t.out, c)
+			t.i++
+		case c == '*' || c == '+' || c == '?' || c == '{' && isInterval(t.in[t.i:]):
+			if atom == -1 {
+				// Nothing to repeat, so leave it to the regex library to complain
+				t.out = append(t.out, c)
+				t.i++
+				continue
+			}
+			atom = t.quantifier(atom)
+		case t.extended && c == '#':
+			end := strings.IndexByte(t.in[t.i:], '\n')
+			if end == -1 {
+				end = len(t.in) - t.i
+			}
+			t.out = append(t.out, t.in[t.i:t.i+end]...)
+			t.i += end
+		case t.extended && strings.IndexByte(" \t\n\r\f\v", c) != -1:
+			t.out = append(t.out, c)
+			t.i++
+		case c == '|':
+			atom = -1
+			t.out = append(t.out, c)
+			t.i++
+		default:
+			atom = len(t.out)
+			_, size := utf8.DecodeRuneInString(t.in[t.i:])
+			t.out = append(t.out, t.in[t.i:t.i+size]...)
+			t.i += size
+		}
+	}
+	if len(groups) != 0 {
+		return fmt.Errorf("missing )")
+	}
+	return nil
+}
+
+// isInterval reports whether s starts with an interval quantifier such as {2,3}.
+func isInterval(s string) bool {
+	end := strings.IndexByte(s, '}')
+	if end < 2 {
+		return false
+	}
+	n := s[1:end]
+	if i := strings.IndexByte(n, ','); i != -1 {
+		if n = n[:i] + n[i+1:]; n == "" || strings.IndexByte(n, ',') != -1 {
+			return false
+		}
+	}
+	for _, r := range n {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// quantifier translates the quantifier at t.i applying to the atom
+// starting at out[atom:], and returns where the quantified atom starts.
+func (t *translator) quantifier(atom int) int {
+	start := t.i
+	if t.in[t.i] == '{' {
+		t.i += strings.IndexByte(t.in[t.i:], '}') + 1
+		q := t.in[start:t.i]
+		if t.i < len(t.in) && t.in[t.i] == '+' {
+			// Not possessive in Ruby syntax, but a quantifier
+			// applied to the repeated atom
+			t.out = append(t.out[:atom], "(?:"+string(t.out[atom:])+q+")"...)
+			return atom
+		}
+		t.out = append(t.out, q...)
+	} else {
+		t.i++
+		t.out = append(t.out, t.in[start])
+	}
+	switch {
+	case t.i < len(t.in) && t.in[t.i] == '?':
+		t.out = append(t.out, '?')
+		t.i++
+	case t.i < len(t.in) && t.in[t.i] == '+' && t.in[start] != '{':
+		// Possessive quantifiers are atomic groups
+		t.out = append(t.out[:atom], "(?>"+string(t.out[atom:])+")"...)
+		t.i++
+	}
+	return -1
+}
+
+// group translates the start of the group at t.i.
+func (t *translator) group() {
+	t.i++
+	if !strings.HasPrefix(t.in[t.i:], "?") {
+		if t.named {
+			t.out = append(t.out, "(?:"...)
+		} else {
+			t.out = append(t.out, '(')
+		}
+		return
+	}
+	// Option settings such as (?i) and (?mx-i:
+	end := t.i + 1
+	for end < len(t.in) && strings.IndexByte("imx-", t.in[end]) != -1 {
+		end++
+	}
+	if end == t.i+1 || end == len(t.in) || (t.in[end] != ')' && t.in[end] != ':') {
+		t.out = append(t.out, '(')
+		return
+	}
+	t.out = append(t.out, "(?"...)
+	on := true
+	for _, c := range t.in[t.i+1 : end] {
+		switch c {
+		case '-':
+			on = false
+		case 'x':
+			t.extended = on
+		case 'm':
+			// Ruby's multiline is .NET's singleline, as ^ and $
+			// always match at line breaks in Ruby
+			c = 's'
+		}
+		t.out = append(t.out, byte(c))
+	}
+	t.i = end
+}
+
+// escape translates the escape sequence at t.i, inside of a
+// character class if class is true.
+func (t *translator) escape(class bool) error {
+	if t.i+1 == len(t.in) {
+		return fmt.Errorf("trailing \\")
+	}
+	c := t.in[t.i+1]
+	t.i += 2
+	switch c {
+	case 'h', 'H':
+		switch {
+		case class && c == 'h':
+			t.out = append(t.out, posixClasses["xdigit"]...)
+		case class:
+			return fmt.Errorf("\\H in character class")
+		case c == 'h':
+			t.out = append(t.out, "[0-9A-Fa-f]"...)
+		default:
+			t.out = append(t.out, "[^0-9A-Fa-f]"...)
+		}
+		return nil
+	case 'x':
+		if !strings.HasPrefix(t.in[t.i:], "{") {
+			t.out = append(t.out, '\\', 'x')
+			t.span(2, "0123456789ABCDEFabcdef")
+			return nil
+		}
+		end := strings.IndexByte(t.in[t.i:], '}')
+		if end == -1 {
+			return fmt.Errorf("unterminated \\x{")
+		}
+		v, err := strconv.ParseUint(t.in[t.i+1:t.i+end], 16, 32)
+		if err != nil || v > utf8.MaxRune {
+			return fmt.Errorf("invalid \\x%s", t.in[t.i:t.i+end+1])
+		}
+		t.i += end + 1
+		if v <= 0xffff {
+			t.out = append(t.out, fmt.Sprintf(`\u%04X`, v)...)
+		} else {
+			t.out = utf8.AppendRune(t.out, rune(v))
+		}
+		return nil
+	case 'p', 'P':
+		t.out = append(t.out, '\\', c)
+		if strings.HasPrefix(t.in[t.i:], "{^") {
+			// \p{^Lu} is \P{Lu}
+			t.out[len(t.out)-1] ^= 'p' ^ 'P'
+			t.out = append(t.out, '{')
+			t.i += 2
+			t.upto('}')
+		} else if strings.HasPrefix(t.in[t.i:], "{") {
+			t.upto('}')
+		} else {
+			t.span(1, "CLMNPSZ")
+		}
+		return nil
+	case 'k':
+		t.out = append(t.out, '\\', c)
+		if strings.HasPrefix(t.in[t.i:], "<") {
+			t.upto('>')
+		} else if strings.HasPrefix(t.in[t.i:], "'") {
+			t.i++
+			t.out = append(t.out, '\'')
+			t.upto('\'')
+		}
+		return nil
+	case 'R':
+		if class {
+			return fmt.Errorf("\\R in character class")
+		}
+		t.out = append(t.out, `(?:\r\n|[\n\v\f\r\u0085\u2028\u2029])`...)
+		return nil
+	case 'g', 'K', 'X', 'y', 'Y':
+		return fmt.Errorf("unsupported \\%c", c)
+	}
+	t.out = append(t.out, '\\')
+	_, size := utf8.DecodeRuneInString(t.in[t.i-1:])
+	t.out = append(t.out, t.in[t.i-1:t.i-1+size]...)
+	t.i += size - 1
+	switch {
+	case c == 'u':
+		t.span(4, "0123456789ABCDEFabcdef")
+	case c >= '0' && c <= '9':
+		t.span(2, "01234567890")
+	}
+	return nil
+}
+
+// span copies up to n of the bytes in set at t.i to out.
+func (t *translator) span(n int, set string) {
+	for ; n > 0 && t.i < len(t.in) && strings.IndexByte(set, t.in[t.i]) != -1; n-- {
+		t.out = append(t.out, t.in[t.i])
+		t.i++
+	}
+}
+
+// upto copies the input up to and including the next end byte to out.
+func (t *translator) upto(end byte) {
+	n := strings.IndexByte(t.in[t.i:], end) + 1
+	if n == 0 {
+		n = len(t.in) - t.i
+	}
+	t.out = append(t.out, t.in[t.i:t.i+n]...)
+	t.i += n
+}
+
+// class translates the rest of a character class, whose opening [ has
+// already been copied to out.
+func (t *translator) class() error {
+	if strings.HasPrefix(t.in[t.i:], "^") {
+		t.out = append(t.out, '^')
+		t.i++
+	}
+	if strings.HasPrefix(t.in[t.i:], "]") {
+		// A ] right at the start is a literal
+		t.out = append(t.out, '\\', ']')
+		t.i++
+	}
+	for t.i < len(t.in) {
+		switch c := t.in[t.i]; {
+		case c == ']':
+			t.out = append(t.out, c)
+			t.i++
+			return nil
+		case c == '\\':
+			if err := t.escape(true); err != nil {
+				return err
+			}
+		case strings.HasPrefix(t.in[t.i:], "[:"):
+			end := strings.Index(t.in[t.i:], ":]")
+			if end == -1 {
+				return fmt.Errorf("unterminated [:")
+			}
+			name := t.in[t.i+2 : t.i+end]
+			t.i += end + 2
+			neg := strings.HasPrefix(name, "^")
+			cls, ok := posixClasses[strings.TrimPrefix(name, "^")]
+			switch {
+			case !ok:
+				return fmt.Errorf("unknown POSIX class [:%s:]", name)
+			case !neg:
+				t.out = append(t.out, cls...)
+			case strings.Count(cls, `\`) == 1 && strings.HasPrefix(cls, `\p`):
+				t.out = append(t.out, `\P`+cls[2:]...)
+			case cls == `\s` || cls == `\w`:
+				t.out = append(t.out, strings.ToUpper(cls)...)
+			default:
+				return fmt.Errorf("unsupported negated POSIX class [:%s:]", name)
+			}
+		case strings.HasPrefix(t.in[t.i:], "&&["):
+			// Intersection with a negated set, the only form
+			// that has an equivalent in .NET: subtraction
+			if !strings.HasPrefix(t.in[t.i:], "&&[^") {
+				return fmt.Errorf("unsupported character class intersection")
+			}
+			t.i += 4
+			t.out = append(t.out, '-', '[')
+			if err := t.class(); err != nil {
+				return err
+			}
+			if !strings.HasPrefix(t.in[t.i:], "]") {
+				return fmt.Errorf("unsupported character class intersection")
+			}
+		case c == '[':
+			// A nested set is the same as its contents, unless negated
+			if strings.HasPrefix(t.in[t.i:], "[^") {
+				return fmt.Errorf("unsupported negated nested character class")
+			}
+			t.i++
+			if err := t.class(); err != nil {
+				return err
+			}
+			// Drop the nested class' closing bracket
+			t.out = t.out[:len(t.out)-1]
+		default:
+			_, size := utf8.DecodeRuneInString(t.in[t.i:])
+			if c == '-' && strings.HasPrefix(t.in[t.i:], "-[") {
+				// .NET would take this as a subtraction
+				t.out = append(t.out, '\\')
+			}
+			t.out = append(t.out, t.in[t.i:t.i+size]...)
+			t.i += size
+		}
+	}
+	return fmt.Errorf("missing ]")
+}
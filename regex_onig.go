@@ -0,0 +1,53 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+//go:build cgo && !purego
+// +build cgo,!purego
+
+package textmate
+
+import "github.com/gbbr/rubex"
+
+type (
+	onigEngine struct{}
+
+	onigRegex struct {
+		re *rubex.Regexp
+	}
+)
+
+// OnigurumaEngine compiles regexes with Oniguruma through cgo.
+//
+// Oniguruma can only search from the start of the data it's given, so
+// regexes that look behind where a search starts see less context than
+// they would with the whole of the data. Regexes with anchors, word
+// boundaries or lookbehind are searched from the start of their line for
+// that reason, but a search skipping over an earlier match on the line
+// starts after it, where ^ matches again. Its searches can't be given up
+// on once they've started, so the Deadline of a LanguageParser is only
+// checked between them.
+var OnigurumaEngine RegexEngine = onigEngine{}
+
+func init() {
+	Engine = OnigurumaEngine
+	engines = append(engines, OnigurumaEngine)
+}
+
+func (onigEngine) Compile(pattern string) (CompiledRegex, error) {
+	re, err := rubex.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return onigRegex{re}, nil
+}
+
+func (r onigRegex) Find(data string, start int) []int {
+	ret := r.re.FindStringSubmatchIndex(data[start:])
+	MatchObject(ret).fix(start)
+	return ret
+}
+
+func (r onigRegex) String() string {
+	return r.re.String()
+}
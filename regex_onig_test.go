@@ -0,0 +1,63 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+//go:build cgo && !purego
+// +build cgo,!purego
+
+package textmate
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestOnigurumaParity(t *testing.T) {
+	tests := []struct {
+		re   string
+		data string
+		pos  int
+		// What Oniguruma finds searching from pos alone, which doesn't
+		// see the data before pos
+		onig string
+		// What a Regex of Oniguruma finds, if it isn't what one of
+		// GoEngine does
+		regex string
+	}{
+		{`(?<=\.)\w+`, "a.b", 2, "[]", ""},
+		{`(?<!\.)\w+`, "a.b", 2, "[2 3]", ""},
+		{`^b`, "ab", 1, "[1 2]", ""},
+		{`\bvar\b`, "xvar var", 1, "[1 4]", ""},
+		{`\Bar`, "var ar", 4, "[]", ""},
+		{`\Gx`, "axx", 1, "[1 2]", ""},
+		{`é(.)`, "aéb", 1, "[1 4 3 4]", ""},
+		// Skipping over a match earlier on the line searches from
+		// after its start, where ^ matches again
+		{`^\s*(//)`, "x\n  // c", 3, "[3 6 4 6]", "[3 6 4 6]"},
+	}
+	for _, test := range tests {
+		var got [2]string
+		for i, e := range []RegexEngine{GoEngine, OnigurumaEngine} {
+			re, err := e.Compile(test.re)
+			if err != nil {
+				t.Fatalf("%T: %s: %s", e, test.re, err)
+			}
+			if e == OnigurumaEngine {
+				if got := fmt.Sprint(re.Find(test.data, test.pos)); got != test.onig {
+					t.Errorf("%s in %q at %d: Expected Oniguruma to find %s, but got %s", test.re, test.data, test.pos, test.onig, got)
+				}
+			}
+			// Regex searches as far back as the regex looks, so that
+			// the engines agree
+			r := Regex{re: re, lookback: lookbackOf(test.re)}
+			got[i] = fmt.Sprint(r.Find(test.data, test.pos))
+		}
+		if test.regex != "" {
+			if got[1] != test.regex {
+				t.Errorf("%s in %q at %d: Expected OnigurumaEngine to find %s, but got %s", test.re, test.data, test.pos, test.regex, got[1])
+			}
+		} else if got[0] != got[1] {
+			t.Errorf("%s in %q at %d: GoEngine found %s, but OnigurumaEngine %s", test.re, test.data, test.pos, got[0], got[1])
+		}
+	}
+}
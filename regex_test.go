@@ -0,0 +1,114 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dlclark/regexp2"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		in, exp string
+	}{
+		{`a*+b`, `(?>a*)b`},
+		{`(ab)++`, `(?>(ab)+)`},
+		{`[[:alpha:]_]\w*`, `[\p{L}\p{M}_]\w*`},
+		{`[[:^digit:]]`, `[\P{Nd}]`},
+		{`[a-z&&[^aeiou]]`, `[a-z-[aeiou]]`},
+		{`[a[bc]]`, `[abc]`},
+		{`\h+\H`, `[0-9A-Fa-f]+[^0-9A-Fa-f]`},
+		{`[\h_]`, `[0-9A-Fa-f_]`},
+		{`\x{2028}\x{1F600}`, `\u2028😀`},
+		{`\p{^Lu}`, `\P{Lu}`},
+		{`(?<q>["'])(a)\k<q>`, `(?<q>["'])(?:a)\k<q>`},
+		{`(?m:.)`, `(?s:.)`},
+		{`(?x) a # b`, `(?x) a # b`},
+		{`(?x:a)#b++`, `(?x:a)#(?>b+)`},
+		{`(?x)#b++`, `(?x)#b++`},
+		{`((?x)a)#b++`, `((?x)a)#(?>b+)`},
+		{`\G(?<=\.)\s`, `\G(?<=\.)\s`},
+	}
+	for _, test := range tests {
+		if got, err := translate(test.in); err != nil {
+			t.Errorf("%s: %s", test.in, err)
+		} else if got != test.exp {
+			t.Errorf("%s: Expected %s, but got %s", test.in, test.exp, got)
+		}
+	}
+	for _, in := range []string{`\K`, `a\`, `a)`} {
+		if _, err := translate(in); err == nil {
+			t.Errorf("%s: Expected an error", in)
+		}
+	}
+}
+
+func TestRegexEngines(t *testing.T) {
+	tests := []struct {
+		re    string
+		data  string
+		start int
+		exp   string
+	}{
+		{`\bvar\b`, "variable var", 0, "[9 12]"},
+		{`(?<=\.)\w+`, "a.b", 0, "[2 3]"},
+		{`\Gx`, "xax", 1, "[]"},
+		{`\Gx`, "xax", 2, "[2 3]"},
+		{`é(.)`, "aéb", 0, "[1 4 3 4]"},
+		{`é(.)`, "aéb", 2, "[]"},
+		{`(?<n>a)|(b)`, "b", 0, "[0 1 -1 -1]"},
+		{`"(?:[^"\\]|\\.)*+"`, `x "a\"b" y`, 0, "[2 8]"},
+		{`^\s*+(//)`, "x\n  // c", 0, "[2 6 4 6]"},
+		{`[[:upper:]][[:lower:]]+`, "go Ünicode", 0, "[3 11]"},
+	}
+	for _, e := range engines {
+		for _, test := range tests {
+			re, err := e.Compile(test.re)
+			if err != nil {
+				t.Errorf("%T: %s: %s", e, test.re, err)
+				continue
+			}
+			if got := fmt.Sprint(re.Find(test.data, test.start)); got != test.exp {
+				t.Errorf("%T: %s in %q at %d: Expected %s, but got %s", e, test.re, test.data, test.start, test.exp, got)
+			}
+		}
+	}
+}
+
+func TestGoRegexDeadlines(t *testing.T) {
+	c, err := GoEngine.Compile(`(a+)+b`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := c.(*goRegex)
+	slow := strings.Repeat("a", 64)
+	// Parses with and without deadlines search with the same regex
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			ps := &parseState{deadline: time.Now().Add(50 * time.Millisecond)}
+			if _, err := r.findIn(ps, slow, 0); err != ErrDeadline {
+				t.Errorf("Expected %v, but got %v", ErrDeadline, err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if got := fmt.Sprint(r.findIn(new(parseState), "aab", 0)); got != "[0 3 0 2] <nil>" {
+				t.Errorf("Expected a match without a deadline, but got %s", got)
+			}
+		}()
+	}
+	wg.Wait()
+	if r.re.MatchTimeout != regexp2.DefaultMatchTimeout {
+		t.Errorf("Expected the shared regex to keep no timeout, but got %s", r.re.MatchTimeout)
+	}
+}
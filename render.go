@@ -0,0 +1,164 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"strings"
+
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+// highlighted is the text of a parse tree split into lines, without their
+// line terminators, and the tokens of every line, which are offset in
+// bytes of their line. The renderers all work from it.
+type highlighted struct {
+	lines  []string
+	tokens [][]Token
+}
+
+// highlight splits the text of the parse tree root, as returned by
+// LanguageParser.Parse, into lines of tokens. Text the tree doesn't cover
+// is given the scope of root.
+func highlight(root *parser.Node) *highlighted {
+	var (
+		text string
+		lut  []int
+	)
+	if lp, ok := root.P.(*LanguageParser); ok {
+		text, lut = lp.text, lp.offsets()
+	} else if root.P != nil {
+		text = root.P.Data(0, root.Range.B)
+	}
+
+	// The tokens of the whole text, in bytes
+	var (
+		outer    = []string{root.Name}
+		all, pos = []Token(nil), 0
+	)
+	toks, _ := flatten(nil, nil, root, root.Range.A, root.Range.B)
+	for _, tok := range toks {
+		a, b := byteOffset(lut, tok.Start), byteOffset(lut, tok.End)
+		if b > len(text) {
+			b = len(text)
+		}
+		if a < pos {
+			a = pos
+		}
+		all = appendToken(all, outer, pos, a)
+		all = appendToken(all, tok.Scopes, a, b)
+		if b > pos {
+			pos = b
+		}
+	}
+	all = appendToken(all, outer, pos, len(text))
+
+	h := &highlighted{}
+	if text == "" {
+		return h
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	start := 0
+	for _, l := range lines {
+		next := start + len(l)
+		l = strings.TrimSuffix(strings.TrimSuffix(l, "\n"), "\r")
+		end := start + len(l)
+		var lt []Token
+		for len(all) != 0 && all[0].Start < end {
+			tok := all[0]
+			if tok.End > end {
+				lt = appendToken(lt, tok.Scopes, tok.Start-start, len(l))
+				break
+			}
+			lt = appendToken(lt, tok.Scopes, tok.Start-start, tok.End-start)
+			all = all[1:]
+		}
+		// Skip the line terminator
+		for len(all) != 0 && all[0].End <= next {
+			all = all[1:]
+		}
+		if len(all) != 0 && all[0].Start < next {
+			all[0].Start = next
+		}
+		h.lines = append(h.lines, l)
+		h.tokens = append(h.tokens, lt)
+		start = next
+	}
+	return h
+}
+
+// A run is text of a line with the same style.
+type run struct {
+	text  string
+	style Style
+}
+
+// runs returns the text of line i of h styled by style, such as
+// Theme.Style, with neighbouring tokens of the same style joined.
+func (h *highlighted) runs(i int, style func(scope string) Style) []run {
+	var ret []run
+	for _, tok := range h.tokens[i] {
+		s := style(strings.Join(tok.Scopes, " "))
+		data := h.lines[i][tok.Start:tok.End]
+		if n := len(ret); n != 0 && ret[n-1].style == s {
+			ret[n-1].text += data
+		} else {
+			ret = append(ret, run{data, s})
+		}
+	}
+	return ret
+}
+
+// A cell is a character laid out on a grid, and its style.
+type cell struct {
+	r     rune
+	style Style
+}
+
+// cells returns the characters of line i of h styled by style, one per
+// column, with tabs expanded to the next multiple of tabWidth columns.
+func (h *highlighted) cells(i int, style func(scope string) Style, tabWidth int) []cell {
+	var ret []cell
+	for _, r := range h.runs(i, style) {
+		for _, c := range r.text {
+			if c != '\t' {
+				ret = append(ret, cell{c, r.style})
+				continue
+			}
+			for n := tabWidth - len(ret)%tabWidth; n > 0; n-- {
+				ret = append(ret, cell{' ', r.style})
+			}
+		}
+	}
+	return ret
+}
+
+// gutterColors returns the foreground and background of line numbers,
+// which are set by the global settings of t, or by its gutter settings.
+func (t *Theme) gutterColors() (fg, bg *Color) {
+	fg, bg = t.Global.GutterForeground, t.Global.Gutter
+	if c, ok := t.GutterSettings.Colors["foreground"]; ok && fg == nil {
+		fg = &c
+	}
+	if c, ok := t.GutterSettings.Colors["background"]; ok && bg == nil {
+		bg = &c
+	}
+	return
+}
+
+// joinCells returns the runs of cells of the same style in cells.
+func joinCells(cells []cell) []run {
+	var ret []run
+	for _, c := range cells {
+		if n := len(ret); n != 0 && ret[n-1].style == c.style {
+			ret[n-1].text += string(c.r)
+		} else {
+			ret = append(ret, run{string(c.r), c.style})
+		}
+	}
+	return ret
+}
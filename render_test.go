@@ -0,0 +1,67 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/gbbr/textmate/vendor/limetext/text"
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+func TestHighlight(t *testing.T) {
+	// Rune offsets, with a string overlapping the comment before it, and
+	// a capture overlapping the end of its parent and crossing a line
+	const in = "é // a\r\nb \"c\" d\n"
+	lp, err := NewLanguageParser("testdata/Go.tmLanguage", in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := func(name string, a, b int, children ...*parser.Node) *parser.Node {
+		return &parser.Node{Name: name, Range: text.Region{A: a, B: b}, P: lp, Children: children}
+	}
+	root := node("source", 0, 15,
+		node("comment", 2, 9,
+			node("punct", 2, 4),
+			node("tail", 5, 11),
+		),
+		node("string", 7, 13,
+			node("punct", 10, 11),
+		),
+	)
+	exp := []string{
+		"é // a",
+		"0-3: source",
+		"3-5: source comment punct",
+		"5-6: source comment",
+		"6-7: source comment tail",
+		"b \"c\" d",
+		"0-1: source comment tail",
+		"1-2: source string",
+		"2-3: source string punct",
+		"3-5: source string",
+		"5-7: source",
+	}
+	h := highlight(root)
+	var got []string
+	for i, l := range h.lines {
+		got = append(got, l)
+		for _, tok := range h.tokens[i] {
+			got = append(got, tok.String())
+		}
+	}
+	if !reflect.DeepEqual(exp, got) {
+		t.Errorf("Expected\n%s\nbut got\n%s", fmt.Sprint(exp), fmt.Sprint(got))
+	}
+
+	if lp, err = NewLanguageParser("testdata/Go.tmLanguage", ""); err != nil {
+		t.Fatal(err)
+	}
+	if h := highlight(node("source", 0, 0)); len(h.lines) != 0 {
+		t.Errorf("Expected no lines, but got %q", h.lines)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"unicode/utf16"
+
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+// RTFOptions control the output of WriteRTF.
+type RTFOptions struct {
+	// The font of the text, "Courier New" if empty.
+	FontName string
+	// The size of the text in points, 10 if 0.
+	FontSize float64
+}
+
+// WriteRTF writes the text of the parse tree root, as returned by
+// LanguageParser.Parse, highlighted by t as an RTF document, such as can
+// be pasted into word processors.
+//
+// Every run of text has the theme's colours, as its colour and shading,
+// and its font styles. If opts is nil, the defaults of RTFOptions are
+// used.
+func WriteRTF(w io.Writer, root *parser.Node, t *Theme, opts *RTFOptions) error {
+	if opts == nil {
+		opts = &RTFOptions{}
+	}
+	font, size := opts.FontName, opts.FontSize
+	if font == "" {
+		font = "Courier New"
+	}
+	if size <= 0 {
+		size = 10
+	}
+	var (
+		h      = highlight(root)
+		colors = make(map[Color]int)
+		table  bytes.Buffer
+		body   bytes.Buffer
+	)
+	// The colour table, in which colours are numbered from 1
+	index := func(c Color) int {
+		i, ok := colors[c]
+		if !ok {
+			i = len(colors) + 1
+			colors[c] = i
+			fmt.Fprintf(&table, `\red%d\green%d\blue%d;`, c.R, c.G, c.B)
+		}
+		return i
+	}
+	for i := range h.lines {
+		for _, r := range h.runs(i, t.OpaqueStyle) {
+			s := r.style
+			fmt.Fprintf(&body, `{\cf%d\chcbpat%d\cb%d`, index(s.Foreground), index(s.Background), index(s.Background))
+			for _, f := range []struct {
+				f    FontStyle
+				word string
+			}{{Bold, `\b`}, {Italic, `\i`}, {Underline, `\ul`}, {Strikethrough, `\strike`}} {
+				if s.Font&f.f != 0 {
+					body.WriteString(f.word)
+				}
+			}
+			body.WriteString(" ")
+			rtfEscape(&body, r.text)
+			body.WriteString("}")
+		}
+		body.WriteString("\\par\n")
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "{\\rtf1\\ansi\\deff0{\\fonttbl{\\f0\\fmodern %s;}}\n", rtfText(font))
+	fmt.Fprintf(bw, "{\\colortbl;%s}\n", table.String())
+	fmt.Fprintf(bw, "\\f0\\fs%d\n", int(size*2+0.5))
+	bw.Write(body.Bytes())
+	bw.WriteString("}\n")
+	return bw.Flush()
+}
+
+// rtfEscape writes s as RTF text to buf. Characters that aren't ASCII are
+// written as Unicode escapes, with a question mark for readers that don't
+// know them.
+func rtfEscape(buf *bytes.Buffer, s string) {
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '{' || r == '}':
+			buf.WriteByte('\\')
+			buf.WriteRune(r)
+		case r == '\t':
+			buf.WriteString(`\tab `)
+		case r < 0x80:
+			buf.WriteRune(r)
+		default:
+			var units []uint16
+			if r1, r2 := utf16.EncodeRune(r); r1 != 0xfffd {
+				units = []uint16{uint16(r1), uint16(r2)}
+			} else {
+				units = []uint16{uint16(r)}
+			}
+			for _, u := range units {
+				fmt.Fprintf(buf, `\u%d?`, int16(u))
+			}
+		}
+	}
+}
+
+// rtfText returns s escaped as RTF text.
+func rtfText(s string) string {
+	var buf bytes.Buffer
+	rtfEscape(&buf, s)
+	return buf.String()
+}
@@ -0,0 +1,34 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gbbr/textmate/vendor/limetext/lime-backend/lib/util"
+)
+
+func TestWriteRTF(t *testing.T) {
+	th, err := LoadTheme("testdata/Monokai.tmTheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := parseString(t, "testdata/Go.tmLanguage", "// {\\} é😀\n\tint\n")
+	const exp = `{\rtf1\ansi\deff0{\fonttbl{\f0\fmodern Menlo;}}
+{\colortbl;\red117\green113\blue94;\red39\green40\blue34;\red248\green248\blue242;\red102\green217\blue239;}
+\f0\fs23
+{\cf1\chcbpat2\cb2 // \{\\\} \u233?\u-10179?\u-8704?}\par
+{\cf3\chcbpat2\cb2 \tab }{\cf4\chcbpat2\cb2\i int}\par
+}
+`
+	var buf bytes.Buffer
+	if err := WriteRTF(&buf, root, th, &RTFOptions{FontName: "Menlo", FontSize: 11.5}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := util.Diff(exp, buf.String()); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"log"
+	"strings"
+)
+
+// A Scanner finds the first match among a list of match and begin/end
+// patterns, much like Oniguruma's OnigScanner does.
+//
+// The result of every regex is cached for the data it last searched, and
+// reused for as long as it's still the first match at or after the position
+// being scanned. A parser moving forward through its data therefore only
+// runs a regex again once the parser has moved past the regex's previous
+// match. As the cache is kept by the regex itself, it's shared by all the
+// scanners a pattern is part of.
+type Scanner struct {
+	pats []*Pattern
+}
+
+// NewScanner creates a Scanner for pats. Earlier patterns take
+// priority over later ones when several match at the same position.
+func NewScanner(pats []*Pattern) *Scanner {
+	return &Scanner{pats: pats}
+}
+
+// Patterns returns the patterns s was created for.
+func (s *Scanner) Patterns() []*Pattern {
+	return s.pats
+}
+
+// Scan returns the index and match of the pattern matching earliest in data
+// at or after pos, or -1 and nil if none of the patterns match.
+func (s *Scanner) Scan(data string, pos int) (best int, ret MatchObject) {
+	best = -1
+	for i, p := range s.pats {
+		if mo := p.regex().cached(data, pos); mo != nil && (ret == nil || mo[0] < ret[0]) {
+			best, ret = i, mo
+			if mo[0] == pos {
+				// Nothing can match before pos, and the patterns
+				// after this one have lower priority
+				break
+			}
+		}
+	}
+	return
+}
+
+// cached is like Find, but returns the result of the previous search
+// if it's also the first match at or after pos.
+func (r *Regex) cached(data string, pos int) MatchObject {
+	if data != r.cacheData || r.cachePos > pos {
+		r.cacheData, r.cachePos = data, pos
+		r.cacheMatch, r.cacheFrom = r.find(data, pos, 0)
+	} else if r.cacheMatch != nil && r.cacheMatch[0] < pos {
+		r.cachePos = pos
+		r.cacheMatch, r.cacheFrom = r.find(data, pos, r.cacheFrom)
+	}
+	return r.cacheMatch
+}
+
+// How much of the data before where a search starts can affect its matches.
+type lookback int
+
+const (
+	// Nothing, so a search can start right where a match is wanted,
+	// rather than having to skip over the matches found before it
+	lookNone lookback = iota
+	// The line the search starts on, due to anchors, word boundaries or
+	// lookbehind. TextMate grammars are applied a line at a time, so they
+	// aren't expected to look further back than that
+	lookLine
+	// Where the search starts, as the regex uses \A or \G. Starting
+	// the search a rune before where a match is wanted gives the same
+	// matches as skipping over the earlier matches one at a time does,
+	// without having to do so
+	lookSearch
+)
+
+// lookbackOf returns how far back from where a search starts the
+// regex pattern might look.
+func lookbackOf(pattern string) lookback {
+	ret := lookNone
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			if i++; i == len(pattern) {
+				break
+			} else if c := pattern[i]; c == 'A' || c == 'G' {
+				return lookSearch
+			} else if c == 'b' || c == 'B' {
+				ret = lookLine
+			}
+		case '^':
+			if i == 0 || pattern[i-1] != '[' {
+				ret = lookLine
+			}
+		case '(':
+			if strings.HasPrefix(pattern[i:], "(?<=") || strings.HasPrefix(pattern[i:], "(?<!") {
+				ret = lookLine
+			}
+		}
+	}
+	return ret
+}
+
+// regex returns the regex that starts a match of p.
+func (p *Pattern) regex() *Regex {
+	if p.Match.re != nil {
+		return &p.Match
+	}
+	return &p.Begin
+}
+
+// collect appends p to ret if it's a match or begin/end pattern, and the
+// patterns p includes or contains otherwise. Include directives are
+// resolved relative to p's language, with "$base" referring to base.
+func (p *Pattern) collect(ret []*Pattern, base *Language, seen map[*Pattern]bool) []*Pattern {
+	switch {
+	case p.Match.re != nil || p.Begin.re != nil:
+		ret = append(ret, p)
+	case p.Include != "":
+		if inc := p.include(base); inc != nil && !seen[inc] {
+			seen[inc] = true
+			ret = inc.collect(ret, base, seen)
+		}
+	default:
+		for i := range p.Patterns {
+			ret = p.Patterns[i].collect(ret, base, seen)
+		}
+	}
+	return ret
+}
+
+// include resolves p's include directive.
+func (p *Pattern) include(base *Language) *Pattern {
+	l := p.owner
+	if l == nil {
+		l = base
+	}
+	switch z := p.Include[0]; {
+	case p.Include == "$self":
+		return &l.RootPattern.Pattern
+	case p.Include == "$base":
+		return &base.RootPattern.Pattern
+	case z == '#':
+		if p2, ok := l.Repository[p.Include[1:]]; ok {
+			return p2
+		}
+		log.Printf("Not found in repository: %s", p.Include)
+	case z == '$':
+		log.Printf("Unhandled include directive: %s", p.Include)
+	default:
+		if l2, err := Provider.GetLanguage(p.Include); err != nil {
+			failedMu.Lock()
+			if !failed[p.Include] {
+				log.Printf("Include directive %s failed: %s", p.Include, err)
+			}
+			failed[p.Include] = true
+			failedMu.Unlock()
+		} else {
+			// Searches of l2 share the parse state of l
+			l2.state = l.state
+			l2.tweak()
+			return &l2.RootPattern.Pattern
+		}
+	}
+	return nil
+}
+
+// newScanner creates a Scanner for the patterns that can be applied inside
+// of p, such as between the begin and end of a begin/end pattern.
+func (p *Pattern) newScanner(base *Language) *Scanner {
+	var (
+		pats []*Pattern
+		seen = make(map[*Pattern]bool)
+	)
+	for i := range p.Patterns {
+		pats = p.Patterns[i].collect(pats, base, seen)
+	}
+	return NewScanner(pats)
+}
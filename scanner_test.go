@@ -0,0 +1,68 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestLookbackOf(t *testing.T) {
+	tests := []struct {
+		in  string
+		exp lookback
+	}{
+		{`[a-z]+`, lookNone},
+		{`[^a-z]+\s*$`, lookNone},
+		{`\\b`, lookNone},
+		{`\bfunc\b`, lookLine},
+		{`^\s*//`, lookLine},
+		{`(?<=\.)\w+`, lookLine},
+		{`(?<!\\)"`, lookLine},
+		{`(?!\G)`, lookSearch},
+		{`\A#!`, lookSearch},
+	}
+	for _, test := range tests {
+		if l := lookbackOf(test.in); l != test.exp {
+			t.Errorf("%s: Expected %d, but got %d", test.in, test.exp, l)
+		}
+	}
+}
+
+func TestScanner(t *testing.T) {
+	var p Pattern
+	if err := json.Unmarshal([]byte(`{"patterns": [
+		{"match": "b"},
+		{"patterns": [{"match": "a"}, {"begin": "\\bx", "end": "y"}]},
+		{"match": "ab"}
+	]}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	s := p.newScanner(nil)
+	if l := len(s.Patterns()); l != 4 {
+		t.Fatalf("Expected 4 patterns, but got %d", l)
+	}
+	const data = "cxab x"
+	tests := []struct {
+		pos int
+		exp string
+	}{
+		{0, "1: [2 3]"},
+		{2, "1: [2 3]"},
+		{3, "0: [3 4]"},
+		{4, "2: [5 6]"},
+		{6, "-1: []"},
+		// There's no word boundary before the x at 1, even
+		// though the search starts there
+		{1, "1: [2 3]"},
+	}
+	for _, test := range tests {
+		i, mo := s.Scan(data, test.pos)
+		if got := fmt.Sprintf("%d: %v", i, mo); got != test.exp {
+			t.Errorf("Scan at %d: Expected %s, but got %s", test.pos, test.exp, got)
+		}
+	}
+}
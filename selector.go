@@ -0,0 +1,291 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+type (
+	// A Selector is a TextMate scope selector, such as
+	// "source.go string - string.quoted.raw, L:(comment | markup)".
+	//
+	// A selector is a comma separated list of composites, and matches a
+	// scope stack if any of them do. A composite is a list of expressions
+	// joined by "|" (or), "&" (and) or "-" (and not), evaluated from left
+	// to right. An expression is a path of scopes or a parenthesised
+	// selector, optionally negated by a leading "-" and preceded by a
+	// filter choosing which side of a position it's matched against: "L:"
+	// for the left, "R:" for the right and "B:" for either. A path matches
+	// a scope stack if each of its scopes is a prefix of a scope in the
+	// stack, in the same order, with "*" matching any part of a scope.
+	Selector struct {
+		src   string
+		comps []composite
+	}
+
+	composite []term
+
+	term struct {
+		// '|', '&', '-', or 0 for the first term of a composite
+		op byte
+		e  expression
+	}
+
+	expression struct {
+		negate bool
+		// 'L', 'R', 'B', or 0 if there's no filter
+		side  byte
+		group *Selector
+		// The scopes of the path, split into their dot separated parts
+		path [][]string
+	}
+
+	selectorParser struct {
+		s string
+		i int
+	}
+)
+
+// ParseSelector parses the scope selector s.
+func ParseSelector(s string) (*Selector, error) {
+	p := selectorParser{s: s}
+	ret, err := p.selector()
+	if err != nil {
+		return nil, err
+	}
+	if p.skip(); p.i < len(s) {
+		return nil, fmt.Errorf("Unexpected %q at %d in selector %q", s[p.i], p.i, s)
+	}
+	return ret, nil
+}
+
+func (s *Selector) String() string {
+	return s.src
+}
+
+// Match returns whether s matches the scope stack scopes, ordered from
+// the outermost scope to the innermost, and how specific the match is.
+//
+// The score is the one TextMate ranks selectors by. Matching a scope
+// closer to the innermost one always scores higher, and then matching
+// more of its parts does. For composites and lists the highest scoring
+// match counts.
+func (s *Selector) Match(scopes []string) (float64, bool) {
+	return s.MatchSides(scopes, scopes)
+}
+
+// MatchSides is like Match, but for a position between two characters,
+// where left and right are the scope stacks of the characters on either
+// side. Expressions without a filter are matched against right.
+func (s *Selector) MatchSides(left, right []string) (score float64, ok bool) {
+	for _, c := range s.comps {
+		if sc, m := c.match(left, right); m && (!ok || sc > score) {
+			score, ok = sc, true
+		}
+	}
+	return
+}
+
+func (c composite) match(left, right []string) (score float64, ok bool) {
+	for _, t := range c {
+		sc, m := t.e.match(left, right)
+		switch t.op {
+		case 0:
+			score, ok = sc, m
+		case '|':
+			if m {
+				score, ok = math.Max(score, sc), true
+			}
+		case '&':
+			if ok = ok && m; ok {
+				score = math.Max(score, sc)
+			}
+		case '-':
+			ok = ok && !m
+		}
+	}
+	return
+}
+
+func (e *expression) match(left, right []string) (score float64, ok bool) {
+	switch e.side {
+	case 'L':
+		score, ok = e.matchStack(left)
+	case 'B':
+		ls, lok := e.matchStack(left)
+		rs, rok := e.matchStack(right)
+		score, ok = math.Max(ls, rs), lok || rok
+	default:
+		score, ok = e.matchStack(right)
+	}
+	if e.negate {
+		return 0, !ok
+	}
+	return
+}
+
+func (e *expression) matchStack(stack []string) (float64, bool) {
+	if e.group != nil {
+		return e.group.Match(stack)
+	}
+	// Match the path from the innermost scope outwards. Every part of a
+	// scope, starting from the innermost, halves the worth of a match.
+	var score, power float64
+	j := len(e.path) - 1
+	for i := len(stack) - 1; i >= 0 && j >= 0; i-- {
+		parts := strings.Split(stack[i], ".")
+		power += float64(len(parts))
+		if !prefixMatch(e.path[j], parts) {
+			continue
+		}
+		for k := range e.path[j] {
+			score += math.Pow(2, float64(k)-power)
+		}
+		j--
+	}
+	return score, j < 0
+}
+
+func prefixMatch(sel, parts []string) bool {
+	if len(sel) > len(parts) {
+		return false
+	}
+	for i := range sel {
+		if sel[i] != parts[i] && sel[i] != "*" {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *selectorParser) skip() {
+	for p.i < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.i]) != -1 {
+		p.i++
+	}
+}
+
+func (p *selectorParser) peek() byte {
+	if p.skip(); p.i < len(p.s) {
+		return p.s[p.i]
+	}
+	return 0
+}
+
+func (p *selectorParser) selector() (*Selector, error) {
+	start := p.i
+	ret := &Selector{}
+	for {
+		c, err := p.composite()
+		if err != nil {
+			return nil, err
+		}
+		ret.comps = append(ret.comps, c)
+		if p.peek() != ',' {
+			break
+		}
+		p.i++
+	}
+	ret.src = strings.TrimSpace(p.s[start:p.i])
+	return ret, nil
+}
+
+func (p *selectorParser) composite() (composite, error) {
+	var (
+		ret composite
+		op  byte
+	)
+	for {
+		e, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, term{op, e})
+		switch op = p.peek(); op {
+		case '|', '&', '-':
+			p.i++
+		default:
+			return ret, nil
+		}
+	}
+}
+
+func (p *selectorParser) expression() (e expression, err error) {
+	if p.peek() == '-' {
+		e.negate = true
+		p.i++
+	}
+	if c := p.peek(); (c == 'L' || c == 'R' || c == 'B') && strings.HasPrefix(p.s[p.i+1:], ":") {
+		e.side = c
+		p.i += 2
+	}
+	if p.peek() == '(' {
+		p.i++
+		if e.group, err = p.selector(); err != nil {
+			return
+		} else if p.peek() != ')' {
+			err = p.unexpected("expected )")
+			return
+		}
+		p.i++
+		return
+	}
+	for {
+		p.skip()
+		start := p.i
+		for p.i < len(p.s) && isScopeByte(p.s[p.i], p.i == start) {
+			p.i++
+		}
+		if p.i == start {
+			break
+		}
+		e.path = append(e.path, strings.Split(p.s[start:p.i], "."))
+	}
+	if len(e.path) == 0 {
+		err = p.unexpected("expected a scope")
+	}
+	return
+}
+
+func (p *selectorParser) unexpected(msg string) error {
+	if p.i == len(p.s) {
+		return fmt.Errorf("Unexpected end of selector %q, %s", p.s, msg)
+	}
+	return fmt.Errorf("Unexpected %q at %d in selector %q, %s", p.s[p.i], p.i, p.s, msg)
+}
+
+// isScopeByte returns whether c can be part of a scope name. A scope
+// name can't start with "-", which is an operator there.
+func isScopeByte(c byte, first bool) bool {
+	switch {
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c >= 0x80:
+		return true
+	case c == '-':
+		return !first
+	}
+	return strings.IndexByte("._+*#$", c) != -1
+}
+
+// examples returns a scope stack matched by each composite of s that has
+// one easily found, which is the path of its first expression.
+func (s *Selector) examples() (ret [][]string) {
+	for _, c := range s.comps {
+		if len(c) == 0 || c[0].e.negate {
+			continue
+		}
+		if e := c[0].e; e.group != nil {
+			ret = append(ret, e.group.examples()...)
+		} else if len(e.path) != 0 {
+			stack := make([]string, len(e.path))
+			for i, p := range e.path {
+				stack[i] = strings.Join(p, ".")
+			}
+			ret = append(ret, stack)
+		}
+	}
+	return
+}
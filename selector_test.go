@@ -0,0 +1,125 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSelectorMatch(t *testing.T) {
+	const stack = "source.go meta.function.go string.quoted.double.go"
+	tests := []struct {
+		sel string
+		exp bool
+	}{
+		{"string", true},
+		{"string.quoted", true},
+		{"string.quoted.single", false},
+		{"str", false},
+		{"source string", true},
+		{"string source", false},
+		{"source.go meta string", true},
+		{"meta.*.go", true},
+		{"source.*.go", false},
+		{"comment, string", true},
+		{"comment, markup", false},
+		{"source - string", false},
+		{"source - comment", true},
+		{"source -comment", true},
+		{"-comment", true},
+		{"-string", false},
+		{"comment | string", true},
+		{"comment & string", false},
+		{"meta & string", true},
+		{"source - (comment | string)", false},
+		{"source - (comment | markup)", true},
+		{"(comment, string) & meta.function", true},
+		{"comment.line.double-slash", false},
+	}
+	for _, test := range tests {
+		sel, err := ParseSelector(test.sel)
+		if err != nil {
+			t.Errorf("%s: %s", test.sel, err)
+			continue
+		}
+		if _, ok := sel.Match(strings.Fields(stack)); ok != test.exp {
+			t.Errorf("%s: Expected %v, but got %v", test.sel, test.exp, ok)
+		}
+	}
+}
+
+func TestSelectorScore(t *testing.T) {
+	stack := strings.Fields("source.go meta.function.go string.quoted.double.go punctuation.definition.string.begin.go")
+	// From the least to the most specific
+	sels := []string{
+		"source",
+		"source.go",
+		"meta",
+		"source meta",
+		"string",
+		"source string",
+		"string.quoted",
+		"punctuation",
+		"string punctuation",
+		"punctuation.definition.string",
+	}
+	prev := -1.0
+	for _, s := range sels {
+		sel, err := ParseSelector(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		score, ok := sel.Match(stack)
+		if !ok {
+			t.Errorf("%s: Expected a match", s)
+		} else if score <= prev {
+			t.Errorf("%s: Expected a score above %g, but got %g", s, prev, score)
+		}
+		prev = score
+	}
+}
+
+func TestSelectorSides(t *testing.T) {
+	left := strings.Fields("source.go comment.line.go")
+	right := strings.Fields("source.go keyword.go")
+	tests := []struct {
+		sel string
+		exp bool
+	}{
+		{"comment", false},
+		{"keyword", true},
+		{"L:comment", true},
+		{"R:comment", false},
+		{"B:comment", true},
+		{"L:keyword", false},
+		{"L:(keyword | comment)", true},
+		{"source - L:comment", false},
+		{"-L:comment", false},
+	}
+	for _, test := range tests {
+		sel, err := ParseSelector(test.sel)
+		if err != nil {
+			t.Errorf("%s: %s", test.sel, err)
+			continue
+		}
+		if _, ok := sel.MatchSides(left, right); ok != test.exp {
+			t.Errorf("%s: Expected %v, but got %v", test.sel, test.exp, ok)
+		}
+	}
+}
+
+func TestParseSelectorErrors(t *testing.T) {
+	for _, s := range []string{"", "source,", "(source", "source)", "source - ", "a | | b"} {
+		if _, err := ParseSelector(s); err == nil {
+			t.Errorf("%q: Expected an error", s)
+		}
+	}
+	if sel, err := ParseSelector(" source - comment , string "); err != nil {
+		t.Error(err)
+	} else if exp := "source - comment , string"; sel.String() != exp {
+		t.Errorf("Expected %q, but got %q", exp, sel)
+	}
+}
@@ -0,0 +1,347 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+type (
+	// A SemanticLegend maps the scopes of tokens to the token types and
+	// modifiers of LSP semantic tokens, as used by
+	// textDocument/semanticTokens.
+	//
+	// The types and modifiers are the legend announced to the client, which
+	// the encoded tokens refer to by index. Every token gets the type and
+	// modifiers of the rule matching its scopes best, compared like the
+	// rules of a theme, and tokens no rule matches are left out.
+	SemanticLegend struct {
+		TokenTypes     []string       `json:"tokenTypes"`
+		TokenModifiers []string       `json:"tokenModifiers"`
+		Rules          []SemanticRule `json:"-"`
+
+		types     map[string]int
+		modifiers map[string]int
+		mu        sync.Mutex
+		// The type and modifiers of the scope stacks seen so far, with
+		// the type -1 for stacks no rule matches.
+		cache map[string]semanticType
+	}
+
+	// A SemanticRule gives the tokens whose scopes Scope, a scope
+	// selector, matches a semantic token type and modifiers.
+	SemanticRule struct {
+		Scope     string
+		Type      string
+		Modifiers []string
+		selector  *Selector
+	}
+
+	// SemanticTokens is the result of a full semantic tokens request.
+	SemanticTokens struct {
+		ResultID string `json:"resultId,omitempty"`
+		// Five integers for every token: its line relative to the line of
+		// the previous token, its start relative to the start of the
+		// previous token if they're on the same line, or else to the
+		// start of the line, its length, type and modifiers. Positions are
+		// in UTF-16 code units.
+		Data []uint32 `json:"data"`
+	}
+
+	// SemanticTokensDelta is the result of a full/delta semantic tokens
+	// request, the edits turning the data of a previous result into the
+	// data of the current one.
+	SemanticTokensDelta struct {
+		ResultID string               `json:"resultId,omitempty"`
+		Edits    []SemanticTokensEdit `json:"edits"`
+	}
+
+	// A SemanticTokensEdit replaces DeleteCount integers of the data of
+	// a previous result, starting at Start, by Data.
+	SemanticTokensEdit struct {
+		Start       uint32   `json:"start"`
+		DeleteCount uint32   `json:"deleteCount"`
+		Data        []uint32 `json:"data,omitempty"`
+	}
+
+	semanticType struct {
+		typ       int
+		modifiers uint32
+	}
+)
+
+// The token types and modifiers predefined by LSP.
+var (
+	SemanticTokenTypes = []string{
+		"namespace", "type", "class", "enum", "interface", "struct",
+		"typeParameter", "parameter", "variable", "property", "enumMember",
+		"event", "function", "method", "macro", "keyword", "modifier",
+		"comment", "string", "number", "regexp", "operator", "decorator",
+	}
+	SemanticTokenModifiers = []string{
+		"declaration", "definition", "readonly", "static", "deprecated",
+		"abstract", "async", "modification", "documentation",
+		"defaultLibrary",
+	}
+)
+
+// The rules of DefaultSemanticLegend, for the scope names most grammars
+// use.
+var defaultSemanticRules = []SemanticRule{
+	{Scope: "comment", Type: "comment"},
+	{Scope: "comment.block.documentation", Type: "comment", Modifiers: []string{"documentation"}},
+	{Scope: "string", Type: "string"},
+	{Scope: "string.regexp", Type: "regexp"},
+	{Scope: "constant.numeric", Type: "number"},
+	{Scope: "constant.language", Type: "keyword"},
+	{Scope: "keyword", Type: "keyword"},
+	{Scope: "keyword.operator", Type: "operator"},
+	{Scope: "storage.type", Type: "type"},
+	{Scope: "storage.modifier", Type: "modifier"},
+	{Scope: "entity.name.namespace", Type: "namespace"},
+	{Scope: "entity.name.type, entity.name.class", Type: "type", Modifiers: []string{"declaration"}},
+	{Scope: "entity.name.function", Type: "function", Modifiers: []string{"declaration"}},
+	{Scope: "entity.other.attribute-name", Type: "property"},
+	{Scope: "support.type, support.class", Type: "type", Modifiers: []string{"defaultLibrary"}},
+	{Scope: "support.function", Type: "function", Modifiers: []string{"defaultLibrary"}},
+	{Scope: "variable", Type: "variable"},
+	{Scope: "variable.parameter", Type: "parameter"},
+	{Scope: "variable.function", Type: "function"},
+	{Scope: "variable.other.constant", Type: "variable", Modifiers: []string{"readonly"}},
+	{Scope: "variable.other.property, variable.other.member", Type: "property"},
+}
+
+// NewSemanticLegend creates a SemanticLegend announcing types and
+// modifiers, and mapping scopes to them by rules.
+func NewSemanticLegend(types, modifiers []string, rules []SemanticRule) (*SemanticLegend, error) {
+	l := &SemanticLegend{
+		TokenTypes:     types,
+		TokenModifiers: modifiers,
+		Rules:          make([]SemanticRule, len(rules)),
+		types:          make(map[string]int),
+		modifiers:      make(map[string]int),
+		cache:          make(map[string]semanticType),
+	}
+	for i, t := range types {
+		l.types[t] = i
+	}
+	for i, m := range modifiers {
+		if i >= 32 {
+			return nil, fmt.Errorf("Too many semantic token modifiers, %d", len(modifiers))
+		}
+		l.modifiers[m] = i
+	}
+	for i, r := range rules {
+		sel, err := ParseSelector(r.Scope)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := l.types[r.Type]; !ok {
+			return nil, fmt.Errorf("Semantic token type %q of %s isn't in the legend", r.Type, r.Scope)
+		}
+		for _, m := range r.Modifiers {
+			if _, ok := l.modifiers[m]; !ok {
+				return nil, fmt.Errorf("Semantic token modifier %q of %s isn't in the legend", m, r.Scope)
+			}
+		}
+		r.selector = sel
+		l.Rules[i] = r
+	}
+	return l, nil
+}
+
+// DefaultSemanticLegend returns a SemanticLegend of the types and modifiers
+// predefined by LSP, with rules for the scope names most grammars use.
+func DefaultSemanticLegend() *SemanticLegend {
+	l, err := NewSemanticLegend(SemanticTokenTypes, SemanticTokenModifiers, defaultSemanticRules)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// Lookup returns the index of the type and the bit set of the modifiers
+// of a token with the scope stack scopes, ordered from the outermost
+// scope to the innermost, or false if no rule matches it.
+func (l *SemanticLegend) Lookup(scopes []string) (typ int, modifiers uint32, ok bool) {
+	key := strings.Join(scopes, " ")
+	l.mu.Lock()
+	st, ok := l.cache[key]
+	l.mu.Unlock()
+	if !ok {
+		st = l.lookup(scopes)
+		l.mu.Lock()
+		l.cache[key] = st
+		l.mu.Unlock()
+	}
+	return st.typ, st.modifiers, st.typ >= 0
+}
+
+func (l *SemanticLegend) lookup(scopes []string) semanticType {
+	var (
+		best  *SemanticRule
+		score float64
+	)
+	for i := range l.Rules {
+		r := &l.Rules[i]
+		// Later rules win ties, like in a theme
+		if sc, ok := r.selector.Match(scopes); ok && (best == nil || sc >= score) {
+			best, score = r, sc
+		}
+	}
+	if best == nil {
+		return semanticType{typ: -1}
+	}
+	st := semanticType{typ: l.types[best.Type]}
+	for _, m := range best.Modifiers {
+		st.modifiers |= 1 << uint(l.modifiers[m])
+	}
+	return st
+}
+
+// Encode returns the semantic tokens of lines, tokens with offsets in
+// UTF-16 code units such as a Tokenizer with UTF16Offsets returns.
+// Neighbouring tokens of the same type and modifiers are merged.
+func (l *SemanticLegend) Encode(lines [][]Token) []uint32 {
+	var (
+		ret              []uint32
+		prevLine, prevCh int
+	)
+	for i, toks := range lines {
+		var (
+			start, end = 0, -1
+			cur        semanticType
+		)
+		flush := func() {
+			if end <= start {
+				return
+			}
+			dl, dc := i-prevLine, start
+			if dl == 0 {
+				dc -= prevCh
+			}
+			ret = append(ret, uint32(dl), uint32(dc), uint32(end-start), uint32(cur.typ), cur.modifiers)
+			prevLine, prevCh = i, start
+		}
+		for _, tok := range toks {
+			typ, mods, ok := l.Lookup(tok.Scopes)
+			st := semanticType{typ, mods}
+			if ok && st == cur && tok.Start == end {
+				end = tok.End
+				continue
+			}
+			flush()
+			if ok {
+				start, end, cur = tok.Start, tok.End, st
+			} else {
+				start, end = 0, -1
+			}
+		}
+		flush()
+	}
+	return ret
+}
+
+// EncodeTree returns the semantic tokens of root, as returned by
+// LanguageParser.Parse, whatever the unit of its offsets.
+func (l *SemanticLegend) EncodeTree(root *parser.Node) []uint32 {
+	return l.Encode(tokensIn(root, UTF16Offsets))
+}
+
+// DiffSemanticTokens returns the edits turning the data of the semantic
+// tokens prev into next, which is no edits at all if they're the same.
+func DiffSemanticTokens(prev, next []uint32) []SemanticTokensEdit {
+	i := 0
+	for i < len(prev) && i < len(next) && prev[i] == next[i] {
+		i++
+	}
+	if i == len(prev) && i == len(next) {
+		return []SemanticTokensEdit{}
+	}
+	j := 0
+	for j < len(prev)-i && j < len(next)-i && prev[len(prev)-1-j] == next[len(next)-1-j] {
+		j++
+	}
+	e := SemanticTokensEdit{
+		Start:       uint32(i),
+		DeleteCount: uint32(len(prev) - i - j),
+	}
+	if d := next[i : len(next)-j]; len(d) != 0 {
+		e.Data = append([]uint32(nil), d...)
+	}
+	return []SemanticTokensEdit{e}
+}
+
+// ApplySemanticEdits returns the data of the semantic tokens prev with
+// edits applied, as a client of DiffSemanticTokens does.
+func ApplySemanticEdits(prev []uint32, edits []SemanticTokensEdit) ([]uint32, error) {
+	ret := append([]uint32(nil), prev...)
+	// Edits refer to prev, so apply them from the last one
+	for k := len(edits) - 1; k >= 0; k-- {
+		e := edits[k]
+		a, b := int(e.Start), int(e.Start)+int(e.DeleteCount)
+		if b > len(ret) {
+			return nil, fmt.Errorf("Semantic tokens edit %d-%d is beyond the end of the data, %d", a, b, len(ret))
+		}
+		ret = append(ret[:a], append(append([]uint32(nil), e.Data...), ret[b:]...)...)
+	}
+	return ret, nil
+}
+
+// SemanticResults keeps the last semantic tokens sent for every document,
+// to answer full/delta requests against them. It is safe for concurrent
+// use.
+type SemanticResults struct {
+	mu      sync.Mutex
+	last    int
+	results map[string]*SemanticTokens
+}
+
+// Full records data as the latest semantic tokens of the document uri,
+// and returns them with a new result id.
+func (r *SemanticResults) Full(uri string, data []uint32) *SemanticTokens {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.full(uri, data)
+}
+
+// full is Full with r locked.
+func (r *SemanticResults) full(uri string, data []uint32) *SemanticTokens {
+	if r.results == nil {
+		r.results = make(map[string]*SemanticTokens)
+	}
+	r.last++
+	ret := &SemanticTokens{ResultID: fmt.Sprint(r.last), Data: data}
+	r.results[uri] = ret
+	return ret
+}
+
+// Delta records data like Full, and returns the edits from the result
+// previous of the document uri. If that isn't the latest result of the
+// document, the full *SemanticTokens are returned instead of a
+// *SemanticTokensDelta.
+func (r *SemanticResults) Delta(uri, previous string, data []uint32) interface{} {
+	r.mu.Lock()
+	// The latest result is replaced under the same lock, so that no
+	// other result can be recorded in between
+	prev := r.results[uri]
+	ret := r.full(uri, data)
+	r.mu.Unlock()
+	if prev == nil || prev.ResultID != previous {
+		return ret
+	}
+	return &SemanticTokensDelta{ResultID: ret.ResultID, Edits: DiffSemanticTokens(prev.Data, data)}
+}
+
+// Forget drops the results of the document uri, once it's closed.
+func (r *SemanticResults) Forget(uri string) {
+	r.mu.Lock()
+	delete(r.results, uri)
+	r.mu.Unlock()
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSemanticEncodeTree(t *testing.T) {
+	if _, err := Provider.LanguageFromFile("testdata/Go.tmLanguage"); err != nil {
+		t.Fatal(err)
+	}
+	l := DefaultSemanticLegend()
+	// The comment is merged into a single token, and the emoji takes up
+	// two UTF-16 code units
+	root := parseString(t, "source.go", "// é😀\nvar x int\n")
+	exp := []uint32{
+		0, 0, 6, 17, 0,
+		1, 0, 3, 15, 0,
+		0, 3, 2, 8, 0,
+		0, 3, 3, 1, 0,
+	}
+	if got := l.EncodeTree(root); !reflect.DeepEqual(got, exp) {
+		t.Errorf("Expected %v, but got %v", exp, got)
+	}
+}
+
+func TestSemanticEncode(t *testing.T) {
+	l, err := NewSemanticLegend(
+		[]string{"keyword", "function"},
+		[]string{"declaration", "defaultLibrary"},
+		[]SemanticRule{
+			{Scope: "keyword", Type: "keyword"},
+			{Scope: "entity.name.function", Type: "function", Modifiers: []string{"declaration"}},
+			{Scope: "support.function", Type: "function", Modifiers: []string{"declaration", "defaultLibrary"}},
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := [][]Token{
+		{
+			{0, 4, []string{"source", "keyword.other"}},
+			{4, 5, []string{"source"}},
+			{5, 8, []string{"source", "entity.name.function"}},
+			{8, 10, []string{"source", "entity.name.function", "punctuation"}},
+		},
+		nil,
+		{
+			{2, 5, []string{"source", "support.function.builtin"}},
+			{5, 6, []string{"source", "keyword"}},
+			{6, 7, []string{"source", "keyword"}},
+		},
+	}
+	exp := []uint32{
+		0, 0, 4, 0, 0,
+		0, 5, 5, 1, 1,
+		2, 2, 3, 1, 3,
+		0, 3, 2, 0, 0,
+	}
+	if got := l.Encode(lines); !reflect.DeepEqual(got, exp) {
+		t.Errorf("Expected %v, but got %v", exp, got)
+	}
+	if _, _, ok := l.Lookup([]string{"source", "string"}); ok {
+		t.Error("Expected no semantic token type for a string")
+	}
+}
+
+func TestNewSemanticLegendErrors(t *testing.T) {
+	tests := [][]SemanticRule{
+		{{Scope: "keyword", Type: "function"}},
+		{{Scope: "keyword", Type: "keyword", Modifiers: []string{"static"}}},
+		{{Scope: "keyword (", Type: "keyword"}},
+	}
+	for i, rules := range tests {
+		if _, err := NewSemanticLegend([]string{"keyword"}, nil, rules); err == nil {
+			t.Errorf("Test %d: Expected an error, but didn't get one", i)
+		}
+	}
+}
+
+func TestDiffSemanticTokens(t *testing.T) {
+	tests := []struct {
+		prev, next []uint32
+		exp        []SemanticTokensEdit
+	}{
+		{
+			[]uint32{0, 0, 4, 0, 0},
+			[]uint32{0, 0, 4, 0, 0},
+			[]SemanticTokensEdit{},
+		},
+		{
+			[]uint32{0, 0, 4, 0, 0, 1, 0, 3, 1, 0},
+			[]uint32{0, 0, 4, 0, 0, 1, 2, 3, 1, 0},
+			[]SemanticTokensEdit{{6, 1, []uint32{2}}},
+		},
+		{
+			[]uint32{0, 0, 4, 0, 0, 1, 0, 3, 1, 0},
+			[]uint32{0, 0, 4, 0, 0},
+			[]SemanticTokensEdit{{5, 5, nil}},
+		},
+		{
+			nil,
+			[]uint32{0, 0, 4, 0, 0},
+			[]SemanticTokensEdit{{0, 0, []uint32{0, 0, 4, 0, 0}}},
+		},
+	}
+	for i, test := range tests {
+		edits := DiffSemanticTokens(test.prev, test.next)
+		if !reflect.DeepEqual(edits, test.exp) {
+			t.Errorf("Test %d: Expected %v, but got %v", i, test.exp, edits)
+		}
+		if got, err := ApplySemanticEdits(test.prev, edits); err != nil {
+			t.Errorf("Test %d: %s", i, err)
+		} else if len(got) != len(test.next) || (len(got) != 0 && !reflect.DeepEqual(got, test.next)) {
+			t.Errorf("Test %d: Expected %v, but got %v", i, test.next, got)
+		}
+	}
+}
+
+func TestSemanticResults(t *testing.T) {
+	var r SemanticResults
+	first := r.Full("a.go", []uint32{0, 0, 4, 0, 0})
+	if d, ok := r.Delta("a.go", first.ResultID, []uint32{0, 1, 4, 0, 0}).(*SemanticTokensDelta); !ok {
+		t.Error("Expected a delta from the latest result")
+	} else if exp := []SemanticTokensEdit{{1, 1, []uint32{1}}}; !reflect.DeepEqual(d.Edits, exp) {
+		t.Errorf("Expected %v, but got %v", exp, d.Edits)
+	}
+	// The first result isn't the latest one anymore
+	if _, ok := r.Delta("a.go", first.ResultID, nil).(*SemanticTokens); !ok {
+		t.Error("Expected full tokens from an outdated result")
+	}
+	r.Forget("a.go")
+	if _, ok := r.Delta("a.go", first.ResultID, nil).(*SemanticTokens); !ok {
+		t.Error("Expected full tokens for a forgotten document")
+	}
+
+	// Of the deltas from the same result, only the first one gets edits
+	first = r.Full("a.go", nil)
+	var (
+		wg     sync.WaitGroup
+		deltas int32
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, ok := r.Delta("a.go", first.ResultID, nil).(*SemanticTokensDelta); ok {
+				atomic.AddInt32(&deltas, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	if deltas != 1 {
+		t.Errorf("Expected 1 delta from the result, but got %d", deltas)
+	}
+}
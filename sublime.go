@@ -0,0 +1,563 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gbbr/textmate/vendor/limetext/lime-backend/lib/loaders"
+)
+
+type (
+	// A Sublime Text colour scheme, as found in .sublime-color-scheme files
+	sublimeScheme struct {
+		Name      string
+		Variables map[string]string
+		Globals   map[string]json.RawMessage
+		Rules     []map[string]json.RawMessage
+		// Not part of Sublime Text colour schemes, but written by
+		// WriteSublimeColorScheme to keep what a tmTheme has
+		UUID           UUID
+		GutterSettings map[string]json.RawMessage `json:"gutter_settings"`
+	}
+
+	// Evaluates the colours of a Sublime Text colour scheme
+	colorEval struct {
+		vars map[string]string
+		done map[string]Color
+		busy map[string]bool
+	}
+
+	colorParser struct {
+		s string
+		i int
+		e *colorEval
+	}
+)
+
+// The settings of Sublime Text colour schemes that must be colours. Others
+// are kept as colours if they are ones.
+var sublimeColors = map[string]bool{
+	"foreground":          true,
+	"background":          true,
+	"caret":               true,
+	"lineHighlight":       true,
+	"invisibles":          true,
+	"selection":           true,
+	"selectionForeground": true,
+	"selectionBorder":     true,
+	"inactiveSelection":   true,
+	"findHighlight":       true,
+	"gutter":              true,
+	"gutterForeground":    true,
+	"guide":               true,
+	"activeGuide":         true,
+	"stackGuide":          true,
+}
+
+// The keys of Sublime Text colour schemes that aren't kept in Theme.Other.
+var sublimeKeys = []string{"name", "variables", "globals", "rules", "uuid", "gutter_settings"}
+
+// LoadSublimeColorScheme loads the Sublime Text colour scheme filename, a
+// .sublime-color-scheme file.
+//
+// The variables and colour functions of the scheme are evaluated, and the
+// names of its settings are converted to those of tmTheme, so that
+// "line_highlight" becomes "lineHighlight" and "font_style" "fontStyle".
+// Its globals become the global settings of the theme.
+func LoadSublimeColorScheme(filename string) (*Theme, error) {
+	d, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load colorscheme definition: %s", err)
+	}
+	var raw map[string]json.RawMessage
+	if err := loaders.LoadJSON([]byte(decode(d).text), &raw); err != nil {
+		return nil, fmt.Errorf("Unable to load colorscheme definition: %s", err)
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var ss sublimeScheme
+	if err := json.Unmarshal(data, &ss); err != nil {
+		return nil, fmt.Errorf("Unable to load colorscheme definition: %s", err)
+	}
+	e := &colorEval{vars: ss.Variables, done: make(map[string]Color), busy: make(map[string]bool)}
+	t := &Theme{Name: ss.Name, UUID: ss.UUID}
+	if t.Other, err = otherValues(data, sublimeKeys...); err != nil {
+		return nil, err
+	}
+	global, err := e.settings(ss.Globals)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load colorscheme definition: globals: %s", err)
+	}
+	if ss.GutterSettings != nil {
+		if t.GutterSettings, err = e.settings(ss.GutterSettings); err != nil {
+			return nil, fmt.Errorf("Unable to load colorscheme definition: gutter_settings: %s", err)
+		}
+	}
+	t.Settings = append(t.Settings, ScopeSetting{Settings: global})
+	for i, r := range ss.Rules {
+		var s ScopeSetting
+		if v, ok := r["name"]; ok {
+			if err := json.Unmarshal(v, &s.Name); err != nil {
+				return nil, fmt.Errorf("Unable to load colorscheme definition: rule %d: name: %s", i, err)
+			}
+		}
+		if v, ok := r["scope"]; ok {
+			if err := json.Unmarshal(v, &s.Scope); err != nil {
+				return nil, fmt.Errorf("Unable to load colorscheme definition: rule %d: scope: %s", i, err)
+			}
+		}
+		delete(r, "name")
+		delete(r, "scope")
+		if s.Settings, err = e.settings(r); err != nil {
+			return nil, fmt.Errorf("Unable to load colorscheme definition: rule %d (%s): %s", i, s.Scope, err)
+		}
+		t.Settings = append(t.Settings, s)
+	}
+	t.prepare()
+	return t, nil
+}
+
+// WriteSublimeColorScheme writes t as a Sublime Text colour scheme.
+//
+// The first setting without a scope is written as the globals of the
+// scheme, and the names of settings are converted to those of Sublime Text.
+// Colours are written as they are, without variables.
+func (t *Theme) WriteSublimeColorScheme(w io.Writer) error {
+	var (
+		globals = make(map[string]interface{})
+		rules   = make([]interface{}, 0, len(t.Settings))
+		found   bool
+	)
+	for _, s := range t.Settings {
+		if !found && strings.TrimSpace(s.Scope) == "" {
+			globals = snakeKeys(s.Settings.values())
+			found = true
+			continue
+		}
+		r := snakeKeys(s.Settings.values())
+		r["name"] = s.Name
+		r["scope"] = s.Scope
+		rules = append(rules, r)
+	}
+	top := map[string]interface{}{
+		"name":    t.Name,
+		"globals": globals,
+		"rules":   rules,
+	}
+	if !t.GutterSettings.empty() {
+		top["gutter_settings"] = snakeKeys(t.GutterSettings.values())
+	}
+	if t.UUID != "" {
+		top["uuid"] = string(t.UUID)
+	}
+	for k, v := range t.Other {
+		top[k] = jsonValue(v)
+	}
+	return writeJSON(w, top)
+}
+
+// settings converts the settings of a rule or of the globals.
+func (e *colorEval) settings(values map[string]json.RawMessage) (ret Settings, err error) {
+	ret = Settings{Colors: make(map[string]Color), Other: make(map[string]string)}
+	for k, v := range values {
+		k = camelCase(k)
+		var str string
+		if err := json.Unmarshal(v, &str); err != nil {
+			// Such as the list of colours of a gradient foreground, of
+			// which the first is kept
+			var list []string
+			if json.Unmarshal(v, &list) != nil || len(list) == 0 || !sublimeColors[k] {
+				ret.Other[k] = string(v)
+				continue
+			}
+			str = list[0]
+		}
+		if k == "fontStyle" {
+			f := ParseFontStyle(str)
+			ret.FontStyle = &f
+		} else if c, err := e.color(str); err == nil {
+			ret.Colors[k] = c
+		} else if sublimeColors[k] {
+			return ret, fmt.Errorf("%s: %s", k, err)
+		} else {
+			ret.Other[k] = str
+		}
+	}
+	return
+}
+
+// camelCase converts a name like "line_highlight" to "lineHighlight".
+func camelCase(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if p := parts[i]; p != "" {
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// color evaluates the colour expression s.
+func (e *colorEval) color(s string) (Color, error) {
+	p := colorParser{s: s, e: e}
+	c, err := p.color()
+	if err == nil {
+		if p.skip(); p.i < len(s) {
+			err = fmt.Errorf("Unexpected %q at %d in colour %q", s[p.i], p.i, s)
+		}
+	}
+	return c, err
+}
+
+// variable returns the colour of the variable name.
+func (e *colorEval) variable(name string) (Color, error) {
+	if c, ok := e.done[name]; ok {
+		return c, nil
+	}
+	v, ok := e.vars[name]
+	if !ok {
+		return Color{}, fmt.Errorf("Undefined variable %s", name)
+	} else if e.busy[name] {
+		return Color{}, fmt.Errorf("Variable %s refers to itself", name)
+	}
+	e.busy[name] = true
+	c, err := e.color(v)
+	delete(e.busy, name)
+	if err != nil {
+		return Color{}, fmt.Errorf("%s: %s", name, err)
+	}
+	e.done[name] = c
+	return c, nil
+}
+
+func (p *colorParser) skip() {
+	for p.i < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.i]) != -1 {
+		p.i++
+	}
+}
+
+func (p *colorParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%s at %d in colour %q", fmt.Sprintf(format, args...), p.i, p.s)
+}
+
+// accept skips over c and returns true if it's next.
+func (p *colorParser) accept(c byte) bool {
+	if p.skip(); p.i < len(p.s) && p.s[p.i] == c {
+		p.i++
+		return true
+	}
+	return false
+}
+
+func (p *colorParser) expect(c byte) error {
+	if !p.accept(c) {
+		return p.errorf("Expected %q", c)
+	}
+	return nil
+}
+
+func (p *colorParser) ident() string {
+	p.skip()
+	start := p.i
+	for p.i < len(p.s) {
+		c := p.s[p.i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			break
+		}
+		p.i++
+	}
+	return p.s[start:p.i]
+}
+
+// number parses a number, returning it divided by 100 if it's followed by %
+// and by scale otherwise.
+func (p *colorParser) number(scale float64) (float64, error) {
+	p.skip()
+	start := p.i
+	for p.i < len(p.s) && strings.IndexByte("0123456789.", p.s[p.i]) != -1 {
+		p.i++
+	}
+	v, err := strconv.ParseFloat(p.s[start:p.i], 64)
+	if err != nil {
+		return 0, p.errorf("Expected a number")
+	}
+	if p.accept('%') {
+		return v / 100, nil
+	}
+	// The unit of hues
+	if strings.HasPrefix(p.s[p.i:], "deg") {
+		p.i += 3
+	}
+	return v / scale, nil
+}
+
+// args parses the comma, space or slash separated numbers of a function
+// such as rgb(), each scaled by the corresponding scale. The last of
+// them, the alpha, is optional and defaults to 1.
+func (p *colorParser) args(scales ...float64) ([]float64, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	ret := make([]float64, 0, len(scales))
+	for i, sc := range scales {
+		if i > 0 && !p.accept(',') {
+			p.accept('/')
+		}
+		if i == len(scales)-1 && p.accept(')') {
+			return append(ret, 1), nil
+		}
+		v, err := p.number(sc)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, v)
+	}
+	return ret, p.expect(')')
+}
+
+func (p *colorParser) color() (Color, error) {
+	if p.skip(); strings.HasPrefix(p.s[p.i:], "#") {
+		start := p.i
+		p.i++
+		p.ident()
+		return ParseColor(p.s[start:p.i])
+	}
+	switch name := p.ident(); strings.ToLower(name) {
+	case "":
+		return Color{}, p.errorf("Expected a colour")
+	case "var":
+		if err := p.expect('('); err != nil {
+			return Color{}, err
+		}
+		v := p.ident()
+		if err := p.expect(')'); err != nil {
+			return Color{}, err
+		}
+		return p.e.variable(v)
+	case "rgb", "rgba":
+		a, err := p.args(255, 255, 255, 1)
+		if err != nil {
+			return Color{}, err
+		}
+		return fromFloats(a[0], a[1], a[2], a[3]), nil
+	case "hsl", "hsla":
+		// Saturation and lightness without % are from 0 to 100, like
+		// in CSS
+		a, err := p.args(1, 100, 100, 1)
+		if err != nil {
+			return Color{}, err
+		}
+		r, g, b := hslToRGB(a[0]/360, a[1], a[2])
+		return fromFloats(r, g, b, a[3]), nil
+	case "hwb":
+		a, err := p.args(1, 100, 100, 1)
+		if err != nil {
+			return Color{}, err
+		}
+		// The hue at full saturation and half lightness, mixed with
+		// white and black
+		r, g, b := hslToRGB(a[0]/360, 1, 0.5)
+		w, bl := a[1], a[2]
+		if w+bl > 1 {
+			w, bl = w/(w+bl), bl/(w+bl)
+		}
+		mix := func(x float64) float64 { return x*(1-w-bl) + w }
+		return fromFloats(mix(r), mix(g), mix(b), a[3]), nil
+	case "color":
+		return p.adjusted()
+	default:
+		return ParseColor(name)
+	}
+}
+
+// adjusted parses the arguments of color(), which are a colour followed
+// by the adjusters applied to it.
+func (p *colorParser) adjusted() (Color, error) {
+	if err := p.expect('('); err != nil {
+		return Color{}, err
+	}
+	c, err := p.color()
+	if err != nil {
+		return Color{}, err
+	}
+	for !p.accept(')') {
+		adj := p.ident()
+		if err := p.expect('('); err != nil {
+			return Color{}, err
+		}
+		switch adj {
+		case "alpha", "a":
+			a, err := p.adjust(float64(c.A) / 255)
+			if err != nil {
+				return Color{}, err
+			}
+			c.A = clamp(a)
+		case "lightness", "l", "saturation", "s":
+			h, s, l := rgbToHSL(c)
+			if adj[0] == 'l' {
+				l, err = p.adjust(l)
+			} else {
+				s, err = p.adjust(s)
+			}
+			if err != nil {
+				return Color{}, err
+			}
+			r, g, b := hslToRGB(h, s, l)
+			c = fromFloats(r, g, b, float64(c.A)/255)
+		case "blend", "blenda":
+			o, err := p.color()
+			if err != nil {
+				return Color{}, err
+			}
+			pct, err := p.number(100)
+			if err != nil {
+				return Color{}, err
+			}
+			hsl := false
+			if mode := p.ident(); mode == "hsl" {
+				hsl = true
+			} else if mode != "" && mode != "rgb" {
+				return Color{}, p.errorf("Unknown blend mode %s", mode)
+			}
+			c = blend(c, o, pct, hsl, adj == "blenda")
+		default:
+			return Color{}, p.errorf("Unsupported adjuster %s", adj)
+		}
+		if err := p.expect(')'); err != nil {
+			return Color{}, err
+		}
+	}
+	return c, nil
+}
+
+// adjust parses the argument of an adjuster, which sets v, or changes it
+// when it starts with "+", "-" or "*".
+func (p *colorParser) adjust(v float64) (float64, error) {
+	p.skip()
+	var op byte
+	if p.i < len(p.s) && strings.IndexByte("+-*", p.s[p.i]) != -1 {
+		op = p.s[p.i]
+		p.i++
+	}
+	x, err := p.number(1)
+	if err != nil {
+		return 0, err
+	}
+	switch op {
+	case '+':
+		v += x
+	case '-':
+		v -= x
+	case '*':
+		v *= x
+	default:
+		v = x
+	}
+	return math.Max(0, math.Min(1, v)), nil
+}
+
+// blend mixes c with o, keeping pct of c. The alpha of c is kept unless
+// alpha is set.
+func blend(c, o Color, pct float64, hsl, alpha bool) Color {
+	mix := func(x, y float64) float64 { return x*pct + y*(1-pct) }
+	var r, g, b float64
+	if hsl {
+		h1, s1, l1 := rgbToHSL(c)
+		h2, s2, l2 := rgbToHSL(o)
+		// Greys have no hue, and hues are mixed the shorter way around
+		// the colour wheel
+		if s1 == 0 {
+			h1 = h2
+		} else if s2 == 0 {
+			h2 = h1
+		}
+		if h2-h1 > 0.5 {
+			h1++
+		} else if h1-h2 > 0.5 {
+			h2++
+		}
+		r, g, b = hslToRGB(mix(h1, h2), mix(s1, s2), mix(l1, l2))
+	} else {
+		r = mix(float64(c.R), float64(o.R)) / 255
+		g = mix(float64(c.G), float64(o.G)) / 255
+		b = mix(float64(c.B), float64(o.B)) / 255
+	}
+	a := float64(c.A) / 255
+	if alpha {
+		a = mix(a, float64(o.A)/255)
+	}
+	return fromFloats(r, g, b, a)
+}
+
+func clamp(v float64) uint8 {
+	return uint8(math.Max(0, math.Min(255, math.Floor(v*255+0.5))))
+}
+
+func fromFloats(r, g, b, a float64) Color {
+	return Color{clamp(r), clamp(g), clamp(b), clamp(a)}
+}
+
+func rgbToHSL(c Color) (h, s, l float64) {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+	max, min := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	l = (max + min) / 2
+	if max == min {
+		return 0, 0, l
+	}
+	d := max - min
+	if l > 0.5 {
+		s = d / (2 - max - min)
+	} else {
+		s = d / (max + min)
+	}
+	switch max {
+	case r:
+		h = (g - b) / d
+		if g < b {
+			h += 6
+		}
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	return h / 6, s, l
+}
+
+func hslToRGB(h, s, l float64) (r, g, b float64) {
+	h -= math.Floor(h)
+	if s == 0 {
+		return l, l, l
+	}
+	var q float64
+	if l < 0.5 {
+		q = l * (1 + s)
+	} else {
+		q = l + s - l*s
+	}
+	p := 2*l - q
+	hue := func(t float64) float64 {
+		t -= math.Floor(t)
+		switch {
+		case t < 1.0/6:
+			return p + (q-p)*6*t
+		case t < 0.5:
+			return q
+		case t < 2.0/3:
+			return p + (q-p)*(2.0/3-t)*6
+		}
+		return p
+	}
+	return hue(h + 1.0/3), hue(h), hue(h - 1.0/3)
+}
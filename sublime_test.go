@@ -0,0 +1,147 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadSublimeColorScheme(t *testing.T) {
+	th, err := LoadTheme("testdata/Mariana.sublime-color-scheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if th.Name != "Mariana" {
+		t.Errorf("Expected the name Mariana, but got %s", th.Name)
+	}
+	g := th.Global
+	for _, test := range []struct {
+		name string
+		c    *Color
+		exp  string
+	}{
+		{"foreground", g.Foreground, "#D8DEE9"},
+		{"background", g.Background, "#1A2633"},
+		{"caret", g.Caret, "#EC5F67"},
+		{"lineHighlight", g.LineHighlight, "#6699CC33"},
+		{"selection", g.Selection, "#596673"},
+		{"gutterForeground", g.GutterForeground, "#999999"},
+	} {
+		if test.c == nil {
+			t.Errorf("Expected %s to be set", test.name)
+		} else if test.c.String() != test.exp {
+			t.Errorf("Expected %s to be %s, but got %s", test.name, test.exp, test.c)
+		}
+	}
+	other := th.Settings[0].Settings.Other
+	if other["bracketsOptions"] != "underline" || other["lineDiffWidth"] != "2" {
+		t.Errorf("Expected the other globals to be kept, but got %v", other)
+	}
+
+	tests := []struct {
+		scope  string
+		fg, bg string
+		font   FontStyle
+	}{
+		{"source.go", "#D8DEE9", "#1A2633", 0},
+		{"source.go comment.line.go", "#BFCCD980", "#1A2633", Italic},
+		{"source.go string.quoted.go", "#99C794", "#1A2633", 0},
+		{"source.go keyword.control.go", "#EC5F67", "#1A2633", Bold},
+		{"source.go keyword.operator.go", "#D8DEE9", "#1A2633", 0},
+		{"source.go invalid.illegal.go", "#FFFFFF", "#C98286", 0},
+	}
+	for _, test := range tests {
+		s := th.Style(test.scope)
+		if fg, bg := s.Foreground.String(), s.Background.String(); fg != test.fg || bg != test.bg || s.Font != test.font {
+			t.Errorf("%s: Expected %s on %s (%q), but got %s on %s (%q)", test.scope, test.fg, test.bg, test.font, fg, bg, s.Font)
+		}
+	}
+}
+
+func TestColorExpressions(t *testing.T) {
+	e := &colorEval{
+		vars: map[string]string{
+			"red":   "#FF0000",
+			"blue":  "rgb(0 0 255)",
+			"loop":  "var(loop2)",
+			"loop2": "color(var(loop) alpha(0.5))",
+		},
+		done: make(map[string]Color),
+		busy: make(map[string]bool),
+	}
+	tests := []struct {
+		in, exp string
+	}{
+		{"var(red)", "#FF0000"},
+		{"rgba(255, 0, 0, 0.5)", "#FF000080"},
+		{"rgb(100% 50% 0% / 25%)", "#FF800040"},
+		{"hsl(120deg, 100%, 25%)", "#008000"},
+		{"hsl(120 100 25)", "#008000"},
+		{"hwb(0 0% 0%)", "#FF0000"},
+		{"hwb(0 20 20)", "#CC3333"},
+		{"hwb(0 20% 20%)", "#CC3333"},
+		{"color(var(red) alpha(0.5))", "#FF000080"},
+		{"color(var(red) a(50%) alpha(* 0.5))", "#FF000040"},
+		{"color(var(red) blend(var(blue) 50%))", "#800080"},
+		{"color(var(red) blend(var(blue) 75%))", "#BF0040"},
+		{"color(color(var(red) alpha(0)) blenda(var(blue) 50%))", "#80008080"},
+		{"color(var(red) blend(var(blue) 50% hsl))", "#FF00FF"},
+		{"color(var(red) lightness(+ 25%))", "#FF8080"},
+		{"color(var(red) l(50%) s(0))", "#808080"},
+		{"Navy", "#000080"},
+	}
+	for _, test := range tests {
+		if c, err := e.color(test.in); err != nil {
+			t.Errorf("%s: %s", test.in, err)
+		} else if c.String() != test.exp {
+			t.Errorf("%s: Expected %s, but got %s", test.in, test.exp, c)
+		}
+	}
+	for _, in := range []string{
+		"var(green)",
+		"var(loop)",
+		"rgb(1, 2)",
+		"color(var(red) hue(10))",
+		"color(var(red) blend(var(blue) 50% lab))",
+		"#FF0000 extra",
+		"color(var(red)",
+		"",
+	} {
+		if _, err := e.color(in); err == nil {
+			t.Errorf("%q: Expected an error", in)
+		}
+	}
+}
+
+func TestLoadSublimeColorSchemeErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "textmate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	tests := []struct {
+		data, exp string
+	}{
+		{`{"rules": [{"scope": "comment"}, {"name": 42, "scope": "string"}]}`, "rule 1: name"},
+		{`{"rules": [{"scope": ["comment"]}]}`, "rule 0: scope"},
+		{`{"rules": [{"scope": "comment", "foreground": "var(missing)"}]}`, "rule 0 (comment)"},
+		{`{"globals": {"background": "#12345"}}`, "globals"},
+	}
+	for i, test := range tests {
+		fn := filepath.Join(dir, "scheme.sublime-color-scheme")
+		if err := ioutil.WriteFile(fn, []byte(test.data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadTheme(fn); err == nil {
+			t.Errorf("Test %d: Expected an error", i)
+		} else if !strings.Contains(err.Error(), test.exp) {
+			t.Errorf("Test %d: Expected an error about %s, but got %s", i, test.exp, err)
+		}
+	}
+}
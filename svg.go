@@ -0,0 +1,177 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+// ImageOptions control the output of WriteSVG and RenderImage.
+type ImageOptions struct {
+	// Number the lines in a gutter.
+	LineNumbers bool
+	// The number of columns of a tab stop, 4 if 0.
+	TabWidth int
+	// The font of SVG text, "monospace" if empty.
+	FontFamily string
+	// The size in pixels of SVG text, 14 if 0.
+	FontSize float64
+	// The number of pixels of an image every pixel of the bitmap font
+	// takes up, 2 if 0.
+	Scale int
+}
+
+func (o *ImageOptions) tabWidth() int {
+	if o.TabWidth <= 0 {
+		return 4
+	}
+	return o.TabWidth
+}
+
+// A grid is highlighted text laid out in a monospace grid of cells, with
+// a margin of one cell around it.
+type grid struct {
+	lines  [][]cell
+	gutter int // The columns of the gutter, 0 without line numbers
+	cols   int // The columns of the text, without the gutter
+	global Style
+	// The colours of the gutter
+	gutterFg, gutterBg Color
+}
+
+// layout lays out the text of the parse tree root highlighted by t.
+func layout(root *parser.Node, t *Theme, opts *ImageOptions) *grid {
+	h := highlight(root)
+	g := &grid{global: t.OpaqueStyle("")}
+	for i := range h.lines {
+		l := h.cells(i, t.OpaqueStyle, opts.tabWidth())
+		if len(l) > g.cols {
+			g.cols = len(l)
+		}
+		g.lines = append(g.lines, l)
+	}
+	if opts.LineNumbers {
+		g.gutter = len(fmt.Sprint(len(g.lines))) + 2
+	}
+	g.gutterFg, g.gutterBg = g.global.Foreground, g.global.Background
+	fg, bg := t.gutterColors()
+	if bg != nil {
+		g.gutterBg = bg.Over(g.global.Background)
+	}
+	if fg != nil {
+		g.gutterFg = fg.Over(g.gutterBg)
+	}
+	return g
+}
+
+// size returns the size of g in cells.
+func (g *grid) size() (cols, rows int) {
+	return g.gutter + g.cols + 2, len(g.lines) + 2
+}
+
+// lineNumber returns the line number of line i, right aligned in the
+// gutter.
+func (g *grid) lineNumber(i int) string {
+	return fmt.Sprintf("%*d", g.gutter-2, i+1)
+}
+
+// WriteSVG writes the text of the parse tree root, as returned by
+// LanguageParser.Parse, highlighted by t as an SVG image.
+//
+// The text is laid out in a monospace grid on the theme's background,
+// with every run of text stretched to the width of its cells, so the grid
+// holds whichever monospace font is used. If opts is nil, the defaults of
+// ImageOptions are used.
+func WriteSVG(w io.Writer, root *parser.Node, t *Theme, opts *ImageOptions) error {
+	if opts == nil {
+		opts = &ImageOptions{}
+	}
+	var (
+		bw     = bufio.NewWriter(w)
+		g      = layout(root, t, opts)
+		size   = opts.FontSize
+		family = opts.FontFamily
+	)
+	if size <= 0 {
+		size = 14
+	}
+	if family == "" {
+		family = "monospace"
+	}
+	cw, lh := size*0.6, size*1.4
+	cols, rows := g.size()
+	width, height := float64(cols)*cw, float64(rows)*lh
+	x := func(col int) float64 { return float64(col+1) * cw }
+	// The baseline of line i
+	y := func(i int) float64 { return float64(i+1)*lh + size*1.05 }
+
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s">`+"\n", num(width), num(height), num(width), num(height))
+	fmt.Fprintf(bw, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", g.global.Background)
+	if g.gutter != 0 {
+		fmt.Fprintf(bw, `<rect width="%s" height="100%%" fill="%s"/>`+"\n", num(x(g.gutter-1)), g.gutterBg)
+	}
+	fmt.Fprintf(bw, `<g font-family="%s" font-size="%s" xml:space="preserve">`+"\n", html.EscapeString(family), num(size))
+	for i, l := range g.lines {
+		if g.gutter != 0 {
+			fmt.Fprintf(bw, `<text x="%s" y="%s" fill="%s">%s</text>`+"\n", num(x(0)), num(y(i)), g.gutterFg, g.lineNumber(i))
+		}
+		for col := 0; col < len(l); {
+			// The run of cells of the same style
+			end := col + 1
+			for end < len(l) && l[end].style == l[col].style {
+				end++
+			}
+			s := l[col].style
+			var text []rune
+			for _, c := range l[col:end] {
+				text = append(text, c.r)
+			}
+			x0, w := x(g.gutter+col), float64(end-col)*cw
+			if s.Background != g.global.Background {
+				fmt.Fprintf(bw, `<rect x="%s" y="%s" width="%s" height="%s" fill="%s"/>`+"\n", num(x0), num(float64(i+1)*lh), num(w), num(lh), s.Background)
+			}
+			if str := string(text); strings.TrimSpace(str) != "" {
+				fmt.Fprintf(bw, `<text x="%s" y="%s" textLength="%s" lengthAdjust="spacingAndGlyphs" fill="%s"%s>%s</text>`+"\n",
+					num(x0), num(y(i)), num(w), s.Foreground, svgFont(s.Font), html.EscapeString(str))
+			}
+			col = end
+		}
+	}
+	bw.WriteString("</g>\n</svg>\n")
+	return bw.Flush()
+}
+
+// num formats v, a length in an SVG image, rounded to hundredths.
+func num(v float64) string {
+	return strconv.FormatFloat(math.Floor(v*100+0.5)/100, 'f', -1, 64)
+}
+
+// svgFont returns the attributes of text of the font style f.
+func svgFont(f FontStyle) string {
+	var attrs string
+	if f&Bold != 0 {
+		attrs += ` font-weight="bold"`
+	}
+	if f&Italic != 0 {
+		attrs += ` font-style="italic"`
+	}
+	switch f & (Underline | Strikethrough) {
+	case Underline:
+		attrs += ` text-decoration="underline"`
+	case Strikethrough:
+		attrs += ` text-decoration="line-through"`
+	case Underline | Strikethrough:
+		attrs += ` text-decoration="underline line-through"`
+	}
+	return attrs
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gbbr/textmate/vendor/limetext/lime-backend/lib/util"
+)
+
+func TestWriteSVG(t *testing.T) {
+	const (
+		in  = "testdata/go2.go"
+		out = "testdata/go2.go.svg.res"
+	)
+	th, err := LoadTheme("testdata/Monokai.tmTheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteSVG(&buf, parseFile(t, "testdata/Go.tmLanguage", in), th, &ImageOptions{LineNumbers: true}); err != nil {
+		t.Fatal(err)
+	}
+	str := buf.String()
+	if d, err := ioutil.ReadFile(out); err != nil {
+		if err := ioutil.WriteFile(out, []byte(str), 0644); err != nil {
+			t.Error(err)
+		}
+	} else if diff := util.Diff(string(d), str); diff != "" {
+		t.Error(diff)
+	}
+
+	// The image is well formed XML
+	dec := xml.NewDecoder(&buf)
+	for {
+		if _, err := dec.Token(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("Expected well formed XML, but got %s", err)
+		}
+	}
+}
+
+func TestLayout(t *testing.T) {
+	th, err := LoadTheme("testdata/Monokai.tmTheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := []string{"\tx", "ab\tc", "é"}
+	g := layout(parseString(t, "testdata/Go.tmLanguage", "\tx\nab\tc\né\n"), th, &ImageOptions{TabWidth: 4, LineNumbers: true})
+	exp := []string{"    x", "ab  c", "é"}
+	for i, l := range g.lines {
+		var s []rune
+		for _, c := range l {
+			s = append(s, c.r)
+		}
+		if string(s) != exp[i] {
+			t.Errorf("%q: Expected %q, but got %q", lines[i], exp[i], string(s))
+		}
+	}
+	if cols, rows := g.size(); cols != 3+5+2 || rows != 5 {
+		t.Errorf("Expected 10x5 cells, but got %dx%d", cols, rows)
+	}
+	if g.gutterBg.String() != "#49483E" || g.gutterFg.String() != "#75715E" {
+		t.Errorf("Expected the gutter colours of the theme, but got %s on %s", g.gutterFg, g.gutterBg)
+	}
+}
@@ -1,50 +1,64 @@
 Monokai - D8D5E82E-3D5B-46B5-B38E-8C841C21347D
 	 - 
-		background: 0x00272822
-		caret: 0x00F8F8F0
-		foreground: 0x00F8F8F2
-		invisibles: 0x0049483E
-		lineHighlight: 0x0049483E
-		selection: 0x0049483E
+		background: #272822
+		caret: #F8F8F0
+		foreground: #F8F8F2
+		invisibles: #49483E
+		lineHighlight: #49483E
+		selection: #49483E
 	Comment - comment
-		foreground: 0x0075715E
+		foreground: #75715E
 	String - string
-		foreground: 0x00E6DB74
+		foreground: #E6DB74
 	Number - constant.numeric
-		foreground: 0x00AE81FF
+		foreground: #AE81FF
 	Built-in constant - constant.language
-		foreground: 0x00AE81FF
+		foreground: #AE81FF
 	User-defined constant - constant.character, constant.other
-		foreground: 0x00AE81FF
+		foreground: #AE81FF
 	Variable - variable
+		fontStyle: 
 	Keyword - keyword
-		foreground: 0x00F92672
+		foreground: #F92672
 	Storage - storage
-		foreground: 0x00F92672
+		fontStyle: 
+		foreground: #F92672
 	Storage type - storage.type
-		foreground: 0x0066D9EF
+		fontStyle: italic
+		foreground: #66D9EF
 	Class name - entity.name.class
-		foreground: 0x00A6E22E
+		fontStyle: underline
+		foreground: #A6E22E
 	Inherited class - entity.other.inherited-class
-		foreground: 0x00A6E22E
+		fontStyle: italic underline
+		foreground: #A6E22E
 	Function name - entity.name.function
-		foreground: 0x00A6E22E
+		fontStyle: 
+		foreground: #A6E22E
 	Function argument - variable.parameter
-		foreground: 0x00FD971F
+		fontStyle: italic
+		foreground: #FD971F
 	Tag name - entity.name.tag
-		foreground: 0x00F92672
+		fontStyle: 
+		foreground: #F92672
 	Tag attribute - entity.other.attribute-name
-		foreground: 0x00A6E22E
+		fontStyle: 
+		foreground: #A6E22E
 	Library function - support.function
-		foreground: 0x0066D9EF
+		fontStyle: 
+		foreground: #66D9EF
 	Library constant - support.constant
-		foreground: 0x0066D9EF
+		fontStyle: 
+		foreground: #66D9EF
 	Library class/type - support.type, support.class
-		foreground: 0x0066D9EF
+		fontStyle: italic
+		foreground: #66D9EF
 	Library variable - support.other.variable
+		fontStyle: 
 	Invalid - invalid
-		background: 0x00F92672
-		foreground: 0x00F8F8F0
+		background: #F92672
+		fontStyle: 
+		foreground: #F8F8F0
 	Invalid deprecated - invalid.deprecated
-		background: 0x00AE81FF
-		foreground: 0x00F8F8F0
+		background: #AE81FF
+		foreground: #F8F8F0
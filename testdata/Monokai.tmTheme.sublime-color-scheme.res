@@ -0,0 +1,139 @@
+{
+	"globals": {
+		"background": "#272822",
+		"caret": "#F8F8F0",
+		"foreground": "#F8F8F2",
+		"invisibles": "#49483E",
+		"line_highlight": "#49483E",
+		"selection": "#49483E"
+	},
+	"gutter_settings": {
+		"background": "#49483E",
+		"divider": "#75715E",
+		"foreground": "#75715E"
+	},
+	"name": "Monokai",
+	"rules": [
+		{
+			"foreground": "#75715E",
+			"name": "Comment",
+			"scope": "comment"
+		},
+		{
+			"foreground": "#E6DB74",
+			"name": "String",
+			"scope": "string"
+		},
+		{
+			"foreground": "#AE81FF",
+			"name": "Number",
+			"scope": "constant.numeric"
+		},
+		{
+			"foreground": "#AE81FF",
+			"name": "Built-in constant",
+			"scope": "constant.language"
+		},
+		{
+			"foreground": "#AE81FF",
+			"name": "User-defined constant",
+			"scope": "constant.character, constant.other"
+		},
+		{
+			"font_style": "",
+			"name": "Variable",
+			"scope": "variable"
+		},
+		{
+			"foreground": "#F92672",
+			"name": "Keyword",
+			"scope": "keyword"
+		},
+		{
+			"font_style": "",
+			"foreground": "#F92672",
+			"name": "Storage",
+			"scope": "storage"
+		},
+		{
+			"font_style": "italic",
+			"foreground": "#66D9EF",
+			"name": "Storage type",
+			"scope": "storage.type"
+		},
+		{
+			"font_style": "underline",
+			"foreground": "#A6E22E",
+			"name": "Class name",
+			"scope": "entity.name.class"
+		},
+		{
+			"font_style": "italic underline",
+			"foreground": "#A6E22E",
+			"name": "Inherited class",
+			"scope": "entity.other.inherited-class"
+		},
+		{
+			"font_style": "",
+			"foreground": "#A6E22E",
+			"name": "Function name",
+			"scope": "entity.name.function"
+		},
+		{
+			"font_style": "italic",
+			"foreground": "#FD971F",
+			"name": "Function argument",
+			"scope": "variable.parameter"
+		},
+		{
+			"font_style": "",
+			"foreground": "#F92672",
+			"name": "Tag name",
+			"scope": "entity.name.tag"
+		},
+		{
+			"font_style": "",
+			"foreground": "#A6E22E",
+			"name": "Tag attribute",
+			"scope": "entity.other.attribute-name"
+		},
+		{
+			"font_style": "",
+			"foreground": "#66D9EF",
+			"name": "Library function",
+			"scope": "support.function"
+		},
+		{
+			"font_style": "",
+			"foreground": "#66D9EF",
+			"name": "Library constant",
+			"scope": "support.constant"
+		},
+		{
+			"font_style": "italic",
+			"foreground": "#66D9EF",
+			"name": "Library class/type",
+			"scope": "support.type, support.class"
+		},
+		{
+			"font_style": "",
+			"name": "Library variable",
+			"scope": "support.other.variable"
+		},
+		{
+			"background": "#F92672",
+			"font_style": "",
+			"foreground": "#F8F8F0",
+			"name": "Invalid",
+			"scope": "invalid"
+		},
+		{
+			"background": "#AE81FF",
+			"foreground": "#F8F8F0",
+			"name": "Invalid deprecated",
+			"scope": "invalid.deprecated"
+		}
+	],
+	"semanticClass": "theme.dark.monokai",
+	"uuid": "D8D5E82E-3D5B-46B5-B38E-8C841C21347D"
+}
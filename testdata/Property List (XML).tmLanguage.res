@@ -1,9617 +0,0 @@
-0-27717: "text.xml.plist"
-	0-38: "meta.tag.preprocessor.xml"
-		0-2: "punctuation.definition.tag.xml" - Data: "<?"
-		2-5: "entity.name.tag.xml" - Data: "xml"
-		5-13: "entity.other.attribute-name.xml" - Data: " version"
-		14-19: "string.quoted.double.xml"
-			14-15: "punctuation.definition.string.begin.xml" - Data: """
-			18-19: "punctuation.definition.string.end.xml" - Data: """
-		19-28: "entity.other.attribute-name.xml" - Data: " encoding"
-		29-36: "string.quoted.double.xml"
-			29-30: "punctuation.definition.string.begin.xml" - Data: """
-			35-36: "punctuation.definition.string.end.xml" - Data: """
-		36-38: "punctuation.definition.tag.xml" - Data: "?>"
-	39-141: "meta.tag.sgml.doctype.xml"
-		39-41: "punctuation.definition.tag.xml" - Data: "<!"
-		41-48: "keyword.other.doctype.xml" - Data: "DOCTYPE"
-		49-54: "variable.language.documentroot.xml" - Data: "plist"
-		140-141: "punctuation.definition.tag.xml" - Data: ">"
-	142-27717: ""
-		142-148: "meta.tag.plist.xml.plist"
-			142-143: "punctuation.definition.tag.xml.plist" - Data: "<"
-			143-148: "entity.name.tag.xml.plist"
-				143-148: "entity.name.tag.localname.xml.plist" - Data: "plist"
-		148-162: "meta.tag.plist.xml.plist"
-			149-156: "entity.other.attribute-name.version.xml.plist" - Data: "version"
-			156-157: "punctuation.separator.key-value.xml.plist" - Data: "="
-			157-162: "string.quoted.double.xml.plist"
-				157-158: "punctuation.definition.string.begin.xml.plist" - Data: """
-				161-162: "punctuation.definition.string.end.xml.plist" - Data: """
-		162-27710: ""
-			162-163: "meta.tag.plist.xml.plist"
-				162-163: "punctuation.definition.tag.xml.plist" - Data: ">"
-			164-27708: ""
-				164-170: "meta.tag.dict.xml.plist"
-					164-165: "punctuation.definition.tag.xml.plist" - Data: "<"
-					165-169: "entity.name.tag.xml.plist"
-						165-169: "entity.name.tag.localname.xml.plist" - Data: "dict"
-					169-170: "punctuation.definition.tag.xml.plist" - Data: ">"
-				172-192: ""
-					172-177: "meta.tag.key.xml.plist"
-						172-173: "punctuation.definition.tag.xml.plist" - Data: "<"
-						173-176: "entity.name.tag.xml.plist"
-							173-176: "entity.name.tag.localname.xml.plist" - Data: "key"
-						176-177: "punctuation.definition.tag.xml.plist" - Data: ">"
-					186-192: "meta.tag.key.xml.plist"
-						186-188: "punctuation.definition.tag.xml.plist" - Data: "</"
-						188-191: "entity.name.tag.xml.plist"
-							188-191: "entity.name.tag.localname.xml.plist" - Data: "key"
-						191-192: "punctuation.definition.tag.xml.plist" - Data: ">"
-				194-202: ""
-					194-202: "meta.tag.array.xml.plist"
-						194-195: "punctuation.definition.tag.xml.plist" - Data: "<"
-						195-200: "entity.name.tag.xml.plist"
-							195-200: "entity.name.tag.localname.xml.plist" - Data: "array"
-						201-202: "punctuation.definition.tag.xml.plist" - Data: ">"
-				204-229: ""
-					204-209: "meta.tag.key.xml.plist"
-						204-205: "punctuation.definition.tag.xml.plist" - Data: "<"
-						205-208: "entity.name.tag.xml.plist"
-							205-208: "entity.name.tag.localname.xml.plist" - Data: "key"
-						208-209: "punctuation.definition.tag.xml.plist" - Data: ">"
-					223-229: "meta.tag.key.xml.plist"
-						223-225: "punctuation.definition.tag.xml.plist" - Data: "</"
-						225-228: "entity.name.tag.xml.plist"
-							225-228: "entity.name.tag.localname.xml.plist" - Data: "key"
-						228-229: "punctuation.definition.tag.xml.plist" - Data: ">"
-				231-295: ""
-					231-239: "meta.tag.string.xml.plist"
-						231-232: "punctuation.definition.tag.xml.plist" - Data: "<"
-						232-238: "entity.name.tag.xml.plist"
-							232-238: "entity.name.tag.localname.xml.plist" - Data: "string"
-						238-239: "punctuation.definition.tag.xml.plist" - Data: ">"
-					286-295: "meta.tag.string.xml.plist"
-						286-288: "punctuation.definition.tag.xml.plist" - Data: "</"
-						288-294: "entity.name.tag.xml.plist"
-							288-294: "entity.name.tag.localname.xml.plist" - Data: "string"
-						294-295: "punctuation.definition.tag.xml.plist" - Data: ">"
-				297-312: ""
-					297-302: "meta.tag.key.xml.plist"
-						297-298: "punctuation.definition.tag.xml.plist" - Data: "<"
-						298-301: "entity.name.tag.xml.plist"
-							298-301: "entity.name.tag.localname.xml.plist" - Data: "key"
-						301-302: "punctuation.definition.tag.xml.plist" - Data: ">"
-					306-312: "meta.tag.key.xml.plist"
-						306-308: "punctuation.definition.tag.xml.plist" - Data: "</"
-						308-311: "entity.name.tag.xml.plist"
-							308-311: "entity.name.tag.localname.xml.plist" - Data: "key"
-						311-312: "punctuation.definition.tag.xml.plist" - Data: ">"
-				314-350: ""
-					314-322: "meta.tag.string.xml.plist"
-						314-315: "punctuation.definition.tag.xml.plist" - Data: "<"
-						315-321: "entity.name.tag.xml.plist"
-							315-321: "entity.name.tag.localname.xml.plist" - Data: "string"
-						321-322: "punctuation.definition.tag.xml.plist" - Data: ">"
-					341-350: "meta.tag.string.xml.plist"
-						341-343: "punctuation.definition.tag.xml.plist" - Data: "</"
-						343-349: "entity.name.tag.xml.plist"
-							343-349: "entity.name.tag.localname.xml.plist" - Data: "string"
-						349-350: "punctuation.definition.tag.xml.plist" - Data: ">"
-				352-371: ""
-					352-357: "meta.tag.key.xml.plist"
-						352-353: "punctuation.definition.tag.xml.plist" - Data: "<"
-						353-356: "entity.name.tag.xml.plist"
-							353-356: "entity.name.tag.localname.xml.plist" - Data: "key"
-						356-357: "punctuation.definition.tag.xml.plist" - Data: ">"
-					365-371: "meta.tag.key.xml.plist"
-						365-367: "punctuation.definition.tag.xml.plist" - Data: "</"
-						367-370: "entity.name.tag.xml.plist"
-							367-370: "entity.name.tag.localname.xml.plist" - Data: "key"
-						370-371: "punctuation.definition.tag.xml.plist" - Data: ">"
-				373-456: ""
-					373-380: "meta.tag.array.xml.plist"
-						373-374: "punctuation.definition.tag.xml.plist" - Data: "<"
-						374-379: "entity.name.tag.xml.plist"
-							374-379: "entity.name.tag.localname.xml.plist" - Data: "array"
-						379-380: "punctuation.definition.tag.xml.plist" - Data: ">"
-					383-446: ""
-						383-389: "meta.tag.dict.xml.plist"
-							383-384: "punctuation.definition.tag.xml.plist" - Data: "<"
-							384-388: "entity.name.tag.xml.plist"
-								384-388: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							388-389: "punctuation.definition.tag.xml.plist" - Data: ">"
-						393-411: ""
-							393-398: "meta.tag.key.xml.plist"
-								393-394: "punctuation.definition.tag.xml.plist" - Data: "<"
-								394-397: "entity.name.tag.xml.plist"
-									394-397: "entity.name.tag.localname.xml.plist" - Data: "key"
-								397-398: "punctuation.definition.tag.xml.plist" - Data: ">"
-							405-411: "meta.tag.key.xml.plist"
-								405-407: "punctuation.definition.tag.xml.plist" - Data: "</"
-								407-410: "entity.name.tag.xml.plist"
-									407-410: "entity.name.tag.localname.xml.plist" - Data: "key"
-								410-411: "punctuation.definition.tag.xml.plist" - Data: ">"
-						415-436: ""
-							415-423: "meta.tag.string.xml.plist"
-								415-416: "punctuation.definition.tag.xml.plist" - Data: "<"
-								416-422: "entity.name.tag.xml.plist"
-									416-422: "entity.name.tag.localname.xml.plist" - Data: "string"
-								422-423: "punctuation.definition.tag.xml.plist" - Data: ">"
-							427-436: "meta.tag.string.xml.plist"
-								427-429: "punctuation.definition.tag.xml.plist" - Data: "</"
-								429-435: "entity.name.tag.xml.plist"
-									429-435: "entity.name.tag.localname.xml.plist" - Data: "string"
-								435-436: "punctuation.definition.tag.xml.plist" - Data: ">"
-						439-446: "meta.tag.dict.xml.plist"
-							439-441: "punctuation.definition.tag.xml.plist" - Data: "</"
-							441-445: "entity.name.tag.xml.plist"
-								441-445: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							445-446: "punctuation.definition.tag.xml.plist" - Data: ">"
-					448-456: "meta.tag.array.xml.plist"
-						448-450: "punctuation.definition.tag.xml.plist" - Data: "</"
-						450-455: "entity.name.tag.xml.plist"
-							450-455: "entity.name.tag.localname.xml.plist" - Data: "array"
-						455-456: "punctuation.definition.tag.xml.plist" - Data: ">"
-				458-479: ""
-					458-463: "meta.tag.key.xml.plist"
-						458-459: "punctuation.definition.tag.xml.plist" - Data: "<"
-						459-462: "entity.name.tag.xml.plist"
-							459-462: "entity.name.tag.localname.xml.plist" - Data: "key"
-						462-463: "punctuation.definition.tag.xml.plist" - Data: ">"
-					473-479: "meta.tag.key.xml.plist"
-						473-475: "punctuation.definition.tag.xml.plist" - Data: "</"
-						475-478: "entity.name.tag.xml.plist"
-							475-478: "entity.name.tag.localname.xml.plist" - Data: "key"
-						478-479: "punctuation.definition.tag.xml.plist" - Data: ">"
-				481-27573: ""
-					481-487: "meta.tag.dict.xml.plist"
-						481-482: "punctuation.definition.tag.xml.plist" - Data: "<"
-						482-486: "entity.name.tag.xml.plist"
-							482-486: "entity.name.tag.localname.xml.plist" - Data: "dict"
-						486-487: "punctuation.definition.tag.xml.plist" - Data: ">"
-					490-504: ""
-						490-495: "meta.tag.key.xml.plist"
-							490-491: "punctuation.definition.tag.xml.plist" - Data: "<"
-							491-494: "entity.name.tag.xml.plist"
-								491-494: "entity.name.tag.localname.xml.plist" - Data: "key"
-							494-495: "punctuation.definition.tag.xml.plist" - Data: ">"
-						498-504: "meta.tag.key.xml.plist"
-							498-500: "punctuation.definition.tag.xml.plist" - Data: "</"
-							500-503: "entity.name.tag.xml.plist"
-								500-503: "entity.name.tag.localname.xml.plist" - Data: "key"
-							503-504: "punctuation.definition.tag.xml.plist" - Data: ">"
-					507-4869: ""
-						507-513: "meta.tag.dict.xml.plist"
-							507-508: "punctuation.definition.tag.xml.plist" - Data: "<"
-							508-512: "entity.name.tag.xml.plist"
-								508-512: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							512-513: "punctuation.definition.tag.xml.plist" - Data: ">"
-						517-536: ""
-							517-522: "meta.tag.key.xml.plist"
-								517-518: "punctuation.definition.tag.xml.plist" - Data: "<"
-								518-521: "entity.name.tag.xml.plist"
-									518-521: "entity.name.tag.localname.xml.plist" - Data: "key"
-								521-522: "punctuation.definition.tag.xml.plist" - Data: ">"
-							530-536: "meta.tag.key.xml.plist"
-								530-532: "punctuation.definition.tag.xml.plist" - Data: "</"
-								532-535: "entity.name.tag.xml.plist"
-									532-535: "entity.name.tag.localname.xml.plist" - Data: "key"
-								535-536: "punctuation.definition.tag.xml.plist" - Data: ">"
-						540-4859: ""
-							540-547: "meta.tag.array.xml.plist"
-								540-541: "punctuation.definition.tag.xml.plist" - Data: "<"
-								541-546: "entity.name.tag.xml.plist"
-									541-546: "entity.name.tag.localname.xml.plist" - Data: "array"
-								546-547: "punctuation.definition.tag.xml.plist" - Data: ">"
-							552-4520: ""
-								552-558: "meta.tag.dict.xml.plist"
-									552-553: "punctuation.definition.tag.xml.plist" - Data: "<"
-									553-557: "entity.name.tag.xml.plist"
-										553-557: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									557-558: "punctuation.definition.tag.xml.plist" - Data: ">"
-								564-580: ""
-									564-569: "meta.tag.key.xml.plist"
-										564-565: "punctuation.definition.tag.xml.plist" - Data: "<"
-										565-568: "entity.name.tag.xml.plist"
-											565-568: "entity.name.tag.localname.xml.plist" - Data: "key"
-										568-569: "punctuation.definition.tag.xml.plist" - Data: ">"
-									574-580: "meta.tag.key.xml.plist"
-										574-576: "punctuation.definition.tag.xml.plist" - Data: "</"
-										576-579: "entity.name.tag.xml.plist"
-											576-579: "entity.name.tag.localname.xml.plist" - Data: "key"
-										579-580: "punctuation.definition.tag.xml.plist" - Data: ">"
-								586-622: ""
-									586-594: "meta.tag.string.xml.plist"
-										586-587: "punctuation.definition.tag.xml.plist" - Data: "<"
-										587-593: "entity.name.tag.xml.plist"
-											587-593: "entity.name.tag.localname.xml.plist" - Data: "string"
-										593-594: "punctuation.definition.tag.xml.plist" - Data: ">"
-									613-622: "meta.tag.string.xml.plist"
-										613-615: "punctuation.definition.tag.xml.plist" - Data: "</"
-										615-621: "entity.name.tag.xml.plist"
-											615-621: "entity.name.tag.localname.xml.plist" - Data: "string"
-										621-622: "punctuation.definition.tag.xml.plist" - Data: ">"
-								628-647: ""
-									628-633: "meta.tag.key.xml.plist"
-										628-629: "punctuation.definition.tag.xml.plist" - Data: "<"
-										629-632: "entity.name.tag.xml.plist"
-											629-632: "entity.name.tag.localname.xml.plist" - Data: "key"
-										632-633: "punctuation.definition.tag.xml.plist" - Data: ">"
-									641-647: "meta.tag.key.xml.plist"
-										641-643: "punctuation.definition.tag.xml.plist" - Data: "</"
-										643-646: "entity.name.tag.xml.plist"
-											643-646: "entity.name.tag.localname.xml.plist" - Data: "key"
-										646-647: "punctuation.definition.tag.xml.plist" - Data: ">"
-								653-1298: ""
-									653-659: "meta.tag.dict.xml.plist"
-										653-654: "punctuation.definition.tag.xml.plist" - Data: "<"
-										654-658: "entity.name.tag.xml.plist"
-											654-658: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										658-659: "punctuation.definition.tag.xml.plist" - Data: ">"
-									666-678: ""
-										666-671: "meta.tag.key.xml.plist"
-											666-667: "punctuation.definition.tag.xml.plist" - Data: "<"
-											667-670: "entity.name.tag.xml.plist"
-												667-670: "entity.name.tag.localname.xml.plist" - Data: "key"
-											670-671: "punctuation.definition.tag.xml.plist" - Data: ">"
-										672-678: "meta.tag.key.xml.plist"
-											672-674: "punctuation.definition.tag.xml.plist" - Data: "</"
-											674-677: "entity.name.tag.xml.plist"
-												674-677: "entity.name.tag.localname.xml.plist" - Data: "key"
-											677-678: "punctuation.definition.tag.xml.plist" - Data: ">"
-									685-777: ""
-										685-691: "meta.tag.dict.xml.plist"
-											685-686: "punctuation.definition.tag.xml.plist" - Data: "<"
-											686-690: "entity.name.tag.xml.plist"
-												686-690: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											690-691: "punctuation.definition.tag.xml.plist" - Data: ">"
-										699-714: ""
-											699-704: "meta.tag.key.xml.plist"
-												699-700: "punctuation.definition.tag.xml.plist" - Data: "<"
-												700-703: "entity.name.tag.xml.plist"
-													700-703: "entity.name.tag.localname.xml.plist" - Data: "key"
-												703-704: "punctuation.definition.tag.xml.plist" - Data: ">"
-											708-714: "meta.tag.key.xml.plist"
-												708-710: "punctuation.definition.tag.xml.plist" - Data: "</"
-												710-713: "entity.name.tag.xml.plist"
-													710-713: "entity.name.tag.localname.xml.plist" - Data: "key"
-												713-714: "punctuation.definition.tag.xml.plist" - Data: ">"
-										722-763: ""
-											722-730: "meta.tag.string.xml.plist"
-												722-723: "punctuation.definition.tag.xml.plist" - Data: "<"
-												723-729: "entity.name.tag.xml.plist"
-													723-729: "entity.name.tag.localname.xml.plist" - Data: "string"
-												729-730: "punctuation.definition.tag.xml.plist" - Data: ">"
-											754-763: "meta.tag.string.xml.plist"
-												754-756: "punctuation.definition.tag.xml.plist" - Data: "</"
-												756-762: "entity.name.tag.xml.plist"
-													756-762: "entity.name.tag.localname.xml.plist" - Data: "string"
-												762-763: "punctuation.definition.tag.xml.plist" - Data: ">"
-										770-777: "meta.tag.dict.xml.plist"
-											770-772: "punctuation.definition.tag.xml.plist" - Data: "</"
-											772-776: "entity.name.tag.xml.plist"
-												772-776: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											776-777: "punctuation.definition.tag.xml.plist" - Data: ">"
-									784-796: ""
-										784-789: "meta.tag.key.xml.plist"
-											784-785: "punctuation.definition.tag.xml.plist" - Data: "<"
-											785-788: "entity.name.tag.xml.plist"
-												785-788: "entity.name.tag.localname.xml.plist" - Data: "key"
-											788-789: "punctuation.definition.tag.xml.plist" - Data: ">"
-										790-796: "meta.tag.key.xml.plist"
-											790-792: "punctuation.definition.tag.xml.plist" - Data: "</"
-											792-795: "entity.name.tag.xml.plist"
-												792-795: "entity.name.tag.localname.xml.plist" - Data: "key"
-											795-796: "punctuation.definition.tag.xml.plist" - Data: ">"
-									803-907: ""
-										803-809: "meta.tag.dict.xml.plist"
-											803-804: "punctuation.definition.tag.xml.plist" - Data: "<"
-											804-808: "entity.name.tag.xml.plist"
-												804-808: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											808-809: "punctuation.definition.tag.xml.plist" - Data: ">"
-										817-832: ""
-											817-822: "meta.tag.key.xml.plist"
-												817-818: "punctuation.definition.tag.xml.plist" - Data: "<"
-												818-821: "entity.name.tag.xml.plist"
-													818-821: "entity.name.tag.localname.xml.plist" - Data: "key"
-												821-822: "punctuation.definition.tag.xml.plist" - Data: ">"
-											826-832: "meta.tag.key.xml.plist"
-												826-828: "punctuation.definition.tag.xml.plist" - Data: "</"
-												828-831: "entity.name.tag.xml.plist"
-													828-831: "entity.name.tag.localname.xml.plist" - Data: "key"
-												831-832: "punctuation.definition.tag.xml.plist" - Data: ">"
-										840-893: ""
-											840-848: "meta.tag.string.xml.plist"
-												840-841: "punctuation.definition.tag.xml.plist" - Data: "<"
-												841-847: "entity.name.tag.xml.plist"
-													841-847: "entity.name.tag.localname.xml.plist" - Data: "string"
-												847-848: "punctuation.definition.tag.xml.plist" - Data: ">"
-											884-893: "meta.tag.string.xml.plist"
-												884-886: "punctuation.definition.tag.xml.plist" - Data: "</"
-												886-892: "entity.name.tag.xml.plist"
-													886-892: "entity.name.tag.localname.xml.plist" - Data: "string"
-												892-893: "punctuation.definition.tag.xml.plist" - Data: ">"
-										900-907: "meta.tag.dict.xml.plist"
-											900-902: "punctuation.definition.tag.xml.plist" - Data: "</"
-											902-906: "entity.name.tag.xml.plist"
-												902-906: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											906-907: "punctuation.definition.tag.xml.plist" - Data: ">"
-									914-926: ""
-										914-919: "meta.tag.key.xml.plist"
-											914-915: "punctuation.definition.tag.xml.plist" - Data: "<"
-											915-918: "entity.name.tag.xml.plist"
-												915-918: "entity.name.tag.localname.xml.plist" - Data: "key"
-											918-919: "punctuation.definition.tag.xml.plist" - Data: ">"
-										920-926: "meta.tag.key.xml.plist"
-											920-922: "punctuation.definition.tag.xml.plist" - Data: "</"
-											922-925: "entity.name.tag.xml.plist"
-												922-925: "entity.name.tag.localname.xml.plist" - Data: "key"
-											925-926: "punctuation.definition.tag.xml.plist" - Data: ">"
-									933-1026: ""
-										933-939: "meta.tag.dict.xml.plist"
-											933-934: "punctuation.definition.tag.xml.plist" - Data: "<"
-											934-938: "entity.name.tag.xml.plist"
-												934-938: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											938-939: "punctuation.definition.tag.xml.plist" - Data: ">"
-										947-962: ""
-											947-952: "meta.tag.key.xml.plist"
-												947-948: "punctuation.definition.tag.xml.plist" - Data: "<"
-												948-951: "entity.name.tag.xml.plist"
-													948-951: "entity.name.tag.localname.xml.plist" - Data: "key"
-												951-952: "punctuation.definition.tag.xml.plist" - Data: ">"
-											956-962: "meta.tag.key.xml.plist"
-												956-958: "punctuation.definition.tag.xml.plist" - Data: "</"
-												958-961: "entity.name.tag.xml.plist"
-													958-961: "entity.name.tag.localname.xml.plist" - Data: "key"
-												961-962: "punctuation.definition.tag.xml.plist" - Data: ">"
-										970-1012: ""
-											970-978: "meta.tag.string.xml.plist"
-												970-971: "punctuation.definition.tag.xml.plist" - Data: "<"
-												971-977: "entity.name.tag.xml.plist"
-													971-977: "entity.name.tag.localname.xml.plist" - Data: "string"
-												977-978: "punctuation.definition.tag.xml.plist" - Data: ">"
-											1003-1012: "meta.tag.string.xml.plist"
-												1003-1005: "punctuation.definition.tag.xml.plist" - Data: "</"
-												1005-1011: "entity.name.tag.xml.plist"
-													1005-1011: "entity.name.tag.localname.xml.plist" - Data: "string"
-												1011-1012: "punctuation.definition.tag.xml.plist" - Data: ">"
-										1019-1026: "meta.tag.dict.xml.plist"
-											1019-1021: "punctuation.definition.tag.xml.plist" - Data: "</"
-											1021-1025: "entity.name.tag.xml.plist"
-												1021-1025: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											1025-1026: "punctuation.definition.tag.xml.plist" - Data: ">"
-									1033-1045: ""
-										1033-1038: "meta.tag.key.xml.plist"
-											1033-1034: "punctuation.definition.tag.xml.plist" - Data: "<"
-											1034-1037: "entity.name.tag.xml.plist"
-												1034-1037: "entity.name.tag.localname.xml.plist" - Data: "key"
-											1037-1038: "punctuation.definition.tag.xml.plist" - Data: ">"
-										1039-1045: "meta.tag.key.xml.plist"
-											1039-1041: "punctuation.definition.tag.xml.plist" - Data: "</"
-											1041-1044: "entity.name.tag.xml.plist"
-												1041-1044: "entity.name.tag.localname.xml.plist" - Data: "key"
-											1044-1045: "punctuation.definition.tag.xml.plist" - Data: ">"
-									1052-1155: ""
-										1052-1058: "meta.tag.dict.xml.plist"
-											1052-1053: "punctuation.definition.tag.xml.plist" - Data: "<"
-											1053-1057: "entity.name.tag.xml.plist"
-												1053-1057: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											1057-1058: "punctuation.definition.tag.xml.plist" - Data: ">"
-										1066-1081: ""
-											1066-1071: "meta.tag.key.xml.plist"
-												1066-1067: "punctuation.definition.tag.xml.plist" - Data: "<"
-												1067-1070: "entity.name.tag.xml.plist"
-													1067-1070: "entity.name.tag.localname.xml.plist" - Data: "key"
-												1070-1071: "punctuation.definition.tag.xml.plist" - Data: ">"
-											1075-1081: "meta.tag.key.xml.plist"
-												1075-1077: "punctuation.definition.tag.xml.plist" - Data: "</"
-												1077-1080: "entity.name.tag.xml.plist"
-													1077-1080: "entity.name.tag.localname.xml.plist" - Data: "key"
-												1080-1081: "punctuation.definition.tag.xml.plist" - Data: ">"
-										1089-1141: ""
-											1089-1097: "meta.tag.string.xml.plist"
-												1089-1090: "punctuation.definition.tag.xml.plist" - Data: "<"
-												1090-1096: "entity.name.tag.xml.plist"
-													1090-1096: "entity.name.tag.localname.xml.plist" - Data: "string"
-												1096-1097: "punctuation.definition.tag.xml.plist" - Data: ">"
-											1132-1141: "meta.tag.string.xml.plist"
-												1132-1134: "punctuation.definition.tag.xml.plist" - Data: "</"
-												1134-1140: "entity.name.tag.xml.plist"
-													1134-1140: "entity.name.tag.localname.xml.plist" - Data: "string"
-												1140-1141: "punctuation.definition.tag.xml.plist" - Data: ">"
-										1148-1155: "meta.tag.dict.xml.plist"
-											1148-1150: "punctuation.definition.tag.xml.plist" - Data: "</"
-											1150-1154: "entity.name.tag.xml.plist"
-												1150-1154: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											1154-1155: "punctuation.definition.tag.xml.plist" - Data: ">"
-									1162-1174: ""
-										1162-1167: "meta.tag.key.xml.plist"
-											1162-1163: "punctuation.definition.tag.xml.plist" - Data: "<"
-											1163-1166: "entity.name.tag.xml.plist"
-												1163-1166: "entity.name.tag.localname.xml.plist" - Data: "key"
-											1166-1167: "punctuation.definition.tag.xml.plist" - Data: ">"
-										1168-1174: "meta.tag.key.xml.plist"
-											1168-1170: "punctuation.definition.tag.xml.plist" - Data: "</"
-											1170-1173: "entity.name.tag.xml.plist"
-												1170-1173: "entity.name.tag.localname.xml.plist" - Data: "key"
-											1173-1174: "punctuation.definition.tag.xml.plist" - Data: ">"
-									1181-1285: ""
-										1181-1187: "meta.tag.dict.xml.plist"
-											1181-1182: "punctuation.definition.tag.xml.plist" - Data: "<"
-											1182-1186: "entity.name.tag.xml.plist"
-												1182-1186: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											1186-1187: "punctuation.definition.tag.xml.plist" - Data: ">"
-										1195-1210: ""
-											1195-1200: "meta.tag.key.xml.plist"
-												1195-1196: "punctuation.definition.tag.xml.plist" - Data: "<"
-												1196-1199: "entity.name.tag.xml.plist"
-													1196-1199: "entity.name.tag.localname.xml.plist" - Data: "key"
-												1199-1200: "punctuation.definition.tag.xml.plist" - Data: ">"
-											1204-1210: "meta.tag.key.xml.plist"
-												1204-1206: "punctuation.definition.tag.xml.plist" - Data: "</"
-												1206-1209: "entity.name.tag.xml.plist"
-													1206-1209: "entity.name.tag.localname.xml.plist" - Data: "key"
-												1209-1210: "punctuation.definition.tag.xml.plist" - Data: ">"
-										1218-1271: ""
-											1218-1226: "meta.tag.string.xml.plist"
-												1218-1219: "punctuation.definition.tag.xml.plist" - Data: "<"
-												1219-1225: "entity.name.tag.xml.plist"
-													1219-1225: "entity.name.tag.localname.xml.plist" - Data: "string"
-												1225-1226: "punctuation.definition.tag.xml.plist" - Data: ">"
-											1262-1271: "meta.tag.string.xml.plist"
-												1262-1264: "punctuation.definition.tag.xml.plist" - Data: "</"
-												1264-1270: "entity.name.tag.xml.plist"
-													1264-1270: "entity.name.tag.localname.xml.plist" - Data: "string"
-												1270-1271: "punctuation.definition.tag.xml.plist" - Data: ">"
-										1278-1285: "meta.tag.dict.xml.plist"
-											1278-1280: "punctuation.definition.tag.xml.plist" - Data: "</"
-											1280-1284: "entity.name.tag.xml.plist"
-												1280-1284: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											1284-1285: "punctuation.definition.tag.xml.plist" - Data: ">"
-									1291-1298: "meta.tag.dict.xml.plist"
-										1291-1293: "punctuation.definition.tag.xml.plist" - Data: "</"
-										1293-1297: "entity.name.tag.xml.plist"
-											1293-1297: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										1297-1298: "punctuation.definition.tag.xml.plist" - Data: ">"
-								1304-1318: ""
-									1304-1309: "meta.tag.key.xml.plist"
-										1304-1305: "punctuation.definition.tag.xml.plist" - Data: "<"
-										1305-1308: "entity.name.tag.xml.plist"
-											1305-1308: "entity.name.tag.localname.xml.plist" - Data: "key"
-										1308-1309: "punctuation.definition.tag.xml.plist" - Data: ">"
-									1312-1318: "meta.tag.key.xml.plist"
-										1312-1314: "punctuation.definition.tag.xml.plist" - Data: "</"
-										1314-1317: "entity.name.tag.xml.plist"
-											1314-1317: "entity.name.tag.localname.xml.plist" - Data: "key"
-										1317-1318: "punctuation.definition.tag.xml.plist" - Data: ">"
-								1324-1361: ""
-									1324-1332: "meta.tag.string.xml.plist"
-										1324-1325: "punctuation.definition.tag.xml.plist" - Data: "<"
-										1325-1331: "entity.name.tag.xml.plist"
-											1325-1331: "entity.name.tag.localname.xml.plist" - Data: "string"
-										1331-1332: "punctuation.definition.tag.xml.plist" - Data: ">"
-									1352-1361: "meta.tag.string.xml.plist"
-										1352-1354: "punctuation.definition.tag.xml.plist" - Data: "</"
-										1354-1360: "entity.name.tag.xml.plist"
-											1354-1360: "entity.name.tag.localname.xml.plist" - Data: "string"
-										1360-1361: "punctuation.definition.tag.xml.plist" - Data: ">"
-								1367-1386: ""
-									1367-1372: "meta.tag.key.xml.plist"
-										1367-1368: "punctuation.definition.tag.xml.plist" - Data: "<"
-										1368-1371: "entity.name.tag.xml.plist"
-											1368-1371: "entity.name.tag.localname.xml.plist" - Data: "key"
-										1371-1372: "punctuation.definition.tag.xml.plist" - Data: ">"
-									1380-1386: "meta.tag.key.xml.plist"
-										1380-1382: "punctuation.definition.tag.xml.plist" - Data: "</"
-										1382-1385: "entity.name.tag.xml.plist"
-											1382-1385: "entity.name.tag.localname.xml.plist" - Data: "key"
-										1385-1386: "punctuation.definition.tag.xml.plist" - Data: ">"
-								1392-4508: ""
-									1392-1399: "meta.tag.array.xml.plist"
-										1392-1393: "punctuation.definition.tag.xml.plist" - Data: "<"
-										1393-1398: "entity.name.tag.xml.plist"
-											1393-1398: "entity.name.tag.localname.xml.plist" - Data: "array"
-										1398-1399: "punctuation.definition.tag.xml.plist" - Data: ">"
-									1406-2889: ""
-										1406-1412: "meta.tag.dict.xml.plist"
-											1406-1407: "punctuation.definition.tag.xml.plist" - Data: "<"
-											1407-1411: "entity.name.tag.xml.plist"
-												1407-1411: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											1411-1412: "punctuation.definition.tag.xml.plist" - Data: ">"
-										1420-1436: ""
-											1420-1425: "meta.tag.key.xml.plist"
-												1420-1421: "punctuation.definition.tag.xml.plist" - Data: "<"
-												1421-1424: "entity.name.tag.xml.plist"
-													1421-1424: "entity.name.tag.localname.xml.plist" - Data: "key"
-												1424-1425: "punctuation.definition.tag.xml.plist" - Data: ">"
-											1430-1436: "meta.tag.key.xml.plist"
-												1430-1432: "punctuation.definition.tag.xml.plist" - Data: "</"
-												1432-1435: "entity.name.tag.xml.plist"
-													1432-1435: "entity.name.tag.localname.xml.plist" - Data: "key"
-												1435-1436: "punctuation.definition.tag.xml.plist" - Data: ">"
-										1444-1533: ""
-											1444-1452: "meta.tag.string.xml.plist"
-												1444-1445: "punctuation.definition.tag.xml.plist" - Data: "<"
-												1445-1451: "entity.name.tag.xml.plist"
-													1445-1451: "entity.name.tag.localname.xml.plist" - Data: "string"
-												1451-1452: "punctuation.definition.tag.xml.plist" - Data: ">"
-											1524-1533: "meta.tag.string.xml.plist"
-												1524-1526: "punctuation.definition.tag.xml.plist" - Data: "</"
-												1526-1532: "entity.name.tag.xml.plist"
-													1526-1532: "entity.name.tag.localname.xml.plist" - Data: "string"
-												1532-1533: "punctuation.definition.tag.xml.plist" - Data: ">"
-										1541-1565: ""
-											1541-1546: "meta.tag.key.xml.plist"
-												1541-1542: "punctuation.definition.tag.xml.plist" - Data: "<"
-												1542-1545: "entity.name.tag.xml.plist"
-													1542-1545: "entity.name.tag.localname.xml.plist" - Data: "key"
-												1545-1546: "punctuation.definition.tag.xml.plist" - Data: ">"
-											1559-1565: "meta.tag.key.xml.plist"
-												1559-1561: "punctuation.definition.tag.xml.plist" - Data: "</"
-												1561-1564: "entity.name.tag.xml.plist"
-													1561-1564: "entity.name.tag.localname.xml.plist" - Data: "key"
-												1564-1565: "punctuation.definition.tag.xml.plist" - Data: ">"
-										1573-2748: ""
-											1573-1579: "meta.tag.dict.xml.plist"
-												1573-1574: "punctuation.definition.tag.xml.plist" - Data: "<"
-												1574-1578: "entity.name.tag.xml.plist"
-													1574-1578: "entity.name.tag.localname.xml.plist" - Data: "dict"
-												1578-1579: "punctuation.definition.tag.xml.plist" - Data: ">"
-											1588-1600: ""
-												1588-1593: "meta.tag.key.xml.plist"
-													1588-1589: "punctuation.definition.tag.xml.plist" - Data: "<"
-													1589-1592: "entity.name.tag.xml.plist"
-														1589-1592: "entity.name.tag.localname.xml.plist" - Data: "key"
-													1592-1593: "punctuation.definition.tag.xml.plist" - Data: ">"
-												1594-1600: "meta.tag.key.xml.plist"
-													1594-1596: "punctuation.definition.tag.xml.plist" - Data: "</"
-													1596-1599: "entity.name.tag.xml.plist"
-														1596-1599: "entity.name.tag.localname.xml.plist" - Data: "key"
-													1599-1600: "punctuation.definition.tag.xml.plist" - Data: ">"
-											1609-1728: ""
-												1609-1615: "meta.tag.dict.xml.plist"
-													1609-1610: "punctuation.definition.tag.xml.plist" - Data: "<"
-													1610-1614: "entity.name.tag.xml.plist"
-														1610-1614: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													1614-1615: "punctuation.definition.tag.xml.plist" - Data: ">"
-												1625-1640: ""
-													1625-1630: "meta.tag.key.xml.plist"
-														1625-1626: "punctuation.definition.tag.xml.plist" - Data: "<"
-														1626-1629: "entity.name.tag.xml.plist"
-															1626-1629: "entity.name.tag.localname.xml.plist" - Data: "key"
-														1629-1630: "punctuation.definition.tag.xml.plist" - Data: ">"
-													1634-1640: "meta.tag.key.xml.plist"
-														1634-1636: "punctuation.definition.tag.xml.plist" - Data: "</"
-														1636-1639: "entity.name.tag.xml.plist"
-															1636-1639: "entity.name.tag.localname.xml.plist" - Data: "key"
-														1639-1640: "punctuation.definition.tag.xml.plist" - Data: ">"
-												1650-1712: ""
-													1650-1658: "meta.tag.string.xml.plist"
-														1650-1651: "punctuation.definition.tag.xml.plist" - Data: "<"
-														1651-1657: "entity.name.tag.xml.plist"
-															1651-1657: "entity.name.tag.localname.xml.plist" - Data: "string"
-														1657-1658: "punctuation.definition.tag.xml.plist" - Data: ">"
-													1703-1712: "meta.tag.string.xml.plist"
-														1703-1705: "punctuation.definition.tag.xml.plist" - Data: "</"
-														1705-1711: "entity.name.tag.xml.plist"
-															1705-1711: "entity.name.tag.localname.xml.plist" - Data: "string"
-														1711-1712: "punctuation.definition.tag.xml.plist" - Data: ">"
-												1721-1728: "meta.tag.dict.xml.plist"
-													1721-1723: "punctuation.definition.tag.xml.plist" - Data: "</"
-													1723-1727: "entity.name.tag.xml.plist"
-														1723-1727: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													1727-1728: "punctuation.definition.tag.xml.plist" - Data: ">"
-											1737-1749: ""
-												1737-1742: "meta.tag.key.xml.plist"
-													1737-1738: "punctuation.definition.tag.xml.plist" - Data: "<"
-													1738-1741: "entity.name.tag.xml.plist"
-														1738-1741: "entity.name.tag.localname.xml.plist" - Data: "key"
-													1741-1742: "punctuation.definition.tag.xml.plist" - Data: ">"
-												1743-1749: "meta.tag.key.xml.plist"
-													1743-1745: "punctuation.definition.tag.xml.plist" - Data: "</"
-													1745-1748: "entity.name.tag.xml.plist"
-														1745-1748: "entity.name.tag.localname.xml.plist" - Data: "key"
-													1748-1749: "punctuation.definition.tag.xml.plist" - Data: ">"
-											1758-1873: ""
-												1758-1764: "meta.tag.dict.xml.plist"
-													1758-1759: "punctuation.definition.tag.xml.plist" - Data: "<"
-													1759-1763: "entity.name.tag.xml.plist"
-														1759-1763: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													1763-1764: "punctuation.definition.tag.xml.plist" - Data: ">"
-												1774-1789: ""
-													1774-1779: "meta.tag.key.xml.plist"
-														1774-1775: "punctuation.definition.tag.xml.plist" - Data: "<"
-														1775-1778: "entity.name.tag.xml.plist"
-															1775-1778: "entity.name.tag.localname.xml.plist" - Data: "key"
-														1778-1779: "punctuation.definition.tag.xml.plist" - Data: ">"
-													1783-1789: "meta.tag.key.xml.plist"
-														1783-1785: "punctuation.definition.tag.xml.plist" - Data: "</"
-														1785-1788: "entity.name.tag.xml.plist"
-															1785-1788: "entity.name.tag.localname.xml.plist" - Data: "key"
-														1788-1789: "punctuation.definition.tag.xml.plist" - Data: ">"
-												1799-1857: ""
-													1799-1807: "meta.tag.string.xml.plist"
-														1799-1800: "punctuation.definition.tag.xml.plist" - Data: "<"
-														1800-1806: "entity.name.tag.xml.plist"
-															1800-1806: "entity.name.tag.localname.xml.plist" - Data: "string"
-														1806-1807: "punctuation.definition.tag.xml.plist" - Data: ">"
-													1848-1857: "meta.tag.string.xml.plist"
-														1848-1850: "punctuation.definition.tag.xml.plist" - Data: "</"
-														1850-1856: "entity.name.tag.xml.plist"
-															1850-1856: "entity.name.tag.localname.xml.plist" - Data: "string"
-														1856-1857: "punctuation.definition.tag.xml.plist" - Data: ">"
-												1866-1873: "meta.tag.dict.xml.plist"
-													1866-1868: "punctuation.definition.tag.xml.plist" - Data: "</"
-													1868-1872: "entity.name.tag.xml.plist"
-														1868-1872: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													1872-1873: "punctuation.definition.tag.xml.plist" - Data: ">"
-											1882-1894: ""
-												1882-1887: "meta.tag.key.xml.plist"
-													1882-1883: "punctuation.definition.tag.xml.plist" - Data: "<"
-													1883-1886: "entity.name.tag.xml.plist"
-														1883-1886: "entity.name.tag.localname.xml.plist" - Data: "key"
-													1886-1887: "punctuation.definition.tag.xml.plist" - Data: ">"
-												1888-1894: "meta.tag.key.xml.plist"
-													1888-1890: "punctuation.definition.tag.xml.plist" - Data: "</"
-													1890-1893: "entity.name.tag.xml.plist"
-														1890-1893: "entity.name.tag.localname.xml.plist" - Data: "key"
-													1893-1894: "punctuation.definition.tag.xml.plist" - Data: ">"
-											1903-2007: ""
-												1903-1909: "meta.tag.dict.xml.plist"
-													1903-1904: "punctuation.definition.tag.xml.plist" - Data: "<"
-													1904-1908: "entity.name.tag.xml.plist"
-														1904-1908: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													1908-1909: "punctuation.definition.tag.xml.plist" - Data: ">"
-												1919-1934: ""
-													1919-1924: "meta.tag.key.xml.plist"
-														1919-1920: "punctuation.definition.tag.xml.plist" - Data: "<"
-														1920-1923: "entity.name.tag.xml.plist"
-															1920-1923: "entity.name.tag.localname.xml.plist" - Data: "key"
-														1923-1924: "punctuation.definition.tag.xml.plist" - Data: ">"
-													1928-1934: "meta.tag.key.xml.plist"
-														1928-1930: "punctuation.definition.tag.xml.plist" - Data: "</"
-														1930-1933: "entity.name.tag.xml.plist"
-															1930-1933: "entity.name.tag.localname.xml.plist" - Data: "key"
-														1933-1934: "punctuation.definition.tag.xml.plist" - Data: ">"
-												1944-1991: ""
-													1944-1952: "meta.tag.string.xml.plist"
-														1944-1945: "punctuation.definition.tag.xml.plist" - Data: "<"
-														1945-1951: "entity.name.tag.xml.plist"
-															1945-1951: "entity.name.tag.localname.xml.plist" - Data: "string"
-														1951-1952: "punctuation.definition.tag.xml.plist" - Data: ">"
-													1982-1991: "meta.tag.string.xml.plist"
-														1982-1984: "punctuation.definition.tag.xml.plist" - Data: "</"
-														1984-1990: "entity.name.tag.xml.plist"
-															1984-1990: "entity.name.tag.localname.xml.plist" - Data: "string"
-														1990-1991: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2000-2007: "meta.tag.dict.xml.plist"
-													2000-2002: "punctuation.definition.tag.xml.plist" - Data: "</"
-													2002-2006: "entity.name.tag.xml.plist"
-														2002-2006: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													2006-2007: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2016-2028: ""
-												2016-2021: "meta.tag.key.xml.plist"
-													2016-2017: "punctuation.definition.tag.xml.plist" - Data: "<"
-													2017-2020: "entity.name.tag.xml.plist"
-														2017-2020: "entity.name.tag.localname.xml.plist" - Data: "key"
-													2020-2021: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2022-2028: "meta.tag.key.xml.plist"
-													2022-2024: "punctuation.definition.tag.xml.plist" - Data: "</"
-													2024-2027: "entity.name.tag.xml.plist"
-														2024-2027: "entity.name.tag.localname.xml.plist" - Data: "key"
-													2027-2028: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2037-2156: ""
-												2037-2043: "meta.tag.dict.xml.plist"
-													2037-2038: "punctuation.definition.tag.xml.plist" - Data: "<"
-													2038-2042: "entity.name.tag.xml.plist"
-														2038-2042: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													2042-2043: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2053-2068: ""
-													2053-2058: "meta.tag.key.xml.plist"
-														2053-2054: "punctuation.definition.tag.xml.plist" - Data: "<"
-														2054-2057: "entity.name.tag.xml.plist"
-															2054-2057: "entity.name.tag.localname.xml.plist" - Data: "key"
-														2057-2058: "punctuation.definition.tag.xml.plist" - Data: ">"
-													2062-2068: "meta.tag.key.xml.plist"
-														2062-2064: "punctuation.definition.tag.xml.plist" - Data: "</"
-														2064-2067: "entity.name.tag.xml.plist"
-															2064-2067: "entity.name.tag.localname.xml.plist" - Data: "key"
-														2067-2068: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2078-2140: ""
-													2078-2086: "meta.tag.string.xml.plist"
-														2078-2079: "punctuation.definition.tag.xml.plist" - Data: "<"
-														2079-2085: "entity.name.tag.xml.plist"
-															2079-2085: "entity.name.tag.localname.xml.plist" - Data: "string"
-														2085-2086: "punctuation.definition.tag.xml.plist" - Data: ">"
-													2131-2140: "meta.tag.string.xml.plist"
-														2131-2133: "punctuation.definition.tag.xml.plist" - Data: "</"
-														2133-2139: "entity.name.tag.xml.plist"
-															2133-2139: "entity.name.tag.localname.xml.plist" - Data: "string"
-														2139-2140: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2149-2156: "meta.tag.dict.xml.plist"
-													2149-2151: "punctuation.definition.tag.xml.plist" - Data: "</"
-													2151-2155: "entity.name.tag.xml.plist"
-														2151-2155: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													2155-2156: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2165-2177: ""
-												2165-2170: "meta.tag.key.xml.plist"
-													2165-2166: "punctuation.definition.tag.xml.plist" - Data: "<"
-													2166-2169: "entity.name.tag.xml.plist"
-														2166-2169: "entity.name.tag.localname.xml.plist" - Data: "key"
-													2169-2170: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2171-2177: "meta.tag.key.xml.plist"
-													2171-2173: "punctuation.definition.tag.xml.plist" - Data: "</"
-													2173-2176: "entity.name.tag.xml.plist"
-														2173-2176: "entity.name.tag.localname.xml.plist" - Data: "key"
-													2176-2177: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2186-2303: ""
-												2186-2192: "meta.tag.dict.xml.plist"
-													2186-2187: "punctuation.definition.tag.xml.plist" - Data: "<"
-													2187-2191: "entity.name.tag.xml.plist"
-														2187-2191: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													2191-2192: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2202-2217: ""
-													2202-2207: "meta.tag.key.xml.plist"
-														2202-2203: "punctuation.definition.tag.xml.plist" - Data: "<"
-														2203-2206: "entity.name.tag.xml.plist"
-															2203-2206: "entity.name.tag.localname.xml.plist" - Data: "key"
-														2206-2207: "punctuation.definition.tag.xml.plist" - Data: ">"
-													2211-2217: "meta.tag.key.xml.plist"
-														2211-2213: "punctuation.definition.tag.xml.plist" - Data: "</"
-														2213-2216: "entity.name.tag.xml.plist"
-															2213-2216: "entity.name.tag.localname.xml.plist" - Data: "key"
-														2216-2217: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2227-2287: ""
-													2227-2235: "meta.tag.string.xml.plist"
-														2227-2228: "punctuation.definition.tag.xml.plist" - Data: "<"
-														2228-2234: "entity.name.tag.xml.plist"
-															2228-2234: "entity.name.tag.localname.xml.plist" - Data: "string"
-														2234-2235: "punctuation.definition.tag.xml.plist" - Data: ">"
-													2278-2287: "meta.tag.string.xml.plist"
-														2278-2280: "punctuation.definition.tag.xml.plist" - Data: "</"
-														2280-2286: "entity.name.tag.xml.plist"
-															2280-2286: "entity.name.tag.localname.xml.plist" - Data: "string"
-														2286-2287: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2296-2303: "meta.tag.dict.xml.plist"
-													2296-2298: "punctuation.definition.tag.xml.plist" - Data: "</"
-													2298-2302: "entity.name.tag.xml.plist"
-														2298-2302: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													2302-2303: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2312-2324: ""
-												2312-2317: "meta.tag.key.xml.plist"
-													2312-2313: "punctuation.definition.tag.xml.plist" - Data: "<"
-													2313-2316: "entity.name.tag.xml.plist"
-														2313-2316: "entity.name.tag.localname.xml.plist" - Data: "key"
-													2316-2317: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2318-2324: "meta.tag.key.xml.plist"
-													2318-2320: "punctuation.definition.tag.xml.plist" - Data: "</"
-													2320-2323: "entity.name.tag.xml.plist"
-														2320-2323: "entity.name.tag.localname.xml.plist" - Data: "key"
-													2323-2324: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2333-2437: ""
-												2333-2339: "meta.tag.dict.xml.plist"
-													2333-2334: "punctuation.definition.tag.xml.plist" - Data: "<"
-													2334-2338: "entity.name.tag.xml.plist"
-														2334-2338: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													2338-2339: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2349-2364: ""
-													2349-2354: "meta.tag.key.xml.plist"
-														2349-2350: "punctuation.definition.tag.xml.plist" - Data: "<"
-														2350-2353: "entity.name.tag.xml.plist"
-															2350-2353: "entity.name.tag.localname.xml.plist" - Data: "key"
-														2353-2354: "punctuation.definition.tag.xml.plist" - Data: ">"
-													2358-2364: "meta.tag.key.xml.plist"
-														2358-2360: "punctuation.definition.tag.xml.plist" - Data: "</"
-														2360-2363: "entity.name.tag.xml.plist"
-															2360-2363: "entity.name.tag.localname.xml.plist" - Data: "key"
-														2363-2364: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2374-2421: ""
-													2374-2382: "meta.tag.string.xml.plist"
-														2374-2375: "punctuation.definition.tag.xml.plist" - Data: "<"
-														2375-2381: "entity.name.tag.xml.plist"
-															2375-2381: "entity.name.tag.localname.xml.plist" - Data: "string"
-														2381-2382: "punctuation.definition.tag.xml.plist" - Data: ">"
-													2412-2421: "meta.tag.string.xml.plist"
-														2412-2414: "punctuation.definition.tag.xml.plist" - Data: "</"
-														2414-2420: "entity.name.tag.xml.plist"
-															2414-2420: "entity.name.tag.localname.xml.plist" - Data: "string"
-														2420-2421: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2430-2437: "meta.tag.dict.xml.plist"
-													2430-2432: "punctuation.definition.tag.xml.plist" - Data: "</"
-													2432-2436: "entity.name.tag.xml.plist"
-														2432-2436: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													2436-2437: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2446-2458: ""
-												2446-2451: "meta.tag.key.xml.plist"
-													2446-2447: "punctuation.definition.tag.xml.plist" - Data: "<"
-													2447-2450: "entity.name.tag.xml.plist"
-														2447-2450: "entity.name.tag.localname.xml.plist" - Data: "key"
-													2450-2451: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2452-2458: "meta.tag.key.xml.plist"
-													2452-2454: "punctuation.definition.tag.xml.plist" - Data: "</"
-													2454-2457: "entity.name.tag.xml.plist"
-														2454-2457: "entity.name.tag.localname.xml.plist" - Data: "key"
-													2457-2458: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2467-2586: ""
-												2467-2473: "meta.tag.dict.xml.plist"
-													2467-2468: "punctuation.definition.tag.xml.plist" - Data: "<"
-													2468-2472: "entity.name.tag.xml.plist"
-														2468-2472: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													2472-2473: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2483-2498: ""
-													2483-2488: "meta.tag.key.xml.plist"
-														2483-2484: "punctuation.definition.tag.xml.plist" - Data: "<"
-														2484-2487: "entity.name.tag.xml.plist"
-															2484-2487: "entity.name.tag.localname.xml.plist" - Data: "key"
-														2487-2488: "punctuation.definition.tag.xml.plist" - Data: ">"
-													2492-2498: "meta.tag.key.xml.plist"
-														2492-2494: "punctuation.definition.tag.xml.plist" - Data: "</"
-														2494-2497: "entity.name.tag.xml.plist"
-															2494-2497: "entity.name.tag.localname.xml.plist" - Data: "key"
-														2497-2498: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2508-2570: ""
-													2508-2516: "meta.tag.string.xml.plist"
-														2508-2509: "punctuation.definition.tag.xml.plist" - Data: "<"
-														2509-2515: "entity.name.tag.xml.plist"
-															2509-2515: "entity.name.tag.localname.xml.plist" - Data: "string"
-														2515-2516: "punctuation.definition.tag.xml.plist" - Data: ">"
-													2561-2570: "meta.tag.string.xml.plist"
-														2561-2563: "punctuation.definition.tag.xml.plist" - Data: "</"
-														2563-2569: "entity.name.tag.xml.plist"
-															2563-2569: "entity.name.tag.localname.xml.plist" - Data: "string"
-														2569-2570: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2579-2586: "meta.tag.dict.xml.plist"
-													2579-2581: "punctuation.definition.tag.xml.plist" - Data: "</"
-													2581-2585: "entity.name.tag.xml.plist"
-														2581-2585: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													2585-2586: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2595-2607: ""
-												2595-2600: "meta.tag.key.xml.plist"
-													2595-2596: "punctuation.definition.tag.xml.plist" - Data: "<"
-													2596-2599: "entity.name.tag.xml.plist"
-														2596-2599: "entity.name.tag.localname.xml.plist" - Data: "key"
-													2599-2600: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2601-2607: "meta.tag.key.xml.plist"
-													2601-2603: "punctuation.definition.tag.xml.plist" - Data: "</"
-													2603-2606: "entity.name.tag.xml.plist"
-														2603-2606: "entity.name.tag.localname.xml.plist" - Data: "key"
-													2606-2607: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2616-2733: ""
-												2616-2622: "meta.tag.dict.xml.plist"
-													2616-2617: "punctuation.definition.tag.xml.plist" - Data: "<"
-													2617-2621: "entity.name.tag.xml.plist"
-														2617-2621: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													2621-2622: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2632-2647: ""
-													2632-2637: "meta.tag.key.xml.plist"
-														2632-2633: "punctuation.definition.tag.xml.plist" - Data: "<"
-														2633-2636: "entity.name.tag.xml.plist"
-															2633-2636: "entity.name.tag.localname.xml.plist" - Data: "key"
-														2636-2637: "punctuation.definition.tag.xml.plist" - Data: ">"
-													2641-2647: "meta.tag.key.xml.plist"
-														2641-2643: "punctuation.definition.tag.xml.plist" - Data: "</"
-														2643-2646: "entity.name.tag.xml.plist"
-															2643-2646: "entity.name.tag.localname.xml.plist" - Data: "key"
-														2646-2647: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2657-2717: ""
-													2657-2665: "meta.tag.string.xml.plist"
-														2657-2658: "punctuation.definition.tag.xml.plist" - Data: "<"
-														2658-2664: "entity.name.tag.xml.plist"
-															2658-2664: "entity.name.tag.localname.xml.plist" - Data: "string"
-														2664-2665: "punctuation.definition.tag.xml.plist" - Data: ">"
-													2708-2717: "meta.tag.string.xml.plist"
-														2708-2710: "punctuation.definition.tag.xml.plist" - Data: "</"
-														2710-2716: "entity.name.tag.xml.plist"
-															2710-2716: "entity.name.tag.localname.xml.plist" - Data: "string"
-														2716-2717: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2726-2733: "meta.tag.dict.xml.plist"
-													2726-2728: "punctuation.definition.tag.xml.plist" - Data: "</"
-													2728-2732: "entity.name.tag.xml.plist"
-														2728-2732: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													2732-2733: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2741-2748: "meta.tag.dict.xml.plist"
-												2741-2743: "punctuation.definition.tag.xml.plist" - Data: "</"
-												2743-2747: "entity.name.tag.xml.plist"
-													2743-2747: "entity.name.tag.localname.xml.plist" - Data: "dict"
-												2747-2748: "punctuation.definition.tag.xml.plist" - Data: ">"
-										2756-2770: ""
-											2756-2761: "meta.tag.key.xml.plist"
-												2756-2757: "punctuation.definition.tag.xml.plist" - Data: "<"
-												2757-2760: "entity.name.tag.xml.plist"
-													2757-2760: "entity.name.tag.localname.xml.plist" - Data: "key"
-												2760-2761: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2764-2770: "meta.tag.key.xml.plist"
-												2764-2766: "punctuation.definition.tag.xml.plist" - Data: "</"
-												2766-2769: "entity.name.tag.xml.plist"
-													2766-2769: "entity.name.tag.localname.xml.plist" - Data: "key"
-												2769-2770: "punctuation.definition.tag.xml.plist" - Data: ">"
-										2778-2803: ""
-											2778-2786: "meta.tag.string.xml.plist"
-												2778-2779: "punctuation.definition.tag.xml.plist" - Data: "<"
-												2779-2785: "entity.name.tag.xml.plist"
-													2779-2785: "entity.name.tag.localname.xml.plist" - Data: "string"
-												2785-2786: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2794-2803: "meta.tag.string.xml.plist"
-												2794-2796: "punctuation.definition.tag.xml.plist" - Data: "</"
-												2796-2802: "entity.name.tag.xml.plist"
-													2796-2802: "entity.name.tag.localname.xml.plist" - Data: "string"
-												2802-2803: "punctuation.definition.tag.xml.plist" - Data: ">"
-										2811-2826: ""
-											2811-2816: "meta.tag.key.xml.plist"
-												2811-2812: "punctuation.definition.tag.xml.plist" - Data: "<"
-												2812-2815: "entity.name.tag.xml.plist"
-													2812-2815: "entity.name.tag.localname.xml.plist" - Data: "key"
-												2815-2816: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2820-2826: "meta.tag.key.xml.plist"
-												2820-2822: "punctuation.definition.tag.xml.plist" - Data: "</"
-												2822-2825: "entity.name.tag.xml.plist"
-													2822-2825: "entity.name.tag.localname.xml.plist" - Data: "key"
-												2825-2826: "punctuation.definition.tag.xml.plist" - Data: ">"
-										2834-2875: ""
-											2834-2842: "meta.tag.string.xml.plist"
-												2834-2835: "punctuation.definition.tag.xml.plist" - Data: "<"
-												2835-2841: "entity.name.tag.xml.plist"
-													2835-2841: "entity.name.tag.localname.xml.plist" - Data: "string"
-												2841-2842: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2866-2875: "meta.tag.string.xml.plist"
-												2866-2868: "punctuation.definition.tag.xml.plist" - Data: "</"
-												2868-2874: "entity.name.tag.xml.plist"
-													2868-2874: "entity.name.tag.localname.xml.plist" - Data: "string"
-												2874-2875: "punctuation.definition.tag.xml.plist" - Data: ">"
-										2882-2889: "meta.tag.dict.xml.plist"
-											2882-2884: "punctuation.definition.tag.xml.plist" - Data: "</"
-											2884-2888: "entity.name.tag.xml.plist"
-												2884-2888: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											2888-2889: "punctuation.definition.tag.xml.plist" - Data: ">"
-									2896-3524: ""
-										2896-2902: "meta.tag.dict.xml.plist"
-											2896-2897: "punctuation.definition.tag.xml.plist" - Data: "<"
-											2897-2901: "entity.name.tag.xml.plist"
-												2897-2901: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											2901-2902: "punctuation.definition.tag.xml.plist" - Data: ">"
-										2910-2929: ""
-											2910-2915: "meta.tag.key.xml.plist"
-												2910-2911: "punctuation.definition.tag.xml.plist" - Data: "<"
-												2911-2914: "entity.name.tag.xml.plist"
-													2911-2914: "entity.name.tag.localname.xml.plist" - Data: "key"
-												2914-2915: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2923-2929: "meta.tag.key.xml.plist"
-												2923-2925: "punctuation.definition.tag.xml.plist" - Data: "</"
-												2925-2928: "entity.name.tag.xml.plist"
-													2925-2928: "entity.name.tag.localname.xml.plist" - Data: "key"
-												2928-2929: "punctuation.definition.tag.xml.plist" - Data: ">"
-										2937-3367: ""
-											2937-2943: "meta.tag.dict.xml.plist"
-												2937-2938: "punctuation.definition.tag.xml.plist" - Data: "<"
-												2938-2942: "entity.name.tag.xml.plist"
-													2938-2942: "entity.name.tag.localname.xml.plist" - Data: "dict"
-												2942-2943: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2952-2964: ""
-												2952-2957: "meta.tag.key.xml.plist"
-													2952-2953: "punctuation.definition.tag.xml.plist" - Data: "<"
-													2953-2956: "entity.name.tag.xml.plist"
-														2953-2956: "entity.name.tag.localname.xml.plist" - Data: "key"
-													2956-2957: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2958-2964: "meta.tag.key.xml.plist"
-													2958-2960: "punctuation.definition.tag.xml.plist" - Data: "</"
-													2960-2963: "entity.name.tag.xml.plist"
-														2960-2963: "entity.name.tag.localname.xml.plist" - Data: "key"
-													2963-2964: "punctuation.definition.tag.xml.plist" - Data: ">"
-											2973-3071: ""
-												2973-2979: "meta.tag.dict.xml.plist"
-													2973-2974: "punctuation.definition.tag.xml.plist" - Data: "<"
-													2974-2978: "entity.name.tag.xml.plist"
-														2974-2978: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													2978-2979: "punctuation.definition.tag.xml.plist" - Data: ">"
-												2989-3004: ""
-													2989-2994: "meta.tag.key.xml.plist"
-														2989-2990: "punctuation.definition.tag.xml.plist" - Data: "<"
-														2990-2993: "entity.name.tag.xml.plist"
-															2990-2993: "entity.name.tag.localname.xml.plist" - Data: "key"
-														2993-2994: "punctuation.definition.tag.xml.plist" - Data: ">"
-													2998-3004: "meta.tag.key.xml.plist"
-														2998-3000: "punctuation.definition.tag.xml.plist" - Data: "</"
-														3000-3003: "entity.name.tag.xml.plist"
-															3000-3003: "entity.name.tag.localname.xml.plist" - Data: "key"
-														3003-3004: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3014-3055: ""
-													3014-3022: "meta.tag.string.xml.plist"
-														3014-3015: "punctuation.definition.tag.xml.plist" - Data: "<"
-														3015-3021: "entity.name.tag.xml.plist"
-															3015-3021: "entity.name.tag.localname.xml.plist" - Data: "string"
-														3021-3022: "punctuation.definition.tag.xml.plist" - Data: ">"
-													3046-3055: "meta.tag.string.xml.plist"
-														3046-3048: "punctuation.definition.tag.xml.plist" - Data: "</"
-														3048-3054: "entity.name.tag.xml.plist"
-															3048-3054: "entity.name.tag.localname.xml.plist" - Data: "string"
-														3054-3055: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3064-3071: "meta.tag.dict.xml.plist"
-													3064-3066: "punctuation.definition.tag.xml.plist" - Data: "</"
-													3066-3070: "entity.name.tag.xml.plist"
-														3066-3070: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													3070-3071: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3080-3092: ""
-												3080-3085: "meta.tag.key.xml.plist"
-													3080-3081: "punctuation.definition.tag.xml.plist" - Data: "<"
-													3081-3084: "entity.name.tag.xml.plist"
-														3081-3084: "entity.name.tag.localname.xml.plist" - Data: "key"
-													3084-3085: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3086-3092: "meta.tag.key.xml.plist"
-													3086-3088: "punctuation.definition.tag.xml.plist" - Data: "</"
-													3088-3091: "entity.name.tag.xml.plist"
-														3088-3091: "entity.name.tag.localname.xml.plist" - Data: "key"
-													3091-3092: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3101-3211: ""
-												3101-3107: "meta.tag.dict.xml.plist"
-													3101-3102: "punctuation.definition.tag.xml.plist" - Data: "<"
-													3102-3106: "entity.name.tag.xml.plist"
-														3102-3106: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													3106-3107: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3117-3132: ""
-													3117-3122: "meta.tag.key.xml.plist"
-														3117-3118: "punctuation.definition.tag.xml.plist" - Data: "<"
-														3118-3121: "entity.name.tag.xml.plist"
-															3118-3121: "entity.name.tag.localname.xml.plist" - Data: "key"
-														3121-3122: "punctuation.definition.tag.xml.plist" - Data: ">"
-													3126-3132: "meta.tag.key.xml.plist"
-														3126-3128: "punctuation.definition.tag.xml.plist" - Data: "</"
-														3128-3131: "entity.name.tag.xml.plist"
-															3128-3131: "entity.name.tag.localname.xml.plist" - Data: "key"
-														3131-3132: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3142-3195: ""
-													3142-3150: "meta.tag.string.xml.plist"
-														3142-3143: "punctuation.definition.tag.xml.plist" - Data: "<"
-														3143-3149: "entity.name.tag.xml.plist"
-															3143-3149: "entity.name.tag.localname.xml.plist" - Data: "string"
-														3149-3150: "punctuation.definition.tag.xml.plist" - Data: ">"
-													3186-3195: "meta.tag.string.xml.plist"
-														3186-3188: "punctuation.definition.tag.xml.plist" - Data: "</"
-														3188-3194: "entity.name.tag.xml.plist"
-															3188-3194: "entity.name.tag.localname.xml.plist" - Data: "string"
-														3194-3195: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3204-3211: "meta.tag.dict.xml.plist"
-													3204-3206: "punctuation.definition.tag.xml.plist" - Data: "</"
-													3206-3210: "entity.name.tag.xml.plist"
-														3206-3210: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													3210-3211: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3220-3232: ""
-												3220-3225: "meta.tag.key.xml.plist"
-													3220-3221: "punctuation.definition.tag.xml.plist" - Data: "<"
-													3221-3224: "entity.name.tag.xml.plist"
-														3221-3224: "entity.name.tag.localname.xml.plist" - Data: "key"
-													3224-3225: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3226-3232: "meta.tag.key.xml.plist"
-													3226-3228: "punctuation.definition.tag.xml.plist" - Data: "</"
-													3228-3231: "entity.name.tag.xml.plist"
-														3228-3231: "entity.name.tag.localname.xml.plist" - Data: "key"
-													3231-3232: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3241-3352: ""
-												3241-3247: "meta.tag.dict.xml.plist"
-													3241-3242: "punctuation.definition.tag.xml.plist" - Data: "<"
-													3242-3246: "entity.name.tag.xml.plist"
-														3242-3246: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													3246-3247: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3257-3272: ""
-													3257-3262: "meta.tag.key.xml.plist"
-														3257-3258: "punctuation.definition.tag.xml.plist" - Data: "<"
-														3258-3261: "entity.name.tag.xml.plist"
-															3258-3261: "entity.name.tag.localname.xml.plist" - Data: "key"
-														3261-3262: "punctuation.definition.tag.xml.plist" - Data: ">"
-													3266-3272: "meta.tag.key.xml.plist"
-														3266-3268: "punctuation.definition.tag.xml.plist" - Data: "</"
-														3268-3271: "entity.name.tag.xml.plist"
-															3268-3271: "entity.name.tag.localname.xml.plist" - Data: "key"
-														3271-3272: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3282-3336: ""
-													3282-3290: "meta.tag.string.xml.plist"
-														3282-3283: "punctuation.definition.tag.xml.plist" - Data: "<"
-														3283-3289: "entity.name.tag.xml.plist"
-															3283-3289: "entity.name.tag.localname.xml.plist" - Data: "string"
-														3289-3290: "punctuation.definition.tag.xml.plist" - Data: ">"
-													3327-3336: "meta.tag.string.xml.plist"
-														3327-3329: "punctuation.definition.tag.xml.plist" - Data: "</"
-														3329-3335: "entity.name.tag.xml.plist"
-															3329-3335: "entity.name.tag.localname.xml.plist" - Data: "string"
-														3335-3336: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3345-3352: "meta.tag.dict.xml.plist"
-													3345-3347: "punctuation.definition.tag.xml.plist" - Data: "</"
-													3347-3351: "entity.name.tag.xml.plist"
-														3347-3351: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													3351-3352: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3360-3367: "meta.tag.dict.xml.plist"
-												3360-3362: "punctuation.definition.tag.xml.plist" - Data: "</"
-												3362-3366: "entity.name.tag.xml.plist"
-													3362-3366: "entity.name.tag.localname.xml.plist" - Data: "dict"
-												3366-3367: "punctuation.definition.tag.xml.plist" - Data: ">"
-										3375-3393: ""
-											3375-3380: "meta.tag.key.xml.plist"
-												3375-3376: "punctuation.definition.tag.xml.plist" - Data: "<"
-												3376-3379: "entity.name.tag.xml.plist"
-													3376-3379: "entity.name.tag.localname.xml.plist" - Data: "key"
-												3379-3380: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3387-3393: "meta.tag.key.xml.plist"
-												3387-3389: "punctuation.definition.tag.xml.plist" - Data: "</"
-												3389-3392: "entity.name.tag.xml.plist"
-													3389-3392: "entity.name.tag.localname.xml.plist" - Data: "key"
-												3392-3393: "punctuation.definition.tag.xml.plist" - Data: ">"
-										3401-3437: ""
-											3401-3409: "meta.tag.string.xml.plist"
-												3401-3402: "punctuation.definition.tag.xml.plist" - Data: "<"
-												3402-3408: "entity.name.tag.xml.plist"
-													3402-3408: "entity.name.tag.localname.xml.plist" - Data: "string"
-												3408-3409: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3428-3437: "meta.tag.string.xml.plist"
-												3428-3430: "punctuation.definition.tag.xml.plist" - Data: "</"
-												3430-3436: "entity.name.tag.xml.plist"
-													3430-3436: "entity.name.tag.localname.xml.plist" - Data: "string"
-												3436-3437: "punctuation.definition.tag.xml.plist" - Data: ">"
-										3445-3461: ""
-											3445-3450: "meta.tag.key.xml.plist"
-												3445-3446: "punctuation.definition.tag.xml.plist" - Data: "<"
-												3446-3449: "entity.name.tag.xml.plist"
-													3446-3449: "entity.name.tag.localname.xml.plist" - Data: "key"
-												3449-3450: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3455-3461: "meta.tag.key.xml.plist"
-												3455-3457: "punctuation.definition.tag.xml.plist" - Data: "</"
-												3457-3460: "entity.name.tag.xml.plist"
-													3457-3460: "entity.name.tag.localname.xml.plist" - Data: "key"
-												3460-3461: "punctuation.definition.tag.xml.plist" - Data: ">"
-										3469-3510: ""
-											3469-3477: "meta.tag.string.xml.plist"
-												3469-3470: "punctuation.definition.tag.xml.plist" - Data: "<"
-												3470-3476: "entity.name.tag.xml.plist"
-													3470-3476: "entity.name.tag.localname.xml.plist" - Data: "string"
-												3476-3477: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3501-3510: "meta.tag.string.xml.plist"
-												3501-3503: "punctuation.definition.tag.xml.plist" - Data: "</"
-												3503-3509: "entity.name.tag.xml.plist"
-													3503-3509: "entity.name.tag.localname.xml.plist" - Data: "string"
-												3509-3510: "punctuation.definition.tag.xml.plist" - Data: ">"
-										3517-3524: "meta.tag.dict.xml.plist"
-											3517-3519: "punctuation.definition.tag.xml.plist" - Data: "</"
-											3519-3523: "entity.name.tag.xml.plist"
-												3519-3523: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											3523-3524: "punctuation.definition.tag.xml.plist" - Data: ">"
-									3531-4494: ""
-										3531-3537: "meta.tag.dict.xml.plist"
-											3531-3532: "punctuation.definition.tag.xml.plist" - Data: "<"
-											3532-3536: "entity.name.tag.xml.plist"
-												3532-3536: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											3536-3537: "punctuation.definition.tag.xml.plist" - Data: ">"
-										3545-3561: ""
-											3545-3550: "meta.tag.key.xml.plist"
-												3545-3546: "punctuation.definition.tag.xml.plist" - Data: "<"
-												3546-3549: "entity.name.tag.xml.plist"
-													3546-3549: "entity.name.tag.localname.xml.plist" - Data: "key"
-												3549-3550: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3555-3561: "meta.tag.key.xml.plist"
-												3555-3557: "punctuation.definition.tag.xml.plist" - Data: "</"
-												3557-3560: "entity.name.tag.xml.plist"
-													3557-3560: "entity.name.tag.localname.xml.plist" - Data: "key"
-												3560-3561: "punctuation.definition.tag.xml.plist" - Data: ">"
-										3569-3608: ""
-											3569-3577: "meta.tag.string.xml.plist"
-												3569-3570: "punctuation.definition.tag.xml.plist" - Data: "<"
-												3570-3576: "entity.name.tag.xml.plist"
-													3570-3576: "entity.name.tag.localname.xml.plist" - Data: "string"
-												3576-3577: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3599-3608: "meta.tag.string.xml.plist"
-												3599-3601: "punctuation.definition.tag.xml.plist" - Data: "</"
-												3601-3607: "entity.name.tag.xml.plist"
-													3601-3607: "entity.name.tag.localname.xml.plist" - Data: "string"
-												3607-3608: "punctuation.definition.tag.xml.plist" - Data: ">"
-										3616-3640: ""
-											3616-3621: "meta.tag.key.xml.plist"
-												3616-3617: "punctuation.definition.tag.xml.plist" - Data: "<"
-												3617-3620: "entity.name.tag.xml.plist"
-													3617-3620: "entity.name.tag.localname.xml.plist" - Data: "key"
-												3620-3621: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3634-3640: "meta.tag.key.xml.plist"
-												3634-3636: "punctuation.definition.tag.xml.plist" - Data: "</"
-												3636-3639: "entity.name.tag.xml.plist"
-													3636-3639: "entity.name.tag.localname.xml.plist" - Data: "key"
-												3639-3640: "punctuation.definition.tag.xml.plist" - Data: ">"
-										3648-3937: ""
-											3648-3654: "meta.tag.dict.xml.plist"
-												3648-3649: "punctuation.definition.tag.xml.plist" - Data: "<"
-												3649-3653: "entity.name.tag.xml.plist"
-													3649-3653: "entity.name.tag.localname.xml.plist" - Data: "dict"
-												3653-3654: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3663-3675: ""
-												3663-3668: "meta.tag.key.xml.plist"
-													3663-3664: "punctuation.definition.tag.xml.plist" - Data: "<"
-													3664-3667: "entity.name.tag.xml.plist"
-														3664-3667: "entity.name.tag.localname.xml.plist" - Data: "key"
-													3667-3668: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3669-3675: "meta.tag.key.xml.plist"
-													3669-3671: "punctuation.definition.tag.xml.plist" - Data: "</"
-													3671-3674: "entity.name.tag.xml.plist"
-														3671-3674: "entity.name.tag.localname.xml.plist" - Data: "key"
-													3674-3675: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3684-3782: ""
-												3684-3690: "meta.tag.dict.xml.plist"
-													3684-3685: "punctuation.definition.tag.xml.plist" - Data: "<"
-													3685-3689: "entity.name.tag.xml.plist"
-														3685-3689: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													3689-3690: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3700-3715: ""
-													3700-3705: "meta.tag.key.xml.plist"
-														3700-3701: "punctuation.definition.tag.xml.plist" - Data: "<"
-														3701-3704: "entity.name.tag.xml.plist"
-															3701-3704: "entity.name.tag.localname.xml.plist" - Data: "key"
-														3704-3705: "punctuation.definition.tag.xml.plist" - Data: ">"
-													3709-3715: "meta.tag.key.xml.plist"
-														3709-3711: "punctuation.definition.tag.xml.plist" - Data: "</"
-														3711-3714: "entity.name.tag.xml.plist"
-															3711-3714: "entity.name.tag.localname.xml.plist" - Data: "key"
-														3714-3715: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3725-3766: ""
-													3725-3733: "meta.tag.string.xml.plist"
-														3725-3726: "punctuation.definition.tag.xml.plist" - Data: "<"
-														3726-3732: "entity.name.tag.xml.plist"
-															3726-3732: "entity.name.tag.localname.xml.plist" - Data: "string"
-														3732-3733: "punctuation.definition.tag.xml.plist" - Data: ">"
-													3757-3766: "meta.tag.string.xml.plist"
-														3757-3759: "punctuation.definition.tag.xml.plist" - Data: "</"
-														3759-3765: "entity.name.tag.xml.plist"
-															3759-3765: "entity.name.tag.localname.xml.plist" - Data: "string"
-														3765-3766: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3775-3782: "meta.tag.dict.xml.plist"
-													3775-3777: "punctuation.definition.tag.xml.plist" - Data: "</"
-													3777-3781: "entity.name.tag.xml.plist"
-														3777-3781: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													3781-3782: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3791-3803: ""
-												3791-3796: "meta.tag.key.xml.plist"
-													3791-3792: "punctuation.definition.tag.xml.plist" - Data: "<"
-													3792-3795: "entity.name.tag.xml.plist"
-														3792-3795: "entity.name.tag.localname.xml.plist" - Data: "key"
-													3795-3796: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3797-3803: "meta.tag.key.xml.plist"
-													3797-3799: "punctuation.definition.tag.xml.plist" - Data: "</"
-													3799-3802: "entity.name.tag.xml.plist"
-														3799-3802: "entity.name.tag.localname.xml.plist" - Data: "key"
-													3802-3803: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3812-3922: ""
-												3812-3818: "meta.tag.dict.xml.plist"
-													3812-3813: "punctuation.definition.tag.xml.plist" - Data: "<"
-													3813-3817: "entity.name.tag.xml.plist"
-														3813-3817: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													3817-3818: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3828-3843: ""
-													3828-3833: "meta.tag.key.xml.plist"
-														3828-3829: "punctuation.definition.tag.xml.plist" - Data: "<"
-														3829-3832: "entity.name.tag.xml.plist"
-															3829-3832: "entity.name.tag.localname.xml.plist" - Data: "key"
-														3832-3833: "punctuation.definition.tag.xml.plist" - Data: ">"
-													3837-3843: "meta.tag.key.xml.plist"
-														3837-3839: "punctuation.definition.tag.xml.plist" - Data: "</"
-														3839-3842: "entity.name.tag.xml.plist"
-															3839-3842: "entity.name.tag.localname.xml.plist" - Data: "key"
-														3842-3843: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3853-3906: ""
-													3853-3861: "meta.tag.string.xml.plist"
-														3853-3854: "punctuation.definition.tag.xml.plist" - Data: "<"
-														3854-3860: "entity.name.tag.xml.plist"
-															3854-3860: "entity.name.tag.localname.xml.plist" - Data: "string"
-														3860-3861: "punctuation.definition.tag.xml.plist" - Data: ">"
-													3897-3906: "meta.tag.string.xml.plist"
-														3897-3899: "punctuation.definition.tag.xml.plist" - Data: "</"
-														3899-3905: "entity.name.tag.xml.plist"
-															3899-3905: "entity.name.tag.localname.xml.plist" - Data: "string"
-														3905-3906: "punctuation.definition.tag.xml.plist" - Data: ">"
-												3915-3922: "meta.tag.dict.xml.plist"
-													3915-3917: "punctuation.definition.tag.xml.plist" - Data: "</"
-													3917-3921: "entity.name.tag.xml.plist"
-														3917-3921: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													3921-3922: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3930-3937: "meta.tag.dict.xml.plist"
-												3930-3932: "punctuation.definition.tag.xml.plist" - Data: "</"
-												3932-3936: "entity.name.tag.xml.plist"
-													3932-3936: "entity.name.tag.localname.xml.plist" - Data: "dict"
-												3936-3937: "punctuation.definition.tag.xml.plist" - Data: ">"
-										3945-3959: ""
-											3945-3950: "meta.tag.key.xml.plist"
-												3945-3946: "punctuation.definition.tag.xml.plist" - Data: "<"
-												3946-3949: "entity.name.tag.xml.plist"
-													3946-3949: "entity.name.tag.localname.xml.plist" - Data: "key"
-												3949-3950: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3953-3959: "meta.tag.key.xml.plist"
-												3953-3955: "punctuation.definition.tag.xml.plist" - Data: "</"
-												3955-3958: "entity.name.tag.xml.plist"
-													3955-3958: "entity.name.tag.localname.xml.plist" - Data: "key"
-												3958-3959: "punctuation.definition.tag.xml.plist" - Data: ">"
-										3967-4000: ""
-											3967-3975: "meta.tag.string.xml.plist"
-												3967-3968: "punctuation.definition.tag.xml.plist" - Data: "<"
-												3968-3974: "entity.name.tag.xml.plist"
-													3968-3974: "entity.name.tag.localname.xml.plist" - Data: "string"
-												3974-3975: "punctuation.definition.tag.xml.plist" - Data: ">"
-											3991-4000: "meta.tag.string.xml.plist"
-												3991-3993: "punctuation.definition.tag.xml.plist" - Data: "</"
-												3993-3999: "entity.name.tag.xml.plist"
-													3993-3999: "entity.name.tag.localname.xml.plist" - Data: "string"
-												3999-4000: "punctuation.definition.tag.xml.plist" - Data: ">"
-										4008-4030: ""
-											4008-4013: "meta.tag.key.xml.plist"
-												4008-4009: "punctuation.definition.tag.xml.plist" - Data: "<"
-												4009-4012: "entity.name.tag.xml.plist"
-													4009-4012: "entity.name.tag.localname.xml.plist" - Data: "key"
-												4012-4013: "punctuation.definition.tag.xml.plist" - Data: ">"
-											4024-4030: "meta.tag.key.xml.plist"
-												4024-4026: "punctuation.definition.tag.xml.plist" - Data: "</"
-												4026-4029: "entity.name.tag.xml.plist"
-													4026-4029: "entity.name.tag.localname.xml.plist" - Data: "key"
-												4029-4030: "punctuation.definition.tag.xml.plist" - Data: ">"
-										4038-4327: ""
-											4038-4044: "meta.tag.dict.xml.plist"
-												4038-4039: "punctuation.definition.tag.xml.plist" - Data: "<"
-												4039-4043: "entity.name.tag.xml.plist"
-													4039-4043: "entity.name.tag.localname.xml.plist" - Data: "dict"
-												4043-4044: "punctuation.definition.tag.xml.plist" - Data: ">"
-											4053-4065: ""
-												4053-4058: "meta.tag.key.xml.plist"
-													4053-4054: "punctuation.definition.tag.xml.plist" - Data: "<"
-													4054-4057: "entity.name.tag.xml.plist"
-														4054-4057: "entity.name.tag.localname.xml.plist" - Data: "key"
-													4057-4058: "punctuation.definition.tag.xml.plist" - Data: ">"
-												4059-4065: "meta.tag.key.xml.plist"
-													4059-4061: "punctuation.definition.tag.xml.plist" - Data: "</"
-													4061-4064: "entity.name.tag.xml.plist"
-														4061-4064: "entity.name.tag.localname.xml.plist" - Data: "key"
-													4064-4065: "punctuation.definition.tag.xml.plist" - Data: ">"
-											4074-4172: ""
-												4074-4080: "meta.tag.dict.xml.plist"
-													4074-4075: "punctuation.definition.tag.xml.plist" - Data: "<"
-													4075-4079: "entity.name.tag.xml.plist"
-														4075-4079: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													4079-4080: "punctuation.definition.tag.xml.plist" - Data: ">"
-												4090-4105: ""
-													4090-4095: "meta.tag.key.xml.plist"
-														4090-4091: "punctuation.definition.tag.xml.plist" - Data: "<"
-														4091-4094: "entity.name.tag.xml.plist"
-															4091-4094: "entity.name.tag.localname.xml.plist" - Data: "key"
-														4094-4095: "punctuation.definition.tag.xml.plist" - Data: ">"
-													4099-4105: "meta.tag.key.xml.plist"
-														4099-4101: "punctuation.definition.tag.xml.plist" - Data: "</"
-														4101-4104: "entity.name.tag.xml.plist"
-															4101-4104: "entity.name.tag.localname.xml.plist" - Data: "key"
-														4104-4105: "punctuation.definition.tag.xml.plist" - Data: ">"
-												4115-4156: ""
-													4115-4123: "meta.tag.string.xml.plist"
-														4115-4116: "punctuation.definition.tag.xml.plist" - Data: "<"
-														4116-4122: "entity.name.tag.xml.plist"
-															4116-4122: "entity.name.tag.localname.xml.plist" - Data: "string"
-														4122-4123: "punctuation.definition.tag.xml.plist" - Data: ">"
-													4147-4156: "meta.tag.string.xml.plist"
-														4147-4149: "punctuation.definition.tag.xml.plist" - Data: "</"
-														4149-4155: "entity.name.tag.xml.plist"
-															4149-4155: "entity.name.tag.localname.xml.plist" - Data: "string"
-														4155-4156: "punctuation.definition.tag.xml.plist" - Data: ">"
-												4165-4172: "meta.tag.dict.xml.plist"
-													4165-4167: "punctuation.definition.tag.xml.plist" - Data: "</"
-													4167-4171: "entity.name.tag.xml.plist"
-														4167-4171: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													4171-4172: "punctuation.definition.tag.xml.plist" - Data: ">"
-											4181-4193: ""
-												4181-4186: "meta.tag.key.xml.plist"
-													4181-4182: "punctuation.definition.tag.xml.plist" - Data: "<"
-													4182-4185: "entity.name.tag.xml.plist"
-														4182-4185: "entity.name.tag.localname.xml.plist" - Data: "key"
-													4185-4186: "punctuation.definition.tag.xml.plist" - Data: ">"
-												4187-4193: "meta.tag.key.xml.plist"
-													4187-4189: "punctuation.definition.tag.xml.plist" - Data: "</"
-													4189-4192: "entity.name.tag.xml.plist"
-														4189-4192: "entity.name.tag.localname.xml.plist" - Data: "key"
-													4192-4193: "punctuation.definition.tag.xml.plist" - Data: ">"
-											4202-4312: ""
-												4202-4208: "meta.tag.dict.xml.plist"
-													4202-4203: "punctuation.definition.tag.xml.plist" - Data: "<"
-													4203-4207: "entity.name.tag.xml.plist"
-														4203-4207: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													4207-4208: "punctuation.definition.tag.xml.plist" - Data: ">"
-												4218-4233: ""
-													4218-4223: "meta.tag.key.xml.plist"
-														4218-4219: "punctuation.definition.tag.xml.plist" - Data: "<"
-														4219-4222: "entity.name.tag.xml.plist"
-															4219-4222: "entity.name.tag.localname.xml.plist" - Data: "key"
-														4222-4223: "punctuation.definition.tag.xml.plist" - Data: ">"
-													4227-4233: "meta.tag.key.xml.plist"
-														4227-4229: "punctuation.definition.tag.xml.plist" - Data: "</"
-														4229-4232: "entity.name.tag.xml.plist"
-															4229-4232: "entity.name.tag.localname.xml.plist" - Data: "key"
-														4232-4233: "punctuation.definition.tag.xml.plist" - Data: ">"
-												4243-4296: ""
-													4243-4251: "meta.tag.string.xml.plist"
-														4243-4244: "punctuation.definition.tag.xml.plist" - Data: "<"
-														4244-4250: "entity.name.tag.xml.plist"
-															4244-4250: "entity.name.tag.localname.xml.plist" - Data: "string"
-														4250-4251: "punctuation.definition.tag.xml.plist" - Data: ">"
-													4287-4296: "meta.tag.string.xml.plist"
-														4287-4289: "punctuation.definition.tag.xml.plist" - Data: "</"
-														4289-4295: "entity.name.tag.xml.plist"
-															4289-4295: "entity.name.tag.localname.xml.plist" - Data: "string"
-														4295-4296: "punctuation.definition.tag.xml.plist" - Data: ">"
-												4305-4312: "meta.tag.dict.xml.plist"
-													4305-4307: "punctuation.definition.tag.xml.plist" - Data: "</"
-													4307-4311: "entity.name.tag.xml.plist"
-														4307-4311: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													4311-4312: "punctuation.definition.tag.xml.plist" - Data: ">"
-											4320-4327: "meta.tag.dict.xml.plist"
-												4320-4322: "punctuation.definition.tag.xml.plist" - Data: "</"
-												4322-4326: "entity.name.tag.xml.plist"
-													4322-4326: "entity.name.tag.localname.xml.plist" - Data: "dict"
-												4326-4327: "punctuation.definition.tag.xml.plist" - Data: ">"
-										4335-4354: ""
-											4335-4340: "meta.tag.key.xml.plist"
-												4335-4336: "punctuation.definition.tag.xml.plist" - Data: "<"
-												4336-4339: "entity.name.tag.xml.plist"
-													4336-4339: "entity.name.tag.localname.xml.plist" - Data: "key"
-												4339-4340: "punctuation.definition.tag.xml.plist" - Data: ">"
-											4348-4354: "meta.tag.key.xml.plist"
-												4348-4350: "punctuation.definition.tag.xml.plist" - Data: "</"
-												4350-4353: "entity.name.tag.xml.plist"
-													4350-4353: "entity.name.tag.localname.xml.plist" - Data: "key"
-												4353-4354: "punctuation.definition.tag.xml.plist" - Data: ">"
-										4362-4480: ""
-											4362-4369: "meta.tag.array.xml.plist"
-												4362-4363: "punctuation.definition.tag.xml.plist" - Data: "<"
-												4363-4368: "entity.name.tag.xml.plist"
-													4363-4368: "entity.name.tag.localname.xml.plist" - Data: "array"
-												4368-4369: "punctuation.definition.tag.xml.plist" - Data: ">"
-											4378-4464: ""
-												4378-4384: "meta.tag.dict.xml.plist"
-													4378-4379: "punctuation.definition.tag.xml.plist" - Data: "<"
-													4379-4383: "entity.name.tag.xml.plist"
-														4379-4383: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													4383-4384: "punctuation.definition.tag.xml.plist" - Data: ">"
-												4394-4412: ""
-													4394-4399: "meta.tag.key.xml.plist"
-														4394-4395: "punctuation.definition.tag.xml.plist" - Data: "<"
-														4395-4398: "entity.name.tag.xml.plist"
-															4395-4398: "entity.name.tag.localname.xml.plist" - Data: "key"
-														4398-4399: "punctuation.definition.tag.xml.plist" - Data: ">"
-													4406-4412: "meta.tag.key.xml.plist"
-														4406-4408: "punctuation.definition.tag.xml.plist" - Data: "</"
-														4408-4411: "entity.name.tag.xml.plist"
-															4408-4411: "entity.name.tag.localname.xml.plist" - Data: "key"
-														4411-4412: "punctuation.definition.tag.xml.plist" - Data: ">"
-												4422-4448: ""
-													4422-4430: "meta.tag.string.xml.plist"
-														4422-4423: "punctuation.definition.tag.xml.plist" - Data: "<"
-														4423-4429: "entity.name.tag.xml.plist"
-															4423-4429: "entity.name.tag.localname.xml.plist" - Data: "string"
-														4429-4430: "punctuation.definition.tag.xml.plist" - Data: ">"
-													4439-4448: "meta.tag.string.xml.plist"
-														4439-4441: "punctuation.definition.tag.xml.plist" - Data: "</"
-														4441-4447: "entity.name.tag.xml.plist"
-															4441-4447: "entity.name.tag.localname.xml.plist" - Data: "string"
-														4447-4448: "punctuation.definition.tag.xml.plist" - Data: ">"
-												4457-4464: "meta.tag.dict.xml.plist"
-													4457-4459: "punctuation.definition.tag.xml.plist" - Data: "</"
-													4459-4463: "entity.name.tag.xml.plist"
-														4459-4463: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													4463-4464: "punctuation.definition.tag.xml.plist" - Data: ">"
-											4472-4480: "meta.tag.array.xml.plist"
-												4472-4474: "punctuation.definition.tag.xml.plist" - Data: "</"
-												4474-4479: "entity.name.tag.xml.plist"
-													4474-4479: "entity.name.tag.localname.xml.plist" - Data: "array"
-												4479-4480: "punctuation.definition.tag.xml.plist" - Data: ">"
-										4487-4494: "meta.tag.dict.xml.plist"
-											4487-4489: "punctuation.definition.tag.xml.plist" - Data: "</"
-											4489-4493: "entity.name.tag.xml.plist"
-												4489-4493: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											4493-4494: "punctuation.definition.tag.xml.plist" - Data: ">"
-									4500-4508: "meta.tag.array.xml.plist"
-										4500-4502: "punctuation.definition.tag.xml.plist" - Data: "</"
-										4502-4507: "entity.name.tag.xml.plist"
-											4502-4507: "entity.name.tag.localname.xml.plist" - Data: "array"
-										4507-4508: "punctuation.definition.tag.xml.plist" - Data: ">"
-								4513-4520: "meta.tag.dict.xml.plist"
-									4513-4515: "punctuation.definition.tag.xml.plist" - Data: "</"
-									4515-4519: "entity.name.tag.xml.plist"
-										4515-4519: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									4519-4520: "punctuation.definition.tag.xml.plist" - Data: ">"
-							4525-4602: ""
-								4525-4531: "meta.tag.dict.xml.plist"
-									4525-4526: "punctuation.definition.tag.xml.plist" - Data: "<"
-									4526-4530: "entity.name.tag.xml.plist"
-										4526-4530: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									4530-4531: "punctuation.definition.tag.xml.plist" - Data: ">"
-								4537-4555: ""
-									4537-4542: "meta.tag.key.xml.plist"
-										4537-4538: "punctuation.definition.tag.xml.plist" - Data: "<"
-										4538-4541: "entity.name.tag.xml.plist"
-											4538-4541: "entity.name.tag.localname.xml.plist" - Data: "key"
-										4541-4542: "punctuation.definition.tag.xml.plist" - Data: ">"
-									4549-4555: "meta.tag.key.xml.plist"
-										4549-4551: "punctuation.definition.tag.xml.plist" - Data: "</"
-										4551-4554: "entity.name.tag.xml.plist"
-											4551-4554: "entity.name.tag.localname.xml.plist" - Data: "key"
-										4554-4555: "punctuation.definition.tag.xml.plist" - Data: ">"
-								4561-4590: ""
-									4561-4569: "meta.tag.string.xml.plist"
-										4561-4562: "punctuation.definition.tag.xml.plist" - Data: "<"
-										4562-4568: "entity.name.tag.xml.plist"
-											4562-4568: "entity.name.tag.localname.xml.plist" - Data: "string"
-										4568-4569: "punctuation.definition.tag.xml.plist" - Data: ">"
-									4581-4590: "meta.tag.string.xml.plist"
-										4581-4583: "punctuation.definition.tag.xml.plist" - Data: "</"
-										4583-4589: "entity.name.tag.xml.plist"
-											4583-4589: "entity.name.tag.localname.xml.plist" - Data: "string"
-										4589-4590: "punctuation.definition.tag.xml.plist" - Data: ">"
-								4595-4602: "meta.tag.dict.xml.plist"
-									4595-4597: "punctuation.definition.tag.xml.plist" - Data: "</"
-									4597-4601: "entity.name.tag.xml.plist"
-										4597-4601: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									4601-4602: "punctuation.definition.tag.xml.plist" - Data: ">"
-							4607-4684: ""
-								4607-4613: "meta.tag.dict.xml.plist"
-									4607-4608: "punctuation.definition.tag.xml.plist" - Data: "<"
-									4608-4612: "entity.name.tag.xml.plist"
-										4608-4612: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									4612-4613: "punctuation.definition.tag.xml.plist" - Data: ">"
-								4619-4637: ""
-									4619-4624: "meta.tag.key.xml.plist"
-										4619-4620: "punctuation.definition.tag.xml.plist" - Data: "<"
-										4620-4623: "entity.name.tag.xml.plist"
-											4620-4623: "entity.name.tag.localname.xml.plist" - Data: "key"
-										4623-4624: "punctuation.definition.tag.xml.plist" - Data: ">"
-									4631-4637: "meta.tag.key.xml.plist"
-										4631-4633: "punctuation.definition.tag.xml.plist" - Data: "</"
-										4633-4636: "entity.name.tag.xml.plist"
-											4633-4636: "entity.name.tag.localname.xml.plist" - Data: "key"
-										4636-4637: "punctuation.definition.tag.xml.plist" - Data: ">"
-								4643-4672: ""
-									4643-4651: "meta.tag.string.xml.plist"
-										4643-4644: "punctuation.definition.tag.xml.plist" - Data: "<"
-										4644-4650: "entity.name.tag.xml.plist"
-											4644-4650: "entity.name.tag.localname.xml.plist" - Data: "string"
-										4650-4651: "punctuation.definition.tag.xml.plist" - Data: ">"
-									4663-4672: "meta.tag.string.xml.plist"
-										4663-4665: "punctuation.definition.tag.xml.plist" - Data: "</"
-										4665-4671: "entity.name.tag.xml.plist"
-											4665-4671: "entity.name.tag.localname.xml.plist" - Data: "string"
-										4671-4672: "punctuation.definition.tag.xml.plist" - Data: ">"
-								4677-4684: "meta.tag.dict.xml.plist"
-									4677-4679: "punctuation.definition.tag.xml.plist" - Data: "</"
-									4679-4683: "entity.name.tag.xml.plist"
-										4679-4683: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									4683-4684: "punctuation.definition.tag.xml.plist" - Data: ">"
-							4689-4762: ""
-								4689-4695: "meta.tag.dict.xml.plist"
-									4689-4690: "punctuation.definition.tag.xml.plist" - Data: "<"
-									4690-4694: "entity.name.tag.xml.plist"
-										4690-4694: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									4694-4695: "punctuation.definition.tag.xml.plist" - Data: ">"
-								4701-4719: ""
-									4701-4706: "meta.tag.key.xml.plist"
-										4701-4702: "punctuation.definition.tag.xml.plist" - Data: "<"
-										4702-4705: "entity.name.tag.xml.plist"
-											4702-4705: "entity.name.tag.localname.xml.plist" - Data: "key"
-										4705-4706: "punctuation.definition.tag.xml.plist" - Data: ">"
-									4713-4719: "meta.tag.key.xml.plist"
-										4713-4715: "punctuation.definition.tag.xml.plist" - Data: "</"
-										4715-4718: "entity.name.tag.xml.plist"
-											4715-4718: "entity.name.tag.localname.xml.plist" - Data: "key"
-										4718-4719: "punctuation.definition.tag.xml.plist" - Data: ">"
-								4725-4750: ""
-									4725-4733: "meta.tag.string.xml.plist"
-										4725-4726: "punctuation.definition.tag.xml.plist" - Data: "<"
-										4726-4732: "entity.name.tag.xml.plist"
-											4726-4732: "entity.name.tag.localname.xml.plist" - Data: "string"
-										4732-4733: "punctuation.definition.tag.xml.plist" - Data: ">"
-									4741-4750: "meta.tag.string.xml.plist"
-										4741-4743: "punctuation.definition.tag.xml.plist" - Data: "</"
-										4743-4749: "entity.name.tag.xml.plist"
-											4743-4749: "entity.name.tag.localname.xml.plist" - Data: "string"
-										4749-4750: "punctuation.definition.tag.xml.plist" - Data: ">"
-								4755-4762: "meta.tag.dict.xml.plist"
-									4755-4757: "punctuation.definition.tag.xml.plist" - Data: "</"
-									4757-4761: "entity.name.tag.xml.plist"
-										4757-4761: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									4761-4762: "punctuation.definition.tag.xml.plist" - Data: ">"
-							4767-4847: ""
-								4767-4773: "meta.tag.dict.xml.plist"
-									4767-4768: "punctuation.definition.tag.xml.plist" - Data: "<"
-									4768-4772: "entity.name.tag.xml.plist"
-										4768-4772: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									4772-4773: "punctuation.definition.tag.xml.plist" - Data: ">"
-								4779-4797: ""
-									4779-4784: "meta.tag.key.xml.plist"
-										4779-4780: "punctuation.definition.tag.xml.plist" - Data: "<"
-										4780-4783: "entity.name.tag.xml.plist"
-											4780-4783: "entity.name.tag.localname.xml.plist" - Data: "key"
-										4783-4784: "punctuation.definition.tag.xml.plist" - Data: ">"
-									4791-4797: "meta.tag.key.xml.plist"
-										4791-4793: "punctuation.definition.tag.xml.plist" - Data: "</"
-										4793-4796: "entity.name.tag.xml.plist"
-											4793-4796: "entity.name.tag.localname.xml.plist" - Data: "key"
-										4796-4797: "punctuation.definition.tag.xml.plist" - Data: ">"
-								4803-4835: ""
-									4803-4811: "meta.tag.string.xml.plist"
-										4803-4804: "punctuation.definition.tag.xml.plist" - Data: "<"
-										4804-4810: "entity.name.tag.xml.plist"
-											4804-4810: "entity.name.tag.localname.xml.plist" - Data: "string"
-										4810-4811: "punctuation.definition.tag.xml.plist" - Data: ">"
-									4826-4835: "meta.tag.string.xml.plist"
-										4826-4828: "punctuation.definition.tag.xml.plist" - Data: "</"
-										4828-4834: "entity.name.tag.xml.plist"
-											4828-4834: "entity.name.tag.localname.xml.plist" - Data: "string"
-										4834-4835: "punctuation.definition.tag.xml.plist" - Data: ">"
-								4840-4847: "meta.tag.dict.xml.plist"
-									4840-4842: "punctuation.definition.tag.xml.plist" - Data: "</"
-									4842-4846: "entity.name.tag.xml.plist"
-										4842-4846: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									4846-4847: "punctuation.definition.tag.xml.plist" - Data: ">"
-							4851-4859: "meta.tag.array.xml.plist"
-								4851-4853: "punctuation.definition.tag.xml.plist" - Data: "</"
-								4853-4858: "entity.name.tag.xml.plist"
-									4853-4858: "entity.name.tag.localname.xml.plist" - Data: "array"
-								4858-4859: "punctuation.definition.tag.xml.plist" - Data: ">"
-						4862-4869: "meta.tag.dict.xml.plist"
-							4862-4864: "punctuation.definition.tag.xml.plist" - Data: "</"
-							4864-4868: "entity.name.tag.xml.plist"
-								4864-4868: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							4868-4869: "punctuation.definition.tag.xml.plist" - Data: ">"
-					4872-4894: ""
-						4872-4877: "meta.tag.key.xml.plist"
-							4872-4873: "punctuation.definition.tag.xml.plist" - Data: "<"
-							4873-4876: "entity.name.tag.xml.plist"
-								4873-4876: "entity.name.tag.localname.xml.plist" - Data: "key"
-							4876-4877: "punctuation.definition.tag.xml.plist" - Data: ">"
-						4888-4894: "meta.tag.key.xml.plist"
-							4888-4890: "punctuation.definition.tag.xml.plist" - Data: "</"
-							4890-4893: "entity.name.tag.xml.plist"
-								4890-4893: "entity.name.tag.localname.xml.plist" - Data: "key"
-							4893-4894: "punctuation.definition.tag.xml.plist" - Data: ">"
-					4897-5467: ""
-						4897-4903: "meta.tag.dict.xml.plist"
-							4897-4898: "punctuation.definition.tag.xml.plist" - Data: "<"
-							4898-4902: "entity.name.tag.xml.plist"
-								4898-4902: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							4902-4903: "punctuation.definition.tag.xml.plist" - Data: ">"
-						4907-4923: ""
-							4907-4912: "meta.tag.key.xml.plist"
-								4907-4908: "punctuation.definition.tag.xml.plist" - Data: "<"
-								4908-4911: "entity.name.tag.xml.plist"
-									4908-4911: "entity.name.tag.localname.xml.plist" - Data: "key"
-								4911-4912: "punctuation.definition.tag.xml.plist" - Data: ">"
-							4917-4923: "meta.tag.key.xml.plist"
-								4917-4919: "punctuation.definition.tag.xml.plist" - Data: "</"
-								4919-4922: "entity.name.tag.xml.plist"
-									4919-4922: "entity.name.tag.localname.xml.plist" - Data: "key"
-								4922-4923: "punctuation.definition.tag.xml.plist" - Data: ">"
-						4927-4951: ""
-							4927-4935: "meta.tag.string.xml.plist"
-								4927-4928: "punctuation.definition.tag.xml.plist" - Data: "<"
-								4928-4934: "entity.name.tag.xml.plist"
-									4928-4934: "entity.name.tag.localname.xml.plist" - Data: "string"
-								4934-4935: "punctuation.definition.tag.xml.plist" - Data: ">"
-							4942-4951: "meta.tag.string.xml.plist"
-								4942-4944: "punctuation.definition.tag.xml.plist" - Data: "</"
-								4944-4950: "entity.name.tag.xml.plist"
-									4944-4950: "entity.name.tag.localname.xml.plist" - Data: "string"
-								4950-4951: "punctuation.definition.tag.xml.plist" - Data: ">"
-						4955-4974: ""
-							4955-4960: "meta.tag.key.xml.plist"
-								4955-4956: "punctuation.definition.tag.xml.plist" - Data: "<"
-								4956-4959: "entity.name.tag.xml.plist"
-									4956-4959: "entity.name.tag.localname.xml.plist" - Data: "key"
-								4959-4960: "punctuation.definition.tag.xml.plist" - Data: ">"
-							4968-4974: "meta.tag.key.xml.plist"
-								4968-4970: "punctuation.definition.tag.xml.plist" - Data: "</"
-								4970-4973: "entity.name.tag.xml.plist"
-									4970-4973: "entity.name.tag.localname.xml.plist" - Data: "key"
-								4973-4974: "punctuation.definition.tag.xml.plist" - Data: ">"
-						4978-5119: ""
-							4978-4984: "meta.tag.dict.xml.plist"
-								4978-4979: "punctuation.definition.tag.xml.plist" - Data: "<"
-								4979-4983: "entity.name.tag.xml.plist"
-									4979-4983: "entity.name.tag.localname.xml.plist" - Data: "dict"
-								4983-4984: "punctuation.definition.tag.xml.plist" - Data: ">"
-							4989-5001: ""
-								4989-4994: "meta.tag.key.xml.plist"
-									4989-4990: "punctuation.definition.tag.xml.plist" - Data: "<"
-									4990-4993: "entity.name.tag.xml.plist"
-										4990-4993: "entity.name.tag.localname.xml.plist" - Data: "key"
-									4993-4994: "punctuation.definition.tag.xml.plist" - Data: ">"
-								4995-5001: "meta.tag.key.xml.plist"
-									4995-4997: "punctuation.definition.tag.xml.plist" - Data: "</"
-									4997-5000: "entity.name.tag.xml.plist"
-										4997-5000: "entity.name.tag.localname.xml.plist" - Data: "key"
-									5000-5001: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5006-5108: ""
-								5006-5012: "meta.tag.dict.xml.plist"
-									5006-5007: "punctuation.definition.tag.xml.plist" - Data: "<"
-									5007-5011: "entity.name.tag.xml.plist"
-										5007-5011: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									5011-5012: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5018-5033: ""
-									5018-5023: "meta.tag.key.xml.plist"
-										5018-5019: "punctuation.definition.tag.xml.plist" - Data: "<"
-										5019-5022: "entity.name.tag.xml.plist"
-											5019-5022: "entity.name.tag.localname.xml.plist" - Data: "key"
-										5022-5023: "punctuation.definition.tag.xml.plist" - Data: ">"
-									5027-5033: "meta.tag.key.xml.plist"
-										5027-5029: "punctuation.definition.tag.xml.plist" - Data: "</"
-										5029-5032: "entity.name.tag.xml.plist"
-											5029-5032: "entity.name.tag.localname.xml.plist" - Data: "key"
-										5032-5033: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5039-5096: ""
-									5039-5047: "meta.tag.string.xml.plist"
-										5039-5040: "punctuation.definition.tag.xml.plist" - Data: "<"
-										5040-5046: "entity.name.tag.xml.plist"
-											5040-5046: "entity.name.tag.localname.xml.plist" - Data: "string"
-										5046-5047: "punctuation.definition.tag.xml.plist" - Data: ">"
-									5087-5096: "meta.tag.string.xml.plist"
-										5087-5089: "punctuation.definition.tag.xml.plist" - Data: "</"
-										5089-5095: "entity.name.tag.xml.plist"
-											5089-5095: "entity.name.tag.localname.xml.plist" - Data: "string"
-										5095-5096: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5101-5108: "meta.tag.dict.xml.plist"
-									5101-5103: "punctuation.definition.tag.xml.plist" - Data: "</"
-									5103-5107: "entity.name.tag.xml.plist"
-										5103-5107: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									5107-5108: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5112-5119: "meta.tag.dict.xml.plist"
-								5112-5114: "punctuation.definition.tag.xml.plist" - Data: "</"
-								5114-5118: "entity.name.tag.xml.plist"
-									5114-5118: "entity.name.tag.localname.xml.plist" - Data: "dict"
-								5118-5119: "punctuation.definition.tag.xml.plist" - Data: ">"
-						5123-5137: ""
-							5123-5128: "meta.tag.key.xml.plist"
-								5123-5124: "punctuation.definition.tag.xml.plist" - Data: "<"
-								5124-5127: "entity.name.tag.xml.plist"
-									5124-5127: "entity.name.tag.localname.xml.plist" - Data: "key"
-								5127-5128: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5131-5137: "meta.tag.key.xml.plist"
-								5131-5133: "punctuation.definition.tag.xml.plist" - Data: "</"
-								5133-5136: "entity.name.tag.xml.plist"
-									5133-5136: "entity.name.tag.localname.xml.plist" - Data: "key"
-								5136-5137: "punctuation.definition.tag.xml.plist" - Data: ">"
-						5141-5173: ""
-							5141-5149: "meta.tag.string.xml.plist"
-								5141-5142: "punctuation.definition.tag.xml.plist" - Data: "<"
-								5142-5148: "entity.name.tag.xml.plist"
-									5142-5148: "entity.name.tag.localname.xml.plist" - Data: "string"
-								5148-5149: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5164-5173: "meta.tag.string.xml.plist"
-								5164-5166: "punctuation.definition.tag.xml.plist" - Data: "</"
-								5166-5172: "entity.name.tag.xml.plist"
-									5166-5172: "entity.name.tag.localname.xml.plist" - Data: "string"
-								5172-5173: "punctuation.definition.tag.xml.plist" - Data: ">"
-						5177-5192: ""
-							5177-5182: "meta.tag.key.xml.plist"
-								5177-5178: "punctuation.definition.tag.xml.plist" - Data: "<"
-								5178-5181: "entity.name.tag.xml.plist"
-									5178-5181: "entity.name.tag.localname.xml.plist" - Data: "key"
-								5181-5182: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5186-5192: "meta.tag.key.xml.plist"
-								5186-5188: "punctuation.definition.tag.xml.plist" - Data: "</"
-								5188-5191: "entity.name.tag.xml.plist"
-									5188-5191: "entity.name.tag.localname.xml.plist" - Data: "key"
-								5191-5192: "punctuation.definition.tag.xml.plist" - Data: ">"
-						5196-5236: ""
-							5196-5204: "meta.tag.string.xml.plist"
-								5196-5197: "punctuation.definition.tag.xml.plist" - Data: "<"
-								5197-5203: "entity.name.tag.xml.plist"
-									5197-5203: "entity.name.tag.localname.xml.plist" - Data: "string"
-								5203-5204: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5227-5236: "meta.tag.string.xml.plist"
-								5227-5229: "punctuation.definition.tag.xml.plist" - Data: "</"
-								5229-5235: "entity.name.tag.xml.plist"
-									5229-5235: "entity.name.tag.localname.xml.plist" - Data: "string"
-								5235-5236: "punctuation.definition.tag.xml.plist" - Data: ">"
-						5240-5259: ""
-							5240-5245: "meta.tag.key.xml.plist"
-								5240-5241: "punctuation.definition.tag.xml.plist" - Data: "<"
-								5241-5244: "entity.name.tag.xml.plist"
-									5241-5244: "entity.name.tag.localname.xml.plist" - Data: "key"
-								5244-5245: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5253-5259: "meta.tag.key.xml.plist"
-								5253-5255: "punctuation.definition.tag.xml.plist" - Data: "</"
-								5255-5258: "entity.name.tag.xml.plist"
-									5255-5258: "entity.name.tag.localname.xml.plist" - Data: "key"
-								5258-5259: "punctuation.definition.tag.xml.plist" - Data: ">"
-						5263-5457: ""
-							5263-5270: "meta.tag.array.xml.plist"
-								5263-5264: "punctuation.definition.tag.xml.plist" - Data: "<"
-								5264-5269: "entity.name.tag.xml.plist"
-									5264-5269: "entity.name.tag.localname.xml.plist" - Data: "array"
-								5269-5270: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5275-5445: ""
-								5275-5281: "meta.tag.dict.xml.plist"
-									5275-5276: "punctuation.definition.tag.xml.plist" - Data: "<"
-									5276-5280: "entity.name.tag.xml.plist"
-										5276-5280: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									5280-5281: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5287-5303: ""
-									5287-5292: "meta.tag.key.xml.plist"
-										5287-5288: "punctuation.definition.tag.xml.plist" - Data: "<"
-										5288-5291: "entity.name.tag.xml.plist"
-											5288-5291: "entity.name.tag.localname.xml.plist" - Data: "key"
-										5291-5292: "punctuation.definition.tag.xml.plist" - Data: ">"
-									5297-5303: "meta.tag.key.xml.plist"
-										5297-5299: "punctuation.definition.tag.xml.plist" - Data: "</"
-										5299-5302: "entity.name.tag.xml.plist"
-											5299-5302: "entity.name.tag.localname.xml.plist" - Data: "key"
-										5302-5303: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5309-5340: ""
-									5309-5317: "meta.tag.string.xml.plist"
-										5309-5310: "punctuation.definition.tag.xml.plist" - Data: "<"
-										5310-5316: "entity.name.tag.xml.plist"
-											5310-5316: "entity.name.tag.localname.xml.plist" - Data: "string"
-										5316-5317: "punctuation.definition.tag.xml.plist" - Data: ">"
-									5331-5340: "meta.tag.string.xml.plist"
-										5331-5333: "punctuation.definition.tag.xml.plist" - Data: "</"
-										5333-5339: "entity.name.tag.xml.plist"
-											5333-5339: "entity.name.tag.localname.xml.plist" - Data: "string"
-										5339-5340: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5346-5361: ""
-									5346-5351: "meta.tag.key.xml.plist"
-										5346-5347: "punctuation.definition.tag.xml.plist" - Data: "<"
-										5347-5350: "entity.name.tag.xml.plist"
-											5347-5350: "entity.name.tag.localname.xml.plist" - Data: "key"
-										5350-5351: "punctuation.definition.tag.xml.plist" - Data: ">"
-									5355-5361: "meta.tag.key.xml.plist"
-										5355-5357: "punctuation.definition.tag.xml.plist" - Data: "</"
-										5357-5360: "entity.name.tag.xml.plist"
-											5357-5360: "entity.name.tag.localname.xml.plist" - Data: "key"
-										5360-5361: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5367-5433: ""
-									5367-5375: "meta.tag.string.xml.plist"
-										5367-5368: "punctuation.definition.tag.xml.plist" - Data: "<"
-										5368-5374: "entity.name.tag.xml.plist"
-											5368-5374: "entity.name.tag.localname.xml.plist" - Data: "string"
-										5374-5375: "punctuation.definition.tag.xml.plist" - Data: ">"
-									5424-5433: "meta.tag.string.xml.plist"
-										5424-5426: "punctuation.definition.tag.xml.plist" - Data: "</"
-										5426-5432: "entity.name.tag.xml.plist"
-											5426-5432: "entity.name.tag.localname.xml.plist" - Data: "string"
-										5432-5433: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5438-5445: "meta.tag.dict.xml.plist"
-									5438-5440: "punctuation.definition.tag.xml.plist" - Data: "</"
-									5440-5444: "entity.name.tag.xml.plist"
-										5440-5444: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									5444-5445: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5449-5457: "meta.tag.array.xml.plist"
-								5449-5451: "punctuation.definition.tag.xml.plist" - Data: "</"
-								5451-5456: "entity.name.tag.xml.plist"
-									5451-5456: "entity.name.tag.localname.xml.plist" - Data: "array"
-								5456-5457: "punctuation.definition.tag.xml.plist" - Data: ">"
-						5460-5467: "meta.tag.dict.xml.plist"
-							5460-5462: "punctuation.definition.tag.xml.plist" - Data: "</"
-							5462-5466: "entity.name.tag.xml.plist"
-								5462-5466: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							5466-5467: "punctuation.definition.tag.xml.plist" - Data: ">"
-					5470-5493: ""
-						5470-5475: "meta.tag.key.xml.plist"
-							5470-5471: "punctuation.definition.tag.xml.plist" - Data: "<"
-							5471-5474: "entity.name.tag.xml.plist"
-								5471-5474: "entity.name.tag.localname.xml.plist" - Data: "key"
-							5474-5475: "punctuation.definition.tag.xml.plist" - Data: ">"
-						5487-5493: "meta.tag.key.xml.plist"
-							5487-5489: "punctuation.definition.tag.xml.plist" - Data: "</"
-							5489-5492: "entity.name.tag.xml.plist"
-								5489-5492: "entity.name.tag.localname.xml.plist" - Data: "key"
-							5492-5493: "punctuation.definition.tag.xml.plist" - Data: ">"
-					5496-5906: ""
-						5496-5502: "meta.tag.dict.xml.plist"
-							5496-5497: "punctuation.definition.tag.xml.plist" - Data: "<"
-							5497-5501: "entity.name.tag.xml.plist"
-								5497-5501: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							5501-5502: "punctuation.definition.tag.xml.plist" - Data: ">"
-						5506-5525: ""
-							5506-5511: "meta.tag.key.xml.plist"
-								5506-5507: "punctuation.definition.tag.xml.plist" - Data: "<"
-								5507-5510: "entity.name.tag.xml.plist"
-									5507-5510: "entity.name.tag.localname.xml.plist" - Data: "key"
-								5510-5511: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5519-5525: "meta.tag.key.xml.plist"
-								5519-5521: "punctuation.definition.tag.xml.plist" - Data: "</"
-								5521-5524: "entity.name.tag.xml.plist"
-									5521-5524: "entity.name.tag.localname.xml.plist" - Data: "key"
-								5524-5525: "punctuation.definition.tag.xml.plist" - Data: ">"
-						5529-5896: ""
-							5529-5536: "meta.tag.array.xml.plist"
-								5529-5530: "punctuation.definition.tag.xml.plist" - Data: "<"
-								5530-5535: "entity.name.tag.xml.plist"
-									5530-5535: "entity.name.tag.localname.xml.plist" - Data: "array"
-								5535-5536: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5541-5728: ""
-								5541-5547: "meta.tag.dict.xml.plist"
-									5541-5542: "punctuation.definition.tag.xml.plist" - Data: "<"
-									5542-5546: "entity.name.tag.xml.plist"
-										5542-5546: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									5546-5547: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5553-5569: ""
-									5553-5558: "meta.tag.key.xml.plist"
-										5553-5554: "punctuation.definition.tag.xml.plist" - Data: "<"
-										5554-5557: "entity.name.tag.xml.plist"
-											5554-5557: "entity.name.tag.localname.xml.plist" - Data: "key"
-										5557-5558: "punctuation.definition.tag.xml.plist" - Data: ">"
-									5563-5569: "meta.tag.key.xml.plist"
-										5563-5565: "punctuation.definition.tag.xml.plist" - Data: "</"
-										5565-5568: "entity.name.tag.xml.plist"
-											5565-5568: "entity.name.tag.localname.xml.plist" - Data: "key"
-										5568-5569: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5575-5637: ""
-									5575-5583: "meta.tag.string.xml.plist"
-										5575-5576: "punctuation.definition.tag.xml.plist" - Data: "<"
-										5576-5582: "entity.name.tag.xml.plist"
-											5576-5582: "entity.name.tag.localname.xml.plist" - Data: "string"
-										5582-5583: "punctuation.definition.tag.xml.plist" - Data: ">"
-									5583-5588: "invalid.illegal.bad-ampersand.xml.plist" - Data: "&amp;"
-									5628-5637: "meta.tag.string.xml.plist"
-										5628-5630: "punctuation.definition.tag.xml.plist" - Data: "</"
-										5630-5636: "entity.name.tag.xml.plist"
-											5630-5636: "entity.name.tag.localname.xml.plist" - Data: "string"
-										5636-5637: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5643-5658: ""
-									5643-5648: "meta.tag.key.xml.plist"
-										5643-5644: "punctuation.definition.tag.xml.plist" - Data: "<"
-										5644-5647: "entity.name.tag.xml.plist"
-											5644-5647: "entity.name.tag.localname.xml.plist" - Data: "key"
-										5647-5648: "punctuation.definition.tag.xml.plist" - Data: ">"
-									5652-5658: "meta.tag.key.xml.plist"
-										5652-5654: "punctuation.definition.tag.xml.plist" - Data: "</"
-										5654-5657: "entity.name.tag.xml.plist"
-											5654-5657: "entity.name.tag.localname.xml.plist" - Data: "key"
-										5657-5658: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5664-5716: ""
-									5664-5672: "meta.tag.string.xml.plist"
-										5664-5665: "punctuation.definition.tag.xml.plist" - Data: "<"
-										5665-5671: "entity.name.tag.xml.plist"
-											5665-5671: "entity.name.tag.localname.xml.plist" - Data: "string"
-										5671-5672: "punctuation.definition.tag.xml.plist" - Data: ">"
-									5707-5716: "meta.tag.string.xml.plist"
-										5707-5709: "punctuation.definition.tag.xml.plist" - Data: "</"
-										5709-5715: "entity.name.tag.xml.plist"
-											5709-5715: "entity.name.tag.localname.xml.plist" - Data: "string"
-										5715-5716: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5721-5728: "meta.tag.dict.xml.plist"
-									5721-5723: "punctuation.definition.tag.xml.plist" - Data: "</"
-									5723-5727: "entity.name.tag.xml.plist"
-										5723-5727: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									5727-5728: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5733-5884: ""
-								5733-5739: "meta.tag.dict.xml.plist"
-									5733-5734: "punctuation.definition.tag.xml.plist" - Data: "<"
-									5734-5738: "entity.name.tag.xml.plist"
-										5734-5738: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									5738-5739: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5745-5761: ""
-									5745-5750: "meta.tag.key.xml.plist"
-										5745-5746: "punctuation.definition.tag.xml.plist" - Data: "<"
-										5746-5749: "entity.name.tag.xml.plist"
-											5746-5749: "entity.name.tag.localname.xml.plist" - Data: "key"
-										5749-5750: "punctuation.definition.tag.xml.plist" - Data: ">"
-									5755-5761: "meta.tag.key.xml.plist"
-										5755-5757: "punctuation.definition.tag.xml.plist" - Data: "</"
-										5757-5760: "entity.name.tag.xml.plist"
-											5757-5760: "entity.name.tag.localname.xml.plist" - Data: "key"
-										5760-5761: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5767-5789: ""
-									5767-5775: "meta.tag.string.xml.plist"
-										5767-5768: "punctuation.definition.tag.xml.plist" - Data: "<"
-										5768-5774: "entity.name.tag.xml.plist"
-											5768-5774: "entity.name.tag.localname.xml.plist" - Data: "string"
-										5774-5775: "punctuation.definition.tag.xml.plist" - Data: ">"
-									5775-5780: "invalid.illegal.bad-ampersand.xml.plist" - Data: "&amp;"
-									5780-5789: "meta.tag.string.xml.plist"
-										5780-5782: "punctuation.definition.tag.xml.plist" - Data: "</"
-										5782-5788: "entity.name.tag.xml.plist"
-											5782-5788: "entity.name.tag.localname.xml.plist" - Data: "string"
-										5788-5789: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5795-5810: ""
-									5795-5800: "meta.tag.key.xml.plist"
-										5795-5796: "punctuation.definition.tag.xml.plist" - Data: "<"
-										5796-5799: "entity.name.tag.xml.plist"
-											5796-5799: "entity.name.tag.localname.xml.plist" - Data: "key"
-										5799-5800: "punctuation.definition.tag.xml.plist" - Data: ">"
-									5804-5810: "meta.tag.key.xml.plist"
-										5804-5806: "punctuation.definition.tag.xml.plist" - Data: "</"
-										5806-5809: "entity.name.tag.xml.plist"
-											5806-5809: "entity.name.tag.localname.xml.plist" - Data: "key"
-										5809-5810: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5816-5872: ""
-									5816-5824: "meta.tag.string.xml.plist"
-										5816-5817: "punctuation.definition.tag.xml.plist" - Data: "<"
-										5817-5823: "entity.name.tag.xml.plist"
-											5817-5823: "entity.name.tag.localname.xml.plist" - Data: "string"
-										5823-5824: "punctuation.definition.tag.xml.plist" - Data: ">"
-									5863-5872: "meta.tag.string.xml.plist"
-										5863-5865: "punctuation.definition.tag.xml.plist" - Data: "</"
-										5865-5871: "entity.name.tag.xml.plist"
-											5865-5871: "entity.name.tag.localname.xml.plist" - Data: "string"
-										5871-5872: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5877-5884: "meta.tag.dict.xml.plist"
-									5877-5879: "punctuation.definition.tag.xml.plist" - Data: "</"
-									5879-5883: "entity.name.tag.xml.plist"
-										5879-5883: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									5883-5884: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5888-5896: "meta.tag.array.xml.plist"
-								5888-5890: "punctuation.definition.tag.xml.plist" - Data: "</"
-								5890-5895: "entity.name.tag.xml.plist"
-									5890-5895: "entity.name.tag.localname.xml.plist" - Data: "array"
-								5895-5896: "punctuation.definition.tag.xml.plist" - Data: ">"
-						5899-5906: "meta.tag.dict.xml.plist"
-							5899-5901: "punctuation.definition.tag.xml.plist" - Data: "</"
-							5901-5905: "entity.name.tag.xml.plist"
-								5901-5905: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							5905-5906: "punctuation.definition.tag.xml.plist" - Data: ">"
-					5909-5931: ""
-						5909-5914: "meta.tag.key.xml.plist"
-							5909-5910: "punctuation.definition.tag.xml.plist" - Data: "<"
-							5910-5913: "entity.name.tag.xml.plist"
-								5910-5913: "entity.name.tag.localname.xml.plist" - Data: "key"
-							5913-5914: "punctuation.definition.tag.xml.plist" - Data: ">"
-						5925-5931: "meta.tag.key.xml.plist"
-							5925-5927: "punctuation.definition.tag.xml.plist" - Data: "</"
-							5927-5930: "entity.name.tag.xml.plist"
-								5927-5930: "entity.name.tag.localname.xml.plist" - Data: "key"
-							5930-5931: "punctuation.definition.tag.xml.plist" - Data: ">"
-					5934-6591: ""
-						5934-5940: "meta.tag.dict.xml.plist"
-							5934-5935: "punctuation.definition.tag.xml.plist" - Data: "<"
-							5935-5939: "entity.name.tag.xml.plist"
-								5935-5939: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							5939-5940: "punctuation.definition.tag.xml.plist" - Data: ">"
-						5944-5963: ""
-							5944-5949: "meta.tag.key.xml.plist"
-								5944-5945: "punctuation.definition.tag.xml.plist" - Data: "<"
-								5945-5948: "entity.name.tag.xml.plist"
-									5945-5948: "entity.name.tag.localname.xml.plist" - Data: "key"
-								5948-5949: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5957-5963: "meta.tag.key.xml.plist"
-								5957-5959: "punctuation.definition.tag.xml.plist" - Data: "</"
-								5959-5962: "entity.name.tag.xml.plist"
-									5959-5962: "entity.name.tag.localname.xml.plist" - Data: "key"
-								5962-5963: "punctuation.definition.tag.xml.plist" - Data: ">"
-						5967-6462: ""
-							5967-5973: "meta.tag.dict.xml.plist"
-								5967-5968: "punctuation.definition.tag.xml.plist" - Data: "<"
-								5968-5972: "entity.name.tag.xml.plist"
-									5968-5972: "entity.name.tag.localname.xml.plist" - Data: "dict"
-								5972-5973: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5978-5990: ""
-								5978-5983: "meta.tag.key.xml.plist"
-									5978-5979: "punctuation.definition.tag.xml.plist" - Data: "<"
-									5979-5982: "entity.name.tag.xml.plist"
-										5979-5982: "entity.name.tag.localname.xml.plist" - Data: "key"
-									5982-5983: "punctuation.definition.tag.xml.plist" - Data: ">"
-								5984-5990: "meta.tag.key.xml.plist"
-									5984-5986: "punctuation.definition.tag.xml.plist" - Data: "</"
-									5986-5989: "entity.name.tag.xml.plist"
-										5986-5989: "entity.name.tag.localname.xml.plist" - Data: "key"
-									5989-5990: "punctuation.definition.tag.xml.plist" - Data: ">"
-							5995-6083: ""
-								5995-6001: "meta.tag.dict.xml.plist"
-									5995-5996: "punctuation.definition.tag.xml.plist" - Data: "<"
-									5996-6000: "entity.name.tag.xml.plist"
-										5996-6000: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									6000-6001: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6007-6022: ""
-									6007-6012: "meta.tag.key.xml.plist"
-										6007-6008: "punctuation.definition.tag.xml.plist" - Data: "<"
-										6008-6011: "entity.name.tag.xml.plist"
-											6008-6011: "entity.name.tag.localname.xml.plist" - Data: "key"
-										6011-6012: "punctuation.definition.tag.xml.plist" - Data: ">"
-									6016-6022: "meta.tag.key.xml.plist"
-										6016-6018: "punctuation.definition.tag.xml.plist" - Data: "</"
-										6018-6021: "entity.name.tag.xml.plist"
-											6018-6021: "entity.name.tag.localname.xml.plist" - Data: "key"
-										6021-6022: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6028-6071: ""
-									6028-6036: "meta.tag.string.xml.plist"
-										6028-6029: "punctuation.definition.tag.xml.plist" - Data: "<"
-										6029-6035: "entity.name.tag.xml.plist"
-											6029-6035: "entity.name.tag.localname.xml.plist" - Data: "string"
-										6035-6036: "punctuation.definition.tag.xml.plist" - Data: ">"
-									6062-6071: "meta.tag.string.xml.plist"
-										6062-6064: "punctuation.definition.tag.xml.plist" - Data: "</"
-										6064-6070: "entity.name.tag.xml.plist"
-											6064-6070: "entity.name.tag.localname.xml.plist" - Data: "string"
-										6070-6071: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6076-6083: "meta.tag.dict.xml.plist"
-									6076-6078: "punctuation.definition.tag.xml.plist" - Data: "</"
-									6078-6082: "entity.name.tag.xml.plist"
-										6078-6082: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									6082-6083: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6088-6100: ""
-								6088-6093: "meta.tag.key.xml.plist"
-									6088-6089: "punctuation.definition.tag.xml.plist" - Data: "<"
-									6089-6092: "entity.name.tag.xml.plist"
-										6089-6092: "entity.name.tag.localname.xml.plist" - Data: "key"
-									6092-6093: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6094-6100: "meta.tag.key.xml.plist"
-									6094-6096: "punctuation.definition.tag.xml.plist" - Data: "</"
-									6096-6099: "entity.name.tag.xml.plist"
-										6096-6099: "entity.name.tag.localname.xml.plist" - Data: "key"
-									6099-6100: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6105-6203: ""
-								6105-6111: "meta.tag.dict.xml.plist"
-									6105-6106: "punctuation.definition.tag.xml.plist" - Data: "<"
-									6106-6110: "entity.name.tag.xml.plist"
-										6106-6110: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									6110-6111: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6117-6132: ""
-									6117-6122: "meta.tag.key.xml.plist"
-										6117-6118: "punctuation.definition.tag.xml.plist" - Data: "<"
-										6118-6121: "entity.name.tag.xml.plist"
-											6118-6121: "entity.name.tag.localname.xml.plist" - Data: "key"
-										6121-6122: "punctuation.definition.tag.xml.plist" - Data: ">"
-									6126-6132: "meta.tag.key.xml.plist"
-										6126-6128: "punctuation.definition.tag.xml.plist" - Data: "</"
-										6128-6131: "entity.name.tag.xml.plist"
-											6128-6131: "entity.name.tag.localname.xml.plist" - Data: "key"
-										6131-6132: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6138-6191: ""
-									6138-6146: "meta.tag.string.xml.plist"
-										6138-6139: "punctuation.definition.tag.xml.plist" - Data: "<"
-										6139-6145: "entity.name.tag.xml.plist"
-											6139-6145: "entity.name.tag.localname.xml.plist" - Data: "string"
-										6145-6146: "punctuation.definition.tag.xml.plist" - Data: ">"
-									6182-6191: "meta.tag.string.xml.plist"
-										6182-6184: "punctuation.definition.tag.xml.plist" - Data: "</"
-										6184-6190: "entity.name.tag.xml.plist"
-											6184-6190: "entity.name.tag.localname.xml.plist" - Data: "string"
-										6190-6191: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6196-6203: "meta.tag.dict.xml.plist"
-									6196-6198: "punctuation.definition.tag.xml.plist" - Data: "</"
-									6198-6202: "entity.name.tag.xml.plist"
-										6198-6202: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									6202-6203: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6208-6220: ""
-								6208-6213: "meta.tag.key.xml.plist"
-									6208-6209: "punctuation.definition.tag.xml.plist" - Data: "<"
-									6209-6212: "entity.name.tag.xml.plist"
-										6209-6212: "entity.name.tag.localname.xml.plist" - Data: "key"
-									6212-6213: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6214-6220: "meta.tag.key.xml.plist"
-									6214-6216: "punctuation.definition.tag.xml.plist" - Data: "</"
-									6216-6219: "entity.name.tag.xml.plist"
-										6216-6219: "entity.name.tag.localname.xml.plist" - Data: "key"
-									6219-6220: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6225-6331: ""
-								6225-6231: "meta.tag.dict.xml.plist"
-									6225-6226: "punctuation.definition.tag.xml.plist" - Data: "<"
-									6226-6230: "entity.name.tag.xml.plist"
-										6226-6230: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									6230-6231: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6237-6252: ""
-									6237-6242: "meta.tag.key.xml.plist"
-										6237-6238: "punctuation.definition.tag.xml.plist" - Data: "<"
-										6238-6241: "entity.name.tag.xml.plist"
-											6238-6241: "entity.name.tag.localname.xml.plist" - Data: "key"
-										6241-6242: "punctuation.definition.tag.xml.plist" - Data: ">"
-									6246-6252: "meta.tag.key.xml.plist"
-										6246-6248: "punctuation.definition.tag.xml.plist" - Data: "</"
-										6248-6251: "entity.name.tag.xml.plist"
-											6248-6251: "entity.name.tag.localname.xml.plist" - Data: "key"
-										6251-6252: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6258-6319: ""
-									6258-6266: "meta.tag.string.xml.plist"
-										6258-6259: "punctuation.definition.tag.xml.plist" - Data: "<"
-										6259-6265: "entity.name.tag.xml.plist"
-											6259-6265: "entity.name.tag.localname.xml.plist" - Data: "string"
-										6265-6266: "punctuation.definition.tag.xml.plist" - Data: ">"
-									6310-6319: "meta.tag.string.xml.plist"
-										6310-6312: "punctuation.definition.tag.xml.plist" - Data: "</"
-										6312-6318: "entity.name.tag.xml.plist"
-											6312-6318: "entity.name.tag.localname.xml.plist" - Data: "string"
-										6318-6319: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6324-6331: "meta.tag.dict.xml.plist"
-									6324-6326: "punctuation.definition.tag.xml.plist" - Data: "</"
-									6326-6330: "entity.name.tag.xml.plist"
-										6326-6330: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									6330-6331: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6336-6348: ""
-								6336-6341: "meta.tag.key.xml.plist"
-									6336-6337: "punctuation.definition.tag.xml.plist" - Data: "<"
-									6337-6340: "entity.name.tag.xml.plist"
-										6337-6340: "entity.name.tag.localname.xml.plist" - Data: "key"
-									6340-6341: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6342-6348: "meta.tag.key.xml.plist"
-									6342-6344: "punctuation.definition.tag.xml.plist" - Data: "</"
-									6344-6347: "entity.name.tag.xml.plist"
-										6344-6347: "entity.name.tag.localname.xml.plist" - Data: "key"
-									6347-6348: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6353-6451: ""
-								6353-6359: "meta.tag.dict.xml.plist"
-									6353-6354: "punctuation.definition.tag.xml.plist" - Data: "<"
-									6354-6358: "entity.name.tag.xml.plist"
-										6354-6358: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									6358-6359: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6365-6380: ""
-									6365-6370: "meta.tag.key.xml.plist"
-										6365-6366: "punctuation.definition.tag.xml.plist" - Data: "<"
-										6366-6369: "entity.name.tag.xml.plist"
-											6366-6369: "entity.name.tag.localname.xml.plist" - Data: "key"
-										6369-6370: "punctuation.definition.tag.xml.plist" - Data: ">"
-									6374-6380: "meta.tag.key.xml.plist"
-										6374-6376: "punctuation.definition.tag.xml.plist" - Data: "</"
-										6376-6379: "entity.name.tag.xml.plist"
-											6376-6379: "entity.name.tag.localname.xml.plist" - Data: "key"
-										6379-6380: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6386-6439: ""
-									6386-6394: "meta.tag.string.xml.plist"
-										6386-6387: "punctuation.definition.tag.xml.plist" - Data: "<"
-										6387-6393: "entity.name.tag.xml.plist"
-											6387-6393: "entity.name.tag.localname.xml.plist" - Data: "string"
-										6393-6394: "punctuation.definition.tag.xml.plist" - Data: ">"
-									6430-6439: "meta.tag.string.xml.plist"
-										6430-6432: "punctuation.definition.tag.xml.plist" - Data: "</"
-										6432-6438: "entity.name.tag.xml.plist"
-											6432-6438: "entity.name.tag.localname.xml.plist" - Data: "string"
-										6438-6439: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6444-6451: "meta.tag.dict.xml.plist"
-									6444-6446: "punctuation.definition.tag.xml.plist" - Data: "</"
-									6446-6450: "entity.name.tag.xml.plist"
-										6446-6450: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									6450-6451: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6455-6462: "meta.tag.dict.xml.plist"
-								6455-6457: "punctuation.definition.tag.xml.plist" - Data: "</"
-								6457-6461: "entity.name.tag.xml.plist"
-									6457-6461: "entity.name.tag.localname.xml.plist" - Data: "dict"
-								6461-6462: "punctuation.definition.tag.xml.plist" - Data: ">"
-						6466-6484: ""
-							6466-6471: "meta.tag.key.xml.plist"
-								6466-6467: "punctuation.definition.tag.xml.plist" - Data: "<"
-								6467-6470: "entity.name.tag.xml.plist"
-									6467-6470: "entity.name.tag.localname.xml.plist" - Data: "key"
-								6470-6471: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6478-6484: "meta.tag.key.xml.plist"
-								6478-6480: "punctuation.definition.tag.xml.plist" - Data: "</"
-								6480-6483: "entity.name.tag.xml.plist"
-									6480-6483: "entity.name.tag.localname.xml.plist" - Data: "key"
-								6483-6484: "punctuation.definition.tag.xml.plist" - Data: ">"
-						6488-6516: ""
-							6488-6496: "meta.tag.string.xml.plist"
-								6488-6489: "punctuation.definition.tag.xml.plist" - Data: "<"
-								6489-6495: "entity.name.tag.xml.plist"
-									6489-6495: "entity.name.tag.localname.xml.plist" - Data: "string"
-								6495-6496: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6507-6516: "meta.tag.string.xml.plist"
-								6507-6509: "punctuation.definition.tag.xml.plist" - Data: "</"
-								6509-6515: "entity.name.tag.xml.plist"
-									6509-6515: "entity.name.tag.localname.xml.plist" - Data: "string"
-								6515-6516: "punctuation.definition.tag.xml.plist" - Data: ">"
-						6520-6536: ""
-							6520-6525: "meta.tag.key.xml.plist"
-								6520-6521: "punctuation.definition.tag.xml.plist" - Data: "<"
-								6521-6524: "entity.name.tag.xml.plist"
-									6521-6524: "entity.name.tag.localname.xml.plist" - Data: "key"
-								6524-6525: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6530-6536: "meta.tag.key.xml.plist"
-								6530-6532: "punctuation.definition.tag.xml.plist" - Data: "</"
-								6532-6535: "entity.name.tag.xml.plist"
-									6532-6535: "entity.name.tag.localname.xml.plist" - Data: "key"
-								6535-6536: "punctuation.definition.tag.xml.plist" - Data: ">"
-						6540-6581: ""
-							6540-6548: "meta.tag.string.xml.plist"
-								6540-6541: "punctuation.definition.tag.xml.plist" - Data: "<"
-								6541-6547: "entity.name.tag.xml.plist"
-									6541-6547: "entity.name.tag.localname.xml.plist" - Data: "string"
-								6547-6548: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6572-6581: "meta.tag.string.xml.plist"
-								6572-6574: "punctuation.definition.tag.xml.plist" - Data: "</"
-								6574-6580: "entity.name.tag.xml.plist"
-									6574-6580: "entity.name.tag.localname.xml.plist" - Data: "string"
-								6580-6581: "punctuation.definition.tag.xml.plist" - Data: ">"
-						6584-6591: "meta.tag.dict.xml.plist"
-							6584-6586: "punctuation.definition.tag.xml.plist" - Data: "</"
-							6586-6590: "entity.name.tag.xml.plist"
-								6586-6590: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							6590-6591: "punctuation.definition.tag.xml.plist" - Data: ">"
-					6594-6619: ""
-						6594-6599: "meta.tag.key.xml.plist"
-							6594-6595: "punctuation.definition.tag.xml.plist" - Data: "<"
-							6595-6598: "entity.name.tag.xml.plist"
-								6595-6598: "entity.name.tag.localname.xml.plist" - Data: "key"
-							6598-6599: "punctuation.definition.tag.xml.plist" - Data: ">"
-						6613-6619: "meta.tag.key.xml.plist"
-							6613-6615: "punctuation.definition.tag.xml.plist" - Data: "</"
-							6615-6618: "entity.name.tag.xml.plist"
-								6615-6618: "entity.name.tag.localname.xml.plist" - Data: "key"
-							6618-6619: "punctuation.definition.tag.xml.plist" - Data: ">"
-					6622-6778: ""
-						6622-6628: "meta.tag.dict.xml.plist"
-							6622-6623: "punctuation.definition.tag.xml.plist" - Data: "<"
-							6623-6627: "entity.name.tag.xml.plist"
-								6623-6627: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							6627-6628: "punctuation.definition.tag.xml.plist" - Data: ">"
-						6632-6648: ""
-							6632-6637: "meta.tag.key.xml.plist"
-								6632-6633: "punctuation.definition.tag.xml.plist" - Data: "<"
-								6633-6636: "entity.name.tag.xml.plist"
-									6633-6636: "entity.name.tag.localname.xml.plist" - Data: "key"
-								6636-6637: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6642-6648: "meta.tag.key.xml.plist"
-								6642-6644: "punctuation.definition.tag.xml.plist" - Data: "</"
-								6644-6647: "entity.name.tag.xml.plist"
-									6644-6647: "entity.name.tag.localname.xml.plist" - Data: "key"
-								6647-6648: "punctuation.definition.tag.xml.plist" - Data: ">"
-						6652-6671: ""
-							6652-6660: "meta.tag.string.xml.plist"
-								6652-6653: "punctuation.definition.tag.xml.plist" - Data: "<"
-								6653-6659: "entity.name.tag.xml.plist"
-									6653-6659: "entity.name.tag.localname.xml.plist" - Data: "string"
-								6659-6660: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6662-6671: "meta.tag.string.xml.plist"
-								6662-6664: "punctuation.definition.tag.xml.plist" - Data: "</"
-								6664-6670: "entity.name.tag.xml.plist"
-									6664-6670: "entity.name.tag.localname.xml.plist" - Data: "string"
-								6670-6671: "punctuation.definition.tag.xml.plist" - Data: ">"
-						6675-6690: ""
-							6675-6680: "meta.tag.key.xml.plist"
-								6675-6676: "punctuation.definition.tag.xml.plist" - Data: "<"
-								6676-6679: "entity.name.tag.xml.plist"
-									6676-6679: "entity.name.tag.localname.xml.plist" - Data: "key"
-								6679-6680: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6684-6690: "meta.tag.key.xml.plist"
-								6684-6686: "punctuation.definition.tag.xml.plist" - Data: "</"
-								6686-6689: "entity.name.tag.xml.plist"
-									6686-6689: "entity.name.tag.localname.xml.plist" - Data: "key"
-								6689-6690: "punctuation.definition.tag.xml.plist" - Data: ">"
-						6694-6768: ""
-							6694-6702: "meta.tag.string.xml.plist"
-								6694-6695: "punctuation.definition.tag.xml.plist" - Data: "<"
-								6695-6701: "entity.name.tag.xml.plist"
-									6695-6701: "entity.name.tag.localname.xml.plist" - Data: "string"
-								6701-6702: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6759-6768: "meta.tag.string.xml.plist"
-								6759-6761: "punctuation.definition.tag.xml.plist" - Data: "</"
-								6761-6767: "entity.name.tag.xml.plist"
-									6761-6767: "entity.name.tag.localname.xml.plist" - Data: "string"
-								6767-6768: "punctuation.definition.tag.xml.plist" - Data: ">"
-						6771-6778: "meta.tag.dict.xml.plist"
-							6771-6773: "punctuation.definition.tag.xml.plist" - Data: "</"
-							6773-6777: "entity.name.tag.xml.plist"
-								6773-6777: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							6777-6778: "punctuation.definition.tag.xml.plist" - Data: ">"
-					6781-6800: ""
-						6781-6786: "meta.tag.key.xml.plist"
-							6781-6782: "punctuation.definition.tag.xml.plist" - Data: "<"
-							6782-6785: "entity.name.tag.xml.plist"
-								6782-6785: "entity.name.tag.localname.xml.plist" - Data: "key"
-							6785-6786: "punctuation.definition.tag.xml.plist" - Data: ">"
-						6794-6800: "meta.tag.key.xml.plist"
-							6794-6796: "punctuation.definition.tag.xml.plist" - Data: "</"
-							6796-6799: "entity.name.tag.xml.plist"
-								6796-6799: "entity.name.tag.localname.xml.plist" - Data: "key"
-							6799-6800: "punctuation.definition.tag.xml.plist" - Data: ">"
-					6803-27564: ""
-						6803-6809: "meta.tag.dict.xml.plist"
-							6803-6804: "punctuation.definition.tag.xml.plist" - Data: "<"
-							6804-6808: "entity.name.tag.xml.plist"
-								6804-6808: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							6808-6809: "punctuation.definition.tag.xml.plist" - Data: ">"
-						6813-6832: ""
-							6813-6818: "meta.tag.key.xml.plist"
-								6813-6814: "punctuation.definition.tag.xml.plist" - Data: "<"
-								6814-6817: "entity.name.tag.xml.plist"
-									6814-6817: "entity.name.tag.localname.xml.plist" - Data: "key"
-								6817-6818: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6826-6832: "meta.tag.key.xml.plist"
-								6826-6828: "punctuation.definition.tag.xml.plist" - Data: "</"
-								6828-6831: "entity.name.tag.xml.plist"
-									6828-6831: "entity.name.tag.localname.xml.plist" - Data: "key"
-								6831-6832: "punctuation.definition.tag.xml.plist" - Data: ">"
-						6836-27554: ""
-							6836-6843: "meta.tag.array.xml.plist"
-								6836-6837: "punctuation.definition.tag.xml.plist" - Data: "<"
-								6837-6842: "entity.name.tag.xml.plist"
-									6837-6842: "entity.name.tag.localname.xml.plist" - Data: "array"
-								6842-6843: "punctuation.definition.tag.xml.plist" - Data: ">"
-							6848-8459: ""
-								6848-6854: "meta.tag.dict.xml.plist"
-									6848-6849: "punctuation.definition.tag.xml.plist" - Data: "<"
-									6849-6853: "entity.name.tag.xml.plist"
-										6849-6853: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									6853-6854: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6860-6879: ""
-									6860-6865: "meta.tag.key.xml.plist"
-										6860-6861: "punctuation.definition.tag.xml.plist" - Data: "<"
-										6861-6864: "entity.name.tag.xml.plist"
-											6861-6864: "entity.name.tag.localname.xml.plist" - Data: "key"
-										6864-6865: "punctuation.definition.tag.xml.plist" - Data: ">"
-									6873-6879: "meta.tag.key.xml.plist"
-										6873-6875: "punctuation.definition.tag.xml.plist" - Data: "</"
-										6875-6878: "entity.name.tag.xml.plist"
-											6875-6878: "entity.name.tag.localname.xml.plist" - Data: "key"
-										6878-6879: "punctuation.definition.tag.xml.plist" - Data: ">"
-								6885-8287: ""
-									6885-6891: "meta.tag.dict.xml.plist"
-										6885-6886: "punctuation.definition.tag.xml.plist" - Data: "<"
-										6886-6890: "entity.name.tag.xml.plist"
-											6886-6890: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										6890-6891: "punctuation.definition.tag.xml.plist" - Data: ">"
-									6898-6910: ""
-										6898-6903: "meta.tag.key.xml.plist"
-											6898-6899: "punctuation.definition.tag.xml.plist" - Data: "<"
-											6899-6902: "entity.name.tag.xml.plist"
-												6899-6902: "entity.name.tag.localname.xml.plist" - Data: "key"
-											6902-6903: "punctuation.definition.tag.xml.plist" - Data: ">"
-										6904-6910: "meta.tag.key.xml.plist"
-											6904-6906: "punctuation.definition.tag.xml.plist" - Data: "</"
-											6906-6909: "entity.name.tag.xml.plist"
-												6906-6909: "entity.name.tag.localname.xml.plist" - Data: "key"
-											6909-6910: "punctuation.definition.tag.xml.plist" - Data: ">"
-									6917-7008: ""
-										6917-6923: "meta.tag.dict.xml.plist"
-											6917-6918: "punctuation.definition.tag.xml.plist" - Data: "<"
-											6918-6922: "entity.name.tag.xml.plist"
-												6918-6922: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											6922-6923: "punctuation.definition.tag.xml.plist" - Data: ">"
-										6931-6946: ""
-											6931-6936: "meta.tag.key.xml.plist"
-												6931-6932: "punctuation.definition.tag.xml.plist" - Data: "<"
-												6932-6935: "entity.name.tag.xml.plist"
-													6932-6935: "entity.name.tag.localname.xml.plist" - Data: "key"
-												6935-6936: "punctuation.definition.tag.xml.plist" - Data: ">"
-											6940-6946: "meta.tag.key.xml.plist"
-												6940-6942: "punctuation.definition.tag.xml.plist" - Data: "</"
-												6942-6945: "entity.name.tag.xml.plist"
-													6942-6945: "entity.name.tag.localname.xml.plist" - Data: "key"
-												6945-6946: "punctuation.definition.tag.xml.plist" - Data: ">"
-										6954-6994: ""
-											6954-6962: "meta.tag.string.xml.plist"
-												6954-6955: "punctuation.definition.tag.xml.plist" - Data: "<"
-												6955-6961: "entity.name.tag.xml.plist"
-													6955-6961: "entity.name.tag.localname.xml.plist" - Data: "string"
-												6961-6962: "punctuation.definition.tag.xml.plist" - Data: ">"
-											6985-6994: "meta.tag.string.xml.plist"
-												6985-6987: "punctuation.definition.tag.xml.plist" - Data: "</"
-												6987-6993: "entity.name.tag.xml.plist"
-													6987-6993: "entity.name.tag.localname.xml.plist" - Data: "string"
-												6993-6994: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7001-7008: "meta.tag.dict.xml.plist"
-											7001-7003: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7003-7007: "entity.name.tag.xml.plist"
-												7003-7007: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7007-7008: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7015-7028: ""
-										7015-7020: "meta.tag.key.xml.plist"
-											7015-7016: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7016-7019: "entity.name.tag.xml.plist"
-												7016-7019: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7019-7020: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7022-7028: "meta.tag.key.xml.plist"
-											7022-7024: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7024-7027: "entity.name.tag.xml.plist"
-												7024-7027: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7027-7028: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7035-7138: ""
-										7035-7041: "meta.tag.dict.xml.plist"
-											7035-7036: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7036-7040: "entity.name.tag.xml.plist"
-												7036-7040: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7040-7041: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7049-7064: ""
-											7049-7054: "meta.tag.key.xml.plist"
-												7049-7050: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7050-7053: "entity.name.tag.xml.plist"
-													7050-7053: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7053-7054: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7058-7064: "meta.tag.key.xml.plist"
-												7058-7060: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7060-7063: "entity.name.tag.xml.plist"
-													7060-7063: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7063-7064: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7072-7124: ""
-											7072-7080: "meta.tag.string.xml.plist"
-												7072-7073: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7073-7079: "entity.name.tag.xml.plist"
-													7073-7079: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7079-7080: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7115-7124: "meta.tag.string.xml.plist"
-												7115-7117: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7117-7123: "entity.name.tag.xml.plist"
-													7117-7123: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7123-7124: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7131-7138: "meta.tag.dict.xml.plist"
-											7131-7133: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7133-7137: "entity.name.tag.xml.plist"
-												7133-7137: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7137-7138: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7145-7158: ""
-										7145-7150: "meta.tag.key.xml.plist"
-											7145-7146: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7146-7149: "entity.name.tag.xml.plist"
-												7146-7149: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7149-7150: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7152-7158: "meta.tag.key.xml.plist"
-											7152-7154: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7154-7157: "entity.name.tag.xml.plist"
-												7154-7157: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7157-7158: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7165-7269: ""
-										7165-7171: "meta.tag.dict.xml.plist"
-											7165-7166: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7166-7170: "entity.name.tag.xml.plist"
-												7166-7170: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7170-7171: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7179-7194: ""
-											7179-7184: "meta.tag.key.xml.plist"
-												7179-7180: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7180-7183: "entity.name.tag.xml.plist"
-													7180-7183: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7183-7184: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7188-7194: "meta.tag.key.xml.plist"
-												7188-7190: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7190-7193: "entity.name.tag.xml.plist"
-													7190-7193: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7193-7194: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7202-7255: ""
-											7202-7210: "meta.tag.string.xml.plist"
-												7202-7203: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7203-7209: "entity.name.tag.xml.plist"
-													7203-7209: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7209-7210: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7246-7255: "meta.tag.string.xml.plist"
-												7246-7248: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7248-7254: "entity.name.tag.xml.plist"
-													7248-7254: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7254-7255: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7262-7269: "meta.tag.dict.xml.plist"
-											7262-7264: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7264-7268: "entity.name.tag.xml.plist"
-												7264-7268: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7268-7269: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7276-7288: ""
-										7276-7281: "meta.tag.key.xml.plist"
-											7276-7277: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7277-7280: "entity.name.tag.xml.plist"
-												7277-7280: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7280-7281: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7282-7288: "meta.tag.key.xml.plist"
-											7282-7284: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7284-7287: "entity.name.tag.xml.plist"
-												7284-7287: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7287-7288: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7295-7399: ""
-										7295-7301: "meta.tag.dict.xml.plist"
-											7295-7296: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7296-7300: "entity.name.tag.xml.plist"
-												7296-7300: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7300-7301: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7309-7324: ""
-											7309-7314: "meta.tag.key.xml.plist"
-												7309-7310: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7310-7313: "entity.name.tag.xml.plist"
-													7310-7313: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7313-7314: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7318-7324: "meta.tag.key.xml.plist"
-												7318-7320: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7320-7323: "entity.name.tag.xml.plist"
-													7320-7323: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7323-7324: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7332-7385: ""
-											7332-7340: "meta.tag.string.xml.plist"
-												7332-7333: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7333-7339: "entity.name.tag.xml.plist"
-													7333-7339: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7339-7340: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7376-7385: "meta.tag.string.xml.plist"
-												7376-7378: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7378-7384: "entity.name.tag.xml.plist"
-													7378-7384: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7384-7385: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7392-7399: "meta.tag.dict.xml.plist"
-											7392-7394: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7394-7398: "entity.name.tag.xml.plist"
-												7394-7398: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7398-7399: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7406-7418: ""
-										7406-7411: "meta.tag.key.xml.plist"
-											7406-7407: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7407-7410: "entity.name.tag.xml.plist"
-												7407-7410: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7410-7411: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7412-7418: "meta.tag.key.xml.plist"
-											7412-7414: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7414-7417: "entity.name.tag.xml.plist"
-												7414-7417: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7417-7418: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7425-7518: ""
-										7425-7431: "meta.tag.dict.xml.plist"
-											7425-7426: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7426-7430: "entity.name.tag.xml.plist"
-												7426-7430: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7430-7431: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7439-7454: ""
-											7439-7444: "meta.tag.key.xml.plist"
-												7439-7440: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7440-7443: "entity.name.tag.xml.plist"
-													7440-7443: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7443-7444: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7448-7454: "meta.tag.key.xml.plist"
-												7448-7450: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7450-7453: "entity.name.tag.xml.plist"
-													7450-7453: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7453-7454: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7462-7504: ""
-											7462-7470: "meta.tag.string.xml.plist"
-												7462-7463: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7463-7469: "entity.name.tag.xml.plist"
-													7463-7469: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7469-7470: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7495-7504: "meta.tag.string.xml.plist"
-												7495-7497: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7497-7503: "entity.name.tag.xml.plist"
-													7497-7503: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7503-7504: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7511-7518: "meta.tag.dict.xml.plist"
-											7511-7513: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7513-7517: "entity.name.tag.xml.plist"
-												7513-7517: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7517-7518: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7525-7537: ""
-										7525-7530: "meta.tag.key.xml.plist"
-											7525-7526: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7526-7529: "entity.name.tag.xml.plist"
-												7526-7529: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7529-7530: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7531-7537: "meta.tag.key.xml.plist"
-											7531-7533: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7533-7536: "entity.name.tag.xml.plist"
-												7533-7536: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7536-7537: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7544-7647: ""
-										7544-7550: "meta.tag.dict.xml.plist"
-											7544-7545: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7545-7549: "entity.name.tag.xml.plist"
-												7545-7549: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7549-7550: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7558-7573: ""
-											7558-7563: "meta.tag.key.xml.plist"
-												7558-7559: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7559-7562: "entity.name.tag.xml.plist"
-													7559-7562: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7562-7563: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7567-7573: "meta.tag.key.xml.plist"
-												7567-7569: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7569-7572: "entity.name.tag.xml.plist"
-													7569-7572: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7572-7573: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7581-7633: ""
-											7581-7589: "meta.tag.string.xml.plist"
-												7581-7582: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7582-7588: "entity.name.tag.xml.plist"
-													7582-7588: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7588-7589: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7624-7633: "meta.tag.string.xml.plist"
-												7624-7626: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7626-7632: "entity.name.tag.xml.plist"
-													7626-7632: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7632-7633: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7640-7647: "meta.tag.dict.xml.plist"
-											7640-7642: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7642-7646: "entity.name.tag.xml.plist"
-												7642-7646: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7646-7647: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7654-7666: ""
-										7654-7659: "meta.tag.key.xml.plist"
-											7654-7655: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7655-7658: "entity.name.tag.xml.plist"
-												7655-7658: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7658-7659: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7660-7666: "meta.tag.key.xml.plist"
-											7660-7662: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7662-7665: "entity.name.tag.xml.plist"
-												7662-7665: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7665-7666: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7673-7777: ""
-										7673-7679: "meta.tag.dict.xml.plist"
-											7673-7674: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7674-7678: "entity.name.tag.xml.plist"
-												7674-7678: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7678-7679: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7687-7702: ""
-											7687-7692: "meta.tag.key.xml.plist"
-												7687-7688: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7688-7691: "entity.name.tag.xml.plist"
-													7688-7691: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7691-7692: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7696-7702: "meta.tag.key.xml.plist"
-												7696-7698: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7698-7701: "entity.name.tag.xml.plist"
-													7698-7701: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7701-7702: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7710-7763: ""
-											7710-7718: "meta.tag.string.xml.plist"
-												7710-7711: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7711-7717: "entity.name.tag.xml.plist"
-													7711-7717: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7717-7718: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7754-7763: "meta.tag.string.xml.plist"
-												7754-7756: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7756-7762: "entity.name.tag.xml.plist"
-													7756-7762: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7762-7763: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7770-7777: "meta.tag.dict.xml.plist"
-											7770-7772: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7772-7776: "entity.name.tag.xml.plist"
-												7772-7776: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7776-7777: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7784-7796: ""
-										7784-7789: "meta.tag.key.xml.plist"
-											7784-7785: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7785-7788: "entity.name.tag.xml.plist"
-												7785-7788: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7788-7789: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7790-7796: "meta.tag.key.xml.plist"
-											7790-7792: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7792-7795: "entity.name.tag.xml.plist"
-												7792-7795: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7795-7796: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7803-7894: ""
-										7803-7809: "meta.tag.dict.xml.plist"
-											7803-7804: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7804-7808: "entity.name.tag.xml.plist"
-												7804-7808: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7808-7809: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7817-7832: ""
-											7817-7822: "meta.tag.key.xml.plist"
-												7817-7818: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7818-7821: "entity.name.tag.xml.plist"
-													7818-7821: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7821-7822: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7826-7832: "meta.tag.key.xml.plist"
-												7826-7828: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7828-7831: "entity.name.tag.xml.plist"
-													7828-7831: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7831-7832: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7840-7880: ""
-											7840-7848: "meta.tag.string.xml.plist"
-												7840-7841: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7841-7847: "entity.name.tag.xml.plist"
-													7841-7847: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7847-7848: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7871-7880: "meta.tag.string.xml.plist"
-												7871-7873: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7873-7879: "entity.name.tag.xml.plist"
-													7873-7879: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7879-7880: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7887-7894: "meta.tag.dict.xml.plist"
-											7887-7889: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7889-7893: "entity.name.tag.xml.plist"
-												7889-7893: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7893-7894: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7901-7913: ""
-										7901-7906: "meta.tag.key.xml.plist"
-											7901-7902: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7902-7905: "entity.name.tag.xml.plist"
-												7902-7905: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7905-7906: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7907-7913: "meta.tag.key.xml.plist"
-											7907-7909: "punctuation.definition.tag.xml.plist" - Data: "</"
-											7909-7912: "entity.name.tag.xml.plist"
-												7909-7912: "entity.name.tag.localname.xml.plist" - Data: "key"
-											7912-7913: "punctuation.definition.tag.xml.plist" - Data: ">"
-									7920-8024: ""
-										7920-7926: "meta.tag.dict.xml.plist"
-											7920-7921: "punctuation.definition.tag.xml.plist" - Data: "<"
-											7921-7925: "entity.name.tag.xml.plist"
-												7921-7925: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											7925-7926: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7934-7949: ""
-											7934-7939: "meta.tag.key.xml.plist"
-												7934-7935: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7935-7938: "entity.name.tag.xml.plist"
-													7935-7938: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7938-7939: "punctuation.definition.tag.xml.plist" - Data: ">"
-											7943-7949: "meta.tag.key.xml.plist"
-												7943-7945: "punctuation.definition.tag.xml.plist" - Data: "</"
-												7945-7948: "entity.name.tag.xml.plist"
-													7945-7948: "entity.name.tag.localname.xml.plist" - Data: "key"
-												7948-7949: "punctuation.definition.tag.xml.plist" - Data: ">"
-										7957-8010: ""
-											7957-7965: "meta.tag.string.xml.plist"
-												7957-7958: "punctuation.definition.tag.xml.plist" - Data: "<"
-												7958-7964: "entity.name.tag.xml.plist"
-													7958-7964: "entity.name.tag.localname.xml.plist" - Data: "string"
-												7964-7965: "punctuation.definition.tag.xml.plist" - Data: ">"
-											8001-8010: "meta.tag.string.xml.plist"
-												8001-8003: "punctuation.definition.tag.xml.plist" - Data: "</"
-												8003-8009: "entity.name.tag.xml.plist"
-													8003-8009: "entity.name.tag.localname.xml.plist" - Data: "string"
-												8009-8010: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8017-8024: "meta.tag.dict.xml.plist"
-											8017-8019: "punctuation.definition.tag.xml.plist" - Data: "</"
-											8019-8023: "entity.name.tag.xml.plist"
-												8019-8023: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											8023-8024: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8031-8043: ""
-										8031-8036: "meta.tag.key.xml.plist"
-											8031-8032: "punctuation.definition.tag.xml.plist" - Data: "<"
-											8032-8035: "entity.name.tag.xml.plist"
-												8032-8035: "entity.name.tag.localname.xml.plist" - Data: "key"
-											8035-8036: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8037-8043: "meta.tag.key.xml.plist"
-											8037-8039: "punctuation.definition.tag.xml.plist" - Data: "</"
-											8039-8042: "entity.name.tag.xml.plist"
-												8039-8042: "entity.name.tag.localname.xml.plist" - Data: "key"
-											8042-8043: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8050-8155: ""
-										8050-8056: "meta.tag.dict.xml.plist"
-											8050-8051: "punctuation.definition.tag.xml.plist" - Data: "<"
-											8051-8055: "entity.name.tag.xml.plist"
-												8051-8055: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											8055-8056: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8064-8079: ""
-											8064-8069: "meta.tag.key.xml.plist"
-												8064-8065: "punctuation.definition.tag.xml.plist" - Data: "<"
-												8065-8068: "entity.name.tag.xml.plist"
-													8065-8068: "entity.name.tag.localname.xml.plist" - Data: "key"
-												8068-8069: "punctuation.definition.tag.xml.plist" - Data: ">"
-											8073-8079: "meta.tag.key.xml.plist"
-												8073-8075: "punctuation.definition.tag.xml.plist" - Data: "</"
-												8075-8078: "entity.name.tag.xml.plist"
-													8075-8078: "entity.name.tag.localname.xml.plist" - Data: "key"
-												8078-8079: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8087-8141: ""
-											8087-8095: "meta.tag.string.xml.plist"
-												8087-8088: "punctuation.definition.tag.xml.plist" - Data: "<"
-												8088-8094: "entity.name.tag.xml.plist"
-													8088-8094: "entity.name.tag.localname.xml.plist" - Data: "string"
-												8094-8095: "punctuation.definition.tag.xml.plist" - Data: ">"
-											8132-8141: "meta.tag.string.xml.plist"
-												8132-8134: "punctuation.definition.tag.xml.plist" - Data: "</"
-												8134-8140: "entity.name.tag.xml.plist"
-													8134-8140: "entity.name.tag.localname.xml.plist" - Data: "string"
-												8140-8141: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8148-8155: "meta.tag.dict.xml.plist"
-											8148-8150: "punctuation.definition.tag.xml.plist" - Data: "</"
-											8150-8154: "entity.name.tag.xml.plist"
-												8150-8154: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											8154-8155: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8162-8174: ""
-										8162-8167: "meta.tag.key.xml.plist"
-											8162-8163: "punctuation.definition.tag.xml.plist" - Data: "<"
-											8163-8166: "entity.name.tag.xml.plist"
-												8163-8166: "entity.name.tag.localname.xml.plist" - Data: "key"
-											8166-8167: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8168-8174: "meta.tag.key.xml.plist"
-											8168-8170: "punctuation.definition.tag.xml.plist" - Data: "</"
-											8170-8173: "entity.name.tag.xml.plist"
-												8170-8173: "entity.name.tag.localname.xml.plist" - Data: "key"
-											8173-8174: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8181-8274: ""
-										8181-8187: "meta.tag.dict.xml.plist"
-											8181-8182: "punctuation.definition.tag.xml.plist" - Data: "<"
-											8182-8186: "entity.name.tag.xml.plist"
-												8182-8186: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											8186-8187: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8195-8210: ""
-											8195-8200: "meta.tag.key.xml.plist"
-												8195-8196: "punctuation.definition.tag.xml.plist" - Data: "<"
-												8196-8199: "entity.name.tag.xml.plist"
-													8196-8199: "entity.name.tag.localname.xml.plist" - Data: "key"
-												8199-8200: "punctuation.definition.tag.xml.plist" - Data: ">"
-											8204-8210: "meta.tag.key.xml.plist"
-												8204-8206: "punctuation.definition.tag.xml.plist" - Data: "</"
-												8206-8209: "entity.name.tag.xml.plist"
-													8206-8209: "entity.name.tag.localname.xml.plist" - Data: "key"
-												8209-8210: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8218-8260: ""
-											8218-8226: "meta.tag.string.xml.plist"
-												8218-8219: "punctuation.definition.tag.xml.plist" - Data: "<"
-												8219-8225: "entity.name.tag.xml.plist"
-													8219-8225: "entity.name.tag.localname.xml.plist" - Data: "string"
-												8225-8226: "punctuation.definition.tag.xml.plist" - Data: ">"
-											8251-8260: "meta.tag.string.xml.plist"
-												8251-8253: "punctuation.definition.tag.xml.plist" - Data: "</"
-												8253-8259: "entity.name.tag.xml.plist"
-													8253-8259: "entity.name.tag.localname.xml.plist" - Data: "string"
-												8259-8260: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8267-8274: "meta.tag.dict.xml.plist"
-											8267-8269: "punctuation.definition.tag.xml.plist" - Data: "</"
-											8269-8273: "entity.name.tag.xml.plist"
-												8269-8273: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											8273-8274: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8280-8287: "meta.tag.dict.xml.plist"
-										8280-8282: "punctuation.definition.tag.xml.plist" - Data: "</"
-										8282-8286: "entity.name.tag.xml.plist"
-											8282-8286: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										8286-8287: "punctuation.definition.tag.xml.plist" - Data: ">"
-								8293-8311: ""
-									8293-8298: "meta.tag.key.xml.plist"
-										8293-8294: "punctuation.definition.tag.xml.plist" - Data: "<"
-										8294-8297: "entity.name.tag.xml.plist"
-											8294-8297: "entity.name.tag.localname.xml.plist" - Data: "key"
-										8297-8298: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8305-8311: "meta.tag.key.xml.plist"
-										8305-8307: "punctuation.definition.tag.xml.plist" - Data: "</"
-										8307-8310: "entity.name.tag.xml.plist"
-											8307-8310: "entity.name.tag.localname.xml.plist" - Data: "key"
-										8310-8311: "punctuation.definition.tag.xml.plist" - Data: ">"
-								8317-8355: ""
-									8317-8325: "meta.tag.string.xml.plist"
-										8317-8318: "punctuation.definition.tag.xml.plist" - Data: "<"
-										8318-8324: "entity.name.tag.xml.plist"
-											8318-8324: "entity.name.tag.localname.xml.plist" - Data: "string"
-										8324-8325: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8346-8355: "meta.tag.string.xml.plist"
-										8346-8348: "punctuation.definition.tag.xml.plist" - Data: "</"
-										8348-8354: "entity.name.tag.xml.plist"
-											8348-8354: "entity.name.tag.localname.xml.plist" - Data: "string"
-										8354-8355: "punctuation.definition.tag.xml.plist" - Data: ">"
-								8361-8377: ""
-									8361-8366: "meta.tag.key.xml.plist"
-										8361-8362: "punctuation.definition.tag.xml.plist" - Data: "<"
-										8362-8365: "entity.name.tag.xml.plist"
-											8362-8365: "entity.name.tag.localname.xml.plist" - Data: "key"
-										8365-8366: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8371-8377: "meta.tag.key.xml.plist"
-										8371-8373: "punctuation.definition.tag.xml.plist" - Data: "</"
-										8373-8376: "entity.name.tag.xml.plist"
-											8373-8376: "entity.name.tag.localname.xml.plist" - Data: "key"
-										8376-8377: "punctuation.definition.tag.xml.plist" - Data: ">"
-								8383-8447: ""
-									8383-8391: "meta.tag.string.xml.plist"
-										8383-8384: "punctuation.definition.tag.xml.plist" - Data: "<"
-										8384-8390: "entity.name.tag.xml.plist"
-											8384-8390: "entity.name.tag.localname.xml.plist" - Data: "string"
-										8390-8391: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8438-8447: "meta.tag.string.xml.plist"
-										8438-8440: "punctuation.definition.tag.xml.plist" - Data: "</"
-										8440-8446: "entity.name.tag.xml.plist"
-											8440-8446: "entity.name.tag.localname.xml.plist" - Data: "string"
-										8446-8447: "punctuation.definition.tag.xml.plist" - Data: ">"
-								8452-8459: "meta.tag.dict.xml.plist"
-									8452-8454: "punctuation.definition.tag.xml.plist" - Data: "</"
-									8454-8458: "entity.name.tag.xml.plist"
-										8454-8458: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									8458-8459: "punctuation.definition.tag.xml.plist" - Data: ">"
-							8464-10074: ""
-								8464-8470: "meta.tag.dict.xml.plist"
-									8464-8465: "punctuation.definition.tag.xml.plist" - Data: "<"
-									8465-8469: "entity.name.tag.xml.plist"
-										8465-8469: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									8469-8470: "punctuation.definition.tag.xml.plist" - Data: ">"
-								8476-8495: ""
-									8476-8481: "meta.tag.key.xml.plist"
-										8476-8477: "punctuation.definition.tag.xml.plist" - Data: "<"
-										8477-8480: "entity.name.tag.xml.plist"
-											8477-8480: "entity.name.tag.localname.xml.plist" - Data: "key"
-										8480-8481: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8489-8495: "meta.tag.key.xml.plist"
-										8489-8491: "punctuation.definition.tag.xml.plist" - Data: "</"
-										8491-8494: "entity.name.tag.xml.plist"
-											8491-8494: "entity.name.tag.localname.xml.plist" - Data: "key"
-										8494-8495: "punctuation.definition.tag.xml.plist" - Data: ">"
-								8501-9905: ""
-									8501-8507: "meta.tag.dict.xml.plist"
-										8501-8502: "punctuation.definition.tag.xml.plist" - Data: "<"
-										8502-8506: "entity.name.tag.xml.plist"
-											8502-8506: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										8506-8507: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8514-8526: ""
-										8514-8519: "meta.tag.key.xml.plist"
-											8514-8515: "punctuation.definition.tag.xml.plist" - Data: "<"
-											8515-8518: "entity.name.tag.xml.plist"
-												8515-8518: "entity.name.tag.localname.xml.plist" - Data: "key"
-											8518-8519: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8520-8526: "meta.tag.key.xml.plist"
-											8520-8522: "punctuation.definition.tag.xml.plist" - Data: "</"
-											8522-8525: "entity.name.tag.xml.plist"
-												8522-8525: "entity.name.tag.localname.xml.plist" - Data: "key"
-											8525-8526: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8533-8625: ""
-										8533-8539: "meta.tag.dict.xml.plist"
-											8533-8534: "punctuation.definition.tag.xml.plist" - Data: "<"
-											8534-8538: "entity.name.tag.xml.plist"
-												8534-8538: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											8538-8539: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8547-8562: ""
-											8547-8552: "meta.tag.key.xml.plist"
-												8547-8548: "punctuation.definition.tag.xml.plist" - Data: "<"
-												8548-8551: "entity.name.tag.xml.plist"
-													8548-8551: "entity.name.tag.localname.xml.plist" - Data: "key"
-												8551-8552: "punctuation.definition.tag.xml.plist" - Data: ">"
-											8556-8562: "meta.tag.key.xml.plist"
-												8556-8558: "punctuation.definition.tag.xml.plist" - Data: "</"
-												8558-8561: "entity.name.tag.xml.plist"
-													8558-8561: "entity.name.tag.localname.xml.plist" - Data: "key"
-												8561-8562: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8570-8611: ""
-											8570-8578: "meta.tag.string.xml.plist"
-												8570-8571: "punctuation.definition.tag.xml.plist" - Data: "<"
-												8571-8577: "entity.name.tag.xml.plist"
-													8571-8577: "entity.name.tag.localname.xml.plist" - Data: "string"
-												8577-8578: "punctuation.definition.tag.xml.plist" - Data: ">"
-											8602-8611: "meta.tag.string.xml.plist"
-												8602-8604: "punctuation.definition.tag.xml.plist" - Data: "</"
-												8604-8610: "entity.name.tag.xml.plist"
-													8604-8610: "entity.name.tag.localname.xml.plist" - Data: "string"
-												8610-8611: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8618-8625: "meta.tag.dict.xml.plist"
-											8618-8620: "punctuation.definition.tag.xml.plist" - Data: "</"
-											8620-8624: "entity.name.tag.xml.plist"
-												8620-8624: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											8624-8625: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8632-8645: ""
-										8632-8637: "meta.tag.key.xml.plist"
-											8632-8633: "punctuation.definition.tag.xml.plist" - Data: "<"
-											8633-8636: "entity.name.tag.xml.plist"
-												8633-8636: "entity.name.tag.localname.xml.plist" - Data: "key"
-											8636-8637: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8639-8645: "meta.tag.key.xml.plist"
-											8639-8641: "punctuation.definition.tag.xml.plist" - Data: "</"
-											8641-8644: "entity.name.tag.xml.plist"
-												8641-8644: "entity.name.tag.localname.xml.plist" - Data: "key"
-											8644-8645: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8652-8755: ""
-										8652-8658: "meta.tag.dict.xml.plist"
-											8652-8653: "punctuation.definition.tag.xml.plist" - Data: "<"
-											8653-8657: "entity.name.tag.xml.plist"
-												8653-8657: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											8657-8658: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8666-8681: ""
-											8666-8671: "meta.tag.key.xml.plist"
-												8666-8667: "punctuation.definition.tag.xml.plist" - Data: "<"
-												8667-8670: "entity.name.tag.xml.plist"
-													8667-8670: "entity.name.tag.localname.xml.plist" - Data: "key"
-												8670-8671: "punctuation.definition.tag.xml.plist" - Data: ">"
-											8675-8681: "meta.tag.key.xml.plist"
-												8675-8677: "punctuation.definition.tag.xml.plist" - Data: "</"
-												8677-8680: "entity.name.tag.xml.plist"
-													8677-8680: "entity.name.tag.localname.xml.plist" - Data: "key"
-												8680-8681: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8689-8741: ""
-											8689-8697: "meta.tag.string.xml.plist"
-												8689-8690: "punctuation.definition.tag.xml.plist" - Data: "<"
-												8690-8696: "entity.name.tag.xml.plist"
-													8690-8696: "entity.name.tag.localname.xml.plist" - Data: "string"
-												8696-8697: "punctuation.definition.tag.xml.plist" - Data: ">"
-											8732-8741: "meta.tag.string.xml.plist"
-												8732-8734: "punctuation.definition.tag.xml.plist" - Data: "</"
-												8734-8740: "entity.name.tag.xml.plist"
-													8734-8740: "entity.name.tag.localname.xml.plist" - Data: "string"
-												8740-8741: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8748-8755: "meta.tag.dict.xml.plist"
-											8748-8750: "punctuation.definition.tag.xml.plist" - Data: "</"
-											8750-8754: "entity.name.tag.xml.plist"
-												8750-8754: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											8754-8755: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8762-8775: ""
-										8762-8767: "meta.tag.key.xml.plist"
-											8762-8763: "punctuation.definition.tag.xml.plist" - Data: "<"
-											8763-8766: "entity.name.tag.xml.plist"
-												8763-8766: "entity.name.tag.localname.xml.plist" - Data: "key"
-											8766-8767: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8769-8775: "meta.tag.key.xml.plist"
-											8769-8771: "punctuation.definition.tag.xml.plist" - Data: "</"
-											8771-8774: "entity.name.tag.xml.plist"
-												8771-8774: "entity.name.tag.localname.xml.plist" - Data: "key"
-											8774-8775: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8782-8886: ""
-										8782-8788: "meta.tag.dict.xml.plist"
-											8782-8783: "punctuation.definition.tag.xml.plist" - Data: "<"
-											8783-8787: "entity.name.tag.xml.plist"
-												8783-8787: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											8787-8788: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8796-8811: ""
-											8796-8801: "meta.tag.key.xml.plist"
-												8796-8797: "punctuation.definition.tag.xml.plist" - Data: "<"
-												8797-8800: "entity.name.tag.xml.plist"
-													8797-8800: "entity.name.tag.localname.xml.plist" - Data: "key"
-												8800-8801: "punctuation.definition.tag.xml.plist" - Data: ">"
-											8805-8811: "meta.tag.key.xml.plist"
-												8805-8807: "punctuation.definition.tag.xml.plist" - Data: "</"
-												8807-8810: "entity.name.tag.xml.plist"
-													8807-8810: "entity.name.tag.localname.xml.plist" - Data: "key"
-												8810-8811: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8819-8872: ""
-											8819-8827: "meta.tag.string.xml.plist"
-												8819-8820: "punctuation.definition.tag.xml.plist" - Data: "<"
-												8820-8826: "entity.name.tag.xml.plist"
-													8820-8826: "entity.name.tag.localname.xml.plist" - Data: "string"
-												8826-8827: "punctuation.definition.tag.xml.plist" - Data: ">"
-											8863-8872: "meta.tag.string.xml.plist"
-												8863-8865: "punctuation.definition.tag.xml.plist" - Data: "</"
-												8865-8871: "entity.name.tag.xml.plist"
-													8865-8871: "entity.name.tag.localname.xml.plist" - Data: "string"
-												8871-8872: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8879-8886: "meta.tag.dict.xml.plist"
-											8879-8881: "punctuation.definition.tag.xml.plist" - Data: "</"
-											8881-8885: "entity.name.tag.xml.plist"
-												8881-8885: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											8885-8886: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8893-8905: ""
-										8893-8898: "meta.tag.key.xml.plist"
-											8893-8894: "punctuation.definition.tag.xml.plist" - Data: "<"
-											8894-8897: "entity.name.tag.xml.plist"
-												8894-8897: "entity.name.tag.localname.xml.plist" - Data: "key"
-											8897-8898: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8899-8905: "meta.tag.key.xml.plist"
-											8899-8901: "punctuation.definition.tag.xml.plist" - Data: "</"
-											8901-8904: "entity.name.tag.xml.plist"
-												8901-8904: "entity.name.tag.localname.xml.plist" - Data: "key"
-											8904-8905: "punctuation.definition.tag.xml.plist" - Data: ">"
-									8912-9016: ""
-										8912-8918: "meta.tag.dict.xml.plist"
-											8912-8913: "punctuation.definition.tag.xml.plist" - Data: "<"
-											8913-8917: "entity.name.tag.xml.plist"
-												8913-8917: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											8917-8918: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8926-8941: ""
-											8926-8931: "meta.tag.key.xml.plist"
-												8926-8927: "punctuation.definition.tag.xml.plist" - Data: "<"
-												8927-8930: "entity.name.tag.xml.plist"
-													8927-8930: "entity.name.tag.localname.xml.plist" - Data: "key"
-												8930-8931: "punctuation.definition.tag.xml.plist" - Data: ">"
-											8935-8941: "meta.tag.key.xml.plist"
-												8935-8937: "punctuation.definition.tag.xml.plist" - Data: "</"
-												8937-8940: "entity.name.tag.xml.plist"
-													8937-8940: "entity.name.tag.localname.xml.plist" - Data: "key"
-												8940-8941: "punctuation.definition.tag.xml.plist" - Data: ">"
-										8949-9002: ""
-											8949-8957: "meta.tag.string.xml.plist"
-												8949-8950: "punctuation.definition.tag.xml.plist" - Data: "<"
-												8950-8956: "entity.name.tag.xml.plist"
-													8950-8956: "entity.name.tag.localname.xml.plist" - Data: "string"
-												8956-8957: "punctuation.definition.tag.xml.plist" - Data: ">"
-											8993-9002: "meta.tag.string.xml.plist"
-												8993-8995: "punctuation.definition.tag.xml.plist" - Data: "</"
-												8995-9001: "entity.name.tag.xml.plist"
-													8995-9001: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9001-9002: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9009-9016: "meta.tag.dict.xml.plist"
-											9009-9011: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9011-9015: "entity.name.tag.xml.plist"
-												9011-9015: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9015-9016: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9023-9035: ""
-										9023-9028: "meta.tag.key.xml.plist"
-											9023-9024: "punctuation.definition.tag.xml.plist" - Data: "<"
-											9024-9027: "entity.name.tag.xml.plist"
-												9024-9027: "entity.name.tag.localname.xml.plist" - Data: "key"
-											9027-9028: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9029-9035: "meta.tag.key.xml.plist"
-											9029-9031: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9031-9034: "entity.name.tag.xml.plist"
-												9031-9034: "entity.name.tag.localname.xml.plist" - Data: "key"
-											9034-9035: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9042-9135: ""
-										9042-9048: "meta.tag.dict.xml.plist"
-											9042-9043: "punctuation.definition.tag.xml.plist" - Data: "<"
-											9043-9047: "entity.name.tag.xml.plist"
-												9043-9047: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9047-9048: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9056-9071: ""
-											9056-9061: "meta.tag.key.xml.plist"
-												9056-9057: "punctuation.definition.tag.xml.plist" - Data: "<"
-												9057-9060: "entity.name.tag.xml.plist"
-													9057-9060: "entity.name.tag.localname.xml.plist" - Data: "key"
-												9060-9061: "punctuation.definition.tag.xml.plist" - Data: ">"
-											9065-9071: "meta.tag.key.xml.plist"
-												9065-9067: "punctuation.definition.tag.xml.plist" - Data: "</"
-												9067-9070: "entity.name.tag.xml.plist"
-													9067-9070: "entity.name.tag.localname.xml.plist" - Data: "key"
-												9070-9071: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9079-9121: ""
-											9079-9087: "meta.tag.string.xml.plist"
-												9079-9080: "punctuation.definition.tag.xml.plist" - Data: "<"
-												9080-9086: "entity.name.tag.xml.plist"
-													9080-9086: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9086-9087: "punctuation.definition.tag.xml.plist" - Data: ">"
-											9112-9121: "meta.tag.string.xml.plist"
-												9112-9114: "punctuation.definition.tag.xml.plist" - Data: "</"
-												9114-9120: "entity.name.tag.xml.plist"
-													9114-9120: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9120-9121: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9128-9135: "meta.tag.dict.xml.plist"
-											9128-9130: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9130-9134: "entity.name.tag.xml.plist"
-												9130-9134: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9134-9135: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9142-9154: ""
-										9142-9147: "meta.tag.key.xml.plist"
-											9142-9143: "punctuation.definition.tag.xml.plist" - Data: "<"
-											9143-9146: "entity.name.tag.xml.plist"
-												9143-9146: "entity.name.tag.localname.xml.plist" - Data: "key"
-											9146-9147: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9148-9154: "meta.tag.key.xml.plist"
-											9148-9150: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9150-9153: "entity.name.tag.xml.plist"
-												9150-9153: "entity.name.tag.localname.xml.plist" - Data: "key"
-											9153-9154: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9161-9264: ""
-										9161-9167: "meta.tag.dict.xml.plist"
-											9161-9162: "punctuation.definition.tag.xml.plist" - Data: "<"
-											9162-9166: "entity.name.tag.xml.plist"
-												9162-9166: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9166-9167: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9175-9190: ""
-											9175-9180: "meta.tag.key.xml.plist"
-												9175-9176: "punctuation.definition.tag.xml.plist" - Data: "<"
-												9176-9179: "entity.name.tag.xml.plist"
-													9176-9179: "entity.name.tag.localname.xml.plist" - Data: "key"
-												9179-9180: "punctuation.definition.tag.xml.plist" - Data: ">"
-											9184-9190: "meta.tag.key.xml.plist"
-												9184-9186: "punctuation.definition.tag.xml.plist" - Data: "</"
-												9186-9189: "entity.name.tag.xml.plist"
-													9186-9189: "entity.name.tag.localname.xml.plist" - Data: "key"
-												9189-9190: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9198-9250: ""
-											9198-9206: "meta.tag.string.xml.plist"
-												9198-9199: "punctuation.definition.tag.xml.plist" - Data: "<"
-												9199-9205: "entity.name.tag.xml.plist"
-													9199-9205: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9205-9206: "punctuation.definition.tag.xml.plist" - Data: ">"
-											9241-9250: "meta.tag.string.xml.plist"
-												9241-9243: "punctuation.definition.tag.xml.plist" - Data: "</"
-												9243-9249: "entity.name.tag.xml.plist"
-													9243-9249: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9249-9250: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9257-9264: "meta.tag.dict.xml.plist"
-											9257-9259: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9259-9263: "entity.name.tag.xml.plist"
-												9259-9263: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9263-9264: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9271-9283: ""
-										9271-9276: "meta.tag.key.xml.plist"
-											9271-9272: "punctuation.definition.tag.xml.plist" - Data: "<"
-											9272-9275: "entity.name.tag.xml.plist"
-												9272-9275: "entity.name.tag.localname.xml.plist" - Data: "key"
-											9275-9276: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9277-9283: "meta.tag.key.xml.plist"
-											9277-9279: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9279-9282: "entity.name.tag.xml.plist"
-												9279-9282: "entity.name.tag.localname.xml.plist" - Data: "key"
-											9282-9283: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9290-9394: ""
-										9290-9296: "meta.tag.dict.xml.plist"
-											9290-9291: "punctuation.definition.tag.xml.plist" - Data: "<"
-											9291-9295: "entity.name.tag.xml.plist"
-												9291-9295: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9295-9296: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9304-9319: ""
-											9304-9309: "meta.tag.key.xml.plist"
-												9304-9305: "punctuation.definition.tag.xml.plist" - Data: "<"
-												9305-9308: "entity.name.tag.xml.plist"
-													9305-9308: "entity.name.tag.localname.xml.plist" - Data: "key"
-												9308-9309: "punctuation.definition.tag.xml.plist" - Data: ">"
-											9313-9319: "meta.tag.key.xml.plist"
-												9313-9315: "punctuation.definition.tag.xml.plist" - Data: "</"
-												9315-9318: "entity.name.tag.xml.plist"
-													9315-9318: "entity.name.tag.localname.xml.plist" - Data: "key"
-												9318-9319: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9327-9380: ""
-											9327-9335: "meta.tag.string.xml.plist"
-												9327-9328: "punctuation.definition.tag.xml.plist" - Data: "<"
-												9328-9334: "entity.name.tag.xml.plist"
-													9328-9334: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9334-9335: "punctuation.definition.tag.xml.plist" - Data: ">"
-											9371-9380: "meta.tag.string.xml.plist"
-												9371-9373: "punctuation.definition.tag.xml.plist" - Data: "</"
-												9373-9379: "entity.name.tag.xml.plist"
-													9373-9379: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9379-9380: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9387-9394: "meta.tag.dict.xml.plist"
-											9387-9389: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9389-9393: "entity.name.tag.xml.plist"
-												9389-9393: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9393-9394: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9401-9413: ""
-										9401-9406: "meta.tag.key.xml.plist"
-											9401-9402: "punctuation.definition.tag.xml.plist" - Data: "<"
-											9402-9405: "entity.name.tag.xml.plist"
-												9402-9405: "entity.name.tag.localname.xml.plist" - Data: "key"
-											9405-9406: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9407-9413: "meta.tag.key.xml.plist"
-											9407-9409: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9409-9412: "entity.name.tag.xml.plist"
-												9409-9412: "entity.name.tag.localname.xml.plist" - Data: "key"
-											9412-9413: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9420-9512: ""
-										9420-9426: "meta.tag.dict.xml.plist"
-											9420-9421: "punctuation.definition.tag.xml.plist" - Data: "<"
-											9421-9425: "entity.name.tag.xml.plist"
-												9421-9425: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9425-9426: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9434-9449: ""
-											9434-9439: "meta.tag.key.xml.plist"
-												9434-9435: "punctuation.definition.tag.xml.plist" - Data: "<"
-												9435-9438: "entity.name.tag.xml.plist"
-													9435-9438: "entity.name.tag.localname.xml.plist" - Data: "key"
-												9438-9439: "punctuation.definition.tag.xml.plist" - Data: ">"
-											9443-9449: "meta.tag.key.xml.plist"
-												9443-9445: "punctuation.definition.tag.xml.plist" - Data: "</"
-												9445-9448: "entity.name.tag.xml.plist"
-													9445-9448: "entity.name.tag.localname.xml.plist" - Data: "key"
-												9448-9449: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9457-9498: ""
-											9457-9465: "meta.tag.string.xml.plist"
-												9457-9458: "punctuation.definition.tag.xml.plist" - Data: "<"
-												9458-9464: "entity.name.tag.xml.plist"
-													9458-9464: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9464-9465: "punctuation.definition.tag.xml.plist" - Data: ">"
-											9489-9498: "meta.tag.string.xml.plist"
-												9489-9491: "punctuation.definition.tag.xml.plist" - Data: "</"
-												9491-9497: "entity.name.tag.xml.plist"
-													9491-9497: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9497-9498: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9505-9512: "meta.tag.dict.xml.plist"
-											9505-9507: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9507-9511: "entity.name.tag.xml.plist"
-												9507-9511: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9511-9512: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9519-9531: ""
-										9519-9524: "meta.tag.key.xml.plist"
-											9519-9520: "punctuation.definition.tag.xml.plist" - Data: "<"
-											9520-9523: "entity.name.tag.xml.plist"
-												9520-9523: "entity.name.tag.localname.xml.plist" - Data: "key"
-											9523-9524: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9525-9531: "meta.tag.key.xml.plist"
-											9525-9527: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9527-9530: "entity.name.tag.xml.plist"
-												9527-9530: "entity.name.tag.localname.xml.plist" - Data: "key"
-											9530-9531: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9538-9642: ""
-										9538-9544: "meta.tag.dict.xml.plist"
-											9538-9539: "punctuation.definition.tag.xml.plist" - Data: "<"
-											9539-9543: "entity.name.tag.xml.plist"
-												9539-9543: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9543-9544: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9552-9567: ""
-											9552-9557: "meta.tag.key.xml.plist"
-												9552-9553: "punctuation.definition.tag.xml.plist" - Data: "<"
-												9553-9556: "entity.name.tag.xml.plist"
-													9553-9556: "entity.name.tag.localname.xml.plist" - Data: "key"
-												9556-9557: "punctuation.definition.tag.xml.plist" - Data: ">"
-											9561-9567: "meta.tag.key.xml.plist"
-												9561-9563: "punctuation.definition.tag.xml.plist" - Data: "</"
-												9563-9566: "entity.name.tag.xml.plist"
-													9563-9566: "entity.name.tag.localname.xml.plist" - Data: "key"
-												9566-9567: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9575-9628: ""
-											9575-9583: "meta.tag.string.xml.plist"
-												9575-9576: "punctuation.definition.tag.xml.plist" - Data: "<"
-												9576-9582: "entity.name.tag.xml.plist"
-													9576-9582: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9582-9583: "punctuation.definition.tag.xml.plist" - Data: ">"
-											9619-9628: "meta.tag.string.xml.plist"
-												9619-9621: "punctuation.definition.tag.xml.plist" - Data: "</"
-												9621-9627: "entity.name.tag.xml.plist"
-													9621-9627: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9627-9628: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9635-9642: "meta.tag.dict.xml.plist"
-											9635-9637: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9637-9641: "entity.name.tag.xml.plist"
-												9637-9641: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9641-9642: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9649-9661: ""
-										9649-9654: "meta.tag.key.xml.plist"
-											9649-9650: "punctuation.definition.tag.xml.plist" - Data: "<"
-											9650-9653: "entity.name.tag.xml.plist"
-												9650-9653: "entity.name.tag.localname.xml.plist" - Data: "key"
-											9653-9654: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9655-9661: "meta.tag.key.xml.plist"
-											9655-9657: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9657-9660: "entity.name.tag.xml.plist"
-												9657-9660: "entity.name.tag.localname.xml.plist" - Data: "key"
-											9660-9661: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9668-9773: ""
-										9668-9674: "meta.tag.dict.xml.plist"
-											9668-9669: "punctuation.definition.tag.xml.plist" - Data: "<"
-											9669-9673: "entity.name.tag.xml.plist"
-												9669-9673: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9673-9674: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9682-9697: ""
-											9682-9687: "meta.tag.key.xml.plist"
-												9682-9683: "punctuation.definition.tag.xml.plist" - Data: "<"
-												9683-9686: "entity.name.tag.xml.plist"
-													9683-9686: "entity.name.tag.localname.xml.plist" - Data: "key"
-												9686-9687: "punctuation.definition.tag.xml.plist" - Data: ">"
-											9691-9697: "meta.tag.key.xml.plist"
-												9691-9693: "punctuation.definition.tag.xml.plist" - Data: "</"
-												9693-9696: "entity.name.tag.xml.plist"
-													9693-9696: "entity.name.tag.localname.xml.plist" - Data: "key"
-												9696-9697: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9705-9759: ""
-											9705-9713: "meta.tag.string.xml.plist"
-												9705-9706: "punctuation.definition.tag.xml.plist" - Data: "<"
-												9706-9712: "entity.name.tag.xml.plist"
-													9706-9712: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9712-9713: "punctuation.definition.tag.xml.plist" - Data: ">"
-											9750-9759: "meta.tag.string.xml.plist"
-												9750-9752: "punctuation.definition.tag.xml.plist" - Data: "</"
-												9752-9758: "entity.name.tag.xml.plist"
-													9752-9758: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9758-9759: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9766-9773: "meta.tag.dict.xml.plist"
-											9766-9768: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9768-9772: "entity.name.tag.xml.plist"
-												9768-9772: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9772-9773: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9780-9792: ""
-										9780-9785: "meta.tag.key.xml.plist"
-											9780-9781: "punctuation.definition.tag.xml.plist" - Data: "<"
-											9781-9784: "entity.name.tag.xml.plist"
-												9781-9784: "entity.name.tag.localname.xml.plist" - Data: "key"
-											9784-9785: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9786-9792: "meta.tag.key.xml.plist"
-											9786-9788: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9788-9791: "entity.name.tag.xml.plist"
-												9788-9791: "entity.name.tag.localname.xml.plist" - Data: "key"
-											9791-9792: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9799-9892: ""
-										9799-9805: "meta.tag.dict.xml.plist"
-											9799-9800: "punctuation.definition.tag.xml.plist" - Data: "<"
-											9800-9804: "entity.name.tag.xml.plist"
-												9800-9804: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9804-9805: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9813-9828: ""
-											9813-9818: "meta.tag.key.xml.plist"
-												9813-9814: "punctuation.definition.tag.xml.plist" - Data: "<"
-												9814-9817: "entity.name.tag.xml.plist"
-													9814-9817: "entity.name.tag.localname.xml.plist" - Data: "key"
-												9817-9818: "punctuation.definition.tag.xml.plist" - Data: ">"
-											9822-9828: "meta.tag.key.xml.plist"
-												9822-9824: "punctuation.definition.tag.xml.plist" - Data: "</"
-												9824-9827: "entity.name.tag.xml.plist"
-													9824-9827: "entity.name.tag.localname.xml.plist" - Data: "key"
-												9827-9828: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9836-9878: ""
-											9836-9844: "meta.tag.string.xml.plist"
-												9836-9837: "punctuation.definition.tag.xml.plist" - Data: "<"
-												9837-9843: "entity.name.tag.xml.plist"
-													9837-9843: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9843-9844: "punctuation.definition.tag.xml.plist" - Data: ">"
-											9869-9878: "meta.tag.string.xml.plist"
-												9869-9871: "punctuation.definition.tag.xml.plist" - Data: "</"
-												9871-9877: "entity.name.tag.xml.plist"
-													9871-9877: "entity.name.tag.localname.xml.plist" - Data: "string"
-												9877-9878: "punctuation.definition.tag.xml.plist" - Data: ">"
-										9885-9892: "meta.tag.dict.xml.plist"
-											9885-9887: "punctuation.definition.tag.xml.plist" - Data: "</"
-											9887-9891: "entity.name.tag.xml.plist"
-												9887-9891: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											9891-9892: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9898-9905: "meta.tag.dict.xml.plist"
-										9898-9900: "punctuation.definition.tag.xml.plist" - Data: "</"
-										9900-9904: "entity.name.tag.xml.plist"
-											9900-9904: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										9904-9905: "punctuation.definition.tag.xml.plist" - Data: ">"
-								9911-9929: ""
-									9911-9916: "meta.tag.key.xml.plist"
-										9911-9912: "punctuation.definition.tag.xml.plist" - Data: "<"
-										9912-9915: "entity.name.tag.xml.plist"
-											9912-9915: "entity.name.tag.localname.xml.plist" - Data: "key"
-										9915-9916: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9923-9929: "meta.tag.key.xml.plist"
-										9923-9925: "punctuation.definition.tag.xml.plist" - Data: "</"
-										9925-9928: "entity.name.tag.xml.plist"
-											9925-9928: "entity.name.tag.localname.xml.plist" - Data: "key"
-										9928-9929: "punctuation.definition.tag.xml.plist" - Data: ">"
-								9935-9968: ""
-									9935-9943: "meta.tag.string.xml.plist"
-										9935-9936: "punctuation.definition.tag.xml.plist" - Data: "<"
-										9936-9942: "entity.name.tag.xml.plist"
-											9936-9942: "entity.name.tag.localname.xml.plist" - Data: "string"
-										9942-9943: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9959-9968: "meta.tag.string.xml.plist"
-										9959-9961: "punctuation.definition.tag.xml.plist" - Data: "</"
-										9961-9967: "entity.name.tag.xml.plist"
-											9961-9967: "entity.name.tag.localname.xml.plist" - Data: "string"
-										9967-9968: "punctuation.definition.tag.xml.plist" - Data: ">"
-								9974-9990: ""
-									9974-9979: "meta.tag.key.xml.plist"
-										9974-9975: "punctuation.definition.tag.xml.plist" - Data: "<"
-										9975-9978: "entity.name.tag.xml.plist"
-											9975-9978: "entity.name.tag.localname.xml.plist" - Data: "key"
-										9978-9979: "punctuation.definition.tag.xml.plist" - Data: ">"
-									9984-9990: "meta.tag.key.xml.plist"
-										9984-9986: "punctuation.definition.tag.xml.plist" - Data: "</"
-										9986-9989: "entity.name.tag.xml.plist"
-											9986-9989: "entity.name.tag.localname.xml.plist" - Data: "key"
-										9989-9990: "punctuation.definition.tag.xml.plist" - Data: ">"
-								9996-10062: ""
-									9996-10004: "meta.tag.string.xml.plist"
-										9996-9997: "punctuation.definition.tag.xml.plist" - Data: "<"
-										9997-10003: "entity.name.tag.xml.plist"
-											9997-10003: "entity.name.tag.localname.xml.plist" - Data: "string"
-										10003-10004: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10053-10062: "meta.tag.string.xml.plist"
-										10053-10055: "punctuation.definition.tag.xml.plist" - Data: "</"
-										10055-10061: "entity.name.tag.xml.plist"
-											10055-10061: "entity.name.tag.localname.xml.plist" - Data: "string"
-										10061-10062: "punctuation.definition.tag.xml.plist" - Data: ">"
-								10067-10074: "meta.tag.dict.xml.plist"
-									10067-10069: "punctuation.definition.tag.xml.plist" - Data: "</"
-									10069-10073: "entity.name.tag.xml.plist"
-										10069-10073: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									10073-10074: "punctuation.definition.tag.xml.plist" - Data: ">"
-							10079-11694: ""
-								10079-10085: "meta.tag.dict.xml.plist"
-									10079-10080: "punctuation.definition.tag.xml.plist" - Data: "<"
-									10080-10084: "entity.name.tag.xml.plist"
-										10080-10084: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									10084-10085: "punctuation.definition.tag.xml.plist" - Data: ">"
-								10091-10110: ""
-									10091-10096: "meta.tag.key.xml.plist"
-										10091-10092: "punctuation.definition.tag.xml.plist" - Data: "<"
-										10092-10095: "entity.name.tag.xml.plist"
-											10092-10095: "entity.name.tag.localname.xml.plist" - Data: "key"
-										10095-10096: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10104-10110: "meta.tag.key.xml.plist"
-										10104-10106: "punctuation.definition.tag.xml.plist" - Data: "</"
-										10106-10109: "entity.name.tag.xml.plist"
-											10106-10109: "entity.name.tag.localname.xml.plist" - Data: "key"
-										10109-10110: "punctuation.definition.tag.xml.plist" - Data: ">"
-								10116-11522: ""
-									10116-10122: "meta.tag.dict.xml.plist"
-										10116-10117: "punctuation.definition.tag.xml.plist" - Data: "<"
-										10117-10121: "entity.name.tag.xml.plist"
-											10117-10121: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										10121-10122: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10129-10141: ""
-										10129-10134: "meta.tag.key.xml.plist"
-											10129-10130: "punctuation.definition.tag.xml.plist" - Data: "<"
-											10130-10133: "entity.name.tag.xml.plist"
-												10130-10133: "entity.name.tag.localname.xml.plist" - Data: "key"
-											10133-10134: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10135-10141: "meta.tag.key.xml.plist"
-											10135-10137: "punctuation.definition.tag.xml.plist" - Data: "</"
-											10137-10140: "entity.name.tag.xml.plist"
-												10137-10140: "entity.name.tag.localname.xml.plist" - Data: "key"
-											10140-10141: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10148-10241: ""
-										10148-10154: "meta.tag.dict.xml.plist"
-											10148-10149: "punctuation.definition.tag.xml.plist" - Data: "<"
-											10149-10153: "entity.name.tag.xml.plist"
-												10149-10153: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											10153-10154: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10162-10177: ""
-											10162-10167: "meta.tag.key.xml.plist"
-												10162-10163: "punctuation.definition.tag.xml.plist" - Data: "<"
-												10163-10166: "entity.name.tag.xml.plist"
-													10163-10166: "entity.name.tag.localname.xml.plist" - Data: "key"
-												10166-10167: "punctuation.definition.tag.xml.plist" - Data: ">"
-											10171-10177: "meta.tag.key.xml.plist"
-												10171-10173: "punctuation.definition.tag.xml.plist" - Data: "</"
-												10173-10176: "entity.name.tag.xml.plist"
-													10173-10176: "entity.name.tag.localname.xml.plist" - Data: "key"
-												10176-10177: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10185-10227: ""
-											10185-10193: "meta.tag.string.xml.plist"
-												10185-10186: "punctuation.definition.tag.xml.plist" - Data: "<"
-												10186-10192: "entity.name.tag.xml.plist"
-													10186-10192: "entity.name.tag.localname.xml.plist" - Data: "string"
-												10192-10193: "punctuation.definition.tag.xml.plist" - Data: ">"
-											10218-10227: "meta.tag.string.xml.plist"
-												10218-10220: "punctuation.definition.tag.xml.plist" - Data: "</"
-												10220-10226: "entity.name.tag.xml.plist"
-													10220-10226: "entity.name.tag.localname.xml.plist" - Data: "string"
-												10226-10227: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10234-10241: "meta.tag.dict.xml.plist"
-											10234-10236: "punctuation.definition.tag.xml.plist" - Data: "</"
-											10236-10240: "entity.name.tag.xml.plist"
-												10236-10240: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											10240-10241: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10248-10261: ""
-										10248-10253: "meta.tag.key.xml.plist"
-											10248-10249: "punctuation.definition.tag.xml.plist" - Data: "<"
-											10249-10252: "entity.name.tag.xml.plist"
-												10249-10252: "entity.name.tag.localname.xml.plist" - Data: "key"
-											10252-10253: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10255-10261: "meta.tag.key.xml.plist"
-											10255-10257: "punctuation.definition.tag.xml.plist" - Data: "</"
-											10257-10260: "entity.name.tag.xml.plist"
-												10257-10260: "entity.name.tag.localname.xml.plist" - Data: "key"
-											10260-10261: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10268-10371: ""
-										10268-10274: "meta.tag.dict.xml.plist"
-											10268-10269: "punctuation.definition.tag.xml.plist" - Data: "<"
-											10269-10273: "entity.name.tag.xml.plist"
-												10269-10273: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											10273-10274: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10282-10297: ""
-											10282-10287: "meta.tag.key.xml.plist"
-												10282-10283: "punctuation.definition.tag.xml.plist" - Data: "<"
-												10283-10286: "entity.name.tag.xml.plist"
-													10283-10286: "entity.name.tag.localname.xml.plist" - Data: "key"
-												10286-10287: "punctuation.definition.tag.xml.plist" - Data: ">"
-											10291-10297: "meta.tag.key.xml.plist"
-												10291-10293: "punctuation.definition.tag.xml.plist" - Data: "</"
-												10293-10296: "entity.name.tag.xml.plist"
-													10293-10296: "entity.name.tag.localname.xml.plist" - Data: "key"
-												10296-10297: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10305-10357: ""
-											10305-10313: "meta.tag.string.xml.plist"
-												10305-10306: "punctuation.definition.tag.xml.plist" - Data: "<"
-												10306-10312: "entity.name.tag.xml.plist"
-													10306-10312: "entity.name.tag.localname.xml.plist" - Data: "string"
-												10312-10313: "punctuation.definition.tag.xml.plist" - Data: ">"
-											10348-10357: "meta.tag.string.xml.plist"
-												10348-10350: "punctuation.definition.tag.xml.plist" - Data: "</"
-												10350-10356: "entity.name.tag.xml.plist"
-													10350-10356: "entity.name.tag.localname.xml.plist" - Data: "string"
-												10356-10357: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10364-10371: "meta.tag.dict.xml.plist"
-											10364-10366: "punctuation.definition.tag.xml.plist" - Data: "</"
-											10366-10370: "entity.name.tag.xml.plist"
-												10366-10370: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											10370-10371: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10378-10391: ""
-										10378-10383: "meta.tag.key.xml.plist"
-											10378-10379: "punctuation.definition.tag.xml.plist" - Data: "<"
-											10379-10382: "entity.name.tag.xml.plist"
-												10379-10382: "entity.name.tag.localname.xml.plist" - Data: "key"
-											10382-10383: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10385-10391: "meta.tag.key.xml.plist"
-											10385-10387: "punctuation.definition.tag.xml.plist" - Data: "</"
-											10387-10390: "entity.name.tag.xml.plist"
-												10387-10390: "entity.name.tag.localname.xml.plist" - Data: "key"
-											10390-10391: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10398-10502: ""
-										10398-10404: "meta.tag.dict.xml.plist"
-											10398-10399: "punctuation.definition.tag.xml.plist" - Data: "<"
-											10399-10403: "entity.name.tag.xml.plist"
-												10399-10403: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											10403-10404: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10412-10427: ""
-											10412-10417: "meta.tag.key.xml.plist"
-												10412-10413: "punctuation.definition.tag.xml.plist" - Data: "<"
-												10413-10416: "entity.name.tag.xml.plist"
-													10413-10416: "entity.name.tag.localname.xml.plist" - Data: "key"
-												10416-10417: "punctuation.definition.tag.xml.plist" - Data: ">"
-											10421-10427: "meta.tag.key.xml.plist"
-												10421-10423: "punctuation.definition.tag.xml.plist" - Data: "</"
-												10423-10426: "entity.name.tag.xml.plist"
-													10423-10426: "entity.name.tag.localname.xml.plist" - Data: "key"
-												10426-10427: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10435-10488: ""
-											10435-10443: "meta.tag.string.xml.plist"
-												10435-10436: "punctuation.definition.tag.xml.plist" - Data: "<"
-												10436-10442: "entity.name.tag.xml.plist"
-													10436-10442: "entity.name.tag.localname.xml.plist" - Data: "string"
-												10442-10443: "punctuation.definition.tag.xml.plist" - Data: ">"
-											10479-10488: "meta.tag.string.xml.plist"
-												10479-10481: "punctuation.definition.tag.xml.plist" - Data: "</"
-												10481-10487: "entity.name.tag.xml.plist"
-													10481-10487: "entity.name.tag.localname.xml.plist" - Data: "string"
-												10487-10488: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10495-10502: "meta.tag.dict.xml.plist"
-											10495-10497: "punctuation.definition.tag.xml.plist" - Data: "</"
-											10497-10501: "entity.name.tag.xml.plist"
-												10497-10501: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											10501-10502: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10509-10521: ""
-										10509-10514: "meta.tag.key.xml.plist"
-											10509-10510: "punctuation.definition.tag.xml.plist" - Data: "<"
-											10510-10513: "entity.name.tag.xml.plist"
-												10510-10513: "entity.name.tag.localname.xml.plist" - Data: "key"
-											10513-10514: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10515-10521: "meta.tag.key.xml.plist"
-											10515-10517: "punctuation.definition.tag.xml.plist" - Data: "</"
-											10517-10520: "entity.name.tag.xml.plist"
-												10517-10520: "entity.name.tag.localname.xml.plist" - Data: "key"
-											10520-10521: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10528-10632: ""
-										10528-10534: "meta.tag.dict.xml.plist"
-											10528-10529: "punctuation.definition.tag.xml.plist" - Data: "<"
-											10529-10533: "entity.name.tag.xml.plist"
-												10529-10533: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											10533-10534: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10542-10557: ""
-											10542-10547: "meta.tag.key.xml.plist"
-												10542-10543: "punctuation.definition.tag.xml.plist" - Data: "<"
-												10543-10546: "entity.name.tag.xml.plist"
-													10543-10546: "entity.name.tag.localname.xml.plist" - Data: "key"
-												10546-10547: "punctuation.definition.tag.xml.plist" - Data: ">"
-											10551-10557: "meta.tag.key.xml.plist"
-												10551-10553: "punctuation.definition.tag.xml.plist" - Data: "</"
-												10553-10556: "entity.name.tag.xml.plist"
-													10553-10556: "entity.name.tag.localname.xml.plist" - Data: "key"
-												10556-10557: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10565-10618: ""
-											10565-10573: "meta.tag.string.xml.plist"
-												10565-10566: "punctuation.definition.tag.xml.plist" - Data: "<"
-												10566-10572: "entity.name.tag.xml.plist"
-													10566-10572: "entity.name.tag.localname.xml.plist" - Data: "string"
-												10572-10573: "punctuation.definition.tag.xml.plist" - Data: ">"
-											10609-10618: "meta.tag.string.xml.plist"
-												10609-10611: "punctuation.definition.tag.xml.plist" - Data: "</"
-												10611-10617: "entity.name.tag.xml.plist"
-													10611-10617: "entity.name.tag.localname.xml.plist" - Data: "string"
-												10617-10618: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10625-10632: "meta.tag.dict.xml.plist"
-											10625-10627: "punctuation.definition.tag.xml.plist" - Data: "</"
-											10627-10631: "entity.name.tag.xml.plist"
-												10627-10631: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											10631-10632: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10639-10651: ""
-										10639-10644: "meta.tag.key.xml.plist"
-											10639-10640: "punctuation.definition.tag.xml.plist" - Data: "<"
-											10640-10643: "entity.name.tag.xml.plist"
-												10640-10643: "entity.name.tag.localname.xml.plist" - Data: "key"
-											10643-10644: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10645-10651: "meta.tag.key.xml.plist"
-											10645-10647: "punctuation.definition.tag.xml.plist" - Data: "</"
-											10647-10650: "entity.name.tag.xml.plist"
-												10647-10650: "entity.name.tag.localname.xml.plist" - Data: "key"
-											10650-10651: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10658-10751: ""
-										10658-10664: "meta.tag.dict.xml.plist"
-											10658-10659: "punctuation.definition.tag.xml.plist" - Data: "<"
-											10659-10663: "entity.name.tag.xml.plist"
-												10659-10663: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											10663-10664: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10672-10687: ""
-											10672-10677: "meta.tag.key.xml.plist"
-												10672-10673: "punctuation.definition.tag.xml.plist" - Data: "<"
-												10673-10676: "entity.name.tag.xml.plist"
-													10673-10676: "entity.name.tag.localname.xml.plist" - Data: "key"
-												10676-10677: "punctuation.definition.tag.xml.plist" - Data: ">"
-											10681-10687: "meta.tag.key.xml.plist"
-												10681-10683: "punctuation.definition.tag.xml.plist" - Data: "</"
-												10683-10686: "entity.name.tag.xml.plist"
-													10683-10686: "entity.name.tag.localname.xml.plist" - Data: "key"
-												10686-10687: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10695-10737: ""
-											10695-10703: "meta.tag.string.xml.plist"
-												10695-10696: "punctuation.definition.tag.xml.plist" - Data: "<"
-												10696-10702: "entity.name.tag.xml.plist"
-													10696-10702: "entity.name.tag.localname.xml.plist" - Data: "string"
-												10702-10703: "punctuation.definition.tag.xml.plist" - Data: ">"
-											10728-10737: "meta.tag.string.xml.plist"
-												10728-10730: "punctuation.definition.tag.xml.plist" - Data: "</"
-												10730-10736: "entity.name.tag.xml.plist"
-													10730-10736: "entity.name.tag.localname.xml.plist" - Data: "string"
-												10736-10737: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10744-10751: "meta.tag.dict.xml.plist"
-											10744-10746: "punctuation.definition.tag.xml.plist" - Data: "</"
-											10746-10750: "entity.name.tag.xml.plist"
-												10746-10750: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											10750-10751: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10758-10770: ""
-										10758-10763: "meta.tag.key.xml.plist"
-											10758-10759: "punctuation.definition.tag.xml.plist" - Data: "<"
-											10759-10762: "entity.name.tag.xml.plist"
-												10759-10762: "entity.name.tag.localname.xml.plist" - Data: "key"
-											10762-10763: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10764-10770: "meta.tag.key.xml.plist"
-											10764-10766: "punctuation.definition.tag.xml.plist" - Data: "</"
-											10766-10769: "entity.name.tag.xml.plist"
-												10766-10769: "entity.name.tag.localname.xml.plist" - Data: "key"
-											10769-10770: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10777-10880: ""
-										10777-10783: "meta.tag.dict.xml.plist"
-											10777-10778: "punctuation.definition.tag.xml.plist" - Data: "<"
-											10778-10782: "entity.name.tag.xml.plist"
-												10778-10782: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											10782-10783: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10791-10806: ""
-											10791-10796: "meta.tag.key.xml.plist"
-												10791-10792: "punctuation.definition.tag.xml.plist" - Data: "<"
-												10792-10795: "entity.name.tag.xml.plist"
-													10792-10795: "entity.name.tag.localname.xml.plist" - Data: "key"
-												10795-10796: "punctuation.definition.tag.xml.plist" - Data: ">"
-											10800-10806: "meta.tag.key.xml.plist"
-												10800-10802: "punctuation.definition.tag.xml.plist" - Data: "</"
-												10802-10805: "entity.name.tag.xml.plist"
-													10802-10805: "entity.name.tag.localname.xml.plist" - Data: "key"
-												10805-10806: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10814-10866: ""
-											10814-10822: "meta.tag.string.xml.plist"
-												10814-10815: "punctuation.definition.tag.xml.plist" - Data: "<"
-												10815-10821: "entity.name.tag.xml.plist"
-													10815-10821: "entity.name.tag.localname.xml.plist" - Data: "string"
-												10821-10822: "punctuation.definition.tag.xml.plist" - Data: ">"
-											10857-10866: "meta.tag.string.xml.plist"
-												10857-10859: "punctuation.definition.tag.xml.plist" - Data: "</"
-												10859-10865: "entity.name.tag.xml.plist"
-													10859-10865: "entity.name.tag.localname.xml.plist" - Data: "string"
-												10865-10866: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10873-10880: "meta.tag.dict.xml.plist"
-											10873-10875: "punctuation.definition.tag.xml.plist" - Data: "</"
-											10875-10879: "entity.name.tag.xml.plist"
-												10875-10879: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											10879-10880: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10887-10899: ""
-										10887-10892: "meta.tag.key.xml.plist"
-											10887-10888: "punctuation.definition.tag.xml.plist" - Data: "<"
-											10888-10891: "entity.name.tag.xml.plist"
-												10888-10891: "entity.name.tag.localname.xml.plist" - Data: "key"
-											10891-10892: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10893-10899: "meta.tag.key.xml.plist"
-											10893-10895: "punctuation.definition.tag.xml.plist" - Data: "</"
-											10895-10898: "entity.name.tag.xml.plist"
-												10895-10898: "entity.name.tag.localname.xml.plist" - Data: "key"
-											10898-10899: "punctuation.definition.tag.xml.plist" - Data: ">"
-									10906-11010: ""
-										10906-10912: "meta.tag.dict.xml.plist"
-											10906-10907: "punctuation.definition.tag.xml.plist" - Data: "<"
-											10907-10911: "entity.name.tag.xml.plist"
-												10907-10911: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											10911-10912: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10920-10935: ""
-											10920-10925: "meta.tag.key.xml.plist"
-												10920-10921: "punctuation.definition.tag.xml.plist" - Data: "<"
-												10921-10924: "entity.name.tag.xml.plist"
-													10921-10924: "entity.name.tag.localname.xml.plist" - Data: "key"
-												10924-10925: "punctuation.definition.tag.xml.plist" - Data: ">"
-											10929-10935: "meta.tag.key.xml.plist"
-												10929-10931: "punctuation.definition.tag.xml.plist" - Data: "</"
-												10931-10934: "entity.name.tag.xml.plist"
-													10931-10934: "entity.name.tag.localname.xml.plist" - Data: "key"
-												10934-10935: "punctuation.definition.tag.xml.plist" - Data: ">"
-										10943-10996: ""
-											10943-10951: "meta.tag.string.xml.plist"
-												10943-10944: "punctuation.definition.tag.xml.plist" - Data: "<"
-												10944-10950: "entity.name.tag.xml.plist"
-													10944-10950: "entity.name.tag.localname.xml.plist" - Data: "string"
-												10950-10951: "punctuation.definition.tag.xml.plist" - Data: ">"
-											10987-10996: "meta.tag.string.xml.plist"
-												10987-10989: "punctuation.definition.tag.xml.plist" - Data: "</"
-												10989-10995: "entity.name.tag.xml.plist"
-													10989-10995: "entity.name.tag.localname.xml.plist" - Data: "string"
-												10995-10996: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11003-11010: "meta.tag.dict.xml.plist"
-											11003-11005: "punctuation.definition.tag.xml.plist" - Data: "</"
-											11005-11009: "entity.name.tag.xml.plist"
-												11005-11009: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											11009-11010: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11017-11029: ""
-										11017-11022: "meta.tag.key.xml.plist"
-											11017-11018: "punctuation.definition.tag.xml.plist" - Data: "<"
-											11018-11021: "entity.name.tag.xml.plist"
-												11018-11021: "entity.name.tag.localname.xml.plist" - Data: "key"
-											11021-11022: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11023-11029: "meta.tag.key.xml.plist"
-											11023-11025: "punctuation.definition.tag.xml.plist" - Data: "</"
-											11025-11028: "entity.name.tag.xml.plist"
-												11025-11028: "entity.name.tag.localname.xml.plist" - Data: "key"
-											11028-11029: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11036-11129: ""
-										11036-11042: "meta.tag.dict.xml.plist"
-											11036-11037: "punctuation.definition.tag.xml.plist" - Data: "<"
-											11037-11041: "entity.name.tag.xml.plist"
-												11037-11041: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											11041-11042: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11050-11065: ""
-											11050-11055: "meta.tag.key.xml.plist"
-												11050-11051: "punctuation.definition.tag.xml.plist" - Data: "<"
-												11051-11054: "entity.name.tag.xml.plist"
-													11051-11054: "entity.name.tag.localname.xml.plist" - Data: "key"
-												11054-11055: "punctuation.definition.tag.xml.plist" - Data: ">"
-											11059-11065: "meta.tag.key.xml.plist"
-												11059-11061: "punctuation.definition.tag.xml.plist" - Data: "</"
-												11061-11064: "entity.name.tag.xml.plist"
-													11061-11064: "entity.name.tag.localname.xml.plist" - Data: "key"
-												11064-11065: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11073-11115: ""
-											11073-11081: "meta.tag.string.xml.plist"
-												11073-11074: "punctuation.definition.tag.xml.plist" - Data: "<"
-												11074-11080: "entity.name.tag.xml.plist"
-													11074-11080: "entity.name.tag.localname.xml.plist" - Data: "string"
-												11080-11081: "punctuation.definition.tag.xml.plist" - Data: ">"
-											11106-11115: "meta.tag.string.xml.plist"
-												11106-11108: "punctuation.definition.tag.xml.plist" - Data: "</"
-												11108-11114: "entity.name.tag.xml.plist"
-													11108-11114: "entity.name.tag.localname.xml.plist" - Data: "string"
-												11114-11115: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11122-11129: "meta.tag.dict.xml.plist"
-											11122-11124: "punctuation.definition.tag.xml.plist" - Data: "</"
-											11124-11128: "entity.name.tag.xml.plist"
-												11124-11128: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											11128-11129: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11136-11148: ""
-										11136-11141: "meta.tag.key.xml.plist"
-											11136-11137: "punctuation.definition.tag.xml.plist" - Data: "<"
-											11137-11140: "entity.name.tag.xml.plist"
-												11137-11140: "entity.name.tag.localname.xml.plist" - Data: "key"
-											11140-11141: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11142-11148: "meta.tag.key.xml.plist"
-											11142-11144: "punctuation.definition.tag.xml.plist" - Data: "</"
-											11144-11147: "entity.name.tag.xml.plist"
-												11144-11147: "entity.name.tag.localname.xml.plist" - Data: "key"
-											11147-11148: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11155-11259: ""
-										11155-11161: "meta.tag.dict.xml.plist"
-											11155-11156: "punctuation.definition.tag.xml.plist" - Data: "<"
-											11156-11160: "entity.name.tag.xml.plist"
-												11156-11160: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											11160-11161: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11169-11184: ""
-											11169-11174: "meta.tag.key.xml.plist"
-												11169-11170: "punctuation.definition.tag.xml.plist" - Data: "<"
-												11170-11173: "entity.name.tag.xml.plist"
-													11170-11173: "entity.name.tag.localname.xml.plist" - Data: "key"
-												11173-11174: "punctuation.definition.tag.xml.plist" - Data: ">"
-											11178-11184: "meta.tag.key.xml.plist"
-												11178-11180: "punctuation.definition.tag.xml.plist" - Data: "</"
-												11180-11183: "entity.name.tag.xml.plist"
-													11180-11183: "entity.name.tag.localname.xml.plist" - Data: "key"
-												11183-11184: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11192-11245: ""
-											11192-11200: "meta.tag.string.xml.plist"
-												11192-11193: "punctuation.definition.tag.xml.plist" - Data: "<"
-												11193-11199: "entity.name.tag.xml.plist"
-													11193-11199: "entity.name.tag.localname.xml.plist" - Data: "string"
-												11199-11200: "punctuation.definition.tag.xml.plist" - Data: ">"
-											11236-11245: "meta.tag.string.xml.plist"
-												11236-11238: "punctuation.definition.tag.xml.plist" - Data: "</"
-												11238-11244: "entity.name.tag.xml.plist"
-													11238-11244: "entity.name.tag.localname.xml.plist" - Data: "string"
-												11244-11245: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11252-11259: "meta.tag.dict.xml.plist"
-											11252-11254: "punctuation.definition.tag.xml.plist" - Data: "</"
-											11254-11258: "entity.name.tag.xml.plist"
-												11254-11258: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											11258-11259: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11266-11278: ""
-										11266-11271: "meta.tag.key.xml.plist"
-											11266-11267: "punctuation.definition.tag.xml.plist" - Data: "<"
-											11267-11270: "entity.name.tag.xml.plist"
-												11267-11270: "entity.name.tag.localname.xml.plist" - Data: "key"
-											11270-11271: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11272-11278: "meta.tag.key.xml.plist"
-											11272-11274: "punctuation.definition.tag.xml.plist" - Data: "</"
-											11274-11277: "entity.name.tag.xml.plist"
-												11274-11277: "entity.name.tag.localname.xml.plist" - Data: "key"
-											11277-11278: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11285-11390: ""
-										11285-11291: "meta.tag.dict.xml.plist"
-											11285-11286: "punctuation.definition.tag.xml.plist" - Data: "<"
-											11286-11290: "entity.name.tag.xml.plist"
-												11286-11290: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											11290-11291: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11299-11314: ""
-											11299-11304: "meta.tag.key.xml.plist"
-												11299-11300: "punctuation.definition.tag.xml.plist" - Data: "<"
-												11300-11303: "entity.name.tag.xml.plist"
-													11300-11303: "entity.name.tag.localname.xml.plist" - Data: "key"
-												11303-11304: "punctuation.definition.tag.xml.plist" - Data: ">"
-											11308-11314: "meta.tag.key.xml.plist"
-												11308-11310: "punctuation.definition.tag.xml.plist" - Data: "</"
-												11310-11313: "entity.name.tag.xml.plist"
-													11310-11313: "entity.name.tag.localname.xml.plist" - Data: "key"
-												11313-11314: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11322-11376: ""
-											11322-11330: "meta.tag.string.xml.plist"
-												11322-11323: "punctuation.definition.tag.xml.plist" - Data: "<"
-												11323-11329: "entity.name.tag.xml.plist"
-													11323-11329: "entity.name.tag.localname.xml.plist" - Data: "string"
-												11329-11330: "punctuation.definition.tag.xml.plist" - Data: ">"
-											11367-11376: "meta.tag.string.xml.plist"
-												11367-11369: "punctuation.definition.tag.xml.plist" - Data: "</"
-												11369-11375: "entity.name.tag.xml.plist"
-													11369-11375: "entity.name.tag.localname.xml.plist" - Data: "string"
-												11375-11376: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11383-11390: "meta.tag.dict.xml.plist"
-											11383-11385: "punctuation.definition.tag.xml.plist" - Data: "</"
-											11385-11389: "entity.name.tag.xml.plist"
-												11385-11389: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											11389-11390: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11397-11409: ""
-										11397-11402: "meta.tag.key.xml.plist"
-											11397-11398: "punctuation.definition.tag.xml.plist" - Data: "<"
-											11398-11401: "entity.name.tag.xml.plist"
-												11398-11401: "entity.name.tag.localname.xml.plist" - Data: "key"
-											11401-11402: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11403-11409: "meta.tag.key.xml.plist"
-											11403-11405: "punctuation.definition.tag.xml.plist" - Data: "</"
-											11405-11408: "entity.name.tag.xml.plist"
-												11405-11408: "entity.name.tag.localname.xml.plist" - Data: "key"
-											11408-11409: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11416-11509: ""
-										11416-11422: "meta.tag.dict.xml.plist"
-											11416-11417: "punctuation.definition.tag.xml.plist" - Data: "<"
-											11417-11421: "entity.name.tag.xml.plist"
-												11417-11421: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											11421-11422: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11430-11445: ""
-											11430-11435: "meta.tag.key.xml.plist"
-												11430-11431: "punctuation.definition.tag.xml.plist" - Data: "<"
-												11431-11434: "entity.name.tag.xml.plist"
-													11431-11434: "entity.name.tag.localname.xml.plist" - Data: "key"
-												11434-11435: "punctuation.definition.tag.xml.plist" - Data: ">"
-											11439-11445: "meta.tag.key.xml.plist"
-												11439-11441: "punctuation.definition.tag.xml.plist" - Data: "</"
-												11441-11444: "entity.name.tag.xml.plist"
-													11441-11444: "entity.name.tag.localname.xml.plist" - Data: "key"
-												11444-11445: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11453-11495: ""
-											11453-11461: "meta.tag.string.xml.plist"
-												11453-11454: "punctuation.definition.tag.xml.plist" - Data: "<"
-												11454-11460: "entity.name.tag.xml.plist"
-													11454-11460: "entity.name.tag.localname.xml.plist" - Data: "string"
-												11460-11461: "punctuation.definition.tag.xml.plist" - Data: ">"
-											11486-11495: "meta.tag.string.xml.plist"
-												11486-11488: "punctuation.definition.tag.xml.plist" - Data: "</"
-												11488-11494: "entity.name.tag.xml.plist"
-													11488-11494: "entity.name.tag.localname.xml.plist" - Data: "string"
-												11494-11495: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11502-11509: "meta.tag.dict.xml.plist"
-											11502-11504: "punctuation.definition.tag.xml.plist" - Data: "</"
-											11504-11508: "entity.name.tag.xml.plist"
-												11504-11508: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											11508-11509: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11515-11522: "meta.tag.dict.xml.plist"
-										11515-11517: "punctuation.definition.tag.xml.plist" - Data: "</"
-										11517-11521: "entity.name.tag.xml.plist"
-											11517-11521: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										11521-11522: "punctuation.definition.tag.xml.plist" - Data: ">"
-								11528-11546: ""
-									11528-11533: "meta.tag.key.xml.plist"
-										11528-11529: "punctuation.definition.tag.xml.plist" - Data: "<"
-										11529-11532: "entity.name.tag.xml.plist"
-											11529-11532: "entity.name.tag.localname.xml.plist" - Data: "key"
-										11532-11533: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11540-11546: "meta.tag.key.xml.plist"
-										11540-11542: "punctuation.definition.tag.xml.plist" - Data: "</"
-										11542-11545: "entity.name.tag.xml.plist"
-											11542-11545: "entity.name.tag.localname.xml.plist" - Data: "key"
-										11545-11546: "punctuation.definition.tag.xml.plist" - Data: ">"
-								11552-11586: ""
-									11552-11560: "meta.tag.string.xml.plist"
-										11552-11553: "punctuation.definition.tag.xml.plist" - Data: "<"
-										11553-11559: "entity.name.tag.xml.plist"
-											11553-11559: "entity.name.tag.localname.xml.plist" - Data: "string"
-										11559-11560: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11577-11586: "meta.tag.string.xml.plist"
-										11577-11579: "punctuation.definition.tag.xml.plist" - Data: "</"
-										11579-11585: "entity.name.tag.xml.plist"
-											11579-11585: "entity.name.tag.localname.xml.plist" - Data: "string"
-										11585-11586: "punctuation.definition.tag.xml.plist" - Data: ">"
-								11592-11608: ""
-									11592-11597: "meta.tag.key.xml.plist"
-										11592-11593: "punctuation.definition.tag.xml.plist" - Data: "<"
-										11593-11596: "entity.name.tag.xml.plist"
-											11593-11596: "entity.name.tag.localname.xml.plist" - Data: "key"
-										11596-11597: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11602-11608: "meta.tag.key.xml.plist"
-										11602-11604: "punctuation.definition.tag.xml.plist" - Data: "</"
-										11604-11607: "entity.name.tag.xml.plist"
-											11604-11607: "entity.name.tag.localname.xml.plist" - Data: "key"
-										11607-11608: "punctuation.definition.tag.xml.plist" - Data: ">"
-								11614-11682: ""
-									11614-11622: "meta.tag.string.xml.plist"
-										11614-11615: "punctuation.definition.tag.xml.plist" - Data: "<"
-										11615-11621: "entity.name.tag.xml.plist"
-											11615-11621: "entity.name.tag.localname.xml.plist" - Data: "string"
-										11621-11622: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11673-11682: "meta.tag.string.xml.plist"
-										11673-11675: "punctuation.definition.tag.xml.plist" - Data: "</"
-										11675-11681: "entity.name.tag.xml.plist"
-											11675-11681: "entity.name.tag.localname.xml.plist" - Data: "string"
-										11681-11682: "punctuation.definition.tag.xml.plist" - Data: ">"
-								11687-11694: "meta.tag.dict.xml.plist"
-									11687-11689: "punctuation.definition.tag.xml.plist" - Data: "</"
-									11689-11693: "entity.name.tag.xml.plist"
-										11689-11693: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									11693-11694: "punctuation.definition.tag.xml.plist" - Data: ">"
-							11699-13137: ""
-								11699-11705: "meta.tag.dict.xml.plist"
-									11699-11700: "punctuation.definition.tag.xml.plist" - Data: "<"
-									11700-11704: "entity.name.tag.xml.plist"
-										11700-11704: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									11704-11705: "punctuation.definition.tag.xml.plist" - Data: ">"
-								11711-11727: ""
-									11711-11716: "meta.tag.key.xml.plist"
-										11711-11712: "punctuation.definition.tag.xml.plist" - Data: "<"
-										11712-11715: "entity.name.tag.xml.plist"
-											11712-11715: "entity.name.tag.localname.xml.plist" - Data: "key"
-										11715-11716: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11721-11727: "meta.tag.key.xml.plist"
-										11721-11723: "punctuation.definition.tag.xml.plist" - Data: "</"
-										11723-11726: "entity.name.tag.xml.plist"
-											11723-11726: "entity.name.tag.localname.xml.plist" - Data: "key"
-										11726-11727: "punctuation.definition.tag.xml.plist" - Data: ">"
-								11733-11771: ""
-									11733-11741: "meta.tag.string.xml.plist"
-										11733-11734: "punctuation.definition.tag.xml.plist" - Data: "<"
-										11734-11740: "entity.name.tag.xml.plist"
-											11734-11740: "entity.name.tag.localname.xml.plist" - Data: "string"
-										11740-11741: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11762-11771: "meta.tag.string.xml.plist"
-										11762-11764: "punctuation.definition.tag.xml.plist" - Data: "</"
-										11764-11770: "entity.name.tag.xml.plist"
-											11764-11770: "entity.name.tag.localname.xml.plist" - Data: "string"
-										11770-11771: "punctuation.definition.tag.xml.plist" - Data: ">"
-								11777-11796: ""
-									11777-11782: "meta.tag.key.xml.plist"
-										11777-11778: "punctuation.definition.tag.xml.plist" - Data: "<"
-										11778-11781: "entity.name.tag.xml.plist"
-											11778-11781: "entity.name.tag.localname.xml.plist" - Data: "key"
-										11781-11782: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11790-11796: "meta.tag.key.xml.plist"
-										11790-11792: "punctuation.definition.tag.xml.plist" - Data: "</"
-										11792-11795: "entity.name.tag.xml.plist"
-											11792-11795: "entity.name.tag.localname.xml.plist" - Data: "key"
-										11795-11796: "punctuation.definition.tag.xml.plist" - Data: ">"
-								11802-12445: ""
-									11802-11808: "meta.tag.dict.xml.plist"
-										11802-11803: "punctuation.definition.tag.xml.plist" - Data: "<"
-										11803-11807: "entity.name.tag.xml.plist"
-											11803-11807: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										11807-11808: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11815-11827: ""
-										11815-11820: "meta.tag.key.xml.plist"
-											11815-11816: "punctuation.definition.tag.xml.plist" - Data: "<"
-											11816-11819: "entity.name.tag.xml.plist"
-												11816-11819: "entity.name.tag.localname.xml.plist" - Data: "key"
-											11819-11820: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11821-11827: "meta.tag.key.xml.plist"
-											11821-11823: "punctuation.definition.tag.xml.plist" - Data: "</"
-											11823-11826: "entity.name.tag.xml.plist"
-												11823-11826: "entity.name.tag.localname.xml.plist" - Data: "key"
-											11826-11827: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11834-11924: ""
-										11834-11840: "meta.tag.dict.xml.plist"
-											11834-11835: "punctuation.definition.tag.xml.plist" - Data: "<"
-											11835-11839: "entity.name.tag.xml.plist"
-												11835-11839: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											11839-11840: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11848-11863: ""
-											11848-11853: "meta.tag.key.xml.plist"
-												11848-11849: "punctuation.definition.tag.xml.plist" - Data: "<"
-												11849-11852: "entity.name.tag.xml.plist"
-													11849-11852: "entity.name.tag.localname.xml.plist" - Data: "key"
-												11852-11853: "punctuation.definition.tag.xml.plist" - Data: ">"
-											11857-11863: "meta.tag.key.xml.plist"
-												11857-11859: "punctuation.definition.tag.xml.plist" - Data: "</"
-												11859-11862: "entity.name.tag.xml.plist"
-													11859-11862: "entity.name.tag.localname.xml.plist" - Data: "key"
-												11862-11863: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11871-11910: ""
-											11871-11879: "meta.tag.string.xml.plist"
-												11871-11872: "punctuation.definition.tag.xml.plist" - Data: "<"
-												11872-11878: "entity.name.tag.xml.plist"
-													11872-11878: "entity.name.tag.localname.xml.plist" - Data: "string"
-												11878-11879: "punctuation.definition.tag.xml.plist" - Data: ">"
-											11901-11910: "meta.tag.string.xml.plist"
-												11901-11903: "punctuation.definition.tag.xml.plist" - Data: "</"
-												11903-11909: "entity.name.tag.xml.plist"
-													11903-11909: "entity.name.tag.localname.xml.plist" - Data: "string"
-												11909-11910: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11917-11924: "meta.tag.dict.xml.plist"
-											11917-11919: "punctuation.definition.tag.xml.plist" - Data: "</"
-											11919-11923: "entity.name.tag.xml.plist"
-												11919-11923: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											11923-11924: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11931-11943: ""
-										11931-11936: "meta.tag.key.xml.plist"
-											11931-11932: "punctuation.definition.tag.xml.plist" - Data: "<"
-											11932-11935: "entity.name.tag.xml.plist"
-												11932-11935: "entity.name.tag.localname.xml.plist" - Data: "key"
-											11935-11936: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11937-11943: "meta.tag.key.xml.plist"
-											11937-11939: "punctuation.definition.tag.xml.plist" - Data: "</"
-											11939-11942: "entity.name.tag.xml.plist"
-												11939-11942: "entity.name.tag.localname.xml.plist" - Data: "key"
-											11942-11943: "punctuation.definition.tag.xml.plist" - Data: ">"
-									11950-12054: ""
-										11950-11956: "meta.tag.dict.xml.plist"
-											11950-11951: "punctuation.definition.tag.xml.plist" - Data: "<"
-											11951-11955: "entity.name.tag.xml.plist"
-												11951-11955: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											11955-11956: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11964-11979: ""
-											11964-11969: "meta.tag.key.xml.plist"
-												11964-11965: "punctuation.definition.tag.xml.plist" - Data: "<"
-												11965-11968: "entity.name.tag.xml.plist"
-													11965-11968: "entity.name.tag.localname.xml.plist" - Data: "key"
-												11968-11969: "punctuation.definition.tag.xml.plist" - Data: ">"
-											11973-11979: "meta.tag.key.xml.plist"
-												11973-11975: "punctuation.definition.tag.xml.plist" - Data: "</"
-												11975-11978: "entity.name.tag.xml.plist"
-													11975-11978: "entity.name.tag.localname.xml.plist" - Data: "key"
-												11978-11979: "punctuation.definition.tag.xml.plist" - Data: ">"
-										11987-12040: ""
-											11987-11995: "meta.tag.string.xml.plist"
-												11987-11988: "punctuation.definition.tag.xml.plist" - Data: "<"
-												11988-11994: "entity.name.tag.xml.plist"
-													11988-11994: "entity.name.tag.localname.xml.plist" - Data: "string"
-												11994-11995: "punctuation.definition.tag.xml.plist" - Data: ">"
-											12031-12040: "meta.tag.string.xml.plist"
-												12031-12033: "punctuation.definition.tag.xml.plist" - Data: "</"
-												12033-12039: "entity.name.tag.xml.plist"
-													12033-12039: "entity.name.tag.localname.xml.plist" - Data: "string"
-												12039-12040: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12047-12054: "meta.tag.dict.xml.plist"
-											12047-12049: "punctuation.definition.tag.xml.plist" - Data: "</"
-											12049-12053: "entity.name.tag.xml.plist"
-												12049-12053: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											12053-12054: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12061-12073: ""
-										12061-12066: "meta.tag.key.xml.plist"
-											12061-12062: "punctuation.definition.tag.xml.plist" - Data: "<"
-											12062-12065: "entity.name.tag.xml.plist"
-												12062-12065: "entity.name.tag.localname.xml.plist" - Data: "key"
-											12065-12066: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12067-12073: "meta.tag.key.xml.plist"
-											12067-12069: "punctuation.definition.tag.xml.plist" - Data: "</"
-											12069-12072: "entity.name.tag.xml.plist"
-												12069-12072: "entity.name.tag.localname.xml.plist" - Data: "key"
-											12072-12073: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12080-12173: ""
-										12080-12086: "meta.tag.dict.xml.plist"
-											12080-12081: "punctuation.definition.tag.xml.plist" - Data: "<"
-											12081-12085: "entity.name.tag.xml.plist"
-												12081-12085: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											12085-12086: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12094-12109: ""
-											12094-12099: "meta.tag.key.xml.plist"
-												12094-12095: "punctuation.definition.tag.xml.plist" - Data: "<"
-												12095-12098: "entity.name.tag.xml.plist"
-													12095-12098: "entity.name.tag.localname.xml.plist" - Data: "key"
-												12098-12099: "punctuation.definition.tag.xml.plist" - Data: ">"
-											12103-12109: "meta.tag.key.xml.plist"
-												12103-12105: "punctuation.definition.tag.xml.plist" - Data: "</"
-												12105-12108: "entity.name.tag.xml.plist"
-													12105-12108: "entity.name.tag.localname.xml.plist" - Data: "key"
-												12108-12109: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12117-12159: ""
-											12117-12125: "meta.tag.string.xml.plist"
-												12117-12118: "punctuation.definition.tag.xml.plist" - Data: "<"
-												12118-12124: "entity.name.tag.xml.plist"
-													12118-12124: "entity.name.tag.localname.xml.plist" - Data: "string"
-												12124-12125: "punctuation.definition.tag.xml.plist" - Data: ">"
-											12150-12159: "meta.tag.string.xml.plist"
-												12150-12152: "punctuation.definition.tag.xml.plist" - Data: "</"
-												12152-12158: "entity.name.tag.xml.plist"
-													12152-12158: "entity.name.tag.localname.xml.plist" - Data: "string"
-												12158-12159: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12166-12173: "meta.tag.dict.xml.plist"
-											12166-12168: "punctuation.definition.tag.xml.plist" - Data: "</"
-											12168-12172: "entity.name.tag.xml.plist"
-												12168-12172: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											12172-12173: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12180-12192: ""
-										12180-12185: "meta.tag.key.xml.plist"
-											12180-12181: "punctuation.definition.tag.xml.plist" - Data: "<"
-											12181-12184: "entity.name.tag.xml.plist"
-												12181-12184: "entity.name.tag.localname.xml.plist" - Data: "key"
-											12184-12185: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12186-12192: "meta.tag.key.xml.plist"
-											12186-12188: "punctuation.definition.tag.xml.plist" - Data: "</"
-											12188-12191: "entity.name.tag.xml.plist"
-												12188-12191: "entity.name.tag.localname.xml.plist" - Data: "key"
-											12191-12192: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12199-12302: ""
-										12199-12205: "meta.tag.dict.xml.plist"
-											12199-12200: "punctuation.definition.tag.xml.plist" - Data: "<"
-											12200-12204: "entity.name.tag.xml.plist"
-												12200-12204: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											12204-12205: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12213-12228: ""
-											12213-12218: "meta.tag.key.xml.plist"
-												12213-12214: "punctuation.definition.tag.xml.plist" - Data: "<"
-												12214-12217: "entity.name.tag.xml.plist"
-													12214-12217: "entity.name.tag.localname.xml.plist" - Data: "key"
-												12217-12218: "punctuation.definition.tag.xml.plist" - Data: ">"
-											12222-12228: "meta.tag.key.xml.plist"
-												12222-12224: "punctuation.definition.tag.xml.plist" - Data: "</"
-												12224-12227: "entity.name.tag.xml.plist"
-													12224-12227: "entity.name.tag.localname.xml.plist" - Data: "key"
-												12227-12228: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12236-12288: ""
-											12236-12244: "meta.tag.string.xml.plist"
-												12236-12237: "punctuation.definition.tag.xml.plist" - Data: "<"
-												12237-12243: "entity.name.tag.xml.plist"
-													12237-12243: "entity.name.tag.localname.xml.plist" - Data: "string"
-												12243-12244: "punctuation.definition.tag.xml.plist" - Data: ">"
-											12279-12288: "meta.tag.string.xml.plist"
-												12279-12281: "punctuation.definition.tag.xml.plist" - Data: "</"
-												12281-12287: "entity.name.tag.xml.plist"
-													12281-12287: "entity.name.tag.localname.xml.plist" - Data: "string"
-												12287-12288: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12295-12302: "meta.tag.dict.xml.plist"
-											12295-12297: "punctuation.definition.tag.xml.plist" - Data: "</"
-											12297-12301: "entity.name.tag.xml.plist"
-												12297-12301: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											12301-12302: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12309-12321: ""
-										12309-12314: "meta.tag.key.xml.plist"
-											12309-12310: "punctuation.definition.tag.xml.plist" - Data: "<"
-											12310-12313: "entity.name.tag.xml.plist"
-												12310-12313: "entity.name.tag.localname.xml.plist" - Data: "key"
-											12313-12314: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12315-12321: "meta.tag.key.xml.plist"
-											12315-12317: "punctuation.definition.tag.xml.plist" - Data: "</"
-											12317-12320: "entity.name.tag.xml.plist"
-												12317-12320: "entity.name.tag.localname.xml.plist" - Data: "key"
-											12320-12321: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12328-12432: ""
-										12328-12334: "meta.tag.dict.xml.plist"
-											12328-12329: "punctuation.definition.tag.xml.plist" - Data: "<"
-											12329-12333: "entity.name.tag.xml.plist"
-												12329-12333: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											12333-12334: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12342-12357: ""
-											12342-12347: "meta.tag.key.xml.plist"
-												12342-12343: "punctuation.definition.tag.xml.plist" - Data: "<"
-												12343-12346: "entity.name.tag.xml.plist"
-													12343-12346: "entity.name.tag.localname.xml.plist" - Data: "key"
-												12346-12347: "punctuation.definition.tag.xml.plist" - Data: ">"
-											12351-12357: "meta.tag.key.xml.plist"
-												12351-12353: "punctuation.definition.tag.xml.plist" - Data: "</"
-												12353-12356: "entity.name.tag.xml.plist"
-													12353-12356: "entity.name.tag.localname.xml.plist" - Data: "key"
-												12356-12357: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12365-12418: ""
-											12365-12373: "meta.tag.string.xml.plist"
-												12365-12366: "punctuation.definition.tag.xml.plist" - Data: "<"
-												12366-12372: "entity.name.tag.xml.plist"
-													12366-12372: "entity.name.tag.localname.xml.plist" - Data: "string"
-												12372-12373: "punctuation.definition.tag.xml.plist" - Data: ">"
-											12409-12418: "meta.tag.string.xml.plist"
-												12409-12411: "punctuation.definition.tag.xml.plist" - Data: "</"
-												12411-12417: "entity.name.tag.xml.plist"
-													12411-12417: "entity.name.tag.localname.xml.plist" - Data: "string"
-												12417-12418: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12425-12432: "meta.tag.dict.xml.plist"
-											12425-12427: "punctuation.definition.tag.xml.plist" - Data: "</"
-											12427-12431: "entity.name.tag.xml.plist"
-												12427-12431: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											12431-12432: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12438-12445: "meta.tag.dict.xml.plist"
-										12438-12440: "punctuation.definition.tag.xml.plist" - Data: "</"
-										12440-12444: "entity.name.tag.xml.plist"
-											12440-12444: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										12444-12445: "punctuation.definition.tag.xml.plist" - Data: ">"
-								12451-12469: ""
-									12451-12456: "meta.tag.key.xml.plist"
-										12451-12452: "punctuation.definition.tag.xml.plist" - Data: "<"
-										12452-12455: "entity.name.tag.xml.plist"
-											12452-12455: "entity.name.tag.localname.xml.plist" - Data: "key"
-										12455-12456: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12463-12469: "meta.tag.key.xml.plist"
-										12463-12465: "punctuation.definition.tag.xml.plist" - Data: "</"
-										12465-12468: "entity.name.tag.xml.plist"
-											12465-12468: "entity.name.tag.localname.xml.plist" - Data: "key"
-										12468-12469: "punctuation.definition.tag.xml.plist" - Data: ">"
-								12475-12590: ""
-									12475-12483: "meta.tag.string.xml.plist"
-										12475-12476: "punctuation.definition.tag.xml.plist" - Data: "<"
-										12476-12482: "entity.name.tag.xml.plist"
-											12476-12482: "entity.name.tag.localname.xml.plist" - Data: "string"
-										12482-12483: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12581-12590: "meta.tag.string.xml.plist"
-										12581-12583: "punctuation.definition.tag.xml.plist" - Data: "</"
-										12583-12589: "entity.name.tag.xml.plist"
-											12583-12589: "entity.name.tag.localname.xml.plist" - Data: "string"
-										12589-12590: "punctuation.definition.tag.xml.plist" - Data: ">"
-								12596-12618: ""
-									12596-12601: "meta.tag.key.xml.plist"
-										12596-12597: "punctuation.definition.tag.xml.plist" - Data: "<"
-										12597-12600: "entity.name.tag.xml.plist"
-											12597-12600: "entity.name.tag.localname.xml.plist" - Data: "key"
-										12600-12601: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12612-12618: "meta.tag.key.xml.plist"
-										12612-12614: "punctuation.definition.tag.xml.plist" - Data: "</"
-										12614-12617: "entity.name.tag.xml.plist"
-											12614-12617: "entity.name.tag.localname.xml.plist" - Data: "key"
-										12617-12618: "punctuation.definition.tag.xml.plist" - Data: ">"
-								12624-12670: ""
-									12624-12632: "meta.tag.string.xml.plist"
-										12624-12625: "punctuation.definition.tag.xml.plist" - Data: "<"
-										12625-12631: "entity.name.tag.xml.plist"
-											12625-12631: "entity.name.tag.localname.xml.plist" - Data: "string"
-										12631-12632: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12661-12670: "meta.tag.string.xml.plist"
-										12661-12663: "punctuation.definition.tag.xml.plist" - Data: "</"
-										12663-12669: "entity.name.tag.xml.plist"
-											12663-12669: "entity.name.tag.localname.xml.plist" - Data: "string"
-										12669-12670: "punctuation.definition.tag.xml.plist" - Data: ">"
-								12676-12690: ""
-									12676-12681: "meta.tag.key.xml.plist"
-										12676-12677: "punctuation.definition.tag.xml.plist" - Data: "<"
-										12677-12680: "entity.name.tag.xml.plist"
-											12677-12680: "entity.name.tag.localname.xml.plist" - Data: "key"
-										12680-12681: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12684-12690: "meta.tag.key.xml.plist"
-										12684-12686: "punctuation.definition.tag.xml.plist" - Data: "</"
-										12686-12689: "entity.name.tag.xml.plist"
-											12686-12689: "entity.name.tag.localname.xml.plist" - Data: "key"
-										12689-12690: "punctuation.definition.tag.xml.plist" - Data: ">"
-								12696-12735: ""
-									12696-12704: "meta.tag.string.xml.plist"
-										12696-12697: "punctuation.definition.tag.xml.plist" - Data: "<"
-										12697-12703: "entity.name.tag.xml.plist"
-											12697-12703: "entity.name.tag.localname.xml.plist" - Data: "string"
-										12703-12704: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12726-12735: "meta.tag.string.xml.plist"
-										12726-12728: "punctuation.definition.tag.xml.plist" - Data: "</"
-										12728-12734: "entity.name.tag.xml.plist"
-											12728-12734: "entity.name.tag.localname.xml.plist" - Data: "string"
-										12734-12735: "punctuation.definition.tag.xml.plist" - Data: ">"
-								12741-12760: ""
-									12741-12746: "meta.tag.key.xml.plist"
-										12741-12742: "punctuation.definition.tag.xml.plist" - Data: "<"
-										12742-12745: "entity.name.tag.xml.plist"
-											12742-12745: "entity.name.tag.localname.xml.plist" - Data: "key"
-										12745-12746: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12754-12760: "meta.tag.key.xml.plist"
-										12754-12756: "punctuation.definition.tag.xml.plist" - Data: "</"
-										12756-12759: "entity.name.tag.xml.plist"
-											12756-12759: "entity.name.tag.localname.xml.plist" - Data: "key"
-										12759-12760: "punctuation.definition.tag.xml.plist" - Data: ">"
-								12766-13125: ""
-									12766-12773: "meta.tag.array.xml.plist"
-										12766-12767: "punctuation.definition.tag.xml.plist" - Data: "<"
-										12767-12772: "entity.name.tag.xml.plist"
-											12767-12772: "entity.name.tag.localname.xml.plist" - Data: "array"
-										12772-12773: "punctuation.definition.tag.xml.plist" - Data: ">"
-									12780-13111: ""
-										12780-12786: "meta.tag.dict.xml.plist"
-											12780-12781: "punctuation.definition.tag.xml.plist" - Data: "<"
-											12781-12785: "entity.name.tag.xml.plist"
-												12781-12785: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											12785-12786: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12794-12810: ""
-											12794-12799: "meta.tag.key.xml.plist"
-												12794-12795: "punctuation.definition.tag.xml.plist" - Data: "<"
-												12795-12798: "entity.name.tag.xml.plist"
-													12795-12798: "entity.name.tag.localname.xml.plist" - Data: "key"
-												12798-12799: "punctuation.definition.tag.xml.plist" - Data: ">"
-											12804-12810: "meta.tag.key.xml.plist"
-												12804-12806: "punctuation.definition.tag.xml.plist" - Data: "</"
-												12806-12809: "entity.name.tag.xml.plist"
-													12806-12809: "entity.name.tag.localname.xml.plist" - Data: "key"
-												12809-12810: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12818-12849: ""
-											12818-12826: "meta.tag.string.xml.plist"
-												12818-12819: "punctuation.definition.tag.xml.plist" - Data: "<"
-												12819-12825: "entity.name.tag.xml.plist"
-													12819-12825: "entity.name.tag.localname.xml.plist" - Data: "string"
-												12825-12826: "punctuation.definition.tag.xml.plist" - Data: ">"
-											12840-12849: "meta.tag.string.xml.plist"
-												12840-12842: "punctuation.definition.tag.xml.plist" - Data: "</"
-												12842-12848: "entity.name.tag.xml.plist"
-													12842-12848: "entity.name.tag.localname.xml.plist" - Data: "string"
-												12848-12849: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12857-12876: ""
-											12857-12862: "meta.tag.key.xml.plist"
-												12857-12858: "punctuation.definition.tag.xml.plist" - Data: "<"
-												12858-12861: "entity.name.tag.xml.plist"
-													12858-12861: "entity.name.tag.localname.xml.plist" - Data: "key"
-												12861-12862: "punctuation.definition.tag.xml.plist" - Data: ">"
-											12870-12876: "meta.tag.key.xml.plist"
-												12870-12872: "punctuation.definition.tag.xml.plist" - Data: "</"
-												12872-12875: "entity.name.tag.xml.plist"
-													12872-12875: "entity.name.tag.localname.xml.plist" - Data: "key"
-												12875-12876: "punctuation.definition.tag.xml.plist" - Data: ">"
-										12884-13044: ""
-											12884-12890: "meta.tag.dict.xml.plist"
-												12884-12885: "punctuation.definition.tag.xml.plist" - Data: "<"
-												12885-12889: "entity.name.tag.xml.plist"
-													12885-12889: "entity.name.tag.localname.xml.plist" - Data: "dict"
-												12889-12890: "punctuation.definition.tag.xml.plist" - Data: ">"
-											12899-12911: ""
-												12899-12904: "meta.tag.key.xml.plist"
-													12899-12900: "punctuation.definition.tag.xml.plist" - Data: "<"
-													12900-12903: "entity.name.tag.xml.plist"
-														12900-12903: "entity.name.tag.localname.xml.plist" - Data: "key"
-													12903-12904: "punctuation.definition.tag.xml.plist" - Data: ">"
-												12905-12911: "meta.tag.key.xml.plist"
-													12905-12907: "punctuation.definition.tag.xml.plist" - Data: "</"
-													12907-12910: "entity.name.tag.xml.plist"
-														12907-12910: "entity.name.tag.localname.xml.plist" - Data: "key"
-													12910-12911: "punctuation.definition.tag.xml.plist" - Data: ">"
-											12920-13029: ""
-												12920-12926: "meta.tag.dict.xml.plist"
-													12920-12921: "punctuation.definition.tag.xml.plist" - Data: "<"
-													12921-12925: "entity.name.tag.xml.plist"
-														12921-12925: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													12925-12926: "punctuation.definition.tag.xml.plist" - Data: ">"
-												12936-12951: ""
-													12936-12941: "meta.tag.key.xml.plist"
-														12936-12937: "punctuation.definition.tag.xml.plist" - Data: "<"
-														12937-12940: "entity.name.tag.xml.plist"
-															12937-12940: "entity.name.tag.localname.xml.plist" - Data: "key"
-														12940-12941: "punctuation.definition.tag.xml.plist" - Data: ">"
-													12945-12951: "meta.tag.key.xml.plist"
-														12945-12947: "punctuation.definition.tag.xml.plist" - Data: "</"
-														12947-12950: "entity.name.tag.xml.plist"
-															12947-12950: "entity.name.tag.localname.xml.plist" - Data: "key"
-														12950-12951: "punctuation.definition.tag.xml.plist" - Data: ">"
-												12961-13013: ""
-													12961-12969: "meta.tag.string.xml.plist"
-														12961-12962: "punctuation.definition.tag.xml.plist" - Data: "<"
-														12962-12968: "entity.name.tag.xml.plist"
-															12962-12968: "entity.name.tag.localname.xml.plist" - Data: "string"
-														12968-12969: "punctuation.definition.tag.xml.plist" - Data: ">"
-													13004-13013: "meta.tag.string.xml.plist"
-														13004-13006: "punctuation.definition.tag.xml.plist" - Data: "</"
-														13006-13012: "entity.name.tag.xml.plist"
-															13006-13012: "entity.name.tag.localname.xml.plist" - Data: "string"
-														13012-13013: "punctuation.definition.tag.xml.plist" - Data: ">"
-												13022-13029: "meta.tag.dict.xml.plist"
-													13022-13024: "punctuation.definition.tag.xml.plist" - Data: "</"
-													13024-13028: "entity.name.tag.xml.plist"
-														13024-13028: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													13028-13029: "punctuation.definition.tag.xml.plist" - Data: ">"
-											13037-13044: "meta.tag.dict.xml.plist"
-												13037-13039: "punctuation.definition.tag.xml.plist" - Data: "</"
-												13039-13043: "entity.name.tag.xml.plist"
-													13039-13043: "entity.name.tag.localname.xml.plist" - Data: "dict"
-												13043-13044: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13052-13066: ""
-											13052-13057: "meta.tag.key.xml.plist"
-												13052-13053: "punctuation.definition.tag.xml.plist" - Data: "<"
-												13053-13056: "entity.name.tag.xml.plist"
-													13053-13056: "entity.name.tag.localname.xml.plist" - Data: "key"
-												13056-13057: "punctuation.definition.tag.xml.plist" - Data: ">"
-											13060-13066: "meta.tag.key.xml.plist"
-												13060-13062: "punctuation.definition.tag.xml.plist" - Data: "</"
-												13062-13065: "entity.name.tag.xml.plist"
-													13062-13065: "entity.name.tag.localname.xml.plist" - Data: "key"
-												13065-13066: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13074-13097: ""
-											13074-13082: "meta.tag.string.xml.plist"
-												13074-13075: "punctuation.definition.tag.xml.plist" - Data: "<"
-												13075-13081: "entity.name.tag.xml.plist"
-													13075-13081: "entity.name.tag.localname.xml.plist" - Data: "string"
-												13081-13082: "punctuation.definition.tag.xml.plist" - Data: ">"
-											13088-13097: "meta.tag.string.xml.plist"
-												13088-13090: "punctuation.definition.tag.xml.plist" - Data: "</"
-												13090-13096: "entity.name.tag.xml.plist"
-													13090-13096: "entity.name.tag.localname.xml.plist" - Data: "string"
-												13096-13097: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13104-13111: "meta.tag.dict.xml.plist"
-											13104-13106: "punctuation.definition.tag.xml.plist" - Data: "</"
-											13106-13110: "entity.name.tag.xml.plist"
-												13106-13110: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											13110-13111: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13117-13125: "meta.tag.array.xml.plist"
-										13117-13119: "punctuation.definition.tag.xml.plist" - Data: "</"
-										13119-13124: "entity.name.tag.xml.plist"
-											13119-13124: "entity.name.tag.localname.xml.plist" - Data: "array"
-										13124-13125: "punctuation.definition.tag.xml.plist" - Data: ">"
-								13130-13137: "meta.tag.dict.xml.plist"
-									13130-13132: "punctuation.definition.tag.xml.plist" - Data: "</"
-									13132-13136: "entity.name.tag.xml.plist"
-										13132-13136: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									13136-13137: "punctuation.definition.tag.xml.plist" - Data: ">"
-							13142-13977: ""
-								13142-13148: "meta.tag.dict.xml.plist"
-									13142-13143: "punctuation.definition.tag.xml.plist" - Data: "<"
-									13143-13147: "entity.name.tag.xml.plist"
-										13143-13147: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									13147-13148: "punctuation.definition.tag.xml.plist" - Data: ">"
-								13154-13173: ""
-									13154-13159: "meta.tag.key.xml.plist"
-										13154-13155: "punctuation.definition.tag.xml.plist" - Data: "<"
-										13155-13158: "entity.name.tag.xml.plist"
-											13155-13158: "entity.name.tag.localname.xml.plist" - Data: "key"
-										13158-13159: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13167-13173: "meta.tag.key.xml.plist"
-										13167-13169: "punctuation.definition.tag.xml.plist" - Data: "</"
-										13169-13172: "entity.name.tag.xml.plist"
-											13169-13172: "entity.name.tag.localname.xml.plist" - Data: "key"
-										13172-13173: "punctuation.definition.tag.xml.plist" - Data: ">"
-								13179-13823: ""
-									13179-13185: "meta.tag.dict.xml.plist"
-										13179-13180: "punctuation.definition.tag.xml.plist" - Data: "<"
-										13180-13184: "entity.name.tag.xml.plist"
-											13180-13184: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										13184-13185: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13192-13204: ""
-										13192-13197: "meta.tag.key.xml.plist"
-											13192-13193: "punctuation.definition.tag.xml.plist" - Data: "<"
-											13193-13196: "entity.name.tag.xml.plist"
-												13193-13196: "entity.name.tag.localname.xml.plist" - Data: "key"
-											13196-13197: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13198-13204: "meta.tag.key.xml.plist"
-											13198-13200: "punctuation.definition.tag.xml.plist" - Data: "</"
-											13200-13203: "entity.name.tag.xml.plist"
-												13200-13203: "entity.name.tag.localname.xml.plist" - Data: "key"
-											13203-13204: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13211-13302: ""
-										13211-13217: "meta.tag.dict.xml.plist"
-											13211-13212: "punctuation.definition.tag.xml.plist" - Data: "<"
-											13212-13216: "entity.name.tag.xml.plist"
-												13212-13216: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											13216-13217: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13225-13240: ""
-											13225-13230: "meta.tag.key.xml.plist"
-												13225-13226: "punctuation.definition.tag.xml.plist" - Data: "<"
-												13226-13229: "entity.name.tag.xml.plist"
-													13226-13229: "entity.name.tag.localname.xml.plist" - Data: "key"
-												13229-13230: "punctuation.definition.tag.xml.plist" - Data: ">"
-											13234-13240: "meta.tag.key.xml.plist"
-												13234-13236: "punctuation.definition.tag.xml.plist" - Data: "</"
-												13236-13239: "entity.name.tag.xml.plist"
-													13236-13239: "entity.name.tag.localname.xml.plist" - Data: "key"
-												13239-13240: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13248-13288: ""
-											13248-13256: "meta.tag.string.xml.plist"
-												13248-13249: "punctuation.definition.tag.xml.plist" - Data: "<"
-												13249-13255: "entity.name.tag.xml.plist"
-													13249-13255: "entity.name.tag.localname.xml.plist" - Data: "string"
-												13255-13256: "punctuation.definition.tag.xml.plist" - Data: ">"
-											13279-13288: "meta.tag.string.xml.plist"
-												13279-13281: "punctuation.definition.tag.xml.plist" - Data: "</"
-												13281-13287: "entity.name.tag.xml.plist"
-													13281-13287: "entity.name.tag.localname.xml.plist" - Data: "string"
-												13287-13288: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13295-13302: "meta.tag.dict.xml.plist"
-											13295-13297: "punctuation.definition.tag.xml.plist" - Data: "</"
-											13297-13301: "entity.name.tag.xml.plist"
-												13297-13301: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											13301-13302: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13309-13321: ""
-										13309-13314: "meta.tag.key.xml.plist"
-											13309-13310: "punctuation.definition.tag.xml.plist" - Data: "<"
-											13310-13313: "entity.name.tag.xml.plist"
-												13310-13313: "entity.name.tag.localname.xml.plist" - Data: "key"
-											13313-13314: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13315-13321: "meta.tag.key.xml.plist"
-											13315-13317: "punctuation.definition.tag.xml.plist" - Data: "</"
-											13317-13320: "entity.name.tag.xml.plist"
-												13317-13320: "entity.name.tag.localname.xml.plist" - Data: "key"
-											13320-13321: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13328-13432: ""
-										13328-13334: "meta.tag.dict.xml.plist"
-											13328-13329: "punctuation.definition.tag.xml.plist" - Data: "<"
-											13329-13333: "entity.name.tag.xml.plist"
-												13329-13333: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											13333-13334: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13342-13357: ""
-											13342-13347: "meta.tag.key.xml.plist"
-												13342-13343: "punctuation.definition.tag.xml.plist" - Data: "<"
-												13343-13346: "entity.name.tag.xml.plist"
-													13343-13346: "entity.name.tag.localname.xml.plist" - Data: "key"
-												13346-13347: "punctuation.definition.tag.xml.plist" - Data: ">"
-											13351-13357: "meta.tag.key.xml.plist"
-												13351-13353: "punctuation.definition.tag.xml.plist" - Data: "</"
-												13353-13356: "entity.name.tag.xml.plist"
-													13353-13356: "entity.name.tag.localname.xml.plist" - Data: "key"
-												13356-13357: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13365-13418: ""
-											13365-13373: "meta.tag.string.xml.plist"
-												13365-13366: "punctuation.definition.tag.xml.plist" - Data: "<"
-												13366-13372: "entity.name.tag.xml.plist"
-													13366-13372: "entity.name.tag.localname.xml.plist" - Data: "string"
-												13372-13373: "punctuation.definition.tag.xml.plist" - Data: ">"
-											13409-13418: "meta.tag.string.xml.plist"
-												13409-13411: "punctuation.definition.tag.xml.plist" - Data: "</"
-												13411-13417: "entity.name.tag.xml.plist"
-													13411-13417: "entity.name.tag.localname.xml.plist" - Data: "string"
-												13417-13418: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13425-13432: "meta.tag.dict.xml.plist"
-											13425-13427: "punctuation.definition.tag.xml.plist" - Data: "</"
-											13427-13431: "entity.name.tag.xml.plist"
-												13427-13431: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											13431-13432: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13439-13451: ""
-										13439-13444: "meta.tag.key.xml.plist"
-											13439-13440: "punctuation.definition.tag.xml.plist" - Data: "<"
-											13440-13443: "entity.name.tag.xml.plist"
-												13440-13443: "entity.name.tag.localname.xml.plist" - Data: "key"
-											13443-13444: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13445-13451: "meta.tag.key.xml.plist"
-											13445-13447: "punctuation.definition.tag.xml.plist" - Data: "</"
-											13447-13450: "entity.name.tag.xml.plist"
-												13447-13450: "entity.name.tag.localname.xml.plist" - Data: "key"
-											13450-13451: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13458-13551: ""
-										13458-13464: "meta.tag.dict.xml.plist"
-											13458-13459: "punctuation.definition.tag.xml.plist" - Data: "<"
-											13459-13463: "entity.name.tag.xml.plist"
-												13459-13463: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											13463-13464: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13472-13487: ""
-											13472-13477: "meta.tag.key.xml.plist"
-												13472-13473: "punctuation.definition.tag.xml.plist" - Data: "<"
-												13473-13476: "entity.name.tag.xml.plist"
-													13473-13476: "entity.name.tag.localname.xml.plist" - Data: "key"
-												13476-13477: "punctuation.definition.tag.xml.plist" - Data: ">"
-											13481-13487: "meta.tag.key.xml.plist"
-												13481-13483: "punctuation.definition.tag.xml.plist" - Data: "</"
-												13483-13486: "entity.name.tag.xml.plist"
-													13483-13486: "entity.name.tag.localname.xml.plist" - Data: "key"
-												13486-13487: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13495-13537: ""
-											13495-13503: "meta.tag.string.xml.plist"
-												13495-13496: "punctuation.definition.tag.xml.plist" - Data: "<"
-												13496-13502: "entity.name.tag.xml.plist"
-													13496-13502: "entity.name.tag.localname.xml.plist" - Data: "string"
-												13502-13503: "punctuation.definition.tag.xml.plist" - Data: ">"
-											13528-13537: "meta.tag.string.xml.plist"
-												13528-13530: "punctuation.definition.tag.xml.plist" - Data: "</"
-												13530-13536: "entity.name.tag.xml.plist"
-													13530-13536: "entity.name.tag.localname.xml.plist" - Data: "string"
-												13536-13537: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13544-13551: "meta.tag.dict.xml.plist"
-											13544-13546: "punctuation.definition.tag.xml.plist" - Data: "</"
-											13546-13550: "entity.name.tag.xml.plist"
-												13546-13550: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											13550-13551: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13558-13570: ""
-										13558-13563: "meta.tag.key.xml.plist"
-											13558-13559: "punctuation.definition.tag.xml.plist" - Data: "<"
-											13559-13562: "entity.name.tag.xml.plist"
-												13559-13562: "entity.name.tag.localname.xml.plist" - Data: "key"
-											13562-13563: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13564-13570: "meta.tag.key.xml.plist"
-											13564-13566: "punctuation.definition.tag.xml.plist" - Data: "</"
-											13566-13569: "entity.name.tag.xml.plist"
-												13566-13569: "entity.name.tag.localname.xml.plist" - Data: "key"
-											13569-13570: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13577-13680: ""
-										13577-13583: "meta.tag.dict.xml.plist"
-											13577-13578: "punctuation.definition.tag.xml.plist" - Data: "<"
-											13578-13582: "entity.name.tag.xml.plist"
-												13578-13582: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											13582-13583: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13591-13606: ""
-											13591-13596: "meta.tag.key.xml.plist"
-												13591-13592: "punctuation.definition.tag.xml.plist" - Data: "<"
-												13592-13595: "entity.name.tag.xml.plist"
-													13592-13595: "entity.name.tag.localname.xml.plist" - Data: "key"
-												13595-13596: "punctuation.definition.tag.xml.plist" - Data: ">"
-											13600-13606: "meta.tag.key.xml.plist"
-												13600-13602: "punctuation.definition.tag.xml.plist" - Data: "</"
-												13602-13605: "entity.name.tag.xml.plist"
-													13602-13605: "entity.name.tag.localname.xml.plist" - Data: "key"
-												13605-13606: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13614-13666: ""
-											13614-13622: "meta.tag.string.xml.plist"
-												13614-13615: "punctuation.definition.tag.xml.plist" - Data: "<"
-												13615-13621: "entity.name.tag.xml.plist"
-													13615-13621: "entity.name.tag.localname.xml.plist" - Data: "string"
-												13621-13622: "punctuation.definition.tag.xml.plist" - Data: ">"
-											13657-13666: "meta.tag.string.xml.plist"
-												13657-13659: "punctuation.definition.tag.xml.plist" - Data: "</"
-												13659-13665: "entity.name.tag.xml.plist"
-													13659-13665: "entity.name.tag.localname.xml.plist" - Data: "string"
-												13665-13666: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13673-13680: "meta.tag.dict.xml.plist"
-											13673-13675: "punctuation.definition.tag.xml.plist" - Data: "</"
-											13675-13679: "entity.name.tag.xml.plist"
-												13675-13679: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											13679-13680: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13687-13699: ""
-										13687-13692: "meta.tag.key.xml.plist"
-											13687-13688: "punctuation.definition.tag.xml.plist" - Data: "<"
-											13688-13691: "entity.name.tag.xml.plist"
-												13688-13691: "entity.name.tag.localname.xml.plist" - Data: "key"
-											13691-13692: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13693-13699: "meta.tag.key.xml.plist"
-											13693-13695: "punctuation.definition.tag.xml.plist" - Data: "</"
-											13695-13698: "entity.name.tag.xml.plist"
-												13695-13698: "entity.name.tag.localname.xml.plist" - Data: "key"
-											13698-13699: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13706-13810: ""
-										13706-13712: "meta.tag.dict.xml.plist"
-											13706-13707: "punctuation.definition.tag.xml.plist" - Data: "<"
-											13707-13711: "entity.name.tag.xml.plist"
-												13707-13711: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											13711-13712: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13720-13735: ""
-											13720-13725: "meta.tag.key.xml.plist"
-												13720-13721: "punctuation.definition.tag.xml.plist" - Data: "<"
-												13721-13724: "entity.name.tag.xml.plist"
-													13721-13724: "entity.name.tag.localname.xml.plist" - Data: "key"
-												13724-13725: "punctuation.definition.tag.xml.plist" - Data: ">"
-											13729-13735: "meta.tag.key.xml.plist"
-												13729-13731: "punctuation.definition.tag.xml.plist" - Data: "</"
-												13731-13734: "entity.name.tag.xml.plist"
-													13731-13734: "entity.name.tag.localname.xml.plist" - Data: "key"
-												13734-13735: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13743-13796: ""
-											13743-13751: "meta.tag.string.xml.plist"
-												13743-13744: "punctuation.definition.tag.xml.plist" - Data: "<"
-												13744-13750: "entity.name.tag.xml.plist"
-													13744-13750: "entity.name.tag.localname.xml.plist" - Data: "string"
-												13750-13751: "punctuation.definition.tag.xml.plist" - Data: ">"
-											13787-13796: "meta.tag.string.xml.plist"
-												13787-13789: "punctuation.definition.tag.xml.plist" - Data: "</"
-												13789-13795: "entity.name.tag.xml.plist"
-													13789-13795: "entity.name.tag.localname.xml.plist" - Data: "string"
-												13795-13796: "punctuation.definition.tag.xml.plist" - Data: ">"
-										13803-13810: "meta.tag.dict.xml.plist"
-											13803-13805: "punctuation.definition.tag.xml.plist" - Data: "</"
-											13805-13809: "entity.name.tag.xml.plist"
-												13805-13809: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											13809-13810: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13816-13823: "meta.tag.dict.xml.plist"
-										13816-13818: "punctuation.definition.tag.xml.plist" - Data: "</"
-										13818-13822: "entity.name.tag.xml.plist"
-											13818-13822: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										13822-13823: "punctuation.definition.tag.xml.plist" - Data: ">"
-								13829-13847: ""
-									13829-13834: "meta.tag.key.xml.plist"
-										13829-13830: "punctuation.definition.tag.xml.plist" - Data: "<"
-										13830-13833: "entity.name.tag.xml.plist"
-											13830-13833: "entity.name.tag.localname.xml.plist" - Data: "key"
-										13833-13834: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13841-13847: "meta.tag.key.xml.plist"
-										13841-13843: "punctuation.definition.tag.xml.plist" - Data: "</"
-										13843-13846: "entity.name.tag.xml.plist"
-											13843-13846: "entity.name.tag.localname.xml.plist" - Data: "key"
-										13846-13847: "punctuation.definition.tag.xml.plist" - Data: ">"
-								13853-13893: ""
-									13853-13861: "meta.tag.string.xml.plist"
-										13853-13854: "punctuation.definition.tag.xml.plist" - Data: "<"
-										13854-13860: "entity.name.tag.xml.plist"
-											13854-13860: "entity.name.tag.localname.xml.plist" - Data: "string"
-										13860-13861: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13884-13893: "meta.tag.string.xml.plist"
-										13884-13886: "punctuation.definition.tag.xml.plist" - Data: "</"
-										13886-13892: "entity.name.tag.xml.plist"
-											13886-13892: "entity.name.tag.localname.xml.plist" - Data: "string"
-										13892-13893: "punctuation.definition.tag.xml.plist" - Data: ">"
-								13899-13915: ""
-									13899-13904: "meta.tag.key.xml.plist"
-										13899-13900: "punctuation.definition.tag.xml.plist" - Data: "<"
-										13900-13903: "entity.name.tag.xml.plist"
-											13900-13903: "entity.name.tag.localname.xml.plist" - Data: "key"
-										13903-13904: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13909-13915: "meta.tag.key.xml.plist"
-										13909-13911: "punctuation.definition.tag.xml.plist" - Data: "</"
-										13911-13914: "entity.name.tag.xml.plist"
-											13911-13914: "entity.name.tag.localname.xml.plist" - Data: "key"
-										13914-13915: "punctuation.definition.tag.xml.plist" - Data: ">"
-								13921-13965: ""
-									13921-13929: "meta.tag.string.xml.plist"
-										13921-13922: "punctuation.definition.tag.xml.plist" - Data: "<"
-										13922-13928: "entity.name.tag.xml.plist"
-											13922-13928: "entity.name.tag.localname.xml.plist" - Data: "string"
-										13928-13929: "punctuation.definition.tag.xml.plist" - Data: ">"
-									13956-13965: "meta.tag.string.xml.plist"
-										13956-13958: "punctuation.definition.tag.xml.plist" - Data: "</"
-										13958-13964: "entity.name.tag.xml.plist"
-											13958-13964: "entity.name.tag.localname.xml.plist" - Data: "string"
-										13964-13965: "punctuation.definition.tag.xml.plist" - Data: ">"
-								13970-13977: "meta.tag.dict.xml.plist"
-									13970-13972: "punctuation.definition.tag.xml.plist" - Data: "</"
-									13972-13976: "entity.name.tag.xml.plist"
-										13972-13976: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									13976-13977: "punctuation.definition.tag.xml.plist" - Data: ">"
-							13982-14814: ""
-								13982-13988: "meta.tag.dict.xml.plist"
-									13982-13983: "punctuation.definition.tag.xml.plist" - Data: "<"
-									13983-13987: "entity.name.tag.xml.plist"
-										13983-13987: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									13987-13988: "punctuation.definition.tag.xml.plist" - Data: ">"
-								13994-14013: ""
-									13994-13999: "meta.tag.key.xml.plist"
-										13994-13995: "punctuation.definition.tag.xml.plist" - Data: "<"
-										13995-13998: "entity.name.tag.xml.plist"
-											13995-13998: "entity.name.tag.localname.xml.plist" - Data: "key"
-										13998-13999: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14007-14013: "meta.tag.key.xml.plist"
-										14007-14009: "punctuation.definition.tag.xml.plist" - Data: "</"
-										14009-14012: "entity.name.tag.xml.plist"
-											14009-14012: "entity.name.tag.localname.xml.plist" - Data: "key"
-										14012-14013: "punctuation.definition.tag.xml.plist" - Data: ">"
-								14019-14664: ""
-									14019-14025: "meta.tag.dict.xml.plist"
-										14019-14020: "punctuation.definition.tag.xml.plist" - Data: "<"
-										14020-14024: "entity.name.tag.xml.plist"
-											14020-14024: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										14024-14025: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14032-14044: ""
-										14032-14037: "meta.tag.key.xml.plist"
-											14032-14033: "punctuation.definition.tag.xml.plist" - Data: "<"
-											14033-14036: "entity.name.tag.xml.plist"
-												14033-14036: "entity.name.tag.localname.xml.plist" - Data: "key"
-											14036-14037: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14038-14044: "meta.tag.key.xml.plist"
-											14038-14040: "punctuation.definition.tag.xml.plist" - Data: "</"
-											14040-14043: "entity.name.tag.xml.plist"
-												14040-14043: "entity.name.tag.localname.xml.plist" - Data: "key"
-											14043-14044: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14051-14143: ""
-										14051-14057: "meta.tag.dict.xml.plist"
-											14051-14052: "punctuation.definition.tag.xml.plist" - Data: "<"
-											14052-14056: "entity.name.tag.xml.plist"
-												14052-14056: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											14056-14057: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14065-14080: ""
-											14065-14070: "meta.tag.key.xml.plist"
-												14065-14066: "punctuation.definition.tag.xml.plist" - Data: "<"
-												14066-14069: "entity.name.tag.xml.plist"
-													14066-14069: "entity.name.tag.localname.xml.plist" - Data: "key"
-												14069-14070: "punctuation.definition.tag.xml.plist" - Data: ">"
-											14074-14080: "meta.tag.key.xml.plist"
-												14074-14076: "punctuation.definition.tag.xml.plist" - Data: "</"
-												14076-14079: "entity.name.tag.xml.plist"
-													14076-14079: "entity.name.tag.localname.xml.plist" - Data: "key"
-												14079-14080: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14088-14129: ""
-											14088-14096: "meta.tag.string.xml.plist"
-												14088-14089: "punctuation.definition.tag.xml.plist" - Data: "<"
-												14089-14095: "entity.name.tag.xml.plist"
-													14089-14095: "entity.name.tag.localname.xml.plist" - Data: "string"
-												14095-14096: "punctuation.definition.tag.xml.plist" - Data: ">"
-											14120-14129: "meta.tag.string.xml.plist"
-												14120-14122: "punctuation.definition.tag.xml.plist" - Data: "</"
-												14122-14128: "entity.name.tag.xml.plist"
-													14122-14128: "entity.name.tag.localname.xml.plist" - Data: "string"
-												14128-14129: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14136-14143: "meta.tag.dict.xml.plist"
-											14136-14138: "punctuation.definition.tag.xml.plist" - Data: "</"
-											14138-14142: "entity.name.tag.xml.plist"
-												14138-14142: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											14142-14143: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14150-14162: ""
-										14150-14155: "meta.tag.key.xml.plist"
-											14150-14151: "punctuation.definition.tag.xml.plist" - Data: "<"
-											14151-14154: "entity.name.tag.xml.plist"
-												14151-14154: "entity.name.tag.localname.xml.plist" - Data: "key"
-											14154-14155: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14156-14162: "meta.tag.key.xml.plist"
-											14156-14158: "punctuation.definition.tag.xml.plist" - Data: "</"
-											14158-14161: "entity.name.tag.xml.plist"
-												14158-14161: "entity.name.tag.localname.xml.plist" - Data: "key"
-											14161-14162: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14169-14273: ""
-										14169-14175: "meta.tag.dict.xml.plist"
-											14169-14170: "punctuation.definition.tag.xml.plist" - Data: "<"
-											14170-14174: "entity.name.tag.xml.plist"
-												14170-14174: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											14174-14175: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14183-14198: ""
-											14183-14188: "meta.tag.key.xml.plist"
-												14183-14184: "punctuation.definition.tag.xml.plist" - Data: "<"
-												14184-14187: "entity.name.tag.xml.plist"
-													14184-14187: "entity.name.tag.localname.xml.plist" - Data: "key"
-												14187-14188: "punctuation.definition.tag.xml.plist" - Data: ">"
-											14192-14198: "meta.tag.key.xml.plist"
-												14192-14194: "punctuation.definition.tag.xml.plist" - Data: "</"
-												14194-14197: "entity.name.tag.xml.plist"
-													14194-14197: "entity.name.tag.localname.xml.plist" - Data: "key"
-												14197-14198: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14206-14259: ""
-											14206-14214: "meta.tag.string.xml.plist"
-												14206-14207: "punctuation.definition.tag.xml.plist" - Data: "<"
-												14207-14213: "entity.name.tag.xml.plist"
-													14207-14213: "entity.name.tag.localname.xml.plist" - Data: "string"
-												14213-14214: "punctuation.definition.tag.xml.plist" - Data: ">"
-											14250-14259: "meta.tag.string.xml.plist"
-												14250-14252: "punctuation.definition.tag.xml.plist" - Data: "</"
-												14252-14258: "entity.name.tag.xml.plist"
-													14252-14258: "entity.name.tag.localname.xml.plist" - Data: "string"
-												14258-14259: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14266-14273: "meta.tag.dict.xml.plist"
-											14266-14268: "punctuation.definition.tag.xml.plist" - Data: "</"
-											14268-14272: "entity.name.tag.xml.plist"
-												14268-14272: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											14272-14273: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14280-14292: ""
-										14280-14285: "meta.tag.key.xml.plist"
-											14280-14281: "punctuation.definition.tag.xml.plist" - Data: "<"
-											14281-14284: "entity.name.tag.xml.plist"
-												14281-14284: "entity.name.tag.localname.xml.plist" - Data: "key"
-											14284-14285: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14286-14292: "meta.tag.key.xml.plist"
-											14286-14288: "punctuation.definition.tag.xml.plist" - Data: "</"
-											14288-14291: "entity.name.tag.xml.plist"
-												14288-14291: "entity.name.tag.localname.xml.plist" - Data: "key"
-											14291-14292: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14299-14392: ""
-										14299-14305: "meta.tag.dict.xml.plist"
-											14299-14300: "punctuation.definition.tag.xml.plist" - Data: "<"
-											14300-14304: "entity.name.tag.xml.plist"
-												14300-14304: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											14304-14305: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14313-14328: ""
-											14313-14318: "meta.tag.key.xml.plist"
-												14313-14314: "punctuation.definition.tag.xml.plist" - Data: "<"
-												14314-14317: "entity.name.tag.xml.plist"
-													14314-14317: "entity.name.tag.localname.xml.plist" - Data: "key"
-												14317-14318: "punctuation.definition.tag.xml.plist" - Data: ">"
-											14322-14328: "meta.tag.key.xml.plist"
-												14322-14324: "punctuation.definition.tag.xml.plist" - Data: "</"
-												14324-14327: "entity.name.tag.xml.plist"
-													14324-14327: "entity.name.tag.localname.xml.plist" - Data: "key"
-												14327-14328: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14336-14378: ""
-											14336-14344: "meta.tag.string.xml.plist"
-												14336-14337: "punctuation.definition.tag.xml.plist" - Data: "<"
-												14337-14343: "entity.name.tag.xml.plist"
-													14337-14343: "entity.name.tag.localname.xml.plist" - Data: "string"
-												14343-14344: "punctuation.definition.tag.xml.plist" - Data: ">"
-											14369-14378: "meta.tag.string.xml.plist"
-												14369-14371: "punctuation.definition.tag.xml.plist" - Data: "</"
-												14371-14377: "entity.name.tag.xml.plist"
-													14371-14377: "entity.name.tag.localname.xml.plist" - Data: "string"
-												14377-14378: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14385-14392: "meta.tag.dict.xml.plist"
-											14385-14387: "punctuation.definition.tag.xml.plist" - Data: "</"
-											14387-14391: "entity.name.tag.xml.plist"
-												14387-14391: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											14391-14392: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14399-14411: ""
-										14399-14404: "meta.tag.key.xml.plist"
-											14399-14400: "punctuation.definition.tag.xml.plist" - Data: "<"
-											14400-14403: "entity.name.tag.xml.plist"
-												14400-14403: "entity.name.tag.localname.xml.plist" - Data: "key"
-											14403-14404: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14405-14411: "meta.tag.key.xml.plist"
-											14405-14407: "punctuation.definition.tag.xml.plist" - Data: "</"
-											14407-14410: "entity.name.tag.xml.plist"
-												14407-14410: "entity.name.tag.localname.xml.plist" - Data: "key"
-											14410-14411: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14418-14521: ""
-										14418-14424: "meta.tag.dict.xml.plist"
-											14418-14419: "punctuation.definition.tag.xml.plist" - Data: "<"
-											14419-14423: "entity.name.tag.xml.plist"
-												14419-14423: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											14423-14424: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14432-14447: ""
-											14432-14437: "meta.tag.key.xml.plist"
-												14432-14433: "punctuation.definition.tag.xml.plist" - Data: "<"
-												14433-14436: "entity.name.tag.xml.plist"
-													14433-14436: "entity.name.tag.localname.xml.plist" - Data: "key"
-												14436-14437: "punctuation.definition.tag.xml.plist" - Data: ">"
-											14441-14447: "meta.tag.key.xml.plist"
-												14441-14443: "punctuation.definition.tag.xml.plist" - Data: "</"
-												14443-14446: "entity.name.tag.xml.plist"
-													14443-14446: "entity.name.tag.localname.xml.plist" - Data: "key"
-												14446-14447: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14455-14507: ""
-											14455-14463: "meta.tag.string.xml.plist"
-												14455-14456: "punctuation.definition.tag.xml.plist" - Data: "<"
-												14456-14462: "entity.name.tag.xml.plist"
-													14456-14462: "entity.name.tag.localname.xml.plist" - Data: "string"
-												14462-14463: "punctuation.definition.tag.xml.plist" - Data: ">"
-											14498-14507: "meta.tag.string.xml.plist"
-												14498-14500: "punctuation.definition.tag.xml.plist" - Data: "</"
-												14500-14506: "entity.name.tag.xml.plist"
-													14500-14506: "entity.name.tag.localname.xml.plist" - Data: "string"
-												14506-14507: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14514-14521: "meta.tag.dict.xml.plist"
-											14514-14516: "punctuation.definition.tag.xml.plist" - Data: "</"
-											14516-14520: "entity.name.tag.xml.plist"
-												14516-14520: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											14520-14521: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14528-14540: ""
-										14528-14533: "meta.tag.key.xml.plist"
-											14528-14529: "punctuation.definition.tag.xml.plist" - Data: "<"
-											14529-14532: "entity.name.tag.xml.plist"
-												14529-14532: "entity.name.tag.localname.xml.plist" - Data: "key"
-											14532-14533: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14534-14540: "meta.tag.key.xml.plist"
-											14534-14536: "punctuation.definition.tag.xml.plist" - Data: "</"
-											14536-14539: "entity.name.tag.xml.plist"
-												14536-14539: "entity.name.tag.localname.xml.plist" - Data: "key"
-											14539-14540: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14547-14651: ""
-										14547-14553: "meta.tag.dict.xml.plist"
-											14547-14548: "punctuation.definition.tag.xml.plist" - Data: "<"
-											14548-14552: "entity.name.tag.xml.plist"
-												14548-14552: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											14552-14553: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14561-14576: ""
-											14561-14566: "meta.tag.key.xml.plist"
-												14561-14562: "punctuation.definition.tag.xml.plist" - Data: "<"
-												14562-14565: "entity.name.tag.xml.plist"
-													14562-14565: "entity.name.tag.localname.xml.plist" - Data: "key"
-												14565-14566: "punctuation.definition.tag.xml.plist" - Data: ">"
-											14570-14576: "meta.tag.key.xml.plist"
-												14570-14572: "punctuation.definition.tag.xml.plist" - Data: "</"
-												14572-14575: "entity.name.tag.xml.plist"
-													14572-14575: "entity.name.tag.localname.xml.plist" - Data: "key"
-												14575-14576: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14584-14637: ""
-											14584-14592: "meta.tag.string.xml.plist"
-												14584-14585: "punctuation.definition.tag.xml.plist" - Data: "<"
-												14585-14591: "entity.name.tag.xml.plist"
-													14585-14591: "entity.name.tag.localname.xml.plist" - Data: "string"
-												14591-14592: "punctuation.definition.tag.xml.plist" - Data: ">"
-											14628-14637: "meta.tag.string.xml.plist"
-												14628-14630: "punctuation.definition.tag.xml.plist" - Data: "</"
-												14630-14636: "entity.name.tag.xml.plist"
-													14630-14636: "entity.name.tag.localname.xml.plist" - Data: "string"
-												14636-14637: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14644-14651: "meta.tag.dict.xml.plist"
-											14644-14646: "punctuation.definition.tag.xml.plist" - Data: "</"
-											14646-14650: "entity.name.tag.xml.plist"
-												14646-14650: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											14650-14651: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14657-14664: "meta.tag.dict.xml.plist"
-										14657-14659: "punctuation.definition.tag.xml.plist" - Data: "</"
-										14659-14663: "entity.name.tag.xml.plist"
-											14659-14663: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										14663-14664: "punctuation.definition.tag.xml.plist" - Data: ">"
-								14670-14688: ""
-									14670-14675: "meta.tag.key.xml.plist"
-										14670-14671: "punctuation.definition.tag.xml.plist" - Data: "<"
-										14671-14674: "entity.name.tag.xml.plist"
-											14671-14674: "entity.name.tag.localname.xml.plist" - Data: "key"
-										14674-14675: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14682-14688: "meta.tag.key.xml.plist"
-										14682-14684: "punctuation.definition.tag.xml.plist" - Data: "</"
-										14684-14687: "entity.name.tag.xml.plist"
-											14684-14687: "entity.name.tag.localname.xml.plist" - Data: "key"
-										14687-14688: "punctuation.definition.tag.xml.plist" - Data: ">"
-								14694-14729: ""
-									14694-14702: "meta.tag.string.xml.plist"
-										14694-14695: "punctuation.definition.tag.xml.plist" - Data: "<"
-										14695-14701: "entity.name.tag.xml.plist"
-											14695-14701: "entity.name.tag.localname.xml.plist" - Data: "string"
-										14701-14702: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14720-14729: "meta.tag.string.xml.plist"
-										14720-14722: "punctuation.definition.tag.xml.plist" - Data: "</"
-										14722-14728: "entity.name.tag.xml.plist"
-											14722-14728: "entity.name.tag.localname.xml.plist" - Data: "string"
-										14728-14729: "punctuation.definition.tag.xml.plist" - Data: ">"
-								14735-14751: ""
-									14735-14740: "meta.tag.key.xml.plist"
-										14735-14736: "punctuation.definition.tag.xml.plist" - Data: "<"
-										14736-14739: "entity.name.tag.xml.plist"
-											14736-14739: "entity.name.tag.localname.xml.plist" - Data: "key"
-										14739-14740: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14745-14751: "meta.tag.key.xml.plist"
-										14745-14747: "punctuation.definition.tag.xml.plist" - Data: "</"
-										14747-14750: "entity.name.tag.xml.plist"
-											14747-14750: "entity.name.tag.localname.xml.plist" - Data: "key"
-										14750-14751: "punctuation.definition.tag.xml.plist" - Data: ">"
-								14757-14802: ""
-									14757-14765: "meta.tag.string.xml.plist"
-										14757-14758: "punctuation.definition.tag.xml.plist" - Data: "<"
-										14758-14764: "entity.name.tag.xml.plist"
-											14758-14764: "entity.name.tag.localname.xml.plist" - Data: "string"
-										14764-14765: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14793-14802: "meta.tag.string.xml.plist"
-										14793-14795: "punctuation.definition.tag.xml.plist" - Data: "</"
-										14795-14801: "entity.name.tag.xml.plist"
-											14795-14801: "entity.name.tag.localname.xml.plist" - Data: "string"
-										14801-14802: "punctuation.definition.tag.xml.plist" - Data: ">"
-								14807-14814: "meta.tag.dict.xml.plist"
-									14807-14809: "punctuation.definition.tag.xml.plist" - Data: "</"
-									14809-14813: "entity.name.tag.xml.plist"
-										14809-14813: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									14813-14814: "punctuation.definition.tag.xml.plist" - Data: ">"
-							14819-15654: ""
-								14819-14825: "meta.tag.dict.xml.plist"
-									14819-14820: "punctuation.definition.tag.xml.plist" - Data: "<"
-									14820-14824: "entity.name.tag.xml.plist"
-										14820-14824: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									14824-14825: "punctuation.definition.tag.xml.plist" - Data: ">"
-								14831-14850: ""
-									14831-14836: "meta.tag.key.xml.plist"
-										14831-14832: "punctuation.definition.tag.xml.plist" - Data: "<"
-										14832-14835: "entity.name.tag.xml.plist"
-											14832-14835: "entity.name.tag.localname.xml.plist" - Data: "key"
-										14835-14836: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14844-14850: "meta.tag.key.xml.plist"
-										14844-14846: "punctuation.definition.tag.xml.plist" - Data: "</"
-										14846-14849: "entity.name.tag.xml.plist"
-											14846-14849: "entity.name.tag.localname.xml.plist" - Data: "key"
-										14849-14850: "punctuation.definition.tag.xml.plist" - Data: ">"
-								14856-15502: ""
-									14856-14862: "meta.tag.dict.xml.plist"
-										14856-14857: "punctuation.definition.tag.xml.plist" - Data: "<"
-										14857-14861: "entity.name.tag.xml.plist"
-											14857-14861: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										14861-14862: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14869-14881: ""
-										14869-14874: "meta.tag.key.xml.plist"
-											14869-14870: "punctuation.definition.tag.xml.plist" - Data: "<"
-											14870-14873: "entity.name.tag.xml.plist"
-												14870-14873: "entity.name.tag.localname.xml.plist" - Data: "key"
-											14873-14874: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14875-14881: "meta.tag.key.xml.plist"
-											14875-14877: "punctuation.definition.tag.xml.plist" - Data: "</"
-											14877-14880: "entity.name.tag.xml.plist"
-												14877-14880: "entity.name.tag.localname.xml.plist" - Data: "key"
-											14880-14881: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14888-14981: ""
-										14888-14894: "meta.tag.dict.xml.plist"
-											14888-14889: "punctuation.definition.tag.xml.plist" - Data: "<"
-											14889-14893: "entity.name.tag.xml.plist"
-												14889-14893: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											14893-14894: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14902-14917: ""
-											14902-14907: "meta.tag.key.xml.plist"
-												14902-14903: "punctuation.definition.tag.xml.plist" - Data: "<"
-												14903-14906: "entity.name.tag.xml.plist"
-													14903-14906: "entity.name.tag.localname.xml.plist" - Data: "key"
-												14906-14907: "punctuation.definition.tag.xml.plist" - Data: ">"
-											14911-14917: "meta.tag.key.xml.plist"
-												14911-14913: "punctuation.definition.tag.xml.plist" - Data: "</"
-												14913-14916: "entity.name.tag.xml.plist"
-													14913-14916: "entity.name.tag.localname.xml.plist" - Data: "key"
-												14916-14917: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14925-14967: ""
-											14925-14933: "meta.tag.string.xml.plist"
-												14925-14926: "punctuation.definition.tag.xml.plist" - Data: "<"
-												14926-14932: "entity.name.tag.xml.plist"
-													14926-14932: "entity.name.tag.localname.xml.plist" - Data: "string"
-												14932-14933: "punctuation.definition.tag.xml.plist" - Data: ">"
-											14958-14967: "meta.tag.string.xml.plist"
-												14958-14960: "punctuation.definition.tag.xml.plist" - Data: "</"
-												14960-14966: "entity.name.tag.xml.plist"
-													14960-14966: "entity.name.tag.localname.xml.plist" - Data: "string"
-												14966-14967: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14974-14981: "meta.tag.dict.xml.plist"
-											14974-14976: "punctuation.definition.tag.xml.plist" - Data: "</"
-											14976-14980: "entity.name.tag.xml.plist"
-												14976-14980: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											14980-14981: "punctuation.definition.tag.xml.plist" - Data: ">"
-									14988-15000: ""
-										14988-14993: "meta.tag.key.xml.plist"
-											14988-14989: "punctuation.definition.tag.xml.plist" - Data: "<"
-											14989-14992: "entity.name.tag.xml.plist"
-												14989-14992: "entity.name.tag.localname.xml.plist" - Data: "key"
-											14992-14993: "punctuation.definition.tag.xml.plist" - Data: ">"
-										14994-15000: "meta.tag.key.xml.plist"
-											14994-14996: "punctuation.definition.tag.xml.plist" - Data: "</"
-											14996-14999: "entity.name.tag.xml.plist"
-												14996-14999: "entity.name.tag.localname.xml.plist" - Data: "key"
-											14999-15000: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15007-15111: ""
-										15007-15013: "meta.tag.dict.xml.plist"
-											15007-15008: "punctuation.definition.tag.xml.plist" - Data: "<"
-											15008-15012: "entity.name.tag.xml.plist"
-												15008-15012: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											15012-15013: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15021-15036: ""
-											15021-15026: "meta.tag.key.xml.plist"
-												15021-15022: "punctuation.definition.tag.xml.plist" - Data: "<"
-												15022-15025: "entity.name.tag.xml.plist"
-													15022-15025: "entity.name.tag.localname.xml.plist" - Data: "key"
-												15025-15026: "punctuation.definition.tag.xml.plist" - Data: ">"
-											15030-15036: "meta.tag.key.xml.plist"
-												15030-15032: "punctuation.definition.tag.xml.plist" - Data: "</"
-												15032-15035: "entity.name.tag.xml.plist"
-													15032-15035: "entity.name.tag.localname.xml.plist" - Data: "key"
-												15035-15036: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15044-15097: ""
-											15044-15052: "meta.tag.string.xml.plist"
-												15044-15045: "punctuation.definition.tag.xml.plist" - Data: "<"
-												15045-15051: "entity.name.tag.xml.plist"
-													15045-15051: "entity.name.tag.localname.xml.plist" - Data: "string"
-												15051-15052: "punctuation.definition.tag.xml.plist" - Data: ">"
-											15088-15097: "meta.tag.string.xml.plist"
-												15088-15090: "punctuation.definition.tag.xml.plist" - Data: "</"
-												15090-15096: "entity.name.tag.xml.plist"
-													15090-15096: "entity.name.tag.localname.xml.plist" - Data: "string"
-												15096-15097: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15104-15111: "meta.tag.dict.xml.plist"
-											15104-15106: "punctuation.definition.tag.xml.plist" - Data: "</"
-											15106-15110: "entity.name.tag.xml.plist"
-												15106-15110: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											15110-15111: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15118-15130: ""
-										15118-15123: "meta.tag.key.xml.plist"
-											15118-15119: "punctuation.definition.tag.xml.plist" - Data: "<"
-											15119-15122: "entity.name.tag.xml.plist"
-												15119-15122: "entity.name.tag.localname.xml.plist" - Data: "key"
-											15122-15123: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15124-15130: "meta.tag.key.xml.plist"
-											15124-15126: "punctuation.definition.tag.xml.plist" - Data: "</"
-											15126-15129: "entity.name.tag.xml.plist"
-												15126-15129: "entity.name.tag.localname.xml.plist" - Data: "key"
-											15129-15130: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15137-15230: ""
-										15137-15143: "meta.tag.dict.xml.plist"
-											15137-15138: "punctuation.definition.tag.xml.plist" - Data: "<"
-											15138-15142: "entity.name.tag.xml.plist"
-												15138-15142: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											15142-15143: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15151-15166: ""
-											15151-15156: "meta.tag.key.xml.plist"
-												15151-15152: "punctuation.definition.tag.xml.plist" - Data: "<"
-												15152-15155: "entity.name.tag.xml.plist"
-													15152-15155: "entity.name.tag.localname.xml.plist" - Data: "key"
-												15155-15156: "punctuation.definition.tag.xml.plist" - Data: ">"
-											15160-15166: "meta.tag.key.xml.plist"
-												15160-15162: "punctuation.definition.tag.xml.plist" - Data: "</"
-												15162-15165: "entity.name.tag.xml.plist"
-													15162-15165: "entity.name.tag.localname.xml.plist" - Data: "key"
-												15165-15166: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15174-15216: ""
-											15174-15182: "meta.tag.string.xml.plist"
-												15174-15175: "punctuation.definition.tag.xml.plist" - Data: "<"
-												15175-15181: "entity.name.tag.xml.plist"
-													15175-15181: "entity.name.tag.localname.xml.plist" - Data: "string"
-												15181-15182: "punctuation.definition.tag.xml.plist" - Data: ">"
-											15207-15216: "meta.tag.string.xml.plist"
-												15207-15209: "punctuation.definition.tag.xml.plist" - Data: "</"
-												15209-15215: "entity.name.tag.xml.plist"
-													15209-15215: "entity.name.tag.localname.xml.plist" - Data: "string"
-												15215-15216: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15223-15230: "meta.tag.dict.xml.plist"
-											15223-15225: "punctuation.definition.tag.xml.plist" - Data: "</"
-											15225-15229: "entity.name.tag.xml.plist"
-												15225-15229: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											15229-15230: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15237-15249: ""
-										15237-15242: "meta.tag.key.xml.plist"
-											15237-15238: "punctuation.definition.tag.xml.plist" - Data: "<"
-											15238-15241: "entity.name.tag.xml.plist"
-												15238-15241: "entity.name.tag.localname.xml.plist" - Data: "key"
-											15241-15242: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15243-15249: "meta.tag.key.xml.plist"
-											15243-15245: "punctuation.definition.tag.xml.plist" - Data: "</"
-											15245-15248: "entity.name.tag.xml.plist"
-												15245-15248: "entity.name.tag.localname.xml.plist" - Data: "key"
-											15248-15249: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15256-15359: ""
-										15256-15262: "meta.tag.dict.xml.plist"
-											15256-15257: "punctuation.definition.tag.xml.plist" - Data: "<"
-											15257-15261: "entity.name.tag.xml.plist"
-												15257-15261: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											15261-15262: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15270-15285: ""
-											15270-15275: "meta.tag.key.xml.plist"
-												15270-15271: "punctuation.definition.tag.xml.plist" - Data: "<"
-												15271-15274: "entity.name.tag.xml.plist"
-													15271-15274: "entity.name.tag.localname.xml.plist" - Data: "key"
-												15274-15275: "punctuation.definition.tag.xml.plist" - Data: ">"
-											15279-15285: "meta.tag.key.xml.plist"
-												15279-15281: "punctuation.definition.tag.xml.plist" - Data: "</"
-												15281-15284: "entity.name.tag.xml.plist"
-													15281-15284: "entity.name.tag.localname.xml.plist" - Data: "key"
-												15284-15285: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15293-15345: ""
-											15293-15301: "meta.tag.string.xml.plist"
-												15293-15294: "punctuation.definition.tag.xml.plist" - Data: "<"
-												15294-15300: "entity.name.tag.xml.plist"
-													15294-15300: "entity.name.tag.localname.xml.plist" - Data: "string"
-												15300-15301: "punctuation.definition.tag.xml.plist" - Data: ">"
-											15336-15345: "meta.tag.string.xml.plist"
-												15336-15338: "punctuation.definition.tag.xml.plist" - Data: "</"
-												15338-15344: "entity.name.tag.xml.plist"
-													15338-15344: "entity.name.tag.localname.xml.plist" - Data: "string"
-												15344-15345: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15352-15359: "meta.tag.dict.xml.plist"
-											15352-15354: "punctuation.definition.tag.xml.plist" - Data: "</"
-											15354-15358: "entity.name.tag.xml.plist"
-												15354-15358: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											15358-15359: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15366-15378: ""
-										15366-15371: "meta.tag.key.xml.plist"
-											15366-15367: "punctuation.definition.tag.xml.plist" - Data: "<"
-											15367-15370: "entity.name.tag.xml.plist"
-												15367-15370: "entity.name.tag.localname.xml.plist" - Data: "key"
-											15370-15371: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15372-15378: "meta.tag.key.xml.plist"
-											15372-15374: "punctuation.definition.tag.xml.plist" - Data: "</"
-											15374-15377: "entity.name.tag.xml.plist"
-												15374-15377: "entity.name.tag.localname.xml.plist" - Data: "key"
-											15377-15378: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15385-15489: ""
-										15385-15391: "meta.tag.dict.xml.plist"
-											15385-15386: "punctuation.definition.tag.xml.plist" - Data: "<"
-											15386-15390: "entity.name.tag.xml.plist"
-												15386-15390: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											15390-15391: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15399-15414: ""
-											15399-15404: "meta.tag.key.xml.plist"
-												15399-15400: "punctuation.definition.tag.xml.plist" - Data: "<"
-												15400-15403: "entity.name.tag.xml.plist"
-													15400-15403: "entity.name.tag.localname.xml.plist" - Data: "key"
-												15403-15404: "punctuation.definition.tag.xml.plist" - Data: ">"
-											15408-15414: "meta.tag.key.xml.plist"
-												15408-15410: "punctuation.definition.tag.xml.plist" - Data: "</"
-												15410-15413: "entity.name.tag.xml.plist"
-													15410-15413: "entity.name.tag.localname.xml.plist" - Data: "key"
-												15413-15414: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15422-15475: ""
-											15422-15430: "meta.tag.string.xml.plist"
-												15422-15423: "punctuation.definition.tag.xml.plist" - Data: "<"
-												15423-15429: "entity.name.tag.xml.plist"
-													15423-15429: "entity.name.tag.localname.xml.plist" - Data: "string"
-												15429-15430: "punctuation.definition.tag.xml.plist" - Data: ">"
-											15466-15475: "meta.tag.string.xml.plist"
-												15466-15468: "punctuation.definition.tag.xml.plist" - Data: "</"
-												15468-15474: "entity.name.tag.xml.plist"
-													15468-15474: "entity.name.tag.localname.xml.plist" - Data: "string"
-												15474-15475: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15482-15489: "meta.tag.dict.xml.plist"
-											15482-15484: "punctuation.definition.tag.xml.plist" - Data: "</"
-											15484-15488: "entity.name.tag.xml.plist"
-												15484-15488: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											15488-15489: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15495-15502: "meta.tag.dict.xml.plist"
-										15495-15497: "punctuation.definition.tag.xml.plist" - Data: "</"
-										15497-15501: "entity.name.tag.xml.plist"
-											15497-15501: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										15501-15502: "punctuation.definition.tag.xml.plist" - Data: ">"
-								15508-15526: ""
-									15508-15513: "meta.tag.key.xml.plist"
-										15508-15509: "punctuation.definition.tag.xml.plist" - Data: "<"
-										15509-15512: "entity.name.tag.xml.plist"
-											15509-15512: "entity.name.tag.localname.xml.plist" - Data: "key"
-										15512-15513: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15520-15526: "meta.tag.key.xml.plist"
-										15520-15522: "punctuation.definition.tag.xml.plist" - Data: "</"
-										15522-15525: "entity.name.tag.xml.plist"
-											15522-15525: "entity.name.tag.localname.xml.plist" - Data: "key"
-										15525-15526: "punctuation.definition.tag.xml.plist" - Data: ">"
-								15532-15568: ""
-									15532-15540: "meta.tag.string.xml.plist"
-										15532-15533: "punctuation.definition.tag.xml.plist" - Data: "<"
-										15533-15539: "entity.name.tag.xml.plist"
-											15533-15539: "entity.name.tag.localname.xml.plist" - Data: "string"
-										15539-15540: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15559-15568: "meta.tag.string.xml.plist"
-										15559-15561: "punctuation.definition.tag.xml.plist" - Data: "</"
-										15561-15567: "entity.name.tag.xml.plist"
-											15561-15567: "entity.name.tag.localname.xml.plist" - Data: "string"
-										15567-15568: "punctuation.definition.tag.xml.plist" - Data: ">"
-								15574-15590: ""
-									15574-15579: "meta.tag.key.xml.plist"
-										15574-15575: "punctuation.definition.tag.xml.plist" - Data: "<"
-										15575-15578: "entity.name.tag.xml.plist"
-											15575-15578: "entity.name.tag.localname.xml.plist" - Data: "key"
-										15578-15579: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15584-15590: "meta.tag.key.xml.plist"
-										15584-15586: "punctuation.definition.tag.xml.plist" - Data: "</"
-										15586-15589: "entity.name.tag.xml.plist"
-											15586-15589: "entity.name.tag.localname.xml.plist" - Data: "key"
-										15589-15590: "punctuation.definition.tag.xml.plist" - Data: ">"
-								15596-15642: ""
-									15596-15604: "meta.tag.string.xml.plist"
-										15596-15597: "punctuation.definition.tag.xml.plist" - Data: "<"
-										15597-15603: "entity.name.tag.xml.plist"
-											15597-15603: "entity.name.tag.localname.xml.plist" - Data: "string"
-										15603-15604: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15633-15642: "meta.tag.string.xml.plist"
-										15633-15635: "punctuation.definition.tag.xml.plist" - Data: "</"
-										15635-15641: "entity.name.tag.xml.plist"
-											15635-15641: "entity.name.tag.localname.xml.plist" - Data: "string"
-										15641-15642: "punctuation.definition.tag.xml.plist" - Data: ">"
-								15647-15654: "meta.tag.dict.xml.plist"
-									15647-15649: "punctuation.definition.tag.xml.plist" - Data: "</"
-									15649-15653: "entity.name.tag.xml.plist"
-										15649-15653: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									15653-15654: "punctuation.definition.tag.xml.plist" - Data: ">"
-							15659-16485: ""
-								15659-15665: "meta.tag.dict.xml.plist"
-									15659-15660: "punctuation.definition.tag.xml.plist" - Data: "<"
-									15660-15664: "entity.name.tag.xml.plist"
-										15660-15664: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									15664-15665: "punctuation.definition.tag.xml.plist" - Data: ">"
-								15671-15690: ""
-									15671-15676: "meta.tag.key.xml.plist"
-										15671-15672: "punctuation.definition.tag.xml.plist" - Data: "<"
-										15672-15675: "entity.name.tag.xml.plist"
-											15672-15675: "entity.name.tag.localname.xml.plist" - Data: "key"
-										15675-15676: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15684-15690: "meta.tag.key.xml.plist"
-										15684-15686: "punctuation.definition.tag.xml.plist" - Data: "</"
-										15686-15689: "entity.name.tag.xml.plist"
-											15686-15689: "entity.name.tag.localname.xml.plist" - Data: "key"
-										15689-15690: "punctuation.definition.tag.xml.plist" - Data: ">"
-								15696-16339: ""
-									15696-15702: "meta.tag.dict.xml.plist"
-										15696-15697: "punctuation.definition.tag.xml.plist" - Data: "<"
-										15697-15701: "entity.name.tag.xml.plist"
-											15697-15701: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										15701-15702: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15709-15721: ""
-										15709-15714: "meta.tag.key.xml.plist"
-											15709-15710: "punctuation.definition.tag.xml.plist" - Data: "<"
-											15710-15713: "entity.name.tag.xml.plist"
-												15710-15713: "entity.name.tag.localname.xml.plist" - Data: "key"
-											15713-15714: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15715-15721: "meta.tag.key.xml.plist"
-											15715-15717: "punctuation.definition.tag.xml.plist" - Data: "</"
-											15717-15720: "entity.name.tag.xml.plist"
-												15717-15720: "entity.name.tag.localname.xml.plist" - Data: "key"
-											15720-15721: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15728-15818: ""
-										15728-15734: "meta.tag.dict.xml.plist"
-											15728-15729: "punctuation.definition.tag.xml.plist" - Data: "<"
-											15729-15733: "entity.name.tag.xml.plist"
-												15729-15733: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											15733-15734: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15742-15757: ""
-											15742-15747: "meta.tag.key.xml.plist"
-												15742-15743: "punctuation.definition.tag.xml.plist" - Data: "<"
-												15743-15746: "entity.name.tag.xml.plist"
-													15743-15746: "entity.name.tag.localname.xml.plist" - Data: "key"
-												15746-15747: "punctuation.definition.tag.xml.plist" - Data: ">"
-											15751-15757: "meta.tag.key.xml.plist"
-												15751-15753: "punctuation.definition.tag.xml.plist" - Data: "</"
-												15753-15756: "entity.name.tag.xml.plist"
-													15753-15756: "entity.name.tag.localname.xml.plist" - Data: "key"
-												15756-15757: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15765-15804: ""
-											15765-15773: "meta.tag.string.xml.plist"
-												15765-15766: "punctuation.definition.tag.xml.plist" - Data: "<"
-												15766-15772: "entity.name.tag.xml.plist"
-													15766-15772: "entity.name.tag.localname.xml.plist" - Data: "string"
-												15772-15773: "punctuation.definition.tag.xml.plist" - Data: ">"
-											15795-15804: "meta.tag.string.xml.plist"
-												15795-15797: "punctuation.definition.tag.xml.plist" - Data: "</"
-												15797-15803: "entity.name.tag.xml.plist"
-													15797-15803: "entity.name.tag.localname.xml.plist" - Data: "string"
-												15803-15804: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15811-15818: "meta.tag.dict.xml.plist"
-											15811-15813: "punctuation.definition.tag.xml.plist" - Data: "</"
-											15813-15817: "entity.name.tag.xml.plist"
-												15813-15817: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											15817-15818: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15825-15837: ""
-										15825-15830: "meta.tag.key.xml.plist"
-											15825-15826: "punctuation.definition.tag.xml.plist" - Data: "<"
-											15826-15829: "entity.name.tag.xml.plist"
-												15826-15829: "entity.name.tag.localname.xml.plist" - Data: "key"
-											15829-15830: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15831-15837: "meta.tag.key.xml.plist"
-											15831-15833: "punctuation.definition.tag.xml.plist" - Data: "</"
-											15833-15836: "entity.name.tag.xml.plist"
-												15833-15836: "entity.name.tag.localname.xml.plist" - Data: "key"
-											15836-15837: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15844-15948: ""
-										15844-15850: "meta.tag.dict.xml.plist"
-											15844-15845: "punctuation.definition.tag.xml.plist" - Data: "<"
-											15845-15849: "entity.name.tag.xml.plist"
-												15845-15849: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											15849-15850: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15858-15873: ""
-											15858-15863: "meta.tag.key.xml.plist"
-												15858-15859: "punctuation.definition.tag.xml.plist" - Data: "<"
-												15859-15862: "entity.name.tag.xml.plist"
-													15859-15862: "entity.name.tag.localname.xml.plist" - Data: "key"
-												15862-15863: "punctuation.definition.tag.xml.plist" - Data: ">"
-											15867-15873: "meta.tag.key.xml.plist"
-												15867-15869: "punctuation.definition.tag.xml.plist" - Data: "</"
-												15869-15872: "entity.name.tag.xml.plist"
-													15869-15872: "entity.name.tag.localname.xml.plist" - Data: "key"
-												15872-15873: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15881-15934: ""
-											15881-15889: "meta.tag.string.xml.plist"
-												15881-15882: "punctuation.definition.tag.xml.plist" - Data: "<"
-												15882-15888: "entity.name.tag.xml.plist"
-													15882-15888: "entity.name.tag.localname.xml.plist" - Data: "string"
-												15888-15889: "punctuation.definition.tag.xml.plist" - Data: ">"
-											15925-15934: "meta.tag.string.xml.plist"
-												15925-15927: "punctuation.definition.tag.xml.plist" - Data: "</"
-												15927-15933: "entity.name.tag.xml.plist"
-													15927-15933: "entity.name.tag.localname.xml.plist" - Data: "string"
-												15933-15934: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15941-15948: "meta.tag.dict.xml.plist"
-											15941-15943: "punctuation.definition.tag.xml.plist" - Data: "</"
-											15943-15947: "entity.name.tag.xml.plist"
-												15943-15947: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											15947-15948: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15955-15967: ""
-										15955-15960: "meta.tag.key.xml.plist"
-											15955-15956: "punctuation.definition.tag.xml.plist" - Data: "<"
-											15956-15959: "entity.name.tag.xml.plist"
-												15956-15959: "entity.name.tag.localname.xml.plist" - Data: "key"
-											15959-15960: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15961-15967: "meta.tag.key.xml.plist"
-											15961-15963: "punctuation.definition.tag.xml.plist" - Data: "</"
-											15963-15966: "entity.name.tag.xml.plist"
-												15963-15966: "entity.name.tag.localname.xml.plist" - Data: "key"
-											15966-15967: "punctuation.definition.tag.xml.plist" - Data: ">"
-									15974-16067: ""
-										15974-15980: "meta.tag.dict.xml.plist"
-											15974-15975: "punctuation.definition.tag.xml.plist" - Data: "<"
-											15975-15979: "entity.name.tag.xml.plist"
-												15975-15979: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											15979-15980: "punctuation.definition.tag.xml.plist" - Data: ">"
-										15988-16003: ""
-											15988-15993: "meta.tag.key.xml.plist"
-												15988-15989: "punctuation.definition.tag.xml.plist" - Data: "<"
-												15989-15992: "entity.name.tag.xml.plist"
-													15989-15992: "entity.name.tag.localname.xml.plist" - Data: "key"
-												15992-15993: "punctuation.definition.tag.xml.plist" - Data: ">"
-											15997-16003: "meta.tag.key.xml.plist"
-												15997-15999: "punctuation.definition.tag.xml.plist" - Data: "</"
-												15999-16002: "entity.name.tag.xml.plist"
-													15999-16002: "entity.name.tag.localname.xml.plist" - Data: "key"
-												16002-16003: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16011-16053: ""
-											16011-16019: "meta.tag.string.xml.plist"
-												16011-16012: "punctuation.definition.tag.xml.plist" - Data: "<"
-												16012-16018: "entity.name.tag.xml.plist"
-													16012-16018: "entity.name.tag.localname.xml.plist" - Data: "string"
-												16018-16019: "punctuation.definition.tag.xml.plist" - Data: ">"
-											16044-16053: "meta.tag.string.xml.plist"
-												16044-16046: "punctuation.definition.tag.xml.plist" - Data: "</"
-												16046-16052: "entity.name.tag.xml.plist"
-													16046-16052: "entity.name.tag.localname.xml.plist" - Data: "string"
-												16052-16053: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16060-16067: "meta.tag.dict.xml.plist"
-											16060-16062: "punctuation.definition.tag.xml.plist" - Data: "</"
-											16062-16066: "entity.name.tag.xml.plist"
-												16062-16066: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											16066-16067: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16074-16086: ""
-										16074-16079: "meta.tag.key.xml.plist"
-											16074-16075: "punctuation.definition.tag.xml.plist" - Data: "<"
-											16075-16078: "entity.name.tag.xml.plist"
-												16075-16078: "entity.name.tag.localname.xml.plist" - Data: "key"
-											16078-16079: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16080-16086: "meta.tag.key.xml.plist"
-											16080-16082: "punctuation.definition.tag.xml.plist" - Data: "</"
-											16082-16085: "entity.name.tag.xml.plist"
-												16082-16085: "entity.name.tag.localname.xml.plist" - Data: "key"
-											16085-16086: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16093-16196: ""
-										16093-16099: "meta.tag.dict.xml.plist"
-											16093-16094: "punctuation.definition.tag.xml.plist" - Data: "<"
-											16094-16098: "entity.name.tag.xml.plist"
-												16094-16098: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											16098-16099: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16107-16122: ""
-											16107-16112: "meta.tag.key.xml.plist"
-												16107-16108: "punctuation.definition.tag.xml.plist" - Data: "<"
-												16108-16111: "entity.name.tag.xml.plist"
-													16108-16111: "entity.name.tag.localname.xml.plist" - Data: "key"
-												16111-16112: "punctuation.definition.tag.xml.plist" - Data: ">"
-											16116-16122: "meta.tag.key.xml.plist"
-												16116-16118: "punctuation.definition.tag.xml.plist" - Data: "</"
-												16118-16121: "entity.name.tag.xml.plist"
-													16118-16121: "entity.name.tag.localname.xml.plist" - Data: "key"
-												16121-16122: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16130-16182: ""
-											16130-16138: "meta.tag.string.xml.plist"
-												16130-16131: "punctuation.definition.tag.xml.plist" - Data: "<"
-												16131-16137: "entity.name.tag.xml.plist"
-													16131-16137: "entity.name.tag.localname.xml.plist" - Data: "string"
-												16137-16138: "punctuation.definition.tag.xml.plist" - Data: ">"
-											16173-16182: "meta.tag.string.xml.plist"
-												16173-16175: "punctuation.definition.tag.xml.plist" - Data: "</"
-												16175-16181: "entity.name.tag.xml.plist"
-													16175-16181: "entity.name.tag.localname.xml.plist" - Data: "string"
-												16181-16182: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16189-16196: "meta.tag.dict.xml.plist"
-											16189-16191: "punctuation.definition.tag.xml.plist" - Data: "</"
-											16191-16195: "entity.name.tag.xml.plist"
-												16191-16195: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											16195-16196: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16203-16215: ""
-										16203-16208: "meta.tag.key.xml.plist"
-											16203-16204: "punctuation.definition.tag.xml.plist" - Data: "<"
-											16204-16207: "entity.name.tag.xml.plist"
-												16204-16207: "entity.name.tag.localname.xml.plist" - Data: "key"
-											16207-16208: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16209-16215: "meta.tag.key.xml.plist"
-											16209-16211: "punctuation.definition.tag.xml.plist" - Data: "</"
-											16211-16214: "entity.name.tag.xml.plist"
-												16211-16214: "entity.name.tag.localname.xml.plist" - Data: "key"
-											16214-16215: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16222-16326: ""
-										16222-16228: "meta.tag.dict.xml.plist"
-											16222-16223: "punctuation.definition.tag.xml.plist" - Data: "<"
-											16223-16227: "entity.name.tag.xml.plist"
-												16223-16227: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											16227-16228: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16236-16251: ""
-											16236-16241: "meta.tag.key.xml.plist"
-												16236-16237: "punctuation.definition.tag.xml.plist" - Data: "<"
-												16237-16240: "entity.name.tag.xml.plist"
-													16237-16240: "entity.name.tag.localname.xml.plist" - Data: "key"
-												16240-16241: "punctuation.definition.tag.xml.plist" - Data: ">"
-											16245-16251: "meta.tag.key.xml.plist"
-												16245-16247: "punctuation.definition.tag.xml.plist" - Data: "</"
-												16247-16250: "entity.name.tag.xml.plist"
-													16247-16250: "entity.name.tag.localname.xml.plist" - Data: "key"
-												16250-16251: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16259-16312: ""
-											16259-16267: "meta.tag.string.xml.plist"
-												16259-16260: "punctuation.definition.tag.xml.plist" - Data: "<"
-												16260-16266: "entity.name.tag.xml.plist"
-													16260-16266: "entity.name.tag.localname.xml.plist" - Data: "string"
-												16266-16267: "punctuation.definition.tag.xml.plist" - Data: ">"
-											16303-16312: "meta.tag.string.xml.plist"
-												16303-16305: "punctuation.definition.tag.xml.plist" - Data: "</"
-												16305-16311: "entity.name.tag.xml.plist"
-													16305-16311: "entity.name.tag.localname.xml.plist" - Data: "string"
-												16311-16312: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16319-16326: "meta.tag.dict.xml.plist"
-											16319-16321: "punctuation.definition.tag.xml.plist" - Data: "</"
-											16321-16325: "entity.name.tag.xml.plist"
-												16321-16325: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											16325-16326: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16332-16339: "meta.tag.dict.xml.plist"
-										16332-16334: "punctuation.definition.tag.xml.plist" - Data: "</"
-										16334-16338: "entity.name.tag.xml.plist"
-											16334-16338: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										16338-16339: "punctuation.definition.tag.xml.plist" - Data: ">"
-								16345-16363: ""
-									16345-16350: "meta.tag.key.xml.plist"
-										16345-16346: "punctuation.definition.tag.xml.plist" - Data: "<"
-										16346-16349: "entity.name.tag.xml.plist"
-											16346-16349: "entity.name.tag.localname.xml.plist" - Data: "key"
-										16349-16350: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16357-16363: "meta.tag.key.xml.plist"
-										16357-16359: "punctuation.definition.tag.xml.plist" - Data: "</"
-										16359-16362: "entity.name.tag.xml.plist"
-											16359-16362: "entity.name.tag.localname.xml.plist" - Data: "key"
-										16362-16363: "punctuation.definition.tag.xml.plist" - Data: ">"
-								16369-16402: ""
-									16369-16377: "meta.tag.string.xml.plist"
-										16369-16370: "punctuation.definition.tag.xml.plist" - Data: "<"
-										16370-16376: "entity.name.tag.xml.plist"
-											16370-16376: "entity.name.tag.localname.xml.plist" - Data: "string"
-										16376-16377: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16393-16402: "meta.tag.string.xml.plist"
-										16393-16395: "punctuation.definition.tag.xml.plist" - Data: "</"
-										16395-16401: "entity.name.tag.xml.plist"
-											16395-16401: "entity.name.tag.localname.xml.plist" - Data: "string"
-										16401-16402: "punctuation.definition.tag.xml.plist" - Data: ">"
-								16408-16424: ""
-									16408-16413: "meta.tag.key.xml.plist"
-										16408-16409: "punctuation.definition.tag.xml.plist" - Data: "<"
-										16409-16412: "entity.name.tag.xml.plist"
-											16409-16412: "entity.name.tag.localname.xml.plist" - Data: "key"
-										16412-16413: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16418-16424: "meta.tag.key.xml.plist"
-										16418-16420: "punctuation.definition.tag.xml.plist" - Data: "</"
-										16420-16423: "entity.name.tag.xml.plist"
-											16420-16423: "entity.name.tag.localname.xml.plist" - Data: "key"
-										16423-16424: "punctuation.definition.tag.xml.plist" - Data: ">"
-								16430-16473: ""
-									16430-16438: "meta.tag.string.xml.plist"
-										16430-16431: "punctuation.definition.tag.xml.plist" - Data: "<"
-										16431-16437: "entity.name.tag.xml.plist"
-											16431-16437: "entity.name.tag.localname.xml.plist" - Data: "string"
-										16437-16438: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16464-16473: "meta.tag.string.xml.plist"
-										16464-16466: "punctuation.definition.tag.xml.plist" - Data: "</"
-										16466-16472: "entity.name.tag.xml.plist"
-											16466-16472: "entity.name.tag.localname.xml.plist" - Data: "string"
-										16472-16473: "punctuation.definition.tag.xml.plist" - Data: ">"
-								16478-16485: "meta.tag.dict.xml.plist"
-									16478-16480: "punctuation.definition.tag.xml.plist" - Data: "</"
-									16480-16484: "entity.name.tag.xml.plist"
-										16480-16484: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									16484-16485: "punctuation.definition.tag.xml.plist" - Data: ">"
-							16490-17512: ""
-								16490-16496: "meta.tag.dict.xml.plist"
-									16490-16491: "punctuation.definition.tag.xml.plist" - Data: "<"
-									16491-16495: "entity.name.tag.xml.plist"
-										16491-16495: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									16495-16496: "punctuation.definition.tag.xml.plist" - Data: ">"
-								16502-16518: ""
-									16502-16507: "meta.tag.key.xml.plist"
-										16502-16503: "punctuation.definition.tag.xml.plist" - Data: "<"
-										16503-16506: "entity.name.tag.xml.plist"
-											16503-16506: "entity.name.tag.localname.xml.plist" - Data: "key"
-										16506-16507: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16512-16518: "meta.tag.key.xml.plist"
-										16512-16514: "punctuation.definition.tag.xml.plist" - Data: "</"
-										16514-16517: "entity.name.tag.xml.plist"
-											16514-16517: "entity.name.tag.localname.xml.plist" - Data: "key"
-										16517-16518: "punctuation.definition.tag.xml.plist" - Data: ">"
-								16524-16563: ""
-									16524-16532: "meta.tag.string.xml.plist"
-										16524-16525: "punctuation.definition.tag.xml.plist" - Data: "<"
-										16525-16531: "entity.name.tag.xml.plist"
-											16525-16531: "entity.name.tag.localname.xml.plist" - Data: "string"
-										16531-16532: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16554-16563: "meta.tag.string.xml.plist"
-										16554-16556: "punctuation.definition.tag.xml.plist" - Data: "</"
-										16556-16562: "entity.name.tag.xml.plist"
-											16556-16562: "entity.name.tag.localname.xml.plist" - Data: "string"
-										16562-16563: "punctuation.definition.tag.xml.plist" - Data: ">"
-								16569-16588: ""
-									16569-16574: "meta.tag.key.xml.plist"
-										16569-16570: "punctuation.definition.tag.xml.plist" - Data: "<"
-										16570-16573: "entity.name.tag.xml.plist"
-											16570-16573: "entity.name.tag.localname.xml.plist" - Data: "key"
-										16573-16574: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16582-16588: "meta.tag.key.xml.plist"
-										16582-16584: "punctuation.definition.tag.xml.plist" - Data: "</"
-										16584-16587: "entity.name.tag.xml.plist"
-											16584-16587: "entity.name.tag.localname.xml.plist" - Data: "key"
-										16587-16588: "punctuation.definition.tag.xml.plist" - Data: ">"
-								16594-17238: ""
-									16594-16600: "meta.tag.dict.xml.plist"
-										16594-16595: "punctuation.definition.tag.xml.plist" - Data: "<"
-										16595-16599: "entity.name.tag.xml.plist"
-											16595-16599: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										16599-16600: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16607-16619: ""
-										16607-16612: "meta.tag.key.xml.plist"
-											16607-16608: "punctuation.definition.tag.xml.plist" - Data: "<"
-											16608-16611: "entity.name.tag.xml.plist"
-												16608-16611: "entity.name.tag.localname.xml.plist" - Data: "key"
-											16611-16612: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16613-16619: "meta.tag.key.xml.plist"
-											16613-16615: "punctuation.definition.tag.xml.plist" - Data: "</"
-											16615-16618: "entity.name.tag.xml.plist"
-												16615-16618: "entity.name.tag.localname.xml.plist" - Data: "key"
-											16618-16619: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16626-16717: ""
-										16626-16632: "meta.tag.dict.xml.plist"
-											16626-16627: "punctuation.definition.tag.xml.plist" - Data: "<"
-											16627-16631: "entity.name.tag.xml.plist"
-												16627-16631: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											16631-16632: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16640-16655: ""
-											16640-16645: "meta.tag.key.xml.plist"
-												16640-16641: "punctuation.definition.tag.xml.plist" - Data: "<"
-												16641-16644: "entity.name.tag.xml.plist"
-													16641-16644: "entity.name.tag.localname.xml.plist" - Data: "key"
-												16644-16645: "punctuation.definition.tag.xml.plist" - Data: ">"
-											16649-16655: "meta.tag.key.xml.plist"
-												16649-16651: "punctuation.definition.tag.xml.plist" - Data: "</"
-												16651-16654: "entity.name.tag.xml.plist"
-													16651-16654: "entity.name.tag.localname.xml.plist" - Data: "key"
-												16654-16655: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16663-16703: ""
-											16663-16671: "meta.tag.string.xml.plist"
-												16663-16664: "punctuation.definition.tag.xml.plist" - Data: "<"
-												16664-16670: "entity.name.tag.xml.plist"
-													16664-16670: "entity.name.tag.localname.xml.plist" - Data: "string"
-												16670-16671: "punctuation.definition.tag.xml.plist" - Data: ">"
-											16694-16703: "meta.tag.string.xml.plist"
-												16694-16696: "punctuation.definition.tag.xml.plist" - Data: "</"
-												16696-16702: "entity.name.tag.xml.plist"
-													16696-16702: "entity.name.tag.localname.xml.plist" - Data: "string"
-												16702-16703: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16710-16717: "meta.tag.dict.xml.plist"
-											16710-16712: "punctuation.definition.tag.xml.plist" - Data: "</"
-											16712-16716: "entity.name.tag.xml.plist"
-												16712-16716: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											16716-16717: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16724-16736: ""
-										16724-16729: "meta.tag.key.xml.plist"
-											16724-16725: "punctuation.definition.tag.xml.plist" - Data: "<"
-											16725-16728: "entity.name.tag.xml.plist"
-												16725-16728: "entity.name.tag.localname.xml.plist" - Data: "key"
-											16728-16729: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16730-16736: "meta.tag.key.xml.plist"
-											16730-16732: "punctuation.definition.tag.xml.plist" - Data: "</"
-											16732-16735: "entity.name.tag.xml.plist"
-												16732-16735: "entity.name.tag.localname.xml.plist" - Data: "key"
-											16735-16736: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16743-16847: ""
-										16743-16749: "meta.tag.dict.xml.plist"
-											16743-16744: "punctuation.definition.tag.xml.plist" - Data: "<"
-											16744-16748: "entity.name.tag.xml.plist"
-												16744-16748: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											16748-16749: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16757-16772: ""
-											16757-16762: "meta.tag.key.xml.plist"
-												16757-16758: "punctuation.definition.tag.xml.plist" - Data: "<"
-												16758-16761: "entity.name.tag.xml.plist"
-													16758-16761: "entity.name.tag.localname.xml.plist" - Data: "key"
-												16761-16762: "punctuation.definition.tag.xml.plist" - Data: ">"
-											16766-16772: "meta.tag.key.xml.plist"
-												16766-16768: "punctuation.definition.tag.xml.plist" - Data: "</"
-												16768-16771: "entity.name.tag.xml.plist"
-													16768-16771: "entity.name.tag.localname.xml.plist" - Data: "key"
-												16771-16772: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16780-16833: ""
-											16780-16788: "meta.tag.string.xml.plist"
-												16780-16781: "punctuation.definition.tag.xml.plist" - Data: "<"
-												16781-16787: "entity.name.tag.xml.plist"
-													16781-16787: "entity.name.tag.localname.xml.plist" - Data: "string"
-												16787-16788: "punctuation.definition.tag.xml.plist" - Data: ">"
-											16824-16833: "meta.tag.string.xml.plist"
-												16824-16826: "punctuation.definition.tag.xml.plist" - Data: "</"
-												16826-16832: "entity.name.tag.xml.plist"
-													16826-16832: "entity.name.tag.localname.xml.plist" - Data: "string"
-												16832-16833: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16840-16847: "meta.tag.dict.xml.plist"
-											16840-16842: "punctuation.definition.tag.xml.plist" - Data: "</"
-											16842-16846: "entity.name.tag.xml.plist"
-												16842-16846: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											16846-16847: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16854-16866: ""
-										16854-16859: "meta.tag.key.xml.plist"
-											16854-16855: "punctuation.definition.tag.xml.plist" - Data: "<"
-											16855-16858: "entity.name.tag.xml.plist"
-												16855-16858: "entity.name.tag.localname.xml.plist" - Data: "key"
-											16858-16859: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16860-16866: "meta.tag.key.xml.plist"
-											16860-16862: "punctuation.definition.tag.xml.plist" - Data: "</"
-											16862-16865: "entity.name.tag.xml.plist"
-												16862-16865: "entity.name.tag.localname.xml.plist" - Data: "key"
-											16865-16866: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16873-16966: ""
-										16873-16879: "meta.tag.dict.xml.plist"
-											16873-16874: "punctuation.definition.tag.xml.plist" - Data: "<"
-											16874-16878: "entity.name.tag.xml.plist"
-												16874-16878: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											16878-16879: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16887-16902: ""
-											16887-16892: "meta.tag.key.xml.plist"
-												16887-16888: "punctuation.definition.tag.xml.plist" - Data: "<"
-												16888-16891: "entity.name.tag.xml.plist"
-													16888-16891: "entity.name.tag.localname.xml.plist" - Data: "key"
-												16891-16892: "punctuation.definition.tag.xml.plist" - Data: ">"
-											16896-16902: "meta.tag.key.xml.plist"
-												16896-16898: "punctuation.definition.tag.xml.plist" - Data: "</"
-												16898-16901: "entity.name.tag.xml.plist"
-													16898-16901: "entity.name.tag.localname.xml.plist" - Data: "key"
-												16901-16902: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16910-16952: ""
-											16910-16918: "meta.tag.string.xml.plist"
-												16910-16911: "punctuation.definition.tag.xml.plist" - Data: "<"
-												16911-16917: "entity.name.tag.xml.plist"
-													16911-16917: "entity.name.tag.localname.xml.plist" - Data: "string"
-												16917-16918: "punctuation.definition.tag.xml.plist" - Data: ">"
-											16943-16952: "meta.tag.string.xml.plist"
-												16943-16945: "punctuation.definition.tag.xml.plist" - Data: "</"
-												16945-16951: "entity.name.tag.xml.plist"
-													16945-16951: "entity.name.tag.localname.xml.plist" - Data: "string"
-												16951-16952: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16959-16966: "meta.tag.dict.xml.plist"
-											16959-16961: "punctuation.definition.tag.xml.plist" - Data: "</"
-											16961-16965: "entity.name.tag.xml.plist"
-												16961-16965: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											16965-16966: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16973-16985: ""
-										16973-16978: "meta.tag.key.xml.plist"
-											16973-16974: "punctuation.definition.tag.xml.plist" - Data: "<"
-											16974-16977: "entity.name.tag.xml.plist"
-												16974-16977: "entity.name.tag.localname.xml.plist" - Data: "key"
-											16977-16978: "punctuation.definition.tag.xml.plist" - Data: ">"
-										16979-16985: "meta.tag.key.xml.plist"
-											16979-16981: "punctuation.definition.tag.xml.plist" - Data: "</"
-											16981-16984: "entity.name.tag.xml.plist"
-												16981-16984: "entity.name.tag.localname.xml.plist" - Data: "key"
-											16984-16985: "punctuation.definition.tag.xml.plist" - Data: ">"
-									16992-17095: ""
-										16992-16998: "meta.tag.dict.xml.plist"
-											16992-16993: "punctuation.definition.tag.xml.plist" - Data: "<"
-											16993-16997: "entity.name.tag.xml.plist"
-												16993-16997: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											16997-16998: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17006-17021: ""
-											17006-17011: "meta.tag.key.xml.plist"
-												17006-17007: "punctuation.definition.tag.xml.plist" - Data: "<"
-												17007-17010: "entity.name.tag.xml.plist"
-													17007-17010: "entity.name.tag.localname.xml.plist" - Data: "key"
-												17010-17011: "punctuation.definition.tag.xml.plist" - Data: ">"
-											17015-17021: "meta.tag.key.xml.plist"
-												17015-17017: "punctuation.definition.tag.xml.plist" - Data: "</"
-												17017-17020: "entity.name.tag.xml.plist"
-													17017-17020: "entity.name.tag.localname.xml.plist" - Data: "key"
-												17020-17021: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17029-17081: ""
-											17029-17037: "meta.tag.string.xml.plist"
-												17029-17030: "punctuation.definition.tag.xml.plist" - Data: "<"
-												17030-17036: "entity.name.tag.xml.plist"
-													17030-17036: "entity.name.tag.localname.xml.plist" - Data: "string"
-												17036-17037: "punctuation.definition.tag.xml.plist" - Data: ">"
-											17072-17081: "meta.tag.string.xml.plist"
-												17072-17074: "punctuation.definition.tag.xml.plist" - Data: "</"
-												17074-17080: "entity.name.tag.xml.plist"
-													17074-17080: "entity.name.tag.localname.xml.plist" - Data: "string"
-												17080-17081: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17088-17095: "meta.tag.dict.xml.plist"
-											17088-17090: "punctuation.definition.tag.xml.plist" - Data: "</"
-											17090-17094: "entity.name.tag.xml.plist"
-												17090-17094: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											17094-17095: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17102-17114: ""
-										17102-17107: "meta.tag.key.xml.plist"
-											17102-17103: "punctuation.definition.tag.xml.plist" - Data: "<"
-											17103-17106: "entity.name.tag.xml.plist"
-												17103-17106: "entity.name.tag.localname.xml.plist" - Data: "key"
-											17106-17107: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17108-17114: "meta.tag.key.xml.plist"
-											17108-17110: "punctuation.definition.tag.xml.plist" - Data: "</"
-											17110-17113: "entity.name.tag.xml.plist"
-												17110-17113: "entity.name.tag.localname.xml.plist" - Data: "key"
-											17113-17114: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17121-17225: ""
-										17121-17127: "meta.tag.dict.xml.plist"
-											17121-17122: "punctuation.definition.tag.xml.plist" - Data: "<"
-											17122-17126: "entity.name.tag.xml.plist"
-												17122-17126: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											17126-17127: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17135-17150: ""
-											17135-17140: "meta.tag.key.xml.plist"
-												17135-17136: "punctuation.definition.tag.xml.plist" - Data: "<"
-												17136-17139: "entity.name.tag.xml.plist"
-													17136-17139: "entity.name.tag.localname.xml.plist" - Data: "key"
-												17139-17140: "punctuation.definition.tag.xml.plist" - Data: ">"
-											17144-17150: "meta.tag.key.xml.plist"
-												17144-17146: "punctuation.definition.tag.xml.plist" - Data: "</"
-												17146-17149: "entity.name.tag.xml.plist"
-													17146-17149: "entity.name.tag.localname.xml.plist" - Data: "key"
-												17149-17150: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17158-17211: ""
-											17158-17166: "meta.tag.string.xml.plist"
-												17158-17159: "punctuation.definition.tag.xml.plist" - Data: "<"
-												17159-17165: "entity.name.tag.xml.plist"
-													17159-17165: "entity.name.tag.localname.xml.plist" - Data: "string"
-												17165-17166: "punctuation.definition.tag.xml.plist" - Data: ">"
-											17202-17211: "meta.tag.string.xml.plist"
-												17202-17204: "punctuation.definition.tag.xml.plist" - Data: "</"
-												17204-17210: "entity.name.tag.xml.plist"
-													17204-17210: "entity.name.tag.localname.xml.plist" - Data: "string"
-												17210-17211: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17218-17225: "meta.tag.dict.xml.plist"
-											17218-17220: "punctuation.definition.tag.xml.plist" - Data: "</"
-											17220-17224: "entity.name.tag.xml.plist"
-												17220-17224: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											17224-17225: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17231-17238: "meta.tag.dict.xml.plist"
-										17231-17233: "punctuation.definition.tag.xml.plist" - Data: "</"
-										17233-17237: "entity.name.tag.xml.plist"
-											17233-17237: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										17237-17238: "punctuation.definition.tag.xml.plist" - Data: ">"
-								17244-17262: ""
-									17244-17249: "meta.tag.key.xml.plist"
-										17244-17245: "punctuation.definition.tag.xml.plist" - Data: "<"
-										17245-17248: "entity.name.tag.xml.plist"
-											17245-17248: "entity.name.tag.localname.xml.plist" - Data: "key"
-										17248-17249: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17256-17262: "meta.tag.key.xml.plist"
-										17256-17258: "punctuation.definition.tag.xml.plist" - Data: "</"
-										17258-17261: "entity.name.tag.xml.plist"
-											17258-17261: "entity.name.tag.localname.xml.plist" - Data: "key"
-										17261-17262: "punctuation.definition.tag.xml.plist" - Data: ">"
-								17268-17295: ""
-									17268-17276: "meta.tag.string.xml.plist"
-										17268-17269: "punctuation.definition.tag.xml.plist" - Data: "<"
-										17269-17275: "entity.name.tag.xml.plist"
-											17269-17275: "entity.name.tag.localname.xml.plist" - Data: "string"
-										17275-17276: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17286-17295: "meta.tag.string.xml.plist"
-										17286-17288: "punctuation.definition.tag.xml.plist" - Data: "</"
-										17288-17294: "entity.name.tag.xml.plist"
-											17288-17294: "entity.name.tag.localname.xml.plist" - Data: "string"
-										17294-17295: "punctuation.definition.tag.xml.plist" - Data: ">"
-								17301-17315: ""
-									17301-17306: "meta.tag.key.xml.plist"
-										17301-17302: "punctuation.definition.tag.xml.plist" - Data: "<"
-										17302-17305: "entity.name.tag.xml.plist"
-											17302-17305: "entity.name.tag.localname.xml.plist" - Data: "key"
-										17305-17306: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17309-17315: "meta.tag.key.xml.plist"
-										17309-17311: "punctuation.definition.tag.xml.plist" - Data: "</"
-										17311-17314: "entity.name.tag.xml.plist"
-											17311-17314: "entity.name.tag.localname.xml.plist" - Data: "key"
-										17314-17315: "punctuation.definition.tag.xml.plist" - Data: ">"
-								17321-17361: ""
-									17321-17329: "meta.tag.string.xml.plist"
-										17321-17322: "punctuation.definition.tag.xml.plist" - Data: "<"
-										17322-17328: "entity.name.tag.xml.plist"
-											17322-17328: "entity.name.tag.localname.xml.plist" - Data: "string"
-										17328-17329: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17352-17361: "meta.tag.string.xml.plist"
-										17352-17354: "punctuation.definition.tag.xml.plist" - Data: "</"
-										17354-17360: "entity.name.tag.xml.plist"
-											17354-17360: "entity.name.tag.localname.xml.plist" - Data: "string"
-										17360-17361: "punctuation.definition.tag.xml.plist" - Data: ">"
-								17367-17386: ""
-									17367-17372: "meta.tag.key.xml.plist"
-										17367-17368: "punctuation.definition.tag.xml.plist" - Data: "<"
-										17368-17371: "entity.name.tag.xml.plist"
-											17368-17371: "entity.name.tag.localname.xml.plist" - Data: "key"
-										17371-17372: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17380-17386: "meta.tag.key.xml.plist"
-										17380-17382: "punctuation.definition.tag.xml.plist" - Data: "</"
-										17382-17385: "entity.name.tag.xml.plist"
-											17382-17385: "entity.name.tag.localname.xml.plist" - Data: "key"
-										17385-17386: "punctuation.definition.tag.xml.plist" - Data: ">"
-								17392-17500: ""
-									17392-17399: "meta.tag.array.xml.plist"
-										17392-17393: "punctuation.definition.tag.xml.plist" - Data: "<"
-										17393-17398: "entity.name.tag.xml.plist"
-											17393-17398: "entity.name.tag.localname.xml.plist" - Data: "array"
-										17398-17399: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17406-17486: ""
-										17406-17412: "meta.tag.dict.xml.plist"
-											17406-17407: "punctuation.definition.tag.xml.plist" - Data: "<"
-											17407-17411: "entity.name.tag.xml.plist"
-												17407-17411: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											17411-17412: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17420-17438: ""
-											17420-17425: "meta.tag.key.xml.plist"
-												17420-17421: "punctuation.definition.tag.xml.plist" - Data: "<"
-												17421-17424: "entity.name.tag.xml.plist"
-													17421-17424: "entity.name.tag.localname.xml.plist" - Data: "key"
-												17424-17425: "punctuation.definition.tag.xml.plist" - Data: ">"
-											17432-17438: "meta.tag.key.xml.plist"
-												17432-17434: "punctuation.definition.tag.xml.plist" - Data: "</"
-												17434-17437: "entity.name.tag.xml.plist"
-													17434-17437: "entity.name.tag.localname.xml.plist" - Data: "key"
-												17437-17438: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17446-17472: ""
-											17446-17454: "meta.tag.string.xml.plist"
-												17446-17447: "punctuation.definition.tag.xml.plist" - Data: "<"
-												17447-17453: "entity.name.tag.xml.plist"
-													17447-17453: "entity.name.tag.localname.xml.plist" - Data: "string"
-												17453-17454: "punctuation.definition.tag.xml.plist" - Data: ">"
-											17463-17472: "meta.tag.string.xml.plist"
-												17463-17465: "punctuation.definition.tag.xml.plist" - Data: "</"
-												17465-17471: "entity.name.tag.xml.plist"
-													17465-17471: "entity.name.tag.localname.xml.plist" - Data: "string"
-												17471-17472: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17479-17486: "meta.tag.dict.xml.plist"
-											17479-17481: "punctuation.definition.tag.xml.plist" - Data: "</"
-											17481-17485: "entity.name.tag.xml.plist"
-												17481-17485: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											17485-17486: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17492-17500: "meta.tag.array.xml.plist"
-										17492-17494: "punctuation.definition.tag.xml.plist" - Data: "</"
-										17494-17499: "entity.name.tag.xml.plist"
-											17494-17499: "entity.name.tag.localname.xml.plist" - Data: "array"
-										17499-17500: "punctuation.definition.tag.xml.plist" - Data: ">"
-								17505-17512: "meta.tag.dict.xml.plist"
-									17505-17507: "punctuation.definition.tag.xml.plist" - Data: "</"
-									17507-17511: "entity.name.tag.xml.plist"
-										17507-17511: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									17511-17512: "punctuation.definition.tag.xml.plist" - Data: ">"
-							17517-18537: ""
-								17517-17523: "meta.tag.dict.xml.plist"
-									17517-17518: "punctuation.definition.tag.xml.plist" - Data: "<"
-									17518-17522: "entity.name.tag.xml.plist"
-										17518-17522: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									17522-17523: "punctuation.definition.tag.xml.plist" - Data: ">"
-								17529-17545: ""
-									17529-17534: "meta.tag.key.xml.plist"
-										17529-17530: "punctuation.definition.tag.xml.plist" - Data: "<"
-										17530-17533: "entity.name.tag.xml.plist"
-											17530-17533: "entity.name.tag.localname.xml.plist" - Data: "key"
-										17533-17534: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17539-17545: "meta.tag.key.xml.plist"
-										17539-17541: "punctuation.definition.tag.xml.plist" - Data: "</"
-										17541-17544: "entity.name.tag.xml.plist"
-											17541-17544: "entity.name.tag.localname.xml.plist" - Data: "key"
-										17544-17545: "punctuation.definition.tag.xml.plist" - Data: ">"
-								17551-17591: ""
-									17551-17559: "meta.tag.string.xml.plist"
-										17551-17552: "punctuation.definition.tag.xml.plist" - Data: "<"
-										17552-17558: "entity.name.tag.xml.plist"
-											17552-17558: "entity.name.tag.localname.xml.plist" - Data: "string"
-										17558-17559: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17582-17591: "meta.tag.string.xml.plist"
-										17582-17584: "punctuation.definition.tag.xml.plist" - Data: "</"
-										17584-17590: "entity.name.tag.xml.plist"
-											17584-17590: "entity.name.tag.localname.xml.plist" - Data: "string"
-										17590-17591: "punctuation.definition.tag.xml.plist" - Data: ">"
-								17597-17616: ""
-									17597-17602: "meta.tag.key.xml.plist"
-										17597-17598: "punctuation.definition.tag.xml.plist" - Data: "<"
-										17598-17601: "entity.name.tag.xml.plist"
-											17598-17601: "entity.name.tag.localname.xml.plist" - Data: "key"
-										17601-17602: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17610-17616: "meta.tag.key.xml.plist"
-										17610-17612: "punctuation.definition.tag.xml.plist" - Data: "</"
-										17612-17615: "entity.name.tag.xml.plist"
-											17612-17615: "entity.name.tag.localname.xml.plist" - Data: "key"
-										17615-17616: "punctuation.definition.tag.xml.plist" - Data: ">"
-								17622-18267: ""
-									17622-17628: "meta.tag.dict.xml.plist"
-										17622-17623: "punctuation.definition.tag.xml.plist" - Data: "<"
-										17623-17627: "entity.name.tag.xml.plist"
-											17623-17627: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										17627-17628: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17635-17647: ""
-										17635-17640: "meta.tag.key.xml.plist"
-											17635-17636: "punctuation.definition.tag.xml.plist" - Data: "<"
-											17636-17639: "entity.name.tag.xml.plist"
-												17636-17639: "entity.name.tag.localname.xml.plist" - Data: "key"
-											17639-17640: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17641-17647: "meta.tag.key.xml.plist"
-											17641-17643: "punctuation.definition.tag.xml.plist" - Data: "</"
-											17643-17646: "entity.name.tag.xml.plist"
-												17643-17646: "entity.name.tag.localname.xml.plist" - Data: "key"
-											17646-17647: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17654-17746: ""
-										17654-17660: "meta.tag.dict.xml.plist"
-											17654-17655: "punctuation.definition.tag.xml.plist" - Data: "<"
-											17655-17659: "entity.name.tag.xml.plist"
-												17655-17659: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											17659-17660: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17668-17683: ""
-											17668-17673: "meta.tag.key.xml.plist"
-												17668-17669: "punctuation.definition.tag.xml.plist" - Data: "<"
-												17669-17672: "entity.name.tag.xml.plist"
-													17669-17672: "entity.name.tag.localname.xml.plist" - Data: "key"
-												17672-17673: "punctuation.definition.tag.xml.plist" - Data: ">"
-											17677-17683: "meta.tag.key.xml.plist"
-												17677-17679: "punctuation.definition.tag.xml.plist" - Data: "</"
-												17679-17682: "entity.name.tag.xml.plist"
-													17679-17682: "entity.name.tag.localname.xml.plist" - Data: "key"
-												17682-17683: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17691-17732: ""
-											17691-17699: "meta.tag.string.xml.plist"
-												17691-17692: "punctuation.definition.tag.xml.plist" - Data: "<"
-												17692-17698: "entity.name.tag.xml.plist"
-													17692-17698: "entity.name.tag.localname.xml.plist" - Data: "string"
-												17698-17699: "punctuation.definition.tag.xml.plist" - Data: ">"
-											17723-17732: "meta.tag.string.xml.plist"
-												17723-17725: "punctuation.definition.tag.xml.plist" - Data: "</"
-												17725-17731: "entity.name.tag.xml.plist"
-													17725-17731: "entity.name.tag.localname.xml.plist" - Data: "string"
-												17731-17732: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17739-17746: "meta.tag.dict.xml.plist"
-											17739-17741: "punctuation.definition.tag.xml.plist" - Data: "</"
-											17741-17745: "entity.name.tag.xml.plist"
-												17741-17745: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											17745-17746: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17753-17765: ""
-										17753-17758: "meta.tag.key.xml.plist"
-											17753-17754: "punctuation.definition.tag.xml.plist" - Data: "<"
-											17754-17757: "entity.name.tag.xml.plist"
-												17754-17757: "entity.name.tag.localname.xml.plist" - Data: "key"
-											17757-17758: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17759-17765: "meta.tag.key.xml.plist"
-											17759-17761: "punctuation.definition.tag.xml.plist" - Data: "</"
-											17761-17764: "entity.name.tag.xml.plist"
-												17761-17764: "entity.name.tag.localname.xml.plist" - Data: "key"
-											17764-17765: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17772-17876: ""
-										17772-17778: "meta.tag.dict.xml.plist"
-											17772-17773: "punctuation.definition.tag.xml.plist" - Data: "<"
-											17773-17777: "entity.name.tag.xml.plist"
-												17773-17777: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											17777-17778: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17786-17801: ""
-											17786-17791: "meta.tag.key.xml.plist"
-												17786-17787: "punctuation.definition.tag.xml.plist" - Data: "<"
-												17787-17790: "entity.name.tag.xml.plist"
-													17787-17790: "entity.name.tag.localname.xml.plist" - Data: "key"
-												17790-17791: "punctuation.definition.tag.xml.plist" - Data: ">"
-											17795-17801: "meta.tag.key.xml.plist"
-												17795-17797: "punctuation.definition.tag.xml.plist" - Data: "</"
-												17797-17800: "entity.name.tag.xml.plist"
-													17797-17800: "entity.name.tag.localname.xml.plist" - Data: "key"
-												17800-17801: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17809-17862: ""
-											17809-17817: "meta.tag.string.xml.plist"
-												17809-17810: "punctuation.definition.tag.xml.plist" - Data: "<"
-												17810-17816: "entity.name.tag.xml.plist"
-													17810-17816: "entity.name.tag.localname.xml.plist" - Data: "string"
-												17816-17817: "punctuation.definition.tag.xml.plist" - Data: ">"
-											17853-17862: "meta.tag.string.xml.plist"
-												17853-17855: "punctuation.definition.tag.xml.plist" - Data: "</"
-												17855-17861: "entity.name.tag.xml.plist"
-													17855-17861: "entity.name.tag.localname.xml.plist" - Data: "string"
-												17861-17862: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17869-17876: "meta.tag.dict.xml.plist"
-											17869-17871: "punctuation.definition.tag.xml.plist" - Data: "</"
-											17871-17875: "entity.name.tag.xml.plist"
-												17871-17875: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											17875-17876: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17883-17895: ""
-										17883-17888: "meta.tag.key.xml.plist"
-											17883-17884: "punctuation.definition.tag.xml.plist" - Data: "<"
-											17884-17887: "entity.name.tag.xml.plist"
-												17884-17887: "entity.name.tag.localname.xml.plist" - Data: "key"
-											17887-17888: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17889-17895: "meta.tag.key.xml.plist"
-											17889-17891: "punctuation.definition.tag.xml.plist" - Data: "</"
-											17891-17894: "entity.name.tag.xml.plist"
-												17891-17894: "entity.name.tag.localname.xml.plist" - Data: "key"
-											17894-17895: "punctuation.definition.tag.xml.plist" - Data: ">"
-									17902-17995: ""
-										17902-17908: "meta.tag.dict.xml.plist"
-											17902-17903: "punctuation.definition.tag.xml.plist" - Data: "<"
-											17903-17907: "entity.name.tag.xml.plist"
-												17903-17907: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											17907-17908: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17916-17931: ""
-											17916-17921: "meta.tag.key.xml.plist"
-												17916-17917: "punctuation.definition.tag.xml.plist" - Data: "<"
-												17917-17920: "entity.name.tag.xml.plist"
-													17917-17920: "entity.name.tag.localname.xml.plist" - Data: "key"
-												17920-17921: "punctuation.definition.tag.xml.plist" - Data: ">"
-											17925-17931: "meta.tag.key.xml.plist"
-												17925-17927: "punctuation.definition.tag.xml.plist" - Data: "</"
-												17927-17930: "entity.name.tag.xml.plist"
-													17927-17930: "entity.name.tag.localname.xml.plist" - Data: "key"
-												17930-17931: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17939-17981: ""
-											17939-17947: "meta.tag.string.xml.plist"
-												17939-17940: "punctuation.definition.tag.xml.plist" - Data: "<"
-												17940-17946: "entity.name.tag.xml.plist"
-													17940-17946: "entity.name.tag.localname.xml.plist" - Data: "string"
-												17946-17947: "punctuation.definition.tag.xml.plist" - Data: ">"
-											17972-17981: "meta.tag.string.xml.plist"
-												17972-17974: "punctuation.definition.tag.xml.plist" - Data: "</"
-												17974-17980: "entity.name.tag.xml.plist"
-													17974-17980: "entity.name.tag.localname.xml.plist" - Data: "string"
-												17980-17981: "punctuation.definition.tag.xml.plist" - Data: ">"
-										17988-17995: "meta.tag.dict.xml.plist"
-											17988-17990: "punctuation.definition.tag.xml.plist" - Data: "</"
-											17990-17994: "entity.name.tag.xml.plist"
-												17990-17994: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											17994-17995: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18002-18014: ""
-										18002-18007: "meta.tag.key.xml.plist"
-											18002-18003: "punctuation.definition.tag.xml.plist" - Data: "<"
-											18003-18006: "entity.name.tag.xml.plist"
-												18003-18006: "entity.name.tag.localname.xml.plist" - Data: "key"
-											18006-18007: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18008-18014: "meta.tag.key.xml.plist"
-											18008-18010: "punctuation.definition.tag.xml.plist" - Data: "</"
-											18010-18013: "entity.name.tag.xml.plist"
-												18010-18013: "entity.name.tag.localname.xml.plist" - Data: "key"
-											18013-18014: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18021-18124: ""
-										18021-18027: "meta.tag.dict.xml.plist"
-											18021-18022: "punctuation.definition.tag.xml.plist" - Data: "<"
-											18022-18026: "entity.name.tag.xml.plist"
-												18022-18026: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											18026-18027: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18035-18050: ""
-											18035-18040: "meta.tag.key.xml.plist"
-												18035-18036: "punctuation.definition.tag.xml.plist" - Data: "<"
-												18036-18039: "entity.name.tag.xml.plist"
-													18036-18039: "entity.name.tag.localname.xml.plist" - Data: "key"
-												18039-18040: "punctuation.definition.tag.xml.plist" - Data: ">"
-											18044-18050: "meta.tag.key.xml.plist"
-												18044-18046: "punctuation.definition.tag.xml.plist" - Data: "</"
-												18046-18049: "entity.name.tag.xml.plist"
-													18046-18049: "entity.name.tag.localname.xml.plist" - Data: "key"
-												18049-18050: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18058-18110: ""
-											18058-18066: "meta.tag.string.xml.plist"
-												18058-18059: "punctuation.definition.tag.xml.plist" - Data: "<"
-												18059-18065: "entity.name.tag.xml.plist"
-													18059-18065: "entity.name.tag.localname.xml.plist" - Data: "string"
-												18065-18066: "punctuation.definition.tag.xml.plist" - Data: ">"
-											18101-18110: "meta.tag.string.xml.plist"
-												18101-18103: "punctuation.definition.tag.xml.plist" - Data: "</"
-												18103-18109: "entity.name.tag.xml.plist"
-													18103-18109: "entity.name.tag.localname.xml.plist" - Data: "string"
-												18109-18110: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18117-18124: "meta.tag.dict.xml.plist"
-											18117-18119: "punctuation.definition.tag.xml.plist" - Data: "</"
-											18119-18123: "entity.name.tag.xml.plist"
-												18119-18123: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											18123-18124: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18131-18143: ""
-										18131-18136: "meta.tag.key.xml.plist"
-											18131-18132: "punctuation.definition.tag.xml.plist" - Data: "<"
-											18132-18135: "entity.name.tag.xml.plist"
-												18132-18135: "entity.name.tag.localname.xml.plist" - Data: "key"
-											18135-18136: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18137-18143: "meta.tag.key.xml.plist"
-											18137-18139: "punctuation.definition.tag.xml.plist" - Data: "</"
-											18139-18142: "entity.name.tag.xml.plist"
-												18139-18142: "entity.name.tag.localname.xml.plist" - Data: "key"
-											18142-18143: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18150-18254: ""
-										18150-18156: "meta.tag.dict.xml.plist"
-											18150-18151: "punctuation.definition.tag.xml.plist" - Data: "<"
-											18151-18155: "entity.name.tag.xml.plist"
-												18151-18155: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											18155-18156: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18164-18179: ""
-											18164-18169: "meta.tag.key.xml.plist"
-												18164-18165: "punctuation.definition.tag.xml.plist" - Data: "<"
-												18165-18168: "entity.name.tag.xml.plist"
-													18165-18168: "entity.name.tag.localname.xml.plist" - Data: "key"
-												18168-18169: "punctuation.definition.tag.xml.plist" - Data: ">"
-											18173-18179: "meta.tag.key.xml.plist"
-												18173-18175: "punctuation.definition.tag.xml.plist" - Data: "</"
-												18175-18178: "entity.name.tag.xml.plist"
-													18175-18178: "entity.name.tag.localname.xml.plist" - Data: "key"
-												18178-18179: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18187-18240: ""
-											18187-18195: "meta.tag.string.xml.plist"
-												18187-18188: "punctuation.definition.tag.xml.plist" - Data: "<"
-												18188-18194: "entity.name.tag.xml.plist"
-													18188-18194: "entity.name.tag.localname.xml.plist" - Data: "string"
-												18194-18195: "punctuation.definition.tag.xml.plist" - Data: ">"
-											18231-18240: "meta.tag.string.xml.plist"
-												18231-18233: "punctuation.definition.tag.xml.plist" - Data: "</"
-												18233-18239: "entity.name.tag.xml.plist"
-													18233-18239: "entity.name.tag.localname.xml.plist" - Data: "string"
-												18239-18240: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18247-18254: "meta.tag.dict.xml.plist"
-											18247-18249: "punctuation.definition.tag.xml.plist" - Data: "</"
-											18249-18253: "entity.name.tag.xml.plist"
-												18249-18253: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											18253-18254: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18260-18267: "meta.tag.dict.xml.plist"
-										18260-18262: "punctuation.definition.tag.xml.plist" - Data: "</"
-										18262-18266: "entity.name.tag.xml.plist"
-											18262-18266: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										18266-18267: "punctuation.definition.tag.xml.plist" - Data: ">"
-								18273-18291: ""
-									18273-18278: "meta.tag.key.xml.plist"
-										18273-18274: "punctuation.definition.tag.xml.plist" - Data: "<"
-										18274-18277: "entity.name.tag.xml.plist"
-											18274-18277: "entity.name.tag.localname.xml.plist" - Data: "key"
-										18277-18278: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18285-18291: "meta.tag.key.xml.plist"
-										18285-18287: "punctuation.definition.tag.xml.plist" - Data: "</"
-										18287-18290: "entity.name.tag.xml.plist"
-											18287-18290: "entity.name.tag.localname.xml.plist" - Data: "key"
-										18290-18291: "punctuation.definition.tag.xml.plist" - Data: ">"
-								18297-18319: ""
-									18297-18305: "meta.tag.string.xml.plist"
-										18297-18298: "punctuation.definition.tag.xml.plist" - Data: "<"
-										18298-18304: "entity.name.tag.xml.plist"
-											18298-18304: "entity.name.tag.localname.xml.plist" - Data: "string"
-										18304-18305: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18310-18319: "meta.tag.string.xml.plist"
-										18310-18312: "punctuation.definition.tag.xml.plist" - Data: "</"
-										18312-18318: "entity.name.tag.xml.plist"
-											18312-18318: "entity.name.tag.localname.xml.plist" - Data: "string"
-										18318-18319: "punctuation.definition.tag.xml.plist" - Data: ">"
-								18325-18339: ""
-									18325-18330: "meta.tag.key.xml.plist"
-										18325-18326: "punctuation.definition.tag.xml.plist" - Data: "<"
-										18326-18329: "entity.name.tag.xml.plist"
-											18326-18329: "entity.name.tag.localname.xml.plist" - Data: "key"
-										18329-18330: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18333-18339: "meta.tag.key.xml.plist"
-										18333-18335: "punctuation.definition.tag.xml.plist" - Data: "</"
-										18335-18338: "entity.name.tag.xml.plist"
-											18335-18338: "entity.name.tag.localname.xml.plist" - Data: "key"
-										18338-18339: "punctuation.definition.tag.xml.plist" - Data: ">"
-								18345-18386: ""
-									18345-18353: "meta.tag.string.xml.plist"
-										18345-18346: "punctuation.definition.tag.xml.plist" - Data: "<"
-										18346-18352: "entity.name.tag.xml.plist"
-											18346-18352: "entity.name.tag.localname.xml.plist" - Data: "string"
-										18352-18353: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18377-18386: "meta.tag.string.xml.plist"
-										18377-18379: "punctuation.definition.tag.xml.plist" - Data: "</"
-										18379-18385: "entity.name.tag.xml.plist"
-											18379-18385: "entity.name.tag.localname.xml.plist" - Data: "string"
-										18385-18386: "punctuation.definition.tag.xml.plist" - Data: ">"
-								18392-18411: ""
-									18392-18397: "meta.tag.key.xml.plist"
-										18392-18393: "punctuation.definition.tag.xml.plist" - Data: "<"
-										18393-18396: "entity.name.tag.xml.plist"
-											18393-18396: "entity.name.tag.localname.xml.plist" - Data: "key"
-										18396-18397: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18405-18411: "meta.tag.key.xml.plist"
-										18405-18407: "punctuation.definition.tag.xml.plist" - Data: "</"
-										18407-18410: "entity.name.tag.xml.plist"
-											18407-18410: "entity.name.tag.localname.xml.plist" - Data: "key"
-										18410-18411: "punctuation.definition.tag.xml.plist" - Data: ">"
-								18417-18525: ""
-									18417-18424: "meta.tag.array.xml.plist"
-										18417-18418: "punctuation.definition.tag.xml.plist" - Data: "<"
-										18418-18423: "entity.name.tag.xml.plist"
-											18418-18423: "entity.name.tag.localname.xml.plist" - Data: "array"
-										18423-18424: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18431-18511: ""
-										18431-18437: "meta.tag.dict.xml.plist"
-											18431-18432: "punctuation.definition.tag.xml.plist" - Data: "<"
-											18432-18436: "entity.name.tag.xml.plist"
-												18432-18436: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											18436-18437: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18445-18463: ""
-											18445-18450: "meta.tag.key.xml.plist"
-												18445-18446: "punctuation.definition.tag.xml.plist" - Data: "<"
-												18446-18449: "entity.name.tag.xml.plist"
-													18446-18449: "entity.name.tag.localname.xml.plist" - Data: "key"
-												18449-18450: "punctuation.definition.tag.xml.plist" - Data: ">"
-											18457-18463: "meta.tag.key.xml.plist"
-												18457-18459: "punctuation.definition.tag.xml.plist" - Data: "</"
-												18459-18462: "entity.name.tag.xml.plist"
-													18459-18462: "entity.name.tag.localname.xml.plist" - Data: "key"
-												18462-18463: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18471-18497: ""
-											18471-18479: "meta.tag.string.xml.plist"
-												18471-18472: "punctuation.definition.tag.xml.plist" - Data: "<"
-												18472-18478: "entity.name.tag.xml.plist"
-													18472-18478: "entity.name.tag.localname.xml.plist" - Data: "string"
-												18478-18479: "punctuation.definition.tag.xml.plist" - Data: ">"
-											18488-18497: "meta.tag.string.xml.plist"
-												18488-18490: "punctuation.definition.tag.xml.plist" - Data: "</"
-												18490-18496: "entity.name.tag.xml.plist"
-													18490-18496: "entity.name.tag.localname.xml.plist" - Data: "string"
-												18496-18497: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18504-18511: "meta.tag.dict.xml.plist"
-											18504-18506: "punctuation.definition.tag.xml.plist" - Data: "</"
-											18506-18510: "entity.name.tag.xml.plist"
-												18506-18510: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											18510-18511: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18517-18525: "meta.tag.array.xml.plist"
-										18517-18519: "punctuation.definition.tag.xml.plist" - Data: "</"
-										18519-18524: "entity.name.tag.xml.plist"
-											18519-18524: "entity.name.tag.localname.xml.plist" - Data: "array"
-										18524-18525: "punctuation.definition.tag.xml.plist" - Data: ">"
-								18530-18537: "meta.tag.dict.xml.plist"
-									18530-18532: "punctuation.definition.tag.xml.plist" - Data: "</"
-									18532-18536: "entity.name.tag.xml.plist"
-										18532-18536: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									18536-18537: "punctuation.definition.tag.xml.plist" - Data: ">"
-							18542-20060: ""
-								18542-18548: "meta.tag.dict.xml.plist"
-									18542-18543: "punctuation.definition.tag.xml.plist" - Data: "<"
-									18543-18547: "entity.name.tag.xml.plist"
-										18543-18547: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									18547-18548: "punctuation.definition.tag.xml.plist" - Data: ">"
-								18554-18570: ""
-									18554-18559: "meta.tag.key.xml.plist"
-										18554-18555: "punctuation.definition.tag.xml.plist" - Data: "<"
-										18555-18558: "entity.name.tag.xml.plist"
-											18555-18558: "entity.name.tag.localname.xml.plist" - Data: "key"
-										18558-18559: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18564-18570: "meta.tag.key.xml.plist"
-										18564-18566: "punctuation.definition.tag.xml.plist" - Data: "</"
-										18566-18569: "entity.name.tag.xml.plist"
-											18566-18569: "entity.name.tag.localname.xml.plist" - Data: "key"
-										18569-18570: "punctuation.definition.tag.xml.plist" - Data: ">"
-								18576-18617: ""
-									18576-18584: "meta.tag.string.xml.plist"
-										18576-18577: "punctuation.definition.tag.xml.plist" - Data: "<"
-										18577-18583: "entity.name.tag.xml.plist"
-											18577-18583: "entity.name.tag.localname.xml.plist" - Data: "string"
-										18583-18584: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18608-18617: "meta.tag.string.xml.plist"
-										18608-18610: "punctuation.definition.tag.xml.plist" - Data: "</"
-										18610-18616: "entity.name.tag.xml.plist"
-											18610-18616: "entity.name.tag.localname.xml.plist" - Data: "string"
-										18616-18617: "punctuation.definition.tag.xml.plist" - Data: ">"
-								18623-18642: ""
-									18623-18628: "meta.tag.key.xml.plist"
-										18623-18624: "punctuation.definition.tag.xml.plist" - Data: "<"
-										18624-18627: "entity.name.tag.xml.plist"
-											18624-18627: "entity.name.tag.localname.xml.plist" - Data: "key"
-										18627-18628: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18636-18642: "meta.tag.key.xml.plist"
-										18636-18638: "punctuation.definition.tag.xml.plist" - Data: "</"
-										18638-18641: "entity.name.tag.xml.plist"
-											18638-18641: "entity.name.tag.localname.xml.plist" - Data: "key"
-										18641-18642: "punctuation.definition.tag.xml.plist" - Data: ">"
-								18648-19294: ""
-									18648-18654: "meta.tag.dict.xml.plist"
-										18648-18649: "punctuation.definition.tag.xml.plist" - Data: "<"
-										18649-18653: "entity.name.tag.xml.plist"
-											18649-18653: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										18653-18654: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18661-18673: ""
-										18661-18666: "meta.tag.key.xml.plist"
-											18661-18662: "punctuation.definition.tag.xml.plist" - Data: "<"
-											18662-18665: "entity.name.tag.xml.plist"
-												18662-18665: "entity.name.tag.localname.xml.plist" - Data: "key"
-											18665-18666: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18667-18673: "meta.tag.key.xml.plist"
-											18667-18669: "punctuation.definition.tag.xml.plist" - Data: "</"
-											18669-18672: "entity.name.tag.xml.plist"
-												18669-18672: "entity.name.tag.localname.xml.plist" - Data: "key"
-											18672-18673: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18680-18773: ""
-										18680-18686: "meta.tag.dict.xml.plist"
-											18680-18681: "punctuation.definition.tag.xml.plist" - Data: "<"
-											18681-18685: "entity.name.tag.xml.plist"
-												18681-18685: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											18685-18686: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18694-18709: ""
-											18694-18699: "meta.tag.key.xml.plist"
-												18694-18695: "punctuation.definition.tag.xml.plist" - Data: "<"
-												18695-18698: "entity.name.tag.xml.plist"
-													18695-18698: "entity.name.tag.localname.xml.plist" - Data: "key"
-												18698-18699: "punctuation.definition.tag.xml.plist" - Data: ">"
-											18703-18709: "meta.tag.key.xml.plist"
-												18703-18705: "punctuation.definition.tag.xml.plist" - Data: "</"
-												18705-18708: "entity.name.tag.xml.plist"
-													18705-18708: "entity.name.tag.localname.xml.plist" - Data: "key"
-												18708-18709: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18717-18759: ""
-											18717-18725: "meta.tag.string.xml.plist"
-												18717-18718: "punctuation.definition.tag.xml.plist" - Data: "<"
-												18718-18724: "entity.name.tag.xml.plist"
-													18718-18724: "entity.name.tag.localname.xml.plist" - Data: "string"
-												18724-18725: "punctuation.definition.tag.xml.plist" - Data: ">"
-											18750-18759: "meta.tag.string.xml.plist"
-												18750-18752: "punctuation.definition.tag.xml.plist" - Data: "</"
-												18752-18758: "entity.name.tag.xml.plist"
-													18752-18758: "entity.name.tag.localname.xml.plist" - Data: "string"
-												18758-18759: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18766-18773: "meta.tag.dict.xml.plist"
-											18766-18768: "punctuation.definition.tag.xml.plist" - Data: "</"
-											18768-18772: "entity.name.tag.xml.plist"
-												18768-18772: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											18772-18773: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18780-18792: ""
-										18780-18785: "meta.tag.key.xml.plist"
-											18780-18781: "punctuation.definition.tag.xml.plist" - Data: "<"
-											18781-18784: "entity.name.tag.xml.plist"
-												18781-18784: "entity.name.tag.localname.xml.plist" - Data: "key"
-											18784-18785: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18786-18792: "meta.tag.key.xml.plist"
-											18786-18788: "punctuation.definition.tag.xml.plist" - Data: "</"
-											18788-18791: "entity.name.tag.xml.plist"
-												18788-18791: "entity.name.tag.localname.xml.plist" - Data: "key"
-											18791-18792: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18799-18903: ""
-										18799-18805: "meta.tag.dict.xml.plist"
-											18799-18800: "punctuation.definition.tag.xml.plist" - Data: "<"
-											18800-18804: "entity.name.tag.xml.plist"
-												18800-18804: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											18804-18805: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18813-18828: ""
-											18813-18818: "meta.tag.key.xml.plist"
-												18813-18814: "punctuation.definition.tag.xml.plist" - Data: "<"
-												18814-18817: "entity.name.tag.xml.plist"
-													18814-18817: "entity.name.tag.localname.xml.plist" - Data: "key"
-												18817-18818: "punctuation.definition.tag.xml.plist" - Data: ">"
-											18822-18828: "meta.tag.key.xml.plist"
-												18822-18824: "punctuation.definition.tag.xml.plist" - Data: "</"
-												18824-18827: "entity.name.tag.xml.plist"
-													18824-18827: "entity.name.tag.localname.xml.plist" - Data: "key"
-												18827-18828: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18836-18889: ""
-											18836-18844: "meta.tag.string.xml.plist"
-												18836-18837: "punctuation.definition.tag.xml.plist" - Data: "<"
-												18837-18843: "entity.name.tag.xml.plist"
-													18837-18843: "entity.name.tag.localname.xml.plist" - Data: "string"
-												18843-18844: "punctuation.definition.tag.xml.plist" - Data: ">"
-											18880-18889: "meta.tag.string.xml.plist"
-												18880-18882: "punctuation.definition.tag.xml.plist" - Data: "</"
-												18882-18888: "entity.name.tag.xml.plist"
-													18882-18888: "entity.name.tag.localname.xml.plist" - Data: "string"
-												18888-18889: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18896-18903: "meta.tag.dict.xml.plist"
-											18896-18898: "punctuation.definition.tag.xml.plist" - Data: "</"
-											18898-18902: "entity.name.tag.xml.plist"
-												18898-18902: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											18902-18903: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18910-18922: ""
-										18910-18915: "meta.tag.key.xml.plist"
-											18910-18911: "punctuation.definition.tag.xml.plist" - Data: "<"
-											18911-18914: "entity.name.tag.xml.plist"
-												18911-18914: "entity.name.tag.localname.xml.plist" - Data: "key"
-											18914-18915: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18916-18922: "meta.tag.key.xml.plist"
-											18916-18918: "punctuation.definition.tag.xml.plist" - Data: "</"
-											18918-18921: "entity.name.tag.xml.plist"
-												18918-18921: "entity.name.tag.localname.xml.plist" - Data: "key"
-											18921-18922: "punctuation.definition.tag.xml.plist" - Data: ">"
-									18929-19022: ""
-										18929-18935: "meta.tag.dict.xml.plist"
-											18929-18930: "punctuation.definition.tag.xml.plist" - Data: "<"
-											18930-18934: "entity.name.tag.xml.plist"
-												18930-18934: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											18934-18935: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18943-18958: ""
-											18943-18948: "meta.tag.key.xml.plist"
-												18943-18944: "punctuation.definition.tag.xml.plist" - Data: "<"
-												18944-18947: "entity.name.tag.xml.plist"
-													18944-18947: "entity.name.tag.localname.xml.plist" - Data: "key"
-												18947-18948: "punctuation.definition.tag.xml.plist" - Data: ">"
-											18952-18958: "meta.tag.key.xml.plist"
-												18952-18954: "punctuation.definition.tag.xml.plist" - Data: "</"
-												18954-18957: "entity.name.tag.xml.plist"
-													18954-18957: "entity.name.tag.localname.xml.plist" - Data: "key"
-												18957-18958: "punctuation.definition.tag.xml.plist" - Data: ">"
-										18966-19008: ""
-											18966-18974: "meta.tag.string.xml.plist"
-												18966-18967: "punctuation.definition.tag.xml.plist" - Data: "<"
-												18967-18973: "entity.name.tag.xml.plist"
-													18967-18973: "entity.name.tag.localname.xml.plist" - Data: "string"
-												18973-18974: "punctuation.definition.tag.xml.plist" - Data: ">"
-											18999-19008: "meta.tag.string.xml.plist"
-												18999-19001: "punctuation.definition.tag.xml.plist" - Data: "</"
-												19001-19007: "entity.name.tag.xml.plist"
-													19001-19007: "entity.name.tag.localname.xml.plist" - Data: "string"
-												19007-19008: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19015-19022: "meta.tag.dict.xml.plist"
-											19015-19017: "punctuation.definition.tag.xml.plist" - Data: "</"
-											19017-19021: "entity.name.tag.xml.plist"
-												19017-19021: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											19021-19022: "punctuation.definition.tag.xml.plist" - Data: ">"
-									19029-19041: ""
-										19029-19034: "meta.tag.key.xml.plist"
-											19029-19030: "punctuation.definition.tag.xml.plist" - Data: "<"
-											19030-19033: "entity.name.tag.xml.plist"
-												19030-19033: "entity.name.tag.localname.xml.plist" - Data: "key"
-											19033-19034: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19035-19041: "meta.tag.key.xml.plist"
-											19035-19037: "punctuation.definition.tag.xml.plist" - Data: "</"
-											19037-19040: "entity.name.tag.xml.plist"
-												19037-19040: "entity.name.tag.localname.xml.plist" - Data: "key"
-											19040-19041: "punctuation.definition.tag.xml.plist" - Data: ">"
-									19048-19151: ""
-										19048-19054: "meta.tag.dict.xml.plist"
-											19048-19049: "punctuation.definition.tag.xml.plist" - Data: "<"
-											19049-19053: "entity.name.tag.xml.plist"
-												19049-19053: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											19053-19054: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19062-19077: ""
-											19062-19067: "meta.tag.key.xml.plist"
-												19062-19063: "punctuation.definition.tag.xml.plist" - Data: "<"
-												19063-19066: "entity.name.tag.xml.plist"
-													19063-19066: "entity.name.tag.localname.xml.plist" - Data: "key"
-												19066-19067: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19071-19077: "meta.tag.key.xml.plist"
-												19071-19073: "punctuation.definition.tag.xml.plist" - Data: "</"
-												19073-19076: "entity.name.tag.xml.plist"
-													19073-19076: "entity.name.tag.localname.xml.plist" - Data: "key"
-												19076-19077: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19085-19137: ""
-											19085-19093: "meta.tag.string.xml.plist"
-												19085-19086: "punctuation.definition.tag.xml.plist" - Data: "<"
-												19086-19092: "entity.name.tag.xml.plist"
-													19086-19092: "entity.name.tag.localname.xml.plist" - Data: "string"
-												19092-19093: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19128-19137: "meta.tag.string.xml.plist"
-												19128-19130: "punctuation.definition.tag.xml.plist" - Data: "</"
-												19130-19136: "entity.name.tag.xml.plist"
-													19130-19136: "entity.name.tag.localname.xml.plist" - Data: "string"
-												19136-19137: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19144-19151: "meta.tag.dict.xml.plist"
-											19144-19146: "punctuation.definition.tag.xml.plist" - Data: "</"
-											19146-19150: "entity.name.tag.xml.plist"
-												19146-19150: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											19150-19151: "punctuation.definition.tag.xml.plist" - Data: ">"
-									19158-19170: ""
-										19158-19163: "meta.tag.key.xml.plist"
-											19158-19159: "punctuation.definition.tag.xml.plist" - Data: "<"
-											19159-19162: "entity.name.tag.xml.plist"
-												19159-19162: "entity.name.tag.localname.xml.plist" - Data: "key"
-											19162-19163: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19164-19170: "meta.tag.key.xml.plist"
-											19164-19166: "punctuation.definition.tag.xml.plist" - Data: "</"
-											19166-19169: "entity.name.tag.xml.plist"
-												19166-19169: "entity.name.tag.localname.xml.plist" - Data: "key"
-											19169-19170: "punctuation.definition.tag.xml.plist" - Data: ">"
-									19177-19281: ""
-										19177-19183: "meta.tag.dict.xml.plist"
-											19177-19178: "punctuation.definition.tag.xml.plist" - Data: "<"
-											19178-19182: "entity.name.tag.xml.plist"
-												19178-19182: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											19182-19183: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19191-19206: ""
-											19191-19196: "meta.tag.key.xml.plist"
-												19191-19192: "punctuation.definition.tag.xml.plist" - Data: "<"
-												19192-19195: "entity.name.tag.xml.plist"
-													19192-19195: "entity.name.tag.localname.xml.plist" - Data: "key"
-												19195-19196: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19200-19206: "meta.tag.key.xml.plist"
-												19200-19202: "punctuation.definition.tag.xml.plist" - Data: "</"
-												19202-19205: "entity.name.tag.xml.plist"
-													19202-19205: "entity.name.tag.localname.xml.plist" - Data: "key"
-												19205-19206: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19214-19267: ""
-											19214-19222: "meta.tag.string.xml.plist"
-												19214-19215: "punctuation.definition.tag.xml.plist" - Data: "<"
-												19215-19221: "entity.name.tag.xml.plist"
-													19215-19221: "entity.name.tag.localname.xml.plist" - Data: "string"
-												19221-19222: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19258-19267: "meta.tag.string.xml.plist"
-												19258-19260: "punctuation.definition.tag.xml.plist" - Data: "</"
-												19260-19266: "entity.name.tag.xml.plist"
-													19260-19266: "entity.name.tag.localname.xml.plist" - Data: "string"
-												19266-19267: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19274-19281: "meta.tag.dict.xml.plist"
-											19274-19276: "punctuation.definition.tag.xml.plist" - Data: "</"
-											19276-19280: "entity.name.tag.xml.plist"
-												19276-19280: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											19280-19281: "punctuation.definition.tag.xml.plist" - Data: ">"
-									19287-19294: "meta.tag.dict.xml.plist"
-										19287-19289: "punctuation.definition.tag.xml.plist" - Data: "</"
-										19289-19293: "entity.name.tag.xml.plist"
-											19289-19293: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										19293-19294: "punctuation.definition.tag.xml.plist" - Data: ">"
-								19300-19318: ""
-									19300-19305: "meta.tag.key.xml.plist"
-										19300-19301: "punctuation.definition.tag.xml.plist" - Data: "<"
-										19301-19304: "entity.name.tag.xml.plist"
-											19301-19304: "entity.name.tag.localname.xml.plist" - Data: "key"
-										19304-19305: "punctuation.definition.tag.xml.plist" - Data: ">"
-									19312-19318: "meta.tag.key.xml.plist"
-										19312-19314: "punctuation.definition.tag.xml.plist" - Data: "</"
-										19314-19317: "entity.name.tag.xml.plist"
-											19314-19317: "entity.name.tag.localname.xml.plist" - Data: "key"
-										19317-19318: "punctuation.definition.tag.xml.plist" - Data: ">"
-								19324-19348: ""
-									19324-19332: "meta.tag.string.xml.plist"
-										19324-19325: "punctuation.definition.tag.xml.plist" - Data: "<"
-										19325-19331: "entity.name.tag.xml.plist"
-											19325-19331: "entity.name.tag.localname.xml.plist" - Data: "string"
-										19331-19332: "punctuation.definition.tag.xml.plist" - Data: ">"
-									19339-19348: "meta.tag.string.xml.plist"
-										19339-19341: "punctuation.definition.tag.xml.plist" - Data: "</"
-										19341-19347: "entity.name.tag.xml.plist"
-											19341-19347: "entity.name.tag.localname.xml.plist" - Data: "string"
-										19347-19348: "punctuation.definition.tag.xml.plist" - Data: ">"
-								19354-19376: ""
-									19354-19359: "meta.tag.key.xml.plist"
-										19354-19355: "punctuation.definition.tag.xml.plist" - Data: "<"
-										19355-19358: "entity.name.tag.xml.plist"
-											19355-19358: "entity.name.tag.localname.xml.plist" - Data: "key"
-										19358-19359: "punctuation.definition.tag.xml.plist" - Data: ">"
-									19370-19376: "meta.tag.key.xml.plist"
-										19370-19372: "punctuation.definition.tag.xml.plist" - Data: "</"
-										19372-19375: "entity.name.tag.xml.plist"
-											19372-19375: "entity.name.tag.localname.xml.plist" - Data: "key"
-										19375-19376: "punctuation.definition.tag.xml.plist" - Data: ">"
-								19382-19428: ""
-									19382-19390: "meta.tag.string.xml.plist"
-										19382-19383: "punctuation.definition.tag.xml.plist" - Data: "<"
-										19383-19389: "entity.name.tag.xml.plist"
-											19383-19389: "entity.name.tag.localname.xml.plist" - Data: "string"
-										19389-19390: "punctuation.definition.tag.xml.plist" - Data: ">"
-									19419-19428: "meta.tag.string.xml.plist"
-										19419-19421: "punctuation.definition.tag.xml.plist" - Data: "</"
-										19421-19427: "entity.name.tag.xml.plist"
-											19421-19427: "entity.name.tag.localname.xml.plist" - Data: "string"
-										19427-19428: "punctuation.definition.tag.xml.plist" - Data: ">"
-								19434-19448: ""
-									19434-19439: "meta.tag.key.xml.plist"
-										19434-19435: "punctuation.definition.tag.xml.plist" - Data: "<"
-										19435-19438: "entity.name.tag.xml.plist"
-											19435-19438: "entity.name.tag.localname.xml.plist" - Data: "key"
-										19438-19439: "punctuation.definition.tag.xml.plist" - Data: ">"
-									19442-19448: "meta.tag.key.xml.plist"
-										19442-19444: "punctuation.definition.tag.xml.plist" - Data: "</"
-										19444-19447: "entity.name.tag.xml.plist"
-											19444-19447: "entity.name.tag.localname.xml.plist" - Data: "key"
-										19447-19448: "punctuation.definition.tag.xml.plist" - Data: ">"
-								19454-19496: ""
-									19454-19462: "meta.tag.string.xml.plist"
-										19454-19455: "punctuation.definition.tag.xml.plist" - Data: "<"
-										19455-19461: "entity.name.tag.xml.plist"
-											19455-19461: "entity.name.tag.localname.xml.plist" - Data: "string"
-										19461-19462: "punctuation.definition.tag.xml.plist" - Data: ">"
-									19487-19496: "meta.tag.string.xml.plist"
-										19487-19489: "punctuation.definition.tag.xml.plist" - Data: "</"
-										19489-19495: "entity.name.tag.xml.plist"
-											19489-19495: "entity.name.tag.localname.xml.plist" - Data: "string"
-										19495-19496: "punctuation.definition.tag.xml.plist" - Data: ">"
-								19502-19521: ""
-									19502-19507: "meta.tag.key.xml.plist"
-										19502-19503: "punctuation.definition.tag.xml.plist" - Data: "<"
-										19503-19506: "entity.name.tag.xml.plist"
-											19503-19506: "entity.name.tag.localname.xml.plist" - Data: "key"
-										19506-19507: "punctuation.definition.tag.xml.plist" - Data: ">"
-									19515-19521: "meta.tag.key.xml.plist"
-										19515-19517: "punctuation.definition.tag.xml.plist" - Data: "</"
-										19517-19520: "entity.name.tag.xml.plist"
-											19517-19520: "entity.name.tag.localname.xml.plist" - Data: "key"
-										19520-19521: "punctuation.definition.tag.xml.plist" - Data: ">"
-								19527-20048: ""
-									19527-19534: "meta.tag.array.xml.plist"
-										19527-19528: "punctuation.definition.tag.xml.plist" - Data: "<"
-										19528-19533: "entity.name.tag.xml.plist"
-											19528-19533: "entity.name.tag.localname.xml.plist" - Data: "array"
-										19533-19534: "punctuation.definition.tag.xml.plist" - Data: ">"
-									19541-19625: ""
-										19541-19547: "meta.tag.dict.xml.plist"
-											19541-19542: "punctuation.definition.tag.xml.plist" - Data: "<"
-											19542-19546: "entity.name.tag.xml.plist"
-												19542-19546: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											19546-19547: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19555-19573: ""
-											19555-19560: "meta.tag.key.xml.plist"
-												19555-19556: "punctuation.definition.tag.xml.plist" - Data: "<"
-												19556-19559: "entity.name.tag.xml.plist"
-													19556-19559: "entity.name.tag.localname.xml.plist" - Data: "key"
-												19559-19560: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19567-19573: "meta.tag.key.xml.plist"
-												19567-19569: "punctuation.definition.tag.xml.plist" - Data: "</"
-												19569-19572: "entity.name.tag.xml.plist"
-													19569-19572: "entity.name.tag.localname.xml.plist" - Data: "key"
-												19572-19573: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19581-19611: ""
-											19581-19589: "meta.tag.string.xml.plist"
-												19581-19582: "punctuation.definition.tag.xml.plist" - Data: "<"
-												19582-19588: "entity.name.tag.xml.plist"
-													19582-19588: "entity.name.tag.localname.xml.plist" - Data: "string"
-												19588-19589: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19602-19611: "meta.tag.string.xml.plist"
-												19602-19604: "punctuation.definition.tag.xml.plist" - Data: "</"
-												19604-19610: "entity.name.tag.xml.plist"
-													19604-19610: "entity.name.tag.localname.xml.plist" - Data: "string"
-												19610-19611: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19618-19625: "meta.tag.dict.xml.plist"
-											19618-19620: "punctuation.definition.tag.xml.plist" - Data: "</"
-											19620-19624: "entity.name.tag.xml.plist"
-												19620-19624: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											19624-19625: "punctuation.definition.tag.xml.plist" - Data: ">"
-									19632-20034: ""
-										19632-19638: "meta.tag.dict.xml.plist"
-											19632-19633: "punctuation.definition.tag.xml.plist" - Data: "<"
-											19633-19637: "entity.name.tag.xml.plist"
-												19633-19637: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											19637-19638: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19646-19662: ""
-											19646-19651: "meta.tag.key.xml.plist"
-												19646-19647: "punctuation.definition.tag.xml.plist" - Data: "<"
-												19647-19650: "entity.name.tag.xml.plist"
-													19647-19650: "entity.name.tag.localname.xml.plist" - Data: "key"
-												19650-19651: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19656-19662: "meta.tag.key.xml.plist"
-												19656-19658: "punctuation.definition.tag.xml.plist" - Data: "</"
-												19658-19661: "entity.name.tag.xml.plist"
-													19658-19661: "entity.name.tag.localname.xml.plist" - Data: "key"
-												19661-19662: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19670-19701: ""
-											19670-19678: "meta.tag.string.xml.plist"
-												19670-19671: "punctuation.definition.tag.xml.plist" - Data: "<"
-												19671-19677: "entity.name.tag.xml.plist"
-													19671-19677: "entity.name.tag.localname.xml.plist" - Data: "string"
-												19677-19678: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19692-19701: "meta.tag.string.xml.plist"
-												19692-19694: "punctuation.definition.tag.xml.plist" - Data: "</"
-												19694-19700: "entity.name.tag.xml.plist"
-													19694-19700: "entity.name.tag.localname.xml.plist" - Data: "string"
-												19700-19701: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19709-19728: ""
-											19709-19714: "meta.tag.key.xml.plist"
-												19709-19710: "punctuation.definition.tag.xml.plist" - Data: "<"
-												19710-19713: "entity.name.tag.xml.plist"
-													19710-19713: "entity.name.tag.localname.xml.plist" - Data: "key"
-												19713-19714: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19722-19728: "meta.tag.key.xml.plist"
-												19722-19724: "punctuation.definition.tag.xml.plist" - Data: "</"
-												19724-19727: "entity.name.tag.xml.plist"
-													19724-19727: "entity.name.tag.localname.xml.plist" - Data: "key"
-												19727-19728: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19736-19894: ""
-											19736-19742: "meta.tag.dict.xml.plist"
-												19736-19737: "punctuation.definition.tag.xml.plist" - Data: "<"
-												19737-19741: "entity.name.tag.xml.plist"
-													19737-19741: "entity.name.tag.localname.xml.plist" - Data: "dict"
-												19741-19742: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19751-19763: ""
-												19751-19756: "meta.tag.key.xml.plist"
-													19751-19752: "punctuation.definition.tag.xml.plist" - Data: "<"
-													19752-19755: "entity.name.tag.xml.plist"
-														19752-19755: "entity.name.tag.localname.xml.plist" - Data: "key"
-													19755-19756: "punctuation.definition.tag.xml.plist" - Data: ">"
-												19757-19763: "meta.tag.key.xml.plist"
-													19757-19759: "punctuation.definition.tag.xml.plist" - Data: "</"
-													19759-19762: "entity.name.tag.xml.plist"
-														19759-19762: "entity.name.tag.localname.xml.plist" - Data: "key"
-													19762-19763: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19772-19879: ""
-												19772-19778: "meta.tag.dict.xml.plist"
-													19772-19773: "punctuation.definition.tag.xml.plist" - Data: "<"
-													19773-19777: "entity.name.tag.xml.plist"
-														19773-19777: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													19777-19778: "punctuation.definition.tag.xml.plist" - Data: ">"
-												19788-19803: ""
-													19788-19793: "meta.tag.key.xml.plist"
-														19788-19789: "punctuation.definition.tag.xml.plist" - Data: "<"
-														19789-19792: "entity.name.tag.xml.plist"
-															19789-19792: "entity.name.tag.localname.xml.plist" - Data: "key"
-														19792-19793: "punctuation.definition.tag.xml.plist" - Data: ">"
-													19797-19803: "meta.tag.key.xml.plist"
-														19797-19799: "punctuation.definition.tag.xml.plist" - Data: "</"
-														19799-19802: "entity.name.tag.xml.plist"
-															19799-19802: "entity.name.tag.localname.xml.plist" - Data: "key"
-														19802-19803: "punctuation.definition.tag.xml.plist" - Data: ">"
-												19813-19863: ""
-													19813-19821: "meta.tag.string.xml.plist"
-														19813-19814: "punctuation.definition.tag.xml.plist" - Data: "<"
-														19814-19820: "entity.name.tag.xml.plist"
-															19814-19820: "entity.name.tag.localname.xml.plist" - Data: "string"
-														19820-19821: "punctuation.definition.tag.xml.plist" - Data: ">"
-													19854-19863: "meta.tag.string.xml.plist"
-														19854-19856: "punctuation.definition.tag.xml.plist" - Data: "</"
-														19856-19862: "entity.name.tag.xml.plist"
-															19856-19862: "entity.name.tag.localname.xml.plist" - Data: "string"
-														19862-19863: "punctuation.definition.tag.xml.plist" - Data: ">"
-												19872-19879: "meta.tag.dict.xml.plist"
-													19872-19874: "punctuation.definition.tag.xml.plist" - Data: "</"
-													19874-19878: "entity.name.tag.xml.plist"
-														19874-19878: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													19878-19879: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19887-19894: "meta.tag.dict.xml.plist"
-												19887-19889: "punctuation.definition.tag.xml.plist" - Data: "</"
-												19889-19893: "entity.name.tag.xml.plist"
-													19889-19893: "entity.name.tag.localname.xml.plist" - Data: "dict"
-												19893-19894: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19902-19916: ""
-											19902-19907: "meta.tag.key.xml.plist"
-												19902-19903: "punctuation.definition.tag.xml.plist" - Data: "<"
-												19903-19906: "entity.name.tag.xml.plist"
-													19903-19906: "entity.name.tag.localname.xml.plist" - Data: "key"
-												19906-19907: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19910-19916: "meta.tag.key.xml.plist"
-												19910-19912: "punctuation.definition.tag.xml.plist" - Data: "</"
-												19912-19915: "entity.name.tag.xml.plist"
-													19912-19915: "entity.name.tag.localname.xml.plist" - Data: "key"
-												19915-19916: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19924-19947: ""
-											19924-19932: "meta.tag.string.xml.plist"
-												19924-19925: "punctuation.definition.tag.xml.plist" - Data: "<"
-												19925-19931: "entity.name.tag.xml.plist"
-													19925-19931: "entity.name.tag.localname.xml.plist" - Data: "string"
-												19931-19932: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19938-19947: "meta.tag.string.xml.plist"
-												19938-19940: "punctuation.definition.tag.xml.plist" - Data: "</"
-												19940-19946: "entity.name.tag.xml.plist"
-													19940-19946: "entity.name.tag.localname.xml.plist" - Data: "string"
-												19946-19947: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19955-19970: ""
-											19955-19960: "meta.tag.key.xml.plist"
-												19955-19956: "punctuation.definition.tag.xml.plist" - Data: "<"
-												19956-19959: "entity.name.tag.xml.plist"
-													19956-19959: "entity.name.tag.localname.xml.plist" - Data: "key"
-												19959-19960: "punctuation.definition.tag.xml.plist" - Data: ">"
-											19964-19970: "meta.tag.key.xml.plist"
-												19964-19966: "punctuation.definition.tag.xml.plist" - Data: "</"
-												19966-19969: "entity.name.tag.xml.plist"
-													19966-19969: "entity.name.tag.localname.xml.plist" - Data: "key"
-												19969-19970: "punctuation.definition.tag.xml.plist" - Data: ">"
-										19978-20020: ""
-											19978-19986: "meta.tag.string.xml.plist"
-												19978-19979: "punctuation.definition.tag.xml.plist" - Data: "<"
-												19979-19985: "entity.name.tag.xml.plist"
-													19979-19985: "entity.name.tag.localname.xml.plist" - Data: "string"
-												19985-19986: "punctuation.definition.tag.xml.plist" - Data: ">"
-											20011-20020: "meta.tag.string.xml.plist"
-												20011-20013: "punctuation.definition.tag.xml.plist" - Data: "</"
-												20013-20019: "entity.name.tag.xml.plist"
-													20013-20019: "entity.name.tag.localname.xml.plist" - Data: "string"
-												20019-20020: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20027-20034: "meta.tag.dict.xml.plist"
-											20027-20029: "punctuation.definition.tag.xml.plist" - Data: "</"
-											20029-20033: "entity.name.tag.xml.plist"
-												20029-20033: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											20033-20034: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20040-20048: "meta.tag.array.xml.plist"
-										20040-20042: "punctuation.definition.tag.xml.plist" - Data: "</"
-										20042-20047: "entity.name.tag.xml.plist"
-											20042-20047: "entity.name.tag.localname.xml.plist" - Data: "array"
-										20047-20048: "punctuation.definition.tag.xml.plist" - Data: ">"
-								20053-20060: "meta.tag.dict.xml.plist"
-									20053-20055: "punctuation.definition.tag.xml.plist" - Data: "</"
-									20055-20059: "entity.name.tag.xml.plist"
-										20055-20059: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									20059-20060: "punctuation.definition.tag.xml.plist" - Data: ">"
-							20065-22246: ""
-								20065-20071: "meta.tag.dict.xml.plist"
-									20065-20066: "punctuation.definition.tag.xml.plist" - Data: "<"
-									20066-20070: "entity.name.tag.xml.plist"
-										20066-20070: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									20070-20071: "punctuation.definition.tag.xml.plist" - Data: ">"
-								20077-20093: ""
-									20077-20082: "meta.tag.key.xml.plist"
-										20077-20078: "punctuation.definition.tag.xml.plist" - Data: "<"
-										20078-20081: "entity.name.tag.xml.plist"
-											20078-20081: "entity.name.tag.localname.xml.plist" - Data: "key"
-										20081-20082: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20087-20093: "meta.tag.key.xml.plist"
-										20087-20089: "punctuation.definition.tag.xml.plist" - Data: "</"
-										20089-20092: "entity.name.tag.xml.plist"
-											20089-20092: "entity.name.tag.localname.xml.plist" - Data: "key"
-										20092-20093: "punctuation.definition.tag.xml.plist" - Data: ">"
-								20099-20138: ""
-									20099-20107: "meta.tag.string.xml.plist"
-										20099-20100: "punctuation.definition.tag.xml.plist" - Data: "<"
-										20100-20106: "entity.name.tag.xml.plist"
-											20100-20106: "entity.name.tag.localname.xml.plist" - Data: "string"
-										20106-20107: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20129-20138: "meta.tag.string.xml.plist"
-										20129-20131: "punctuation.definition.tag.xml.plist" - Data: "</"
-										20131-20137: "entity.name.tag.xml.plist"
-											20131-20137: "entity.name.tag.localname.xml.plist" - Data: "string"
-										20137-20138: "punctuation.definition.tag.xml.plist" - Data: ">"
-								20144-20163: ""
-									20144-20149: "meta.tag.key.xml.plist"
-										20144-20145: "punctuation.definition.tag.xml.plist" - Data: "<"
-										20145-20148: "entity.name.tag.xml.plist"
-											20145-20148: "entity.name.tag.localname.xml.plist" - Data: "key"
-										20148-20149: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20157-20163: "meta.tag.key.xml.plist"
-										20157-20159: "punctuation.definition.tag.xml.plist" - Data: "</"
-										20159-20162: "entity.name.tag.xml.plist"
-											20159-20162: "entity.name.tag.localname.xml.plist" - Data: "key"
-										20162-20163: "punctuation.definition.tag.xml.plist" - Data: ">"
-								20169-20813: ""
-									20169-20175: "meta.tag.dict.xml.plist"
-										20169-20170: "punctuation.definition.tag.xml.plist" - Data: "<"
-										20170-20174: "entity.name.tag.xml.plist"
-											20170-20174: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										20174-20175: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20182-20194: ""
-										20182-20187: "meta.tag.key.xml.plist"
-											20182-20183: "punctuation.definition.tag.xml.plist" - Data: "<"
-											20183-20186: "entity.name.tag.xml.plist"
-												20183-20186: "entity.name.tag.localname.xml.plist" - Data: "key"
-											20186-20187: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20188-20194: "meta.tag.key.xml.plist"
-											20188-20190: "punctuation.definition.tag.xml.plist" - Data: "</"
-											20190-20193: "entity.name.tag.xml.plist"
-												20190-20193: "entity.name.tag.localname.xml.plist" - Data: "key"
-											20193-20194: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20201-20292: ""
-										20201-20207: "meta.tag.dict.xml.plist"
-											20201-20202: "punctuation.definition.tag.xml.plist" - Data: "<"
-											20202-20206: "entity.name.tag.xml.plist"
-												20202-20206: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											20206-20207: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20215-20230: ""
-											20215-20220: "meta.tag.key.xml.plist"
-												20215-20216: "punctuation.definition.tag.xml.plist" - Data: "<"
-												20216-20219: "entity.name.tag.xml.plist"
-													20216-20219: "entity.name.tag.localname.xml.plist" - Data: "key"
-												20219-20220: "punctuation.definition.tag.xml.plist" - Data: ">"
-											20224-20230: "meta.tag.key.xml.plist"
-												20224-20226: "punctuation.definition.tag.xml.plist" - Data: "</"
-												20226-20229: "entity.name.tag.xml.plist"
-													20226-20229: "entity.name.tag.localname.xml.plist" - Data: "key"
-												20229-20230: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20238-20278: ""
-											20238-20246: "meta.tag.string.xml.plist"
-												20238-20239: "punctuation.definition.tag.xml.plist" - Data: "<"
-												20239-20245: "entity.name.tag.xml.plist"
-													20239-20245: "entity.name.tag.localname.xml.plist" - Data: "string"
-												20245-20246: "punctuation.definition.tag.xml.plist" - Data: ">"
-											20269-20278: "meta.tag.string.xml.plist"
-												20269-20271: "punctuation.definition.tag.xml.plist" - Data: "</"
-												20271-20277: "entity.name.tag.xml.plist"
-													20271-20277: "entity.name.tag.localname.xml.plist" - Data: "string"
-												20277-20278: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20285-20292: "meta.tag.dict.xml.plist"
-											20285-20287: "punctuation.definition.tag.xml.plist" - Data: "</"
-											20287-20291: "entity.name.tag.xml.plist"
-												20287-20291: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											20291-20292: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20299-20311: ""
-										20299-20304: "meta.tag.key.xml.plist"
-											20299-20300: "punctuation.definition.tag.xml.plist" - Data: "<"
-											20300-20303: "entity.name.tag.xml.plist"
-												20300-20303: "entity.name.tag.localname.xml.plist" - Data: "key"
-											20303-20304: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20305-20311: "meta.tag.key.xml.plist"
-											20305-20307: "punctuation.definition.tag.xml.plist" - Data: "</"
-											20307-20310: "entity.name.tag.xml.plist"
-												20307-20310: "entity.name.tag.localname.xml.plist" - Data: "key"
-											20310-20311: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20318-20422: ""
-										20318-20324: "meta.tag.dict.xml.plist"
-											20318-20319: "punctuation.definition.tag.xml.plist" - Data: "<"
-											20319-20323: "entity.name.tag.xml.plist"
-												20319-20323: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											20323-20324: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20332-20347: ""
-											20332-20337: "meta.tag.key.xml.plist"
-												20332-20333: "punctuation.definition.tag.xml.plist" - Data: "<"
-												20333-20336: "entity.name.tag.xml.plist"
-													20333-20336: "entity.name.tag.localname.xml.plist" - Data: "key"
-												20336-20337: "punctuation.definition.tag.xml.plist" - Data: ">"
-											20341-20347: "meta.tag.key.xml.plist"
-												20341-20343: "punctuation.definition.tag.xml.plist" - Data: "</"
-												20343-20346: "entity.name.tag.xml.plist"
-													20343-20346: "entity.name.tag.localname.xml.plist" - Data: "key"
-												20346-20347: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20355-20408: ""
-											20355-20363: "meta.tag.string.xml.plist"
-												20355-20356: "punctuation.definition.tag.xml.plist" - Data: "<"
-												20356-20362: "entity.name.tag.xml.plist"
-													20356-20362: "entity.name.tag.localname.xml.plist" - Data: "string"
-												20362-20363: "punctuation.definition.tag.xml.plist" - Data: ">"
-											20399-20408: "meta.tag.string.xml.plist"
-												20399-20401: "punctuation.definition.tag.xml.plist" - Data: "</"
-												20401-20407: "entity.name.tag.xml.plist"
-													20401-20407: "entity.name.tag.localname.xml.plist" - Data: "string"
-												20407-20408: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20415-20422: "meta.tag.dict.xml.plist"
-											20415-20417: "punctuation.definition.tag.xml.plist" - Data: "</"
-											20417-20421: "entity.name.tag.xml.plist"
-												20417-20421: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											20421-20422: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20429-20441: ""
-										20429-20434: "meta.tag.key.xml.plist"
-											20429-20430: "punctuation.definition.tag.xml.plist" - Data: "<"
-											20430-20433: "entity.name.tag.xml.plist"
-												20430-20433: "entity.name.tag.localname.xml.plist" - Data: "key"
-											20433-20434: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20435-20441: "meta.tag.key.xml.plist"
-											20435-20437: "punctuation.definition.tag.xml.plist" - Data: "</"
-											20437-20440: "entity.name.tag.xml.plist"
-												20437-20440: "entity.name.tag.localname.xml.plist" - Data: "key"
-											20440-20441: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20448-20541: ""
-										20448-20454: "meta.tag.dict.xml.plist"
-											20448-20449: "punctuation.definition.tag.xml.plist" - Data: "<"
-											20449-20453: "entity.name.tag.xml.plist"
-												20449-20453: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											20453-20454: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20462-20477: ""
-											20462-20467: "meta.tag.key.xml.plist"
-												20462-20463: "punctuation.definition.tag.xml.plist" - Data: "<"
-												20463-20466: "entity.name.tag.xml.plist"
-													20463-20466: "entity.name.tag.localname.xml.plist" - Data: "key"
-												20466-20467: "punctuation.definition.tag.xml.plist" - Data: ">"
-											20471-20477: "meta.tag.key.xml.plist"
-												20471-20473: "punctuation.definition.tag.xml.plist" - Data: "</"
-												20473-20476: "entity.name.tag.xml.plist"
-													20473-20476: "entity.name.tag.localname.xml.plist" - Data: "key"
-												20476-20477: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20485-20527: ""
-											20485-20493: "meta.tag.string.xml.plist"
-												20485-20486: "punctuation.definition.tag.xml.plist" - Data: "<"
-												20486-20492: "entity.name.tag.xml.plist"
-													20486-20492: "entity.name.tag.localname.xml.plist" - Data: "string"
-												20492-20493: "punctuation.definition.tag.xml.plist" - Data: ">"
-											20518-20527: "meta.tag.string.xml.plist"
-												20518-20520: "punctuation.definition.tag.xml.plist" - Data: "</"
-												20520-20526: "entity.name.tag.xml.plist"
-													20520-20526: "entity.name.tag.localname.xml.plist" - Data: "string"
-												20526-20527: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20534-20541: "meta.tag.dict.xml.plist"
-											20534-20536: "punctuation.definition.tag.xml.plist" - Data: "</"
-											20536-20540: "entity.name.tag.xml.plist"
-												20536-20540: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											20540-20541: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20548-20560: ""
-										20548-20553: "meta.tag.key.xml.plist"
-											20548-20549: "punctuation.definition.tag.xml.plist" - Data: "<"
-											20549-20552: "entity.name.tag.xml.plist"
-												20549-20552: "entity.name.tag.localname.xml.plist" - Data: "key"
-											20552-20553: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20554-20560: "meta.tag.key.xml.plist"
-											20554-20556: "punctuation.definition.tag.xml.plist" - Data: "</"
-											20556-20559: "entity.name.tag.xml.plist"
-												20556-20559: "entity.name.tag.localname.xml.plist" - Data: "key"
-											20559-20560: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20567-20670: ""
-										20567-20573: "meta.tag.dict.xml.plist"
-											20567-20568: "punctuation.definition.tag.xml.plist" - Data: "<"
-											20568-20572: "entity.name.tag.xml.plist"
-												20568-20572: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											20572-20573: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20581-20596: ""
-											20581-20586: "meta.tag.key.xml.plist"
-												20581-20582: "punctuation.definition.tag.xml.plist" - Data: "<"
-												20582-20585: "entity.name.tag.xml.plist"
-													20582-20585: "entity.name.tag.localname.xml.plist" - Data: "key"
-												20585-20586: "punctuation.definition.tag.xml.plist" - Data: ">"
-											20590-20596: "meta.tag.key.xml.plist"
-												20590-20592: "punctuation.definition.tag.xml.plist" - Data: "</"
-												20592-20595: "entity.name.tag.xml.plist"
-													20592-20595: "entity.name.tag.localname.xml.plist" - Data: "key"
-												20595-20596: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20604-20656: ""
-											20604-20612: "meta.tag.string.xml.plist"
-												20604-20605: "punctuation.definition.tag.xml.plist" - Data: "<"
-												20605-20611: "entity.name.tag.xml.plist"
-													20605-20611: "entity.name.tag.localname.xml.plist" - Data: "string"
-												20611-20612: "punctuation.definition.tag.xml.plist" - Data: ">"
-											20647-20656: "meta.tag.string.xml.plist"
-												20647-20649: "punctuation.definition.tag.xml.plist" - Data: "</"
-												20649-20655: "entity.name.tag.xml.plist"
-													20649-20655: "entity.name.tag.localname.xml.plist" - Data: "string"
-												20655-20656: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20663-20670: "meta.tag.dict.xml.plist"
-											20663-20665: "punctuation.definition.tag.xml.plist" - Data: "</"
-											20665-20669: "entity.name.tag.xml.plist"
-												20665-20669: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											20669-20670: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20677-20689: ""
-										20677-20682: "meta.tag.key.xml.plist"
-											20677-20678: "punctuation.definition.tag.xml.plist" - Data: "<"
-											20678-20681: "entity.name.tag.xml.plist"
-												20678-20681: "entity.name.tag.localname.xml.plist" - Data: "key"
-											20681-20682: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20683-20689: "meta.tag.key.xml.plist"
-											20683-20685: "punctuation.definition.tag.xml.plist" - Data: "</"
-											20685-20688: "entity.name.tag.xml.plist"
-												20685-20688: "entity.name.tag.localname.xml.plist" - Data: "key"
-											20688-20689: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20696-20800: ""
-										20696-20702: "meta.tag.dict.xml.plist"
-											20696-20697: "punctuation.definition.tag.xml.plist" - Data: "<"
-											20697-20701: "entity.name.tag.xml.plist"
-												20697-20701: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											20701-20702: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20710-20725: ""
-											20710-20715: "meta.tag.key.xml.plist"
-												20710-20711: "punctuation.definition.tag.xml.plist" - Data: "<"
-												20711-20714: "entity.name.tag.xml.plist"
-													20711-20714: "entity.name.tag.localname.xml.plist" - Data: "key"
-												20714-20715: "punctuation.definition.tag.xml.plist" - Data: ">"
-											20719-20725: "meta.tag.key.xml.plist"
-												20719-20721: "punctuation.definition.tag.xml.plist" - Data: "</"
-												20721-20724: "entity.name.tag.xml.plist"
-													20721-20724: "entity.name.tag.localname.xml.plist" - Data: "key"
-												20724-20725: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20733-20786: ""
-											20733-20741: "meta.tag.string.xml.plist"
-												20733-20734: "punctuation.definition.tag.xml.plist" - Data: "<"
-												20734-20740: "entity.name.tag.xml.plist"
-													20734-20740: "entity.name.tag.localname.xml.plist" - Data: "string"
-												20740-20741: "punctuation.definition.tag.xml.plist" - Data: ">"
-											20777-20786: "meta.tag.string.xml.plist"
-												20777-20779: "punctuation.definition.tag.xml.plist" - Data: "</"
-												20779-20785: "entity.name.tag.xml.plist"
-													20779-20785: "entity.name.tag.localname.xml.plist" - Data: "string"
-												20785-20786: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20793-20800: "meta.tag.dict.xml.plist"
-											20793-20795: "punctuation.definition.tag.xml.plist" - Data: "</"
-											20795-20799: "entity.name.tag.xml.plist"
-												20795-20799: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											20799-20800: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20806-20813: "meta.tag.dict.xml.plist"
-										20806-20808: "punctuation.definition.tag.xml.plist" - Data: "</"
-										20808-20812: "entity.name.tag.xml.plist"
-											20808-20812: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										20812-20813: "punctuation.definition.tag.xml.plist" - Data: ">"
-								20819-20837: ""
-									20819-20824: "meta.tag.key.xml.plist"
-										20819-20820: "punctuation.definition.tag.xml.plist" - Data: "<"
-										20820-20823: "entity.name.tag.xml.plist"
-											20820-20823: "entity.name.tag.localname.xml.plist" - Data: "key"
-										20823-20824: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20831-20837: "meta.tag.key.xml.plist"
-										20831-20833: "punctuation.definition.tag.xml.plist" - Data: "</"
-										20833-20836: "entity.name.tag.xml.plist"
-											20833-20836: "entity.name.tag.localname.xml.plist" - Data: "key"
-										20836-20837: "punctuation.definition.tag.xml.plist" - Data: ">"
-								20843-20867: ""
-									20843-20851: "meta.tag.string.xml.plist"
-										20843-20844: "punctuation.definition.tag.xml.plist" - Data: "<"
-										20844-20850: "entity.name.tag.xml.plist"
-											20844-20850: "entity.name.tag.localname.xml.plist" - Data: "string"
-										20850-20851: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20858-20867: "meta.tag.string.xml.plist"
-										20858-20860: "punctuation.definition.tag.xml.plist" - Data: "</"
-										20860-20866: "entity.name.tag.xml.plist"
-											20860-20866: "entity.name.tag.localname.xml.plist" - Data: "string"
-										20866-20867: "punctuation.definition.tag.xml.plist" - Data: ">"
-								20873-20887: ""
-									20873-20878: "meta.tag.key.xml.plist"
-										20873-20874: "punctuation.definition.tag.xml.plist" - Data: "<"
-										20874-20877: "entity.name.tag.xml.plist"
-											20874-20877: "entity.name.tag.localname.xml.plist" - Data: "key"
-										20877-20878: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20881-20887: "meta.tag.key.xml.plist"
-										20881-20883: "punctuation.definition.tag.xml.plist" - Data: "</"
-										20883-20886: "entity.name.tag.xml.plist"
-											20883-20886: "entity.name.tag.localname.xml.plist" - Data: "key"
-										20886-20887: "punctuation.definition.tag.xml.plist" - Data: ">"
-								20893-20933: ""
-									20893-20901: "meta.tag.string.xml.plist"
-										20893-20894: "punctuation.definition.tag.xml.plist" - Data: "<"
-										20894-20900: "entity.name.tag.xml.plist"
-											20894-20900: "entity.name.tag.localname.xml.plist" - Data: "string"
-										20900-20901: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20924-20933: "meta.tag.string.xml.plist"
-										20924-20926: "punctuation.definition.tag.xml.plist" - Data: "</"
-										20926-20932: "entity.name.tag.xml.plist"
-											20926-20932: "entity.name.tag.localname.xml.plist" - Data: "string"
-										20932-20933: "punctuation.definition.tag.xml.plist" - Data: ">"
-								20939-20958: ""
-									20939-20944: "meta.tag.key.xml.plist"
-										20939-20940: "punctuation.definition.tag.xml.plist" - Data: "<"
-										20940-20943: "entity.name.tag.xml.plist"
-											20940-20943: "entity.name.tag.localname.xml.plist" - Data: "key"
-										20943-20944: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20952-20958: "meta.tag.key.xml.plist"
-										20952-20954: "punctuation.definition.tag.xml.plist" - Data: "</"
-										20954-20957: "entity.name.tag.xml.plist"
-											20954-20957: "entity.name.tag.localname.xml.plist" - Data: "key"
-										20957-20958: "punctuation.definition.tag.xml.plist" - Data: ">"
-								20964-22234: ""
-									20964-20971: "meta.tag.array.xml.plist"
-										20964-20965: "punctuation.definition.tag.xml.plist" - Data: "<"
-										20965-20970: "entity.name.tag.xml.plist"
-											20965-20970: "entity.name.tag.localname.xml.plist" - Data: "array"
-										20970-20971: "punctuation.definition.tag.xml.plist" - Data: ">"
-									20978-21875: ""
-										20978-20984: "meta.tag.dict.xml.plist"
-											20978-20979: "punctuation.definition.tag.xml.plist" - Data: "<"
-											20979-20983: "entity.name.tag.xml.plist"
-												20979-20983: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											20983-20984: "punctuation.definition.tag.xml.plist" - Data: ">"
-										20992-21008: ""
-											20992-20997: "meta.tag.key.xml.plist"
-												20992-20993: "punctuation.definition.tag.xml.plist" - Data: "<"
-												20993-20996: "entity.name.tag.xml.plist"
-													20993-20996: "entity.name.tag.localname.xml.plist" - Data: "key"
-												20996-20997: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21002-21008: "meta.tag.key.xml.plist"
-												21002-21004: "punctuation.definition.tag.xml.plist" - Data: "</"
-												21004-21007: "entity.name.tag.xml.plist"
-													21004-21007: "entity.name.tag.localname.xml.plist" - Data: "key"
-												21007-21008: "punctuation.definition.tag.xml.plist" - Data: ">"
-										21016-21049: ""
-											21016-21024: "meta.tag.string.xml.plist"
-												21016-21017: "punctuation.definition.tag.xml.plist" - Data: "<"
-												21017-21023: "entity.name.tag.xml.plist"
-													21017-21023: "entity.name.tag.localname.xml.plist" - Data: "string"
-												21023-21024: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21040-21049: "meta.tag.string.xml.plist"
-												21040-21042: "punctuation.definition.tag.xml.plist" - Data: "</"
-												21042-21048: "entity.name.tag.xml.plist"
-													21042-21048: "entity.name.tag.localname.xml.plist" - Data: "string"
-												21048-21049: "punctuation.definition.tag.xml.plist" - Data: ">"
-										21057-21076: ""
-											21057-21062: "meta.tag.key.xml.plist"
-												21057-21058: "punctuation.definition.tag.xml.plist" - Data: "<"
-												21058-21061: "entity.name.tag.xml.plist"
-													21058-21061: "entity.name.tag.localname.xml.plist" - Data: "key"
-												21061-21062: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21070-21076: "meta.tag.key.xml.plist"
-												21070-21072: "punctuation.definition.tag.xml.plist" - Data: "</"
-												21072-21075: "entity.name.tag.xml.plist"
-													21072-21075: "entity.name.tag.localname.xml.plist" - Data: "key"
-												21075-21076: "punctuation.definition.tag.xml.plist" - Data: ">"
-										21084-21379: ""
-											21084-21090: "meta.tag.dict.xml.plist"
-												21084-21085: "punctuation.definition.tag.xml.plist" - Data: "<"
-												21085-21089: "entity.name.tag.xml.plist"
-													21085-21089: "entity.name.tag.localname.xml.plist" - Data: "dict"
-												21089-21090: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21099-21111: ""
-												21099-21104: "meta.tag.key.xml.plist"
-													21099-21100: "punctuation.definition.tag.xml.plist" - Data: "<"
-													21100-21103: "entity.name.tag.xml.plist"
-														21100-21103: "entity.name.tag.localname.xml.plist" - Data: "key"
-													21103-21104: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21105-21111: "meta.tag.key.xml.plist"
-													21105-21107: "punctuation.definition.tag.xml.plist" - Data: "</"
-													21107-21110: "entity.name.tag.xml.plist"
-														21107-21110: "entity.name.tag.localname.xml.plist" - Data: "key"
-													21110-21111: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21120-21229: ""
-												21120-21126: "meta.tag.dict.xml.plist"
-													21120-21121: "punctuation.definition.tag.xml.plist" - Data: "<"
-													21121-21125: "entity.name.tag.xml.plist"
-														21121-21125: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													21125-21126: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21136-21151: ""
-													21136-21141: "meta.tag.key.xml.plist"
-														21136-21137: "punctuation.definition.tag.xml.plist" - Data: "<"
-														21137-21140: "entity.name.tag.xml.plist"
-															21137-21140: "entity.name.tag.localname.xml.plist" - Data: "key"
-														21140-21141: "punctuation.definition.tag.xml.plist" - Data: ">"
-													21145-21151: "meta.tag.key.xml.plist"
-														21145-21147: "punctuation.definition.tag.xml.plist" - Data: "</"
-														21147-21150: "entity.name.tag.xml.plist"
-															21147-21150: "entity.name.tag.localname.xml.plist" - Data: "key"
-														21150-21151: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21161-21213: ""
-													21161-21169: "meta.tag.string.xml.plist"
-														21161-21162: "punctuation.definition.tag.xml.plist" - Data: "<"
-														21162-21168: "entity.name.tag.xml.plist"
-															21162-21168: "entity.name.tag.localname.xml.plist" - Data: "string"
-														21168-21169: "punctuation.definition.tag.xml.plist" - Data: ">"
-													21204-21213: "meta.tag.string.xml.plist"
-														21204-21206: "punctuation.definition.tag.xml.plist" - Data: "</"
-														21206-21212: "entity.name.tag.xml.plist"
-															21206-21212: "entity.name.tag.localname.xml.plist" - Data: "string"
-														21212-21213: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21222-21229: "meta.tag.dict.xml.plist"
-													21222-21224: "punctuation.definition.tag.xml.plist" - Data: "</"
-													21224-21228: "entity.name.tag.xml.plist"
-														21224-21228: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													21228-21229: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21238-21250: ""
-												21238-21243: "meta.tag.key.xml.plist"
-													21238-21239: "punctuation.definition.tag.xml.plist" - Data: "<"
-													21239-21242: "entity.name.tag.xml.plist"
-														21239-21242: "entity.name.tag.localname.xml.plist" - Data: "key"
-													21242-21243: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21244-21250: "meta.tag.key.xml.plist"
-													21244-21246: "punctuation.definition.tag.xml.plist" - Data: "</"
-													21246-21249: "entity.name.tag.xml.plist"
-														21246-21249: "entity.name.tag.localname.xml.plist" - Data: "key"
-													21249-21250: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21259-21364: ""
-												21259-21265: "meta.tag.dict.xml.plist"
-													21259-21260: "punctuation.definition.tag.xml.plist" - Data: "<"
-													21260-21264: "entity.name.tag.xml.plist"
-														21260-21264: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													21264-21265: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21275-21290: ""
-													21275-21280: "meta.tag.key.xml.plist"
-														21275-21276: "punctuation.definition.tag.xml.plist" - Data: "<"
-														21276-21279: "entity.name.tag.xml.plist"
-															21276-21279: "entity.name.tag.localname.xml.plist" - Data: "key"
-														21279-21280: "punctuation.definition.tag.xml.plist" - Data: ">"
-													21284-21290: "meta.tag.key.xml.plist"
-														21284-21286: "punctuation.definition.tag.xml.plist" - Data: "</"
-														21286-21289: "entity.name.tag.xml.plist"
-															21286-21289: "entity.name.tag.localname.xml.plist" - Data: "key"
-														21289-21290: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21300-21348: ""
-													21300-21308: "meta.tag.string.xml.plist"
-														21300-21301: "punctuation.definition.tag.xml.plist" - Data: "<"
-														21301-21307: "entity.name.tag.xml.plist"
-															21301-21307: "entity.name.tag.localname.xml.plist" - Data: "string"
-														21307-21308: "punctuation.definition.tag.xml.plist" - Data: ">"
-													21339-21348: "meta.tag.string.xml.plist"
-														21339-21341: "punctuation.definition.tag.xml.plist" - Data: "</"
-														21341-21347: "entity.name.tag.xml.plist"
-															21341-21347: "entity.name.tag.localname.xml.plist" - Data: "string"
-														21347-21348: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21357-21364: "meta.tag.dict.xml.plist"
-													21357-21359: "punctuation.definition.tag.xml.plist" - Data: "</"
-													21359-21363: "entity.name.tag.xml.plist"
-														21359-21363: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													21363-21364: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21372-21379: "meta.tag.dict.xml.plist"
-												21372-21374: "punctuation.definition.tag.xml.plist" - Data: "</"
-												21374-21378: "entity.name.tag.xml.plist"
-													21374-21378: "entity.name.tag.localname.xml.plist" - Data: "dict"
-												21378-21379: "punctuation.definition.tag.xml.plist" - Data: ">"
-										21387-21401: ""
-											21387-21392: "meta.tag.key.xml.plist"
-												21387-21388: "punctuation.definition.tag.xml.plist" - Data: "<"
-												21388-21391: "entity.name.tag.xml.plist"
-													21388-21391: "entity.name.tag.localname.xml.plist" - Data: "key"
-												21391-21392: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21395-21401: "meta.tag.key.xml.plist"
-												21395-21397: "punctuation.definition.tag.xml.plist" - Data: "</"
-												21397-21400: "entity.name.tag.xml.plist"
-													21397-21400: "entity.name.tag.localname.xml.plist" - Data: "key"
-												21400-21401: "punctuation.definition.tag.xml.plist" - Data: ">"
-										21409-21434: ""
-											21409-21417: "meta.tag.string.xml.plist"
-												21409-21410: "punctuation.definition.tag.xml.plist" - Data: "<"
-												21410-21416: "entity.name.tag.xml.plist"
-													21410-21416: "entity.name.tag.localname.xml.plist" - Data: "string"
-												21416-21417: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21425-21434: "meta.tag.string.xml.plist"
-												21425-21427: "punctuation.definition.tag.xml.plist" - Data: "</"
-												21427-21433: "entity.name.tag.xml.plist"
-													21427-21433: "entity.name.tag.localname.xml.plist" - Data: "string"
-												21433-21434: "punctuation.definition.tag.xml.plist" - Data: ">"
-										21442-21461: ""
-											21442-21447: "meta.tag.key.xml.plist"
-												21442-21443: "punctuation.definition.tag.xml.plist" - Data: "<"
-												21443-21446: "entity.name.tag.xml.plist"
-													21443-21446: "entity.name.tag.localname.xml.plist" - Data: "key"
-												21446-21447: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21455-21461: "meta.tag.key.xml.plist"
-												21455-21457: "punctuation.definition.tag.xml.plist" - Data: "</"
-												21457-21460: "entity.name.tag.xml.plist"
-													21457-21460: "entity.name.tag.localname.xml.plist" - Data: "key"
-												21460-21461: "punctuation.definition.tag.xml.plist" - Data: ">"
-										21469-21861: ""
-											21469-21476: "meta.tag.array.xml.plist"
-												21469-21470: "punctuation.definition.tag.xml.plist" - Data: "<"
-												21470-21475: "entity.name.tag.xml.plist"
-													21470-21475: "entity.name.tag.localname.xml.plist" - Data: "array"
-												21475-21476: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21485-21670: ""
-												21485-21491: "meta.tag.dict.xml.plist"
-													21485-21486: "punctuation.definition.tag.xml.plist" - Data: "<"
-													21486-21490: "entity.name.tag.xml.plist"
-														21486-21490: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													21490-21491: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21501-21517: ""
-													21501-21506: "meta.tag.key.xml.plist"
-														21501-21502: "punctuation.definition.tag.xml.plist" - Data: "<"
-														21502-21505: "entity.name.tag.xml.plist"
-															21502-21505: "entity.name.tag.localname.xml.plist" - Data: "key"
-														21505-21506: "punctuation.definition.tag.xml.plist" - Data: ">"
-													21511-21517: "meta.tag.key.xml.plist"
-														21511-21513: "punctuation.definition.tag.xml.plist" - Data: "</"
-														21513-21516: "entity.name.tag.xml.plist"
-															21513-21516: "entity.name.tag.localname.xml.plist" - Data: "key"
-														21516-21517: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21527-21571: ""
-													21527-21535: "meta.tag.string.xml.plist"
-														21527-21528: "punctuation.definition.tag.xml.plist" - Data: "<"
-														21528-21534: "entity.name.tag.xml.plist"
-															21528-21534: "entity.name.tag.localname.xml.plist" - Data: "string"
-														21534-21535: "punctuation.definition.tag.xml.plist" - Data: ">"
-													21562-21571: "meta.tag.string.xml.plist"
-														21562-21564: "punctuation.definition.tag.xml.plist" - Data: "</"
-														21564-21570: "entity.name.tag.xml.plist"
-															21564-21570: "entity.name.tag.localname.xml.plist" - Data: "string"
-														21570-21571: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21581-21596: ""
-													21581-21586: "meta.tag.key.xml.plist"
-														21581-21582: "punctuation.definition.tag.xml.plist" - Data: "<"
-														21582-21585: "entity.name.tag.xml.plist"
-															21582-21585: "entity.name.tag.localname.xml.plist" - Data: "key"
-														21585-21586: "punctuation.definition.tag.xml.plist" - Data: ">"
-													21590-21596: "meta.tag.key.xml.plist"
-														21590-21592: "punctuation.definition.tag.xml.plist" - Data: "</"
-														21592-21595: "entity.name.tag.xml.plist"
-															21592-21595: "entity.name.tag.localname.xml.plist" - Data: "key"
-														21595-21596: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21606-21654: ""
-													21606-21614: "meta.tag.string.xml.plist"
-														21606-21607: "punctuation.definition.tag.xml.plist" - Data: "<"
-														21607-21613: "entity.name.tag.xml.plist"
-															21607-21613: "entity.name.tag.localname.xml.plist" - Data: "string"
-														21613-21614: "punctuation.definition.tag.xml.plist" - Data: ">"
-													21645-21654: "meta.tag.string.xml.plist"
-														21645-21647: "punctuation.definition.tag.xml.plist" - Data: "</"
-														21647-21653: "entity.name.tag.xml.plist"
-															21647-21653: "entity.name.tag.localname.xml.plist" - Data: "string"
-														21653-21654: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21663-21670: "meta.tag.dict.xml.plist"
-													21663-21665: "punctuation.definition.tag.xml.plist" - Data: "</"
-													21665-21669: "entity.name.tag.xml.plist"
-														21665-21669: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													21669-21670: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21679-21845: ""
-												21679-21685: "meta.tag.dict.xml.plist"
-													21679-21680: "punctuation.definition.tag.xml.plist" - Data: "<"
-													21680-21684: "entity.name.tag.xml.plist"
-														21680-21684: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													21684-21685: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21695-21711: ""
-													21695-21700: "meta.tag.key.xml.plist"
-														21695-21696: "punctuation.definition.tag.xml.plist" - Data: "<"
-														21696-21699: "entity.name.tag.xml.plist"
-															21696-21699: "entity.name.tag.localname.xml.plist" - Data: "key"
-														21699-21700: "punctuation.definition.tag.xml.plist" - Data: ">"
-													21705-21711: "meta.tag.key.xml.plist"
-														21705-21707: "punctuation.definition.tag.xml.plist" - Data: "</"
-														21707-21710: "entity.name.tag.xml.plist"
-															21707-21710: "entity.name.tag.localname.xml.plist" - Data: "key"
-														21710-21711: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21721-21739: ""
-													21721-21729: "meta.tag.string.xml.plist"
-														21721-21722: "punctuation.definition.tag.xml.plist" - Data: "<"
-														21722-21728: "entity.name.tag.xml.plist"
-															21722-21728: "entity.name.tag.localname.xml.plist" - Data: "string"
-														21728-21729: "punctuation.definition.tag.xml.plist" - Data: ">"
-													21730-21739: "meta.tag.string.xml.plist"
-														21730-21732: "punctuation.definition.tag.xml.plist" - Data: "</"
-														21732-21738: "entity.name.tag.xml.plist"
-															21732-21738: "entity.name.tag.localname.xml.plist" - Data: "string"
-														21738-21739: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21749-21764: ""
-													21749-21754: "meta.tag.key.xml.plist"
-														21749-21750: "punctuation.definition.tag.xml.plist" - Data: "<"
-														21750-21753: "entity.name.tag.xml.plist"
-															21750-21753: "entity.name.tag.localname.xml.plist" - Data: "key"
-														21753-21754: "punctuation.definition.tag.xml.plist" - Data: ">"
-													21758-21764: "meta.tag.key.xml.plist"
-														21758-21760: "punctuation.definition.tag.xml.plist" - Data: "</"
-														21760-21763: "entity.name.tag.xml.plist"
-															21760-21763: "entity.name.tag.localname.xml.plist" - Data: "key"
-														21763-21764: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21774-21829: ""
-													21774-21782: "meta.tag.string.xml.plist"
-														21774-21775: "punctuation.definition.tag.xml.plist" - Data: "<"
-														21775-21781: "entity.name.tag.xml.plist"
-															21775-21781: "entity.name.tag.localname.xml.plist" - Data: "string"
-														21781-21782: "punctuation.definition.tag.xml.plist" - Data: ">"
-													21820-21829: "meta.tag.string.xml.plist"
-														21820-21822: "punctuation.definition.tag.xml.plist" - Data: "</"
-														21822-21828: "entity.name.tag.xml.plist"
-															21822-21828: "entity.name.tag.localname.xml.plist" - Data: "string"
-														21828-21829: "punctuation.definition.tag.xml.plist" - Data: ">"
-												21838-21845: "meta.tag.dict.xml.plist"
-													21838-21840: "punctuation.definition.tag.xml.plist" - Data: "</"
-													21840-21844: "entity.name.tag.xml.plist"
-														21840-21844: "entity.name.tag.localname.xml.plist" - Data: "dict"
-													21844-21845: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21853-21861: "meta.tag.array.xml.plist"
-												21853-21855: "punctuation.definition.tag.xml.plist" - Data: "</"
-												21855-21860: "entity.name.tag.xml.plist"
-													21855-21860: "entity.name.tag.localname.xml.plist" - Data: "array"
-												21860-21861: "punctuation.definition.tag.xml.plist" - Data: ">"
-										21868-21875: "meta.tag.dict.xml.plist"
-											21868-21870: "punctuation.definition.tag.xml.plist" - Data: "</"
-											21870-21874: "entity.name.tag.xml.plist"
-												21870-21874: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											21874-21875: "punctuation.definition.tag.xml.plist" - Data: ">"
-									21882-22057: ""
-										21882-21888: "meta.tag.dict.xml.plist"
-											21882-21883: "punctuation.definition.tag.xml.plist" - Data: "<"
-											21883-21887: "entity.name.tag.xml.plist"
-												21883-21887: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											21887-21888: "punctuation.definition.tag.xml.plist" - Data: ">"
-										21896-21912: ""
-											21896-21901: "meta.tag.key.xml.plist"
-												21896-21897: "punctuation.definition.tag.xml.plist" - Data: "<"
-												21897-21900: "entity.name.tag.xml.plist"
-													21897-21900: "entity.name.tag.localname.xml.plist" - Data: "key"
-												21900-21901: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21906-21912: "meta.tag.key.xml.plist"
-												21906-21908: "punctuation.definition.tag.xml.plist" - Data: "</"
-												21908-21911: "entity.name.tag.xml.plist"
-													21908-21911: "entity.name.tag.localname.xml.plist" - Data: "key"
-												21911-21912: "punctuation.definition.tag.xml.plist" - Data: ">"
-										21920-21964: ""
-											21920-21928: "meta.tag.string.xml.plist"
-												21920-21921: "punctuation.definition.tag.xml.plist" - Data: "<"
-												21921-21927: "entity.name.tag.xml.plist"
-													21921-21927: "entity.name.tag.localname.xml.plist" - Data: "string"
-												21927-21928: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21955-21964: "meta.tag.string.xml.plist"
-												21955-21957: "punctuation.definition.tag.xml.plist" - Data: "</"
-												21957-21963: "entity.name.tag.xml.plist"
-													21957-21963: "entity.name.tag.localname.xml.plist" - Data: "string"
-												21963-21964: "punctuation.definition.tag.xml.plist" - Data: ">"
-										21972-21987: ""
-											21972-21977: "meta.tag.key.xml.plist"
-												21972-21973: "punctuation.definition.tag.xml.plist" - Data: "<"
-												21973-21976: "entity.name.tag.xml.plist"
-													21973-21976: "entity.name.tag.localname.xml.plist" - Data: "key"
-												21976-21977: "punctuation.definition.tag.xml.plist" - Data: ">"
-											21981-21987: "meta.tag.key.xml.plist"
-												21981-21983: "punctuation.definition.tag.xml.plist" - Data: "</"
-												21983-21986: "entity.name.tag.xml.plist"
-													21983-21986: "entity.name.tag.localname.xml.plist" - Data: "key"
-												21986-21987: "punctuation.definition.tag.xml.plist" - Data: ">"
-										21995-22043: ""
-											21995-22003: "meta.tag.string.xml.plist"
-												21995-21996: "punctuation.definition.tag.xml.plist" - Data: "<"
-												21996-22002: "entity.name.tag.xml.plist"
-													21996-22002: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22002-22003: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22034-22043: "meta.tag.string.xml.plist"
-												22034-22036: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22036-22042: "entity.name.tag.xml.plist"
-													22036-22042: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22042-22043: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22050-22057: "meta.tag.dict.xml.plist"
-											22050-22052: "punctuation.definition.tag.xml.plist" - Data: "</"
-											22052-22056: "entity.name.tag.xml.plist"
-												22052-22056: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											22056-22057: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22064-22220: ""
-										22064-22070: "meta.tag.dict.xml.plist"
-											22064-22065: "punctuation.definition.tag.xml.plist" - Data: "<"
-											22065-22069: "entity.name.tag.xml.plist"
-												22065-22069: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											22069-22070: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22078-22094: ""
-											22078-22083: "meta.tag.key.xml.plist"
-												22078-22079: "punctuation.definition.tag.xml.plist" - Data: "<"
-												22079-22082: "entity.name.tag.xml.plist"
-													22079-22082: "entity.name.tag.localname.xml.plist" - Data: "key"
-												22082-22083: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22088-22094: "meta.tag.key.xml.plist"
-												22088-22090: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22090-22093: "entity.name.tag.xml.plist"
-													22090-22093: "entity.name.tag.localname.xml.plist" - Data: "key"
-												22093-22094: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22102-22120: ""
-											22102-22110: "meta.tag.string.xml.plist"
-												22102-22103: "punctuation.definition.tag.xml.plist" - Data: "<"
-												22103-22109: "entity.name.tag.xml.plist"
-													22103-22109: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22109-22110: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22111-22120: "meta.tag.string.xml.plist"
-												22111-22113: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22113-22119: "entity.name.tag.xml.plist"
-													22113-22119: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22119-22120: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22128-22143: ""
-											22128-22133: "meta.tag.key.xml.plist"
-												22128-22129: "punctuation.definition.tag.xml.plist" - Data: "<"
-												22129-22132: "entity.name.tag.xml.plist"
-													22129-22132: "entity.name.tag.localname.xml.plist" - Data: "key"
-												22132-22133: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22137-22143: "meta.tag.key.xml.plist"
-												22137-22139: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22139-22142: "entity.name.tag.xml.plist"
-													22139-22142: "entity.name.tag.localname.xml.plist" - Data: "key"
-												22142-22143: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22151-22206: ""
-											22151-22159: "meta.tag.string.xml.plist"
-												22151-22152: "punctuation.definition.tag.xml.plist" - Data: "<"
-												22152-22158: "entity.name.tag.xml.plist"
-													22152-22158: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22158-22159: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22197-22206: "meta.tag.string.xml.plist"
-												22197-22199: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22199-22205: "entity.name.tag.xml.plist"
-													22199-22205: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22205-22206: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22213-22220: "meta.tag.dict.xml.plist"
-											22213-22215: "punctuation.definition.tag.xml.plist" - Data: "</"
-											22215-22219: "entity.name.tag.xml.plist"
-												22215-22219: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											22219-22220: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22226-22234: "meta.tag.array.xml.plist"
-										22226-22228: "punctuation.definition.tag.xml.plist" - Data: "</"
-										22228-22233: "entity.name.tag.xml.plist"
-											22228-22233: "entity.name.tag.localname.xml.plist" - Data: "array"
-										22233-22234: "punctuation.definition.tag.xml.plist" - Data: ">"
-								22239-22246: "meta.tag.dict.xml.plist"
-									22239-22241: "punctuation.definition.tag.xml.plist" - Data: "</"
-									22241-22245: "entity.name.tag.xml.plist"
-										22241-22245: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									22245-22246: "punctuation.definition.tag.xml.plist" - Data: ">"
-							22251-23521: ""
-								22251-22257: "meta.tag.dict.xml.plist"
-									22251-22252: "punctuation.definition.tag.xml.plist" - Data: "<"
-									22252-22256: "entity.name.tag.xml.plist"
-										22252-22256: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									22256-22257: "punctuation.definition.tag.xml.plist" - Data: ">"
-								22263-22279: ""
-									22263-22268: "meta.tag.key.xml.plist"
-										22263-22264: "punctuation.definition.tag.xml.plist" - Data: "<"
-										22264-22267: "entity.name.tag.xml.plist"
-											22264-22267: "entity.name.tag.localname.xml.plist" - Data: "key"
-										22267-22268: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22273-22279: "meta.tag.key.xml.plist"
-										22273-22275: "punctuation.definition.tag.xml.plist" - Data: "</"
-										22275-22278: "entity.name.tag.xml.plist"
-											22275-22278: "entity.name.tag.localname.xml.plist" - Data: "key"
-										22278-22279: "punctuation.definition.tag.xml.plist" - Data: ">"
-								22285-22327: ""
-									22285-22293: "meta.tag.string.xml.plist"
-										22285-22286: "punctuation.definition.tag.xml.plist" - Data: "<"
-										22286-22292: "entity.name.tag.xml.plist"
-											22286-22292: "entity.name.tag.localname.xml.plist" - Data: "string"
-										22292-22293: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22318-22327: "meta.tag.string.xml.plist"
-										22318-22320: "punctuation.definition.tag.xml.plist" - Data: "</"
-										22320-22326: "entity.name.tag.xml.plist"
-											22320-22326: "entity.name.tag.localname.xml.plist" - Data: "string"
-										22326-22327: "punctuation.definition.tag.xml.plist" - Data: ">"
-								22333-22352: ""
-									22333-22338: "meta.tag.key.xml.plist"
-										22333-22334: "punctuation.definition.tag.xml.plist" - Data: "<"
-										22334-22337: "entity.name.tag.xml.plist"
-											22334-22337: "entity.name.tag.localname.xml.plist" - Data: "key"
-										22337-22338: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22346-22352: "meta.tag.key.xml.plist"
-										22346-22348: "punctuation.definition.tag.xml.plist" - Data: "</"
-										22348-22351: "entity.name.tag.xml.plist"
-											22348-22351: "entity.name.tag.localname.xml.plist" - Data: "key"
-										22351-22352: "punctuation.definition.tag.xml.plist" - Data: ">"
-								22358-23005: ""
-									22358-22364: "meta.tag.dict.xml.plist"
-										22358-22359: "punctuation.definition.tag.xml.plist" - Data: "<"
-										22359-22363: "entity.name.tag.xml.plist"
-											22359-22363: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										22363-22364: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22371-22383: ""
-										22371-22376: "meta.tag.key.xml.plist"
-											22371-22372: "punctuation.definition.tag.xml.plist" - Data: "<"
-											22372-22375: "entity.name.tag.xml.plist"
-												22372-22375: "entity.name.tag.localname.xml.plist" - Data: "key"
-											22375-22376: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22377-22383: "meta.tag.key.xml.plist"
-											22377-22379: "punctuation.definition.tag.xml.plist" - Data: "</"
-											22379-22382: "entity.name.tag.xml.plist"
-												22379-22382: "entity.name.tag.localname.xml.plist" - Data: "key"
-											22382-22383: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22390-22484: ""
-										22390-22396: "meta.tag.dict.xml.plist"
-											22390-22391: "punctuation.definition.tag.xml.plist" - Data: "<"
-											22391-22395: "entity.name.tag.xml.plist"
-												22391-22395: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											22395-22396: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22404-22419: ""
-											22404-22409: "meta.tag.key.xml.plist"
-												22404-22405: "punctuation.definition.tag.xml.plist" - Data: "<"
-												22405-22408: "entity.name.tag.xml.plist"
-													22405-22408: "entity.name.tag.localname.xml.plist" - Data: "key"
-												22408-22409: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22413-22419: "meta.tag.key.xml.plist"
-												22413-22415: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22415-22418: "entity.name.tag.xml.plist"
-													22415-22418: "entity.name.tag.localname.xml.plist" - Data: "key"
-												22418-22419: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22427-22470: ""
-											22427-22435: "meta.tag.string.xml.plist"
-												22427-22428: "punctuation.definition.tag.xml.plist" - Data: "<"
-												22428-22434: "entity.name.tag.xml.plist"
-													22428-22434: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22434-22435: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22461-22470: "meta.tag.string.xml.plist"
-												22461-22463: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22463-22469: "entity.name.tag.xml.plist"
-													22463-22469: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22469-22470: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22477-22484: "meta.tag.dict.xml.plist"
-											22477-22479: "punctuation.definition.tag.xml.plist" - Data: "</"
-											22479-22483: "entity.name.tag.xml.plist"
-												22479-22483: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											22483-22484: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22491-22503: ""
-										22491-22496: "meta.tag.key.xml.plist"
-											22491-22492: "punctuation.definition.tag.xml.plist" - Data: "<"
-											22492-22495: "entity.name.tag.xml.plist"
-												22492-22495: "entity.name.tag.localname.xml.plist" - Data: "key"
-											22495-22496: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22497-22503: "meta.tag.key.xml.plist"
-											22497-22499: "punctuation.definition.tag.xml.plist" - Data: "</"
-											22499-22502: "entity.name.tag.xml.plist"
-												22499-22502: "entity.name.tag.localname.xml.plist" - Data: "key"
-											22502-22503: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22510-22614: ""
-										22510-22516: "meta.tag.dict.xml.plist"
-											22510-22511: "punctuation.definition.tag.xml.plist" - Data: "<"
-											22511-22515: "entity.name.tag.xml.plist"
-												22511-22515: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											22515-22516: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22524-22539: ""
-											22524-22529: "meta.tag.key.xml.plist"
-												22524-22525: "punctuation.definition.tag.xml.plist" - Data: "<"
-												22525-22528: "entity.name.tag.xml.plist"
-													22525-22528: "entity.name.tag.localname.xml.plist" - Data: "key"
-												22528-22529: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22533-22539: "meta.tag.key.xml.plist"
-												22533-22535: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22535-22538: "entity.name.tag.xml.plist"
-													22535-22538: "entity.name.tag.localname.xml.plist" - Data: "key"
-												22538-22539: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22547-22600: ""
-											22547-22555: "meta.tag.string.xml.plist"
-												22547-22548: "punctuation.definition.tag.xml.plist" - Data: "<"
-												22548-22554: "entity.name.tag.xml.plist"
-													22548-22554: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22554-22555: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22591-22600: "meta.tag.string.xml.plist"
-												22591-22593: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22593-22599: "entity.name.tag.xml.plist"
-													22593-22599: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22599-22600: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22607-22614: "meta.tag.dict.xml.plist"
-											22607-22609: "punctuation.definition.tag.xml.plist" - Data: "</"
-											22609-22613: "entity.name.tag.xml.plist"
-												22609-22613: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											22613-22614: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22621-22633: ""
-										22621-22626: "meta.tag.key.xml.plist"
-											22621-22622: "punctuation.definition.tag.xml.plist" - Data: "<"
-											22622-22625: "entity.name.tag.xml.plist"
-												22622-22625: "entity.name.tag.localname.xml.plist" - Data: "key"
-											22625-22626: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22627-22633: "meta.tag.key.xml.plist"
-											22627-22629: "punctuation.definition.tag.xml.plist" - Data: "</"
-											22629-22632: "entity.name.tag.xml.plist"
-												22629-22632: "entity.name.tag.localname.xml.plist" - Data: "key"
-											22632-22633: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22640-22733: ""
-										22640-22646: "meta.tag.dict.xml.plist"
-											22640-22641: "punctuation.definition.tag.xml.plist" - Data: "<"
-											22641-22645: "entity.name.tag.xml.plist"
-												22641-22645: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											22645-22646: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22654-22669: ""
-											22654-22659: "meta.tag.key.xml.plist"
-												22654-22655: "punctuation.definition.tag.xml.plist" - Data: "<"
-												22655-22658: "entity.name.tag.xml.plist"
-													22655-22658: "entity.name.tag.localname.xml.plist" - Data: "key"
-												22658-22659: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22663-22669: "meta.tag.key.xml.plist"
-												22663-22665: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22665-22668: "entity.name.tag.xml.plist"
-													22665-22668: "entity.name.tag.localname.xml.plist" - Data: "key"
-												22668-22669: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22677-22719: ""
-											22677-22685: "meta.tag.string.xml.plist"
-												22677-22678: "punctuation.definition.tag.xml.plist" - Data: "<"
-												22678-22684: "entity.name.tag.xml.plist"
-													22678-22684: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22684-22685: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22710-22719: "meta.tag.string.xml.plist"
-												22710-22712: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22712-22718: "entity.name.tag.xml.plist"
-													22712-22718: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22718-22719: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22726-22733: "meta.tag.dict.xml.plist"
-											22726-22728: "punctuation.definition.tag.xml.plist" - Data: "</"
-											22728-22732: "entity.name.tag.xml.plist"
-												22728-22732: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											22732-22733: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22740-22752: ""
-										22740-22745: "meta.tag.key.xml.plist"
-											22740-22741: "punctuation.definition.tag.xml.plist" - Data: "<"
-											22741-22744: "entity.name.tag.xml.plist"
-												22741-22744: "entity.name.tag.localname.xml.plist" - Data: "key"
-											22744-22745: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22746-22752: "meta.tag.key.xml.plist"
-											22746-22748: "punctuation.definition.tag.xml.plist" - Data: "</"
-											22748-22751: "entity.name.tag.xml.plist"
-												22748-22751: "entity.name.tag.localname.xml.plist" - Data: "key"
-											22751-22752: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22759-22862: ""
-										22759-22765: "meta.tag.dict.xml.plist"
-											22759-22760: "punctuation.definition.tag.xml.plist" - Data: "<"
-											22760-22764: "entity.name.tag.xml.plist"
-												22760-22764: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											22764-22765: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22773-22788: ""
-											22773-22778: "meta.tag.key.xml.plist"
-												22773-22774: "punctuation.definition.tag.xml.plist" - Data: "<"
-												22774-22777: "entity.name.tag.xml.plist"
-													22774-22777: "entity.name.tag.localname.xml.plist" - Data: "key"
-												22777-22778: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22782-22788: "meta.tag.key.xml.plist"
-												22782-22784: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22784-22787: "entity.name.tag.xml.plist"
-													22784-22787: "entity.name.tag.localname.xml.plist" - Data: "key"
-												22787-22788: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22796-22848: ""
-											22796-22804: "meta.tag.string.xml.plist"
-												22796-22797: "punctuation.definition.tag.xml.plist" - Data: "<"
-												22797-22803: "entity.name.tag.xml.plist"
-													22797-22803: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22803-22804: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22839-22848: "meta.tag.string.xml.plist"
-												22839-22841: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22841-22847: "entity.name.tag.xml.plist"
-													22841-22847: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22847-22848: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22855-22862: "meta.tag.dict.xml.plist"
-											22855-22857: "punctuation.definition.tag.xml.plist" - Data: "</"
-											22857-22861: "entity.name.tag.xml.plist"
-												22857-22861: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											22861-22862: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22869-22881: ""
-										22869-22874: "meta.tag.key.xml.plist"
-											22869-22870: "punctuation.definition.tag.xml.plist" - Data: "<"
-											22870-22873: "entity.name.tag.xml.plist"
-												22870-22873: "entity.name.tag.localname.xml.plist" - Data: "key"
-											22873-22874: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22875-22881: "meta.tag.key.xml.plist"
-											22875-22877: "punctuation.definition.tag.xml.plist" - Data: "</"
-											22877-22880: "entity.name.tag.xml.plist"
-												22877-22880: "entity.name.tag.localname.xml.plist" - Data: "key"
-											22880-22881: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22888-22992: ""
-										22888-22894: "meta.tag.dict.xml.plist"
-											22888-22889: "punctuation.definition.tag.xml.plist" - Data: "<"
-											22889-22893: "entity.name.tag.xml.plist"
-												22889-22893: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											22893-22894: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22902-22917: ""
-											22902-22907: "meta.tag.key.xml.plist"
-												22902-22903: "punctuation.definition.tag.xml.plist" - Data: "<"
-												22903-22906: "entity.name.tag.xml.plist"
-													22903-22906: "entity.name.tag.localname.xml.plist" - Data: "key"
-												22906-22907: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22911-22917: "meta.tag.key.xml.plist"
-												22911-22913: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22913-22916: "entity.name.tag.xml.plist"
-													22913-22916: "entity.name.tag.localname.xml.plist" - Data: "key"
-												22916-22917: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22925-22978: ""
-											22925-22933: "meta.tag.string.xml.plist"
-												22925-22926: "punctuation.definition.tag.xml.plist" - Data: "<"
-												22926-22932: "entity.name.tag.xml.plist"
-													22926-22932: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22932-22933: "punctuation.definition.tag.xml.plist" - Data: ">"
-											22969-22978: "meta.tag.string.xml.plist"
-												22969-22971: "punctuation.definition.tag.xml.plist" - Data: "</"
-												22971-22977: "entity.name.tag.xml.plist"
-													22971-22977: "entity.name.tag.localname.xml.plist" - Data: "string"
-												22977-22978: "punctuation.definition.tag.xml.plist" - Data: ">"
-										22985-22992: "meta.tag.dict.xml.plist"
-											22985-22987: "punctuation.definition.tag.xml.plist" - Data: "</"
-											22987-22991: "entity.name.tag.xml.plist"
-												22987-22991: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											22991-22992: "punctuation.definition.tag.xml.plist" - Data: ">"
-									22998-23005: "meta.tag.dict.xml.plist"
-										22998-23000: "punctuation.definition.tag.xml.plist" - Data: "</"
-										23000-23004: "entity.name.tag.xml.plist"
-											23000-23004: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										23004-23005: "punctuation.definition.tag.xml.plist" - Data: ">"
-								23011-23029: ""
-									23011-23016: "meta.tag.key.xml.plist"
-										23011-23012: "punctuation.definition.tag.xml.plist" - Data: "<"
-										23012-23015: "entity.name.tag.xml.plist"
-											23012-23015: "entity.name.tag.localname.xml.plist" - Data: "key"
-										23015-23016: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23023-23029: "meta.tag.key.xml.plist"
-										23023-23025: "punctuation.definition.tag.xml.plist" - Data: "</"
-										23025-23028: "entity.name.tag.xml.plist"
-											23025-23028: "entity.name.tag.localname.xml.plist" - Data: "key"
-										23028-23029: "punctuation.definition.tag.xml.plist" - Data: ">"
-								23035-23059: ""
-									23035-23043: "meta.tag.string.xml.plist"
-										23035-23036: "punctuation.definition.tag.xml.plist" - Data: "<"
-										23036-23042: "entity.name.tag.xml.plist"
-											23036-23042: "entity.name.tag.localname.xml.plist" - Data: "string"
-										23042-23043: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23050-23059: "meta.tag.string.xml.plist"
-										23050-23052: "punctuation.definition.tag.xml.plist" - Data: "</"
-										23052-23058: "entity.name.tag.xml.plist"
-											23052-23058: "entity.name.tag.localname.xml.plist" - Data: "string"
-										23058-23059: "punctuation.definition.tag.xml.plist" - Data: ">"
-								23065-23079: ""
-									23065-23070: "meta.tag.key.xml.plist"
-										23065-23066: "punctuation.definition.tag.xml.plist" - Data: "<"
-										23066-23069: "entity.name.tag.xml.plist"
-											23066-23069: "entity.name.tag.localname.xml.plist" - Data: "key"
-										23069-23070: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23073-23079: "meta.tag.key.xml.plist"
-										23073-23075: "punctuation.definition.tag.xml.plist" - Data: "</"
-										23075-23078: "entity.name.tag.xml.plist"
-											23075-23078: "entity.name.tag.localname.xml.plist" - Data: "key"
-										23078-23079: "punctuation.definition.tag.xml.plist" - Data: ">"
-								23085-23128: ""
-									23085-23093: "meta.tag.string.xml.plist"
-										23085-23086: "punctuation.definition.tag.xml.plist" - Data: "<"
-										23086-23092: "entity.name.tag.xml.plist"
-											23086-23092: "entity.name.tag.localname.xml.plist" - Data: "string"
-										23092-23093: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23119-23128: "meta.tag.string.xml.plist"
-										23119-23121: "punctuation.definition.tag.xml.plist" - Data: "</"
-										23121-23127: "entity.name.tag.xml.plist"
-											23121-23127: "entity.name.tag.localname.xml.plist" - Data: "string"
-										23127-23128: "punctuation.definition.tag.xml.plist" - Data: ">"
-								23134-23153: ""
-									23134-23139: "meta.tag.key.xml.plist"
-										23134-23135: "punctuation.definition.tag.xml.plist" - Data: "<"
-										23135-23138: "entity.name.tag.xml.plist"
-											23135-23138: "entity.name.tag.localname.xml.plist" - Data: "key"
-										23138-23139: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23147-23153: "meta.tag.key.xml.plist"
-										23147-23149: "punctuation.definition.tag.xml.plist" - Data: "</"
-										23149-23152: "entity.name.tag.xml.plist"
-											23149-23152: "entity.name.tag.localname.xml.plist" - Data: "key"
-										23152-23153: "punctuation.definition.tag.xml.plist" - Data: ">"
-								23159-23509: ""
-									23159-23166: "meta.tag.array.xml.plist"
-										23159-23160: "punctuation.definition.tag.xml.plist" - Data: "<"
-										23160-23165: "entity.name.tag.xml.plist"
-											23160-23165: "entity.name.tag.localname.xml.plist" - Data: "array"
-										23165-23166: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23173-23332: ""
-										23173-23179: "meta.tag.dict.xml.plist"
-											23173-23174: "punctuation.definition.tag.xml.plist" - Data: "<"
-											23174-23178: "entity.name.tag.xml.plist"
-												23174-23178: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											23178-23179: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23187-23203: ""
-											23187-23192: "meta.tag.key.xml.plist"
-												23187-23188: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23188-23191: "entity.name.tag.xml.plist"
-													23188-23191: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23191-23192: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23197-23203: "meta.tag.key.xml.plist"
-												23197-23199: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23199-23202: "entity.name.tag.xml.plist"
-													23199-23202: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23202-23203: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23211-23236: ""
-											23211-23219: "meta.tag.string.xml.plist"
-												23211-23212: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23212-23218: "entity.name.tag.xml.plist"
-													23212-23218: "entity.name.tag.localname.xml.plist" - Data: "string"
-												23218-23219: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23227-23236: "meta.tag.string.xml.plist"
-												23227-23229: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23229-23235: "entity.name.tag.xml.plist"
-													23229-23235: "entity.name.tag.localname.xml.plist" - Data: "string"
-												23235-23236: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23244-23259: ""
-											23244-23249: "meta.tag.key.xml.plist"
-												23244-23245: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23245-23248: "entity.name.tag.xml.plist"
-													23245-23248: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23248-23249: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23253-23259: "meta.tag.key.xml.plist"
-												23253-23255: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23255-23258: "entity.name.tag.xml.plist"
-													23255-23258: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23258-23259: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23267-23318: ""
-											23267-23275: "meta.tag.string.xml.plist"
-												23267-23268: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23268-23274: "entity.name.tag.xml.plist"
-													23268-23274: "entity.name.tag.localname.xml.plist" - Data: "string"
-												23274-23275: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23309-23318: "meta.tag.string.xml.plist"
-												23309-23311: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23311-23317: "entity.name.tag.xml.plist"
-													23311-23317: "entity.name.tag.localname.xml.plist" - Data: "string"
-												23317-23318: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23325-23332: "meta.tag.dict.xml.plist"
-											23325-23327: "punctuation.definition.tag.xml.plist" - Data: "</"
-											23327-23331: "entity.name.tag.xml.plist"
-												23327-23331: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											23331-23332: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23339-23495: ""
-										23339-23345: "meta.tag.dict.xml.plist"
-											23339-23340: "punctuation.definition.tag.xml.plist" - Data: "<"
-											23340-23344: "entity.name.tag.xml.plist"
-												23340-23344: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											23344-23345: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23353-23369: ""
-											23353-23358: "meta.tag.key.xml.plist"
-												23353-23354: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23354-23357: "entity.name.tag.xml.plist"
-													23354-23357: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23357-23358: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23363-23369: "meta.tag.key.xml.plist"
-												23363-23365: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23365-23368: "entity.name.tag.xml.plist"
-													23365-23368: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23368-23369: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23377-23395: ""
-											23377-23385: "meta.tag.string.xml.plist"
-												23377-23378: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23378-23384: "entity.name.tag.xml.plist"
-													23378-23384: "entity.name.tag.localname.xml.plist" - Data: "string"
-												23384-23385: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23386-23395: "meta.tag.string.xml.plist"
-												23386-23388: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23388-23394: "entity.name.tag.xml.plist"
-													23388-23394: "entity.name.tag.localname.xml.plist" - Data: "string"
-												23394-23395: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23403-23418: ""
-											23403-23408: "meta.tag.key.xml.plist"
-												23403-23404: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23404-23407: "entity.name.tag.xml.plist"
-													23404-23407: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23407-23408: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23412-23418: "meta.tag.key.xml.plist"
-												23412-23414: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23414-23417: "entity.name.tag.xml.plist"
-													23414-23417: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23417-23418: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23426-23481: ""
-											23426-23434: "meta.tag.string.xml.plist"
-												23426-23427: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23427-23433: "entity.name.tag.xml.plist"
-													23427-23433: "entity.name.tag.localname.xml.plist" - Data: "string"
-												23433-23434: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23472-23481: "meta.tag.string.xml.plist"
-												23472-23474: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23474-23480: "entity.name.tag.xml.plist"
-													23474-23480: "entity.name.tag.localname.xml.plist" - Data: "string"
-												23480-23481: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23488-23495: "meta.tag.dict.xml.plist"
-											23488-23490: "punctuation.definition.tag.xml.plist" - Data: "</"
-											23490-23494: "entity.name.tag.xml.plist"
-												23490-23494: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											23494-23495: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23501-23509: "meta.tag.array.xml.plist"
-										23501-23503: "punctuation.definition.tag.xml.plist" - Data: "</"
-										23503-23508: "entity.name.tag.xml.plist"
-											23503-23508: "entity.name.tag.localname.xml.plist" - Data: "array"
-										23508-23509: "punctuation.definition.tag.xml.plist" - Data: ">"
-								23514-23521: "meta.tag.dict.xml.plist"
-									23514-23516: "punctuation.definition.tag.xml.plist" - Data: "</"
-									23516-23520: "entity.name.tag.xml.plist"
-										23516-23520: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									23520-23521: "punctuation.definition.tag.xml.plist" - Data: ">"
-							23526-24354: ""
-								23526-23532: "meta.tag.dict.xml.plist"
-									23526-23527: "punctuation.definition.tag.xml.plist" - Data: "<"
-									23527-23531: "entity.name.tag.xml.plist"
-										23527-23531: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									23531-23532: "punctuation.definition.tag.xml.plist" - Data: ">"
-								23538-23557: ""
-									23538-23543: "meta.tag.key.xml.plist"
-										23538-23539: "punctuation.definition.tag.xml.plist" - Data: "<"
-										23539-23542: "entity.name.tag.xml.plist"
-											23539-23542: "entity.name.tag.localname.xml.plist" - Data: "key"
-										23542-23543: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23551-23557: "meta.tag.key.xml.plist"
-										23551-23553: "punctuation.definition.tag.xml.plist" - Data: "</"
-										23553-23556: "entity.name.tag.xml.plist"
-											23553-23556: "entity.name.tag.localname.xml.plist" - Data: "key"
-										23556-23557: "punctuation.definition.tag.xml.plist" - Data: ">"
-								23563-24210: ""
-									23563-23569: "meta.tag.dict.xml.plist"
-										23563-23564: "punctuation.definition.tag.xml.plist" - Data: "<"
-										23564-23568: "entity.name.tag.xml.plist"
-											23564-23568: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										23568-23569: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23576-23588: ""
-										23576-23581: "meta.tag.key.xml.plist"
-											23576-23577: "punctuation.definition.tag.xml.plist" - Data: "<"
-											23577-23580: "entity.name.tag.xml.plist"
-												23577-23580: "entity.name.tag.localname.xml.plist" - Data: "key"
-											23580-23581: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23582-23588: "meta.tag.key.xml.plist"
-											23582-23584: "punctuation.definition.tag.xml.plist" - Data: "</"
-											23584-23587: "entity.name.tag.xml.plist"
-												23584-23587: "entity.name.tag.localname.xml.plist" - Data: "key"
-											23587-23588: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23595-23689: ""
-										23595-23601: "meta.tag.dict.xml.plist"
-											23595-23596: "punctuation.definition.tag.xml.plist" - Data: "<"
-											23596-23600: "entity.name.tag.xml.plist"
-												23596-23600: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											23600-23601: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23609-23624: ""
-											23609-23614: "meta.tag.key.xml.plist"
-												23609-23610: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23610-23613: "entity.name.tag.xml.plist"
-													23610-23613: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23613-23614: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23618-23624: "meta.tag.key.xml.plist"
-												23618-23620: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23620-23623: "entity.name.tag.xml.plist"
-													23620-23623: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23623-23624: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23632-23675: ""
-											23632-23640: "meta.tag.string.xml.plist"
-												23632-23633: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23633-23639: "entity.name.tag.xml.plist"
-													23633-23639: "entity.name.tag.localname.xml.plist" - Data: "string"
-												23639-23640: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23666-23675: "meta.tag.string.xml.plist"
-												23666-23668: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23668-23674: "entity.name.tag.xml.plist"
-													23668-23674: "entity.name.tag.localname.xml.plist" - Data: "string"
-												23674-23675: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23682-23689: "meta.tag.dict.xml.plist"
-											23682-23684: "punctuation.definition.tag.xml.plist" - Data: "</"
-											23684-23688: "entity.name.tag.xml.plist"
-												23684-23688: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											23688-23689: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23696-23708: ""
-										23696-23701: "meta.tag.key.xml.plist"
-											23696-23697: "punctuation.definition.tag.xml.plist" - Data: "<"
-											23697-23700: "entity.name.tag.xml.plist"
-												23697-23700: "entity.name.tag.localname.xml.plist" - Data: "key"
-											23700-23701: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23702-23708: "meta.tag.key.xml.plist"
-											23702-23704: "punctuation.definition.tag.xml.plist" - Data: "</"
-											23704-23707: "entity.name.tag.xml.plist"
-												23704-23707: "entity.name.tag.localname.xml.plist" - Data: "key"
-											23707-23708: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23715-23819: ""
-										23715-23721: "meta.tag.dict.xml.plist"
-											23715-23716: "punctuation.definition.tag.xml.plist" - Data: "<"
-											23716-23720: "entity.name.tag.xml.plist"
-												23716-23720: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											23720-23721: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23729-23744: ""
-											23729-23734: "meta.tag.key.xml.plist"
-												23729-23730: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23730-23733: "entity.name.tag.xml.plist"
-													23730-23733: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23733-23734: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23738-23744: "meta.tag.key.xml.plist"
-												23738-23740: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23740-23743: "entity.name.tag.xml.plist"
-													23740-23743: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23743-23744: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23752-23805: ""
-											23752-23760: "meta.tag.string.xml.plist"
-												23752-23753: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23753-23759: "entity.name.tag.xml.plist"
-													23753-23759: "entity.name.tag.localname.xml.plist" - Data: "string"
-												23759-23760: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23796-23805: "meta.tag.string.xml.plist"
-												23796-23798: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23798-23804: "entity.name.tag.xml.plist"
-													23798-23804: "entity.name.tag.localname.xml.plist" - Data: "string"
-												23804-23805: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23812-23819: "meta.tag.dict.xml.plist"
-											23812-23814: "punctuation.definition.tag.xml.plist" - Data: "</"
-											23814-23818: "entity.name.tag.xml.plist"
-												23814-23818: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											23818-23819: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23826-23838: ""
-										23826-23831: "meta.tag.key.xml.plist"
-											23826-23827: "punctuation.definition.tag.xml.plist" - Data: "<"
-											23827-23830: "entity.name.tag.xml.plist"
-												23827-23830: "entity.name.tag.localname.xml.plist" - Data: "key"
-											23830-23831: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23832-23838: "meta.tag.key.xml.plist"
-											23832-23834: "punctuation.definition.tag.xml.plist" - Data: "</"
-											23834-23837: "entity.name.tag.xml.plist"
-												23834-23837: "entity.name.tag.localname.xml.plist" - Data: "key"
-											23837-23838: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23845-23938: ""
-										23845-23851: "meta.tag.dict.xml.plist"
-											23845-23846: "punctuation.definition.tag.xml.plist" - Data: "<"
-											23846-23850: "entity.name.tag.xml.plist"
-												23846-23850: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											23850-23851: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23859-23874: ""
-											23859-23864: "meta.tag.key.xml.plist"
-												23859-23860: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23860-23863: "entity.name.tag.xml.plist"
-													23860-23863: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23863-23864: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23868-23874: "meta.tag.key.xml.plist"
-												23868-23870: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23870-23873: "entity.name.tag.xml.plist"
-													23870-23873: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23873-23874: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23882-23924: ""
-											23882-23890: "meta.tag.string.xml.plist"
-												23882-23883: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23883-23889: "entity.name.tag.xml.plist"
-													23883-23889: "entity.name.tag.localname.xml.plist" - Data: "string"
-												23889-23890: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23915-23924: "meta.tag.string.xml.plist"
-												23915-23917: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23917-23923: "entity.name.tag.xml.plist"
-													23917-23923: "entity.name.tag.localname.xml.plist" - Data: "string"
-												23923-23924: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23931-23938: "meta.tag.dict.xml.plist"
-											23931-23933: "punctuation.definition.tag.xml.plist" - Data: "</"
-											23933-23937: "entity.name.tag.xml.plist"
-												23933-23937: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											23937-23938: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23945-23957: ""
-										23945-23950: "meta.tag.key.xml.plist"
-											23945-23946: "punctuation.definition.tag.xml.plist" - Data: "<"
-											23946-23949: "entity.name.tag.xml.plist"
-												23946-23949: "entity.name.tag.localname.xml.plist" - Data: "key"
-											23949-23950: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23951-23957: "meta.tag.key.xml.plist"
-											23951-23953: "punctuation.definition.tag.xml.plist" - Data: "</"
-											23953-23956: "entity.name.tag.xml.plist"
-												23953-23956: "entity.name.tag.localname.xml.plist" - Data: "key"
-											23956-23957: "punctuation.definition.tag.xml.plist" - Data: ">"
-									23964-24067: ""
-										23964-23970: "meta.tag.dict.xml.plist"
-											23964-23965: "punctuation.definition.tag.xml.plist" - Data: "<"
-											23965-23969: "entity.name.tag.xml.plist"
-												23965-23969: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											23969-23970: "punctuation.definition.tag.xml.plist" - Data: ">"
-										23978-23993: ""
-											23978-23983: "meta.tag.key.xml.plist"
-												23978-23979: "punctuation.definition.tag.xml.plist" - Data: "<"
-												23979-23982: "entity.name.tag.xml.plist"
-													23979-23982: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23982-23983: "punctuation.definition.tag.xml.plist" - Data: ">"
-											23987-23993: "meta.tag.key.xml.plist"
-												23987-23989: "punctuation.definition.tag.xml.plist" - Data: "</"
-												23989-23992: "entity.name.tag.xml.plist"
-													23989-23992: "entity.name.tag.localname.xml.plist" - Data: "key"
-												23992-23993: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24001-24053: ""
-											24001-24009: "meta.tag.string.xml.plist"
-												24001-24002: "punctuation.definition.tag.xml.plist" - Data: "<"
-												24002-24008: "entity.name.tag.xml.plist"
-													24002-24008: "entity.name.tag.localname.xml.plist" - Data: "string"
-												24008-24009: "punctuation.definition.tag.xml.plist" - Data: ">"
-											24044-24053: "meta.tag.string.xml.plist"
-												24044-24046: "punctuation.definition.tag.xml.plist" - Data: "</"
-												24046-24052: "entity.name.tag.xml.plist"
-													24046-24052: "entity.name.tag.localname.xml.plist" - Data: "string"
-												24052-24053: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24060-24067: "meta.tag.dict.xml.plist"
-											24060-24062: "punctuation.definition.tag.xml.plist" - Data: "</"
-											24062-24066: "entity.name.tag.xml.plist"
-												24062-24066: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											24066-24067: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24074-24086: ""
-										24074-24079: "meta.tag.key.xml.plist"
-											24074-24075: "punctuation.definition.tag.xml.plist" - Data: "<"
-											24075-24078: "entity.name.tag.xml.plist"
-												24075-24078: "entity.name.tag.localname.xml.plist" - Data: "key"
-											24078-24079: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24080-24086: "meta.tag.key.xml.plist"
-											24080-24082: "punctuation.definition.tag.xml.plist" - Data: "</"
-											24082-24085: "entity.name.tag.xml.plist"
-												24082-24085: "entity.name.tag.localname.xml.plist" - Data: "key"
-											24085-24086: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24093-24197: ""
-										24093-24099: "meta.tag.dict.xml.plist"
-											24093-24094: "punctuation.definition.tag.xml.plist" - Data: "<"
-											24094-24098: "entity.name.tag.xml.plist"
-												24094-24098: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											24098-24099: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24107-24122: ""
-											24107-24112: "meta.tag.key.xml.plist"
-												24107-24108: "punctuation.definition.tag.xml.plist" - Data: "<"
-												24108-24111: "entity.name.tag.xml.plist"
-													24108-24111: "entity.name.tag.localname.xml.plist" - Data: "key"
-												24111-24112: "punctuation.definition.tag.xml.plist" - Data: ">"
-											24116-24122: "meta.tag.key.xml.plist"
-												24116-24118: "punctuation.definition.tag.xml.plist" - Data: "</"
-												24118-24121: "entity.name.tag.xml.plist"
-													24118-24121: "entity.name.tag.localname.xml.plist" - Data: "key"
-												24121-24122: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24130-24183: ""
-											24130-24138: "meta.tag.string.xml.plist"
-												24130-24131: "punctuation.definition.tag.xml.plist" - Data: "<"
-												24131-24137: "entity.name.tag.xml.plist"
-													24131-24137: "entity.name.tag.localname.xml.plist" - Data: "string"
-												24137-24138: "punctuation.definition.tag.xml.plist" - Data: ">"
-											24174-24183: "meta.tag.string.xml.plist"
-												24174-24176: "punctuation.definition.tag.xml.plist" - Data: "</"
-												24176-24182: "entity.name.tag.xml.plist"
-													24176-24182: "entity.name.tag.localname.xml.plist" - Data: "string"
-												24182-24183: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24190-24197: "meta.tag.dict.xml.plist"
-											24190-24192: "punctuation.definition.tag.xml.plist" - Data: "</"
-											24192-24196: "entity.name.tag.xml.plist"
-												24192-24196: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											24196-24197: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24203-24210: "meta.tag.dict.xml.plist"
-										24203-24205: "punctuation.definition.tag.xml.plist" - Data: "</"
-										24205-24209: "entity.name.tag.xml.plist"
-											24205-24209: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										24209-24210: "punctuation.definition.tag.xml.plist" - Data: ">"
-								24216-24234: ""
-									24216-24221: "meta.tag.key.xml.plist"
-										24216-24217: "punctuation.definition.tag.xml.plist" - Data: "<"
-										24217-24220: "entity.name.tag.xml.plist"
-											24217-24220: "entity.name.tag.localname.xml.plist" - Data: "key"
-										24220-24221: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24228-24234: "meta.tag.key.xml.plist"
-										24228-24230: "punctuation.definition.tag.xml.plist" - Data: "</"
-										24230-24233: "entity.name.tag.xml.plist"
-											24230-24233: "entity.name.tag.localname.xml.plist" - Data: "key"
-										24233-24234: "punctuation.definition.tag.xml.plist" - Data: ">"
-								24240-24264: ""
-									24240-24248: "meta.tag.string.xml.plist"
-										24240-24241: "punctuation.definition.tag.xml.plist" - Data: "<"
-										24241-24247: "entity.name.tag.xml.plist"
-											24241-24247: "entity.name.tag.localname.xml.plist" - Data: "string"
-										24247-24248: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24255-24264: "meta.tag.string.xml.plist"
-										24255-24257: "punctuation.definition.tag.xml.plist" - Data: "</"
-										24257-24263: "entity.name.tag.xml.plist"
-											24257-24263: "entity.name.tag.localname.xml.plist" - Data: "string"
-										24263-24264: "punctuation.definition.tag.xml.plist" - Data: ">"
-								24270-24286: ""
-									24270-24275: "meta.tag.key.xml.plist"
-										24270-24271: "punctuation.definition.tag.xml.plist" - Data: "<"
-										24271-24274: "entity.name.tag.xml.plist"
-											24271-24274: "entity.name.tag.localname.xml.plist" - Data: "key"
-										24274-24275: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24280-24286: "meta.tag.key.xml.plist"
-										24280-24282: "punctuation.definition.tag.xml.plist" - Data: "</"
-										24282-24285: "entity.name.tag.xml.plist"
-											24282-24285: "entity.name.tag.localname.xml.plist" - Data: "key"
-										24285-24286: "punctuation.definition.tag.xml.plist" - Data: ">"
-								24292-24342: ""
-									24292-24300: "meta.tag.string.xml.plist"
-										24292-24293: "punctuation.definition.tag.xml.plist" - Data: "<"
-										24293-24299: "entity.name.tag.xml.plist"
-											24293-24299: "entity.name.tag.localname.xml.plist" - Data: "string"
-										24299-24300: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24333-24342: "meta.tag.string.xml.plist"
-										24333-24335: "punctuation.definition.tag.xml.plist" - Data: "</"
-										24335-24341: "entity.name.tag.xml.plist"
-											24335-24341: "entity.name.tag.localname.xml.plist" - Data: "string"
-										24341-24342: "punctuation.definition.tag.xml.plist" - Data: ">"
-								24347-24354: "meta.tag.dict.xml.plist"
-									24347-24349: "punctuation.definition.tag.xml.plist" - Data: "</"
-									24349-24353: "entity.name.tag.xml.plist"
-										24349-24353: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									24353-24354: "punctuation.definition.tag.xml.plist" - Data: ">"
-							24359-25791: ""
-								24359-24365: "meta.tag.dict.xml.plist"
-									24359-24360: "punctuation.definition.tag.xml.plist" - Data: "<"
-									24360-24364: "entity.name.tag.xml.plist"
-										24360-24364: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									24364-24365: "punctuation.definition.tag.xml.plist" - Data: ">"
-								24371-24387: ""
-									24371-24376: "meta.tag.key.xml.plist"
-										24371-24372: "punctuation.definition.tag.xml.plist" - Data: "<"
-										24372-24375: "entity.name.tag.xml.plist"
-											24372-24375: "entity.name.tag.localname.xml.plist" - Data: "key"
-										24375-24376: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24381-24387: "meta.tag.key.xml.plist"
-										24381-24383: "punctuation.definition.tag.xml.plist" - Data: "</"
-										24383-24386: "entity.name.tag.xml.plist"
-											24383-24386: "entity.name.tag.localname.xml.plist" - Data: "key"
-										24386-24387: "punctuation.definition.tag.xml.plist" - Data: ">"
-								24393-24432: ""
-									24393-24401: "meta.tag.string.xml.plist"
-										24393-24394: "punctuation.definition.tag.xml.plist" - Data: "<"
-										24394-24400: "entity.name.tag.xml.plist"
-											24394-24400: "entity.name.tag.localname.xml.plist" - Data: "string"
-										24400-24401: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24423-24432: "meta.tag.string.xml.plist"
-										24423-24425: "punctuation.definition.tag.xml.plist" - Data: "</"
-										24425-24431: "entity.name.tag.xml.plist"
-											24425-24431: "entity.name.tag.localname.xml.plist" - Data: "string"
-										24431-24432: "punctuation.definition.tag.xml.plist" - Data: ">"
-								24438-24457: ""
-									24438-24443: "meta.tag.key.xml.plist"
-										24438-24439: "punctuation.definition.tag.xml.plist" - Data: "<"
-										24439-24442: "entity.name.tag.xml.plist"
-											24439-24442: "entity.name.tag.localname.xml.plist" - Data: "key"
-										24442-24443: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24451-24457: "meta.tag.key.xml.plist"
-										24451-24453: "punctuation.definition.tag.xml.plist" - Data: "</"
-										24453-24456: "entity.name.tag.xml.plist"
-											24453-24456: "entity.name.tag.localname.xml.plist" - Data: "key"
-										24456-24457: "punctuation.definition.tag.xml.plist" - Data: ">"
-								24463-25107: ""
-									24463-24469: "meta.tag.dict.xml.plist"
-										24463-24464: "punctuation.definition.tag.xml.plist" - Data: "<"
-										24464-24468: "entity.name.tag.xml.plist"
-											24464-24468: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										24468-24469: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24476-24488: ""
-										24476-24481: "meta.tag.key.xml.plist"
-											24476-24477: "punctuation.definition.tag.xml.plist" - Data: "<"
-											24477-24480: "entity.name.tag.xml.plist"
-												24477-24480: "entity.name.tag.localname.xml.plist" - Data: "key"
-											24480-24481: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24482-24488: "meta.tag.key.xml.plist"
-											24482-24484: "punctuation.definition.tag.xml.plist" - Data: "</"
-											24484-24487: "entity.name.tag.xml.plist"
-												24484-24487: "entity.name.tag.localname.xml.plist" - Data: "key"
-											24487-24488: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24495-24586: ""
-										24495-24501: "meta.tag.dict.xml.plist"
-											24495-24496: "punctuation.definition.tag.xml.plist" - Data: "<"
-											24496-24500: "entity.name.tag.xml.plist"
-												24496-24500: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											24500-24501: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24509-24524: ""
-											24509-24514: "meta.tag.key.xml.plist"
-												24509-24510: "punctuation.definition.tag.xml.plist" - Data: "<"
-												24510-24513: "entity.name.tag.xml.plist"
-													24510-24513: "entity.name.tag.localname.xml.plist" - Data: "key"
-												24513-24514: "punctuation.definition.tag.xml.plist" - Data: ">"
-											24518-24524: "meta.tag.key.xml.plist"
-												24518-24520: "punctuation.definition.tag.xml.plist" - Data: "</"
-												24520-24523: "entity.name.tag.xml.plist"
-													24520-24523: "entity.name.tag.localname.xml.plist" - Data: "key"
-												24523-24524: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24532-24572: ""
-											24532-24540: "meta.tag.string.xml.plist"
-												24532-24533: "punctuation.definition.tag.xml.plist" - Data: "<"
-												24533-24539: "entity.name.tag.xml.plist"
-													24533-24539: "entity.name.tag.localname.xml.plist" - Data: "string"
-												24539-24540: "punctuation.definition.tag.xml.plist" - Data: ">"
-											24563-24572: "meta.tag.string.xml.plist"
-												24563-24565: "punctuation.definition.tag.xml.plist" - Data: "</"
-												24565-24571: "entity.name.tag.xml.plist"
-													24565-24571: "entity.name.tag.localname.xml.plist" - Data: "string"
-												24571-24572: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24579-24586: "meta.tag.dict.xml.plist"
-											24579-24581: "punctuation.definition.tag.xml.plist" - Data: "</"
-											24581-24585: "entity.name.tag.xml.plist"
-												24581-24585: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											24585-24586: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24593-24605: ""
-										24593-24598: "meta.tag.key.xml.plist"
-											24593-24594: "punctuation.definition.tag.xml.plist" - Data: "<"
-											24594-24597: "entity.name.tag.xml.plist"
-												24594-24597: "entity.name.tag.localname.xml.plist" - Data: "key"
-											24597-24598: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24599-24605: "meta.tag.key.xml.plist"
-											24599-24601: "punctuation.definition.tag.xml.plist" - Data: "</"
-											24601-24604: "entity.name.tag.xml.plist"
-												24601-24604: "entity.name.tag.localname.xml.plist" - Data: "key"
-											24604-24605: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24612-24716: ""
-										24612-24618: "meta.tag.dict.xml.plist"
-											24612-24613: "punctuation.definition.tag.xml.plist" - Data: "<"
-											24613-24617: "entity.name.tag.xml.plist"
-												24613-24617: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											24617-24618: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24626-24641: ""
-											24626-24631: "meta.tag.key.xml.plist"
-												24626-24627: "punctuation.definition.tag.xml.plist" - Data: "<"
-												24627-24630: "entity.name.tag.xml.plist"
-													24627-24630: "entity.name.tag.localname.xml.plist" - Data: "key"
-												24630-24631: "punctuation.definition.tag.xml.plist" - Data: ">"
-											24635-24641: "meta.tag.key.xml.plist"
-												24635-24637: "punctuation.definition.tag.xml.plist" - Data: "</"
-												24637-24640: "entity.name.tag.xml.plist"
-													24637-24640: "entity.name.tag.localname.xml.plist" - Data: "key"
-												24640-24641: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24649-24702: ""
-											24649-24657: "meta.tag.string.xml.plist"
-												24649-24650: "punctuation.definition.tag.xml.plist" - Data: "<"
-												24650-24656: "entity.name.tag.xml.plist"
-													24650-24656: "entity.name.tag.localname.xml.plist" - Data: "string"
-												24656-24657: "punctuation.definition.tag.xml.plist" - Data: ">"
-											24693-24702: "meta.tag.string.xml.plist"
-												24693-24695: "punctuation.definition.tag.xml.plist" - Data: "</"
-												24695-24701: "entity.name.tag.xml.plist"
-													24695-24701: "entity.name.tag.localname.xml.plist" - Data: "string"
-												24701-24702: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24709-24716: "meta.tag.dict.xml.plist"
-											24709-24711: "punctuation.definition.tag.xml.plist" - Data: "</"
-											24711-24715: "entity.name.tag.xml.plist"
-												24711-24715: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											24715-24716: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24723-24735: ""
-										24723-24728: "meta.tag.key.xml.plist"
-											24723-24724: "punctuation.definition.tag.xml.plist" - Data: "<"
-											24724-24727: "entity.name.tag.xml.plist"
-												24724-24727: "entity.name.tag.localname.xml.plist" - Data: "key"
-											24727-24728: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24729-24735: "meta.tag.key.xml.plist"
-											24729-24731: "punctuation.definition.tag.xml.plist" - Data: "</"
-											24731-24734: "entity.name.tag.xml.plist"
-												24731-24734: "entity.name.tag.localname.xml.plist" - Data: "key"
-											24734-24735: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24742-24835: ""
-										24742-24748: "meta.tag.dict.xml.plist"
-											24742-24743: "punctuation.definition.tag.xml.plist" - Data: "<"
-											24743-24747: "entity.name.tag.xml.plist"
-												24743-24747: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											24747-24748: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24756-24771: ""
-											24756-24761: "meta.tag.key.xml.plist"
-												24756-24757: "punctuation.definition.tag.xml.plist" - Data: "<"
-												24757-24760: "entity.name.tag.xml.plist"
-													24757-24760: "entity.name.tag.localname.xml.plist" - Data: "key"
-												24760-24761: "punctuation.definition.tag.xml.plist" - Data: ">"
-											24765-24771: "meta.tag.key.xml.plist"
-												24765-24767: "punctuation.definition.tag.xml.plist" - Data: "</"
-												24767-24770: "entity.name.tag.xml.plist"
-													24767-24770: "entity.name.tag.localname.xml.plist" - Data: "key"
-												24770-24771: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24779-24821: ""
-											24779-24787: "meta.tag.string.xml.plist"
-												24779-24780: "punctuation.definition.tag.xml.plist" - Data: "<"
-												24780-24786: "entity.name.tag.xml.plist"
-													24780-24786: "entity.name.tag.localname.xml.plist" - Data: "string"
-												24786-24787: "punctuation.definition.tag.xml.plist" - Data: ">"
-											24812-24821: "meta.tag.string.xml.plist"
-												24812-24814: "punctuation.definition.tag.xml.plist" - Data: "</"
-												24814-24820: "entity.name.tag.xml.plist"
-													24814-24820: "entity.name.tag.localname.xml.plist" - Data: "string"
-												24820-24821: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24828-24835: "meta.tag.dict.xml.plist"
-											24828-24830: "punctuation.definition.tag.xml.plist" - Data: "</"
-											24830-24834: "entity.name.tag.xml.plist"
-												24830-24834: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											24834-24835: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24842-24854: ""
-										24842-24847: "meta.tag.key.xml.plist"
-											24842-24843: "punctuation.definition.tag.xml.plist" - Data: "<"
-											24843-24846: "entity.name.tag.xml.plist"
-												24843-24846: "entity.name.tag.localname.xml.plist" - Data: "key"
-											24846-24847: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24848-24854: "meta.tag.key.xml.plist"
-											24848-24850: "punctuation.definition.tag.xml.plist" - Data: "</"
-											24850-24853: "entity.name.tag.xml.plist"
-												24850-24853: "entity.name.tag.localname.xml.plist" - Data: "key"
-											24853-24854: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24861-24964: ""
-										24861-24867: "meta.tag.dict.xml.plist"
-											24861-24862: "punctuation.definition.tag.xml.plist" - Data: "<"
-											24862-24866: "entity.name.tag.xml.plist"
-												24862-24866: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											24866-24867: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24875-24890: ""
-											24875-24880: "meta.tag.key.xml.plist"
-												24875-24876: "punctuation.definition.tag.xml.plist" - Data: "<"
-												24876-24879: "entity.name.tag.xml.plist"
-													24876-24879: "entity.name.tag.localname.xml.plist" - Data: "key"
-												24879-24880: "punctuation.definition.tag.xml.plist" - Data: ">"
-											24884-24890: "meta.tag.key.xml.plist"
-												24884-24886: "punctuation.definition.tag.xml.plist" - Data: "</"
-												24886-24889: "entity.name.tag.xml.plist"
-													24886-24889: "entity.name.tag.localname.xml.plist" - Data: "key"
-												24889-24890: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24898-24950: ""
-											24898-24906: "meta.tag.string.xml.plist"
-												24898-24899: "punctuation.definition.tag.xml.plist" - Data: "<"
-												24899-24905: "entity.name.tag.xml.plist"
-													24899-24905: "entity.name.tag.localname.xml.plist" - Data: "string"
-												24905-24906: "punctuation.definition.tag.xml.plist" - Data: ">"
-											24941-24950: "meta.tag.string.xml.plist"
-												24941-24943: "punctuation.definition.tag.xml.plist" - Data: "</"
-												24943-24949: "entity.name.tag.xml.plist"
-													24943-24949: "entity.name.tag.localname.xml.plist" - Data: "string"
-												24949-24950: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24957-24964: "meta.tag.dict.xml.plist"
-											24957-24959: "punctuation.definition.tag.xml.plist" - Data: "</"
-											24959-24963: "entity.name.tag.xml.plist"
-												24959-24963: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											24963-24964: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24971-24983: ""
-										24971-24976: "meta.tag.key.xml.plist"
-											24971-24972: "punctuation.definition.tag.xml.plist" - Data: "<"
-											24972-24975: "entity.name.tag.xml.plist"
-												24972-24975: "entity.name.tag.localname.xml.plist" - Data: "key"
-											24975-24976: "punctuation.definition.tag.xml.plist" - Data: ">"
-										24977-24983: "meta.tag.key.xml.plist"
-											24977-24979: "punctuation.definition.tag.xml.plist" - Data: "</"
-											24979-24982: "entity.name.tag.xml.plist"
-												24979-24982: "entity.name.tag.localname.xml.plist" - Data: "key"
-											24982-24983: "punctuation.definition.tag.xml.plist" - Data: ">"
-									24990-25094: ""
-										24990-24996: "meta.tag.dict.xml.plist"
-											24990-24991: "punctuation.definition.tag.xml.plist" - Data: "<"
-											24991-24995: "entity.name.tag.xml.plist"
-												24991-24995: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											24995-24996: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25004-25019: ""
-											25004-25009: "meta.tag.key.xml.plist"
-												25004-25005: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25005-25008: "entity.name.tag.xml.plist"
-													25005-25008: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25008-25009: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25013-25019: "meta.tag.key.xml.plist"
-												25013-25015: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25015-25018: "entity.name.tag.xml.plist"
-													25015-25018: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25018-25019: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25027-25080: ""
-											25027-25035: "meta.tag.string.xml.plist"
-												25027-25028: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25028-25034: "entity.name.tag.xml.plist"
-													25028-25034: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25034-25035: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25071-25080: "meta.tag.string.xml.plist"
-												25071-25073: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25073-25079: "entity.name.tag.xml.plist"
-													25073-25079: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25079-25080: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25087-25094: "meta.tag.dict.xml.plist"
-											25087-25089: "punctuation.definition.tag.xml.plist" - Data: "</"
-											25089-25093: "entity.name.tag.xml.plist"
-												25089-25093: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											25093-25094: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25100-25107: "meta.tag.dict.xml.plist"
-										25100-25102: "punctuation.definition.tag.xml.plist" - Data: "</"
-										25102-25106: "entity.name.tag.xml.plist"
-											25102-25106: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										25106-25107: "punctuation.definition.tag.xml.plist" - Data: ">"
-								25113-25131: ""
-									25113-25118: "meta.tag.key.xml.plist"
-										25113-25114: "punctuation.definition.tag.xml.plist" - Data: "<"
-										25114-25117: "entity.name.tag.xml.plist"
-											25114-25117: "entity.name.tag.localname.xml.plist" - Data: "key"
-										25117-25118: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25125-25131: "meta.tag.key.xml.plist"
-										25125-25127: "punctuation.definition.tag.xml.plist" - Data: "</"
-										25127-25130: "entity.name.tag.xml.plist"
-											25127-25130: "entity.name.tag.localname.xml.plist" - Data: "key"
-										25130-25131: "punctuation.definition.tag.xml.plist" - Data: ">"
-								25137-25158: ""
-									25137-25145: "meta.tag.string.xml.plist"
-										25137-25138: "punctuation.definition.tag.xml.plist" - Data: "<"
-										25138-25144: "entity.name.tag.xml.plist"
-											25138-25144: "entity.name.tag.localname.xml.plist" - Data: "string"
-										25144-25145: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25149-25158: "meta.tag.string.xml.plist"
-										25149-25151: "punctuation.definition.tag.xml.plist" - Data: "</"
-										25151-25157: "entity.name.tag.xml.plist"
-											25151-25157: "entity.name.tag.localname.xml.plist" - Data: "string"
-										25157-25158: "punctuation.definition.tag.xml.plist" - Data: ">"
-								25164-25178: ""
-									25164-25169: "meta.tag.key.xml.plist"
-										25164-25165: "punctuation.definition.tag.xml.plist" - Data: "<"
-										25165-25168: "entity.name.tag.xml.plist"
-											25165-25168: "entity.name.tag.localname.xml.plist" - Data: "key"
-										25168-25169: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25172-25178: "meta.tag.key.xml.plist"
-										25172-25174: "punctuation.definition.tag.xml.plist" - Data: "</"
-										25174-25177: "entity.name.tag.xml.plist"
-											25174-25177: "entity.name.tag.localname.xml.plist" - Data: "key"
-										25177-25178: "punctuation.definition.tag.xml.plist" - Data: ">"
-								25184-25224: ""
-									25184-25192: "meta.tag.string.xml.plist"
-										25184-25185: "punctuation.definition.tag.xml.plist" - Data: "<"
-										25185-25191: "entity.name.tag.xml.plist"
-											25185-25191: "entity.name.tag.localname.xml.plist" - Data: "string"
-										25191-25192: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25215-25224: "meta.tag.string.xml.plist"
-										25215-25217: "punctuation.definition.tag.xml.plist" - Data: "</"
-										25217-25223: "entity.name.tag.xml.plist"
-											25217-25223: "entity.name.tag.localname.xml.plist" - Data: "string"
-										25223-25224: "punctuation.definition.tag.xml.plist" - Data: ">"
-								25230-25249: ""
-									25230-25235: "meta.tag.key.xml.plist"
-										25230-25231: "punctuation.definition.tag.xml.plist" - Data: "<"
-										25231-25234: "entity.name.tag.xml.plist"
-											25231-25234: "entity.name.tag.localname.xml.plist" - Data: "key"
-										25234-25235: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25243-25249: "meta.tag.key.xml.plist"
-										25243-25245: "punctuation.definition.tag.xml.plist" - Data: "</"
-										25245-25248: "entity.name.tag.xml.plist"
-											25245-25248: "entity.name.tag.localname.xml.plist" - Data: "key"
-										25248-25249: "punctuation.definition.tag.xml.plist" - Data: ">"
-								25255-25779: ""
-									25255-25262: "meta.tag.array.xml.plist"
-										25255-25256: "punctuation.definition.tag.xml.plist" - Data: "<"
-										25256-25261: "entity.name.tag.xml.plist"
-											25256-25261: "entity.name.tag.localname.xml.plist" - Data: "array"
-										25261-25262: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25269-25432: ""
-										25269-25275: "meta.tag.dict.xml.plist"
-											25269-25270: "punctuation.definition.tag.xml.plist" - Data: "<"
-											25270-25274: "entity.name.tag.xml.plist"
-												25270-25274: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											25274-25275: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25283-25299: ""
-											25283-25288: "meta.tag.key.xml.plist"
-												25283-25284: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25284-25287: "entity.name.tag.xml.plist"
-													25284-25287: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25287-25288: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25293-25299: "meta.tag.key.xml.plist"
-												25293-25295: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25295-25298: "entity.name.tag.xml.plist"
-													25295-25298: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25298-25299: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25307-25337: ""
-											25307-25315: "meta.tag.string.xml.plist"
-												25307-25308: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25308-25314: "entity.name.tag.xml.plist"
-													25308-25314: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25314-25315: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25328-25337: "meta.tag.string.xml.plist"
-												25328-25330: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25330-25336: "entity.name.tag.xml.plist"
-													25330-25336: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25336-25337: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25345-25360: ""
-											25345-25350: "meta.tag.key.xml.plist"
-												25345-25346: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25346-25349: "entity.name.tag.xml.plist"
-													25346-25349: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25349-25350: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25354-25360: "meta.tag.key.xml.plist"
-												25354-25356: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25356-25359: "entity.name.tag.xml.plist"
-													25356-25359: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25359-25360: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25368-25418: ""
-											25368-25376: "meta.tag.string.xml.plist"
-												25368-25369: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25369-25375: "entity.name.tag.xml.plist"
-													25369-25375: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25375-25376: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25409-25418: "meta.tag.string.xml.plist"
-												25409-25411: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25411-25417: "entity.name.tag.xml.plist"
-													25411-25417: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25417-25418: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25425-25432: "meta.tag.dict.xml.plist"
-											25425-25427: "punctuation.definition.tag.xml.plist" - Data: "</"
-											25427-25431: "entity.name.tag.xml.plist"
-												25427-25431: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											25431-25432: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25439-25590: ""
-										25439-25445: "meta.tag.dict.xml.plist"
-											25439-25440: "punctuation.definition.tag.xml.plist" - Data: "<"
-											25440-25444: "entity.name.tag.xml.plist"
-												25440-25444: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											25444-25445: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25453-25469: ""
-											25453-25458: "meta.tag.key.xml.plist"
-												25453-25454: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25454-25457: "entity.name.tag.xml.plist"
-													25454-25457: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25457-25458: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25463-25469: "meta.tag.key.xml.plist"
-												25463-25465: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25465-25468: "entity.name.tag.xml.plist"
-													25465-25468: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25468-25469: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25477-25495: ""
-											25477-25485: "meta.tag.string.xml.plist"
-												25477-25478: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25478-25484: "entity.name.tag.xml.plist"
-													25478-25484: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25484-25485: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25486-25495: "meta.tag.string.xml.plist"
-												25486-25488: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25488-25494: "entity.name.tag.xml.plist"
-													25488-25494: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25494-25495: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25503-25518: ""
-											25503-25508: "meta.tag.key.xml.plist"
-												25503-25504: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25504-25507: "entity.name.tag.xml.plist"
-													25504-25507: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25507-25508: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25512-25518: "meta.tag.key.xml.plist"
-												25512-25514: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25514-25517: "entity.name.tag.xml.plist"
-													25514-25517: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25517-25518: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25526-25576: ""
-											25526-25534: "meta.tag.string.xml.plist"
-												25526-25527: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25527-25533: "entity.name.tag.xml.plist"
-													25527-25533: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25533-25534: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25567-25576: "meta.tag.string.xml.plist"
-												25567-25569: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25569-25575: "entity.name.tag.xml.plist"
-													25569-25575: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25575-25576: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25583-25590: "meta.tag.dict.xml.plist"
-											25583-25585: "punctuation.definition.tag.xml.plist" - Data: "</"
-											25585-25589: "entity.name.tag.xml.plist"
-												25585-25589: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											25589-25590: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25597-25765: ""
-										25597-25603: "meta.tag.dict.xml.plist"
-											25597-25598: "punctuation.definition.tag.xml.plist" - Data: "<"
-											25598-25602: "entity.name.tag.xml.plist"
-												25598-25602: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											25602-25603: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25611-25627: ""
-											25611-25616: "meta.tag.key.xml.plist"
-												25611-25612: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25612-25615: "entity.name.tag.xml.plist"
-													25612-25615: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25615-25616: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25621-25627: "meta.tag.key.xml.plist"
-												25621-25623: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25623-25626: "entity.name.tag.xml.plist"
-													25623-25626: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25626-25627: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25635-25660: ""
-											25635-25643: "meta.tag.string.xml.plist"
-												25635-25636: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25636-25642: "entity.name.tag.xml.plist"
-													25636-25642: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25642-25643: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25651-25660: "meta.tag.string.xml.plist"
-												25651-25653: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25653-25659: "entity.name.tag.xml.plist"
-													25653-25659: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25659-25660: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25668-25683: ""
-											25668-25673: "meta.tag.key.xml.plist"
-												25668-25669: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25669-25672: "entity.name.tag.xml.plist"
-													25669-25672: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25672-25673: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25677-25683: "meta.tag.key.xml.plist"
-												25677-25679: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25679-25682: "entity.name.tag.xml.plist"
-													25679-25682: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25682-25683: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25691-25751: ""
-											25691-25699: "meta.tag.string.xml.plist"
-												25691-25692: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25692-25698: "entity.name.tag.xml.plist"
-													25692-25698: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25698-25699: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25742-25751: "meta.tag.string.xml.plist"
-												25742-25744: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25744-25750: "entity.name.tag.xml.plist"
-													25744-25750: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25750-25751: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25758-25765: "meta.tag.dict.xml.plist"
-											25758-25760: "punctuation.definition.tag.xml.plist" - Data: "</"
-											25760-25764: "entity.name.tag.xml.plist"
-												25760-25764: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											25764-25765: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25771-25779: "meta.tag.array.xml.plist"
-										25771-25773: "punctuation.definition.tag.xml.plist" - Data: "</"
-										25773-25778: "entity.name.tag.xml.plist"
-											25773-25778: "entity.name.tag.localname.xml.plist" - Data: "array"
-										25778-25779: "punctuation.definition.tag.xml.plist" - Data: ">"
-								25784-25791: "meta.tag.dict.xml.plist"
-									25784-25786: "punctuation.definition.tag.xml.plist" - Data: "</"
-									25786-25790: "entity.name.tag.xml.plist"
-										25786-25790: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									25790-25791: "punctuation.definition.tag.xml.plist" - Data: ">"
-							25796-27293: ""
-								25796-25802: "meta.tag.dict.xml.plist"
-									25796-25797: "punctuation.definition.tag.xml.plist" - Data: "<"
-									25797-25801: "entity.name.tag.xml.plist"
-										25797-25801: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									25801-25802: "punctuation.definition.tag.xml.plist" - Data: ">"
-								25808-25824: ""
-									25808-25813: "meta.tag.key.xml.plist"
-										25808-25809: "punctuation.definition.tag.xml.plist" - Data: "<"
-										25809-25812: "entity.name.tag.xml.plist"
-											25809-25812: "entity.name.tag.localname.xml.plist" - Data: "key"
-										25812-25813: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25818-25824: "meta.tag.key.xml.plist"
-										25818-25820: "punctuation.definition.tag.xml.plist" - Data: "</"
-										25820-25823: "entity.name.tag.xml.plist"
-											25820-25823: "entity.name.tag.localname.xml.plist" - Data: "key"
-										25823-25824: "punctuation.definition.tag.xml.plist" - Data: ">"
-								25830-25869: ""
-									25830-25838: "meta.tag.string.xml.plist"
-										25830-25831: "punctuation.definition.tag.xml.plist" - Data: "<"
-										25831-25837: "entity.name.tag.xml.plist"
-											25831-25837: "entity.name.tag.localname.xml.plist" - Data: "string"
-										25837-25838: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25860-25869: "meta.tag.string.xml.plist"
-										25860-25862: "punctuation.definition.tag.xml.plist" - Data: "</"
-										25862-25868: "entity.name.tag.xml.plist"
-											25862-25868: "entity.name.tag.localname.xml.plist" - Data: "string"
-										25868-25869: "punctuation.definition.tag.xml.plist" - Data: ">"
-								25875-25894: ""
-									25875-25880: "meta.tag.key.xml.plist"
-										25875-25876: "punctuation.definition.tag.xml.plist" - Data: "<"
-										25876-25879: "entity.name.tag.xml.plist"
-											25876-25879: "entity.name.tag.localname.xml.plist" - Data: "key"
-										25879-25880: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25888-25894: "meta.tag.key.xml.plist"
-										25888-25890: "punctuation.definition.tag.xml.plist" - Data: "</"
-										25890-25893: "entity.name.tag.xml.plist"
-											25890-25893: "entity.name.tag.localname.xml.plist" - Data: "key"
-										25893-25894: "punctuation.definition.tag.xml.plist" - Data: ">"
-								25900-26544: ""
-									25900-25906: "meta.tag.dict.xml.plist"
-										25900-25901: "punctuation.definition.tag.xml.plist" - Data: "<"
-										25901-25905: "entity.name.tag.xml.plist"
-											25901-25905: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										25905-25906: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25913-25925: ""
-										25913-25918: "meta.tag.key.xml.plist"
-											25913-25914: "punctuation.definition.tag.xml.plist" - Data: "<"
-											25914-25917: "entity.name.tag.xml.plist"
-												25914-25917: "entity.name.tag.localname.xml.plist" - Data: "key"
-											25917-25918: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25919-25925: "meta.tag.key.xml.plist"
-											25919-25921: "punctuation.definition.tag.xml.plist" - Data: "</"
-											25921-25924: "entity.name.tag.xml.plist"
-												25921-25924: "entity.name.tag.localname.xml.plist" - Data: "key"
-											25924-25925: "punctuation.definition.tag.xml.plist" - Data: ">"
-									25932-26023: ""
-										25932-25938: "meta.tag.dict.xml.plist"
-											25932-25933: "punctuation.definition.tag.xml.plist" - Data: "<"
-											25933-25937: "entity.name.tag.xml.plist"
-												25933-25937: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											25937-25938: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25946-25961: ""
-											25946-25951: "meta.tag.key.xml.plist"
-												25946-25947: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25947-25950: "entity.name.tag.xml.plist"
-													25947-25950: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25950-25951: "punctuation.definition.tag.xml.plist" - Data: ">"
-											25955-25961: "meta.tag.key.xml.plist"
-												25955-25957: "punctuation.definition.tag.xml.plist" - Data: "</"
-												25957-25960: "entity.name.tag.xml.plist"
-													25957-25960: "entity.name.tag.localname.xml.plist" - Data: "key"
-												25960-25961: "punctuation.definition.tag.xml.plist" - Data: ">"
-										25969-26009: ""
-											25969-25977: "meta.tag.string.xml.plist"
-												25969-25970: "punctuation.definition.tag.xml.plist" - Data: "<"
-												25970-25976: "entity.name.tag.xml.plist"
-													25970-25976: "entity.name.tag.localname.xml.plist" - Data: "string"
-												25976-25977: "punctuation.definition.tag.xml.plist" - Data: ">"
-											26000-26009: "meta.tag.string.xml.plist"
-												26000-26002: "punctuation.definition.tag.xml.plist" - Data: "</"
-												26002-26008: "entity.name.tag.xml.plist"
-													26002-26008: "entity.name.tag.localname.xml.plist" - Data: "string"
-												26008-26009: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26016-26023: "meta.tag.dict.xml.plist"
-											26016-26018: "punctuation.definition.tag.xml.plist" - Data: "</"
-											26018-26022: "entity.name.tag.xml.plist"
-												26018-26022: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											26022-26023: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26030-26042: ""
-										26030-26035: "meta.tag.key.xml.plist"
-											26030-26031: "punctuation.definition.tag.xml.plist" - Data: "<"
-											26031-26034: "entity.name.tag.xml.plist"
-												26031-26034: "entity.name.tag.localname.xml.plist" - Data: "key"
-											26034-26035: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26036-26042: "meta.tag.key.xml.plist"
-											26036-26038: "punctuation.definition.tag.xml.plist" - Data: "</"
-											26038-26041: "entity.name.tag.xml.plist"
-												26038-26041: "entity.name.tag.localname.xml.plist" - Data: "key"
-											26041-26042: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26049-26153: ""
-										26049-26055: "meta.tag.dict.xml.plist"
-											26049-26050: "punctuation.definition.tag.xml.plist" - Data: "<"
-											26050-26054: "entity.name.tag.xml.plist"
-												26050-26054: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											26054-26055: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26063-26078: ""
-											26063-26068: "meta.tag.key.xml.plist"
-												26063-26064: "punctuation.definition.tag.xml.plist" - Data: "<"
-												26064-26067: "entity.name.tag.xml.plist"
-													26064-26067: "entity.name.tag.localname.xml.plist" - Data: "key"
-												26067-26068: "punctuation.definition.tag.xml.plist" - Data: ">"
-											26072-26078: "meta.tag.key.xml.plist"
-												26072-26074: "punctuation.definition.tag.xml.plist" - Data: "</"
-												26074-26077: "entity.name.tag.xml.plist"
-													26074-26077: "entity.name.tag.localname.xml.plist" - Data: "key"
-												26077-26078: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26086-26139: ""
-											26086-26094: "meta.tag.string.xml.plist"
-												26086-26087: "punctuation.definition.tag.xml.plist" - Data: "<"
-												26087-26093: "entity.name.tag.xml.plist"
-													26087-26093: "entity.name.tag.localname.xml.plist" - Data: "string"
-												26093-26094: "punctuation.definition.tag.xml.plist" - Data: ">"
-											26130-26139: "meta.tag.string.xml.plist"
-												26130-26132: "punctuation.definition.tag.xml.plist" - Data: "</"
-												26132-26138: "entity.name.tag.xml.plist"
-													26132-26138: "entity.name.tag.localname.xml.plist" - Data: "string"
-												26138-26139: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26146-26153: "meta.tag.dict.xml.plist"
-											26146-26148: "punctuation.definition.tag.xml.plist" - Data: "</"
-											26148-26152: "entity.name.tag.xml.plist"
-												26148-26152: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											26152-26153: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26160-26172: ""
-										26160-26165: "meta.tag.key.xml.plist"
-											26160-26161: "punctuation.definition.tag.xml.plist" - Data: "<"
-											26161-26164: "entity.name.tag.xml.plist"
-												26161-26164: "entity.name.tag.localname.xml.plist" - Data: "key"
-											26164-26165: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26166-26172: "meta.tag.key.xml.plist"
-											26166-26168: "punctuation.definition.tag.xml.plist" - Data: "</"
-											26168-26171: "entity.name.tag.xml.plist"
-												26168-26171: "entity.name.tag.localname.xml.plist" - Data: "key"
-											26171-26172: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26179-26272: ""
-										26179-26185: "meta.tag.dict.xml.plist"
-											26179-26180: "punctuation.definition.tag.xml.plist" - Data: "<"
-											26180-26184: "entity.name.tag.xml.plist"
-												26180-26184: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											26184-26185: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26193-26208: ""
-											26193-26198: "meta.tag.key.xml.plist"
-												26193-26194: "punctuation.definition.tag.xml.plist" - Data: "<"
-												26194-26197: "entity.name.tag.xml.plist"
-													26194-26197: "entity.name.tag.localname.xml.plist" - Data: "key"
-												26197-26198: "punctuation.definition.tag.xml.plist" - Data: ">"
-											26202-26208: "meta.tag.key.xml.plist"
-												26202-26204: "punctuation.definition.tag.xml.plist" - Data: "</"
-												26204-26207: "entity.name.tag.xml.plist"
-													26204-26207: "entity.name.tag.localname.xml.plist" - Data: "key"
-												26207-26208: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26216-26258: ""
-											26216-26224: "meta.tag.string.xml.plist"
-												26216-26217: "punctuation.definition.tag.xml.plist" - Data: "<"
-												26217-26223: "entity.name.tag.xml.plist"
-													26217-26223: "entity.name.tag.localname.xml.plist" - Data: "string"
-												26223-26224: "punctuation.definition.tag.xml.plist" - Data: ">"
-											26249-26258: "meta.tag.string.xml.plist"
-												26249-26251: "punctuation.definition.tag.xml.plist" - Data: "</"
-												26251-26257: "entity.name.tag.xml.plist"
-													26251-26257: "entity.name.tag.localname.xml.plist" - Data: "string"
-												26257-26258: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26265-26272: "meta.tag.dict.xml.plist"
-											26265-26267: "punctuation.definition.tag.xml.plist" - Data: "</"
-											26267-26271: "entity.name.tag.xml.plist"
-												26267-26271: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											26271-26272: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26279-26291: ""
-										26279-26284: "meta.tag.key.xml.plist"
-											26279-26280: "punctuation.definition.tag.xml.plist" - Data: "<"
-											26280-26283: "entity.name.tag.xml.plist"
-												26280-26283: "entity.name.tag.localname.xml.plist" - Data: "key"
-											26283-26284: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26285-26291: "meta.tag.key.xml.plist"
-											26285-26287: "punctuation.definition.tag.xml.plist" - Data: "</"
-											26287-26290: "entity.name.tag.xml.plist"
-												26287-26290: "entity.name.tag.localname.xml.plist" - Data: "key"
-											26290-26291: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26298-26401: ""
-										26298-26304: "meta.tag.dict.xml.plist"
-											26298-26299: "punctuation.definition.tag.xml.plist" - Data: "<"
-											26299-26303: "entity.name.tag.xml.plist"
-												26299-26303: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											26303-26304: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26312-26327: ""
-											26312-26317: "meta.tag.key.xml.plist"
-												26312-26313: "punctuation.definition.tag.xml.plist" - Data: "<"
-												26313-26316: "entity.name.tag.xml.plist"
-													26313-26316: "entity.name.tag.localname.xml.plist" - Data: "key"
-												26316-26317: "punctuation.definition.tag.xml.plist" - Data: ">"
-											26321-26327: "meta.tag.key.xml.plist"
-												26321-26323: "punctuation.definition.tag.xml.plist" - Data: "</"
-												26323-26326: "entity.name.tag.xml.plist"
-													26323-26326: "entity.name.tag.localname.xml.plist" - Data: "key"
-												26326-26327: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26335-26387: ""
-											26335-26343: "meta.tag.string.xml.plist"
-												26335-26336: "punctuation.definition.tag.xml.plist" - Data: "<"
-												26336-26342: "entity.name.tag.xml.plist"
-													26336-26342: "entity.name.tag.localname.xml.plist" - Data: "string"
-												26342-26343: "punctuation.definition.tag.xml.plist" - Data: ">"
-											26378-26387: "meta.tag.string.xml.plist"
-												26378-26380: "punctuation.definition.tag.xml.plist" - Data: "</"
-												26380-26386: "entity.name.tag.xml.plist"
-													26380-26386: "entity.name.tag.localname.xml.plist" - Data: "string"
-												26386-26387: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26394-26401: "meta.tag.dict.xml.plist"
-											26394-26396: "punctuation.definition.tag.xml.plist" - Data: "</"
-											26396-26400: "entity.name.tag.xml.plist"
-												26396-26400: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											26400-26401: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26408-26420: ""
-										26408-26413: "meta.tag.key.xml.plist"
-											26408-26409: "punctuation.definition.tag.xml.plist" - Data: "<"
-											26409-26412: "entity.name.tag.xml.plist"
-												26409-26412: "entity.name.tag.localname.xml.plist" - Data: "key"
-											26412-26413: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26414-26420: "meta.tag.key.xml.plist"
-											26414-26416: "punctuation.definition.tag.xml.plist" - Data: "</"
-											26416-26419: "entity.name.tag.xml.plist"
-												26416-26419: "entity.name.tag.localname.xml.plist" - Data: "key"
-											26419-26420: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26427-26531: ""
-										26427-26433: "meta.tag.dict.xml.plist"
-											26427-26428: "punctuation.definition.tag.xml.plist" - Data: "<"
-											26428-26432: "entity.name.tag.xml.plist"
-												26428-26432: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											26432-26433: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26441-26456: ""
-											26441-26446: "meta.tag.key.xml.plist"
-												26441-26442: "punctuation.definition.tag.xml.plist" - Data: "<"
-												26442-26445: "entity.name.tag.xml.plist"
-													26442-26445: "entity.name.tag.localname.xml.plist" - Data: "key"
-												26445-26446: "punctuation.definition.tag.xml.plist" - Data: ">"
-											26450-26456: "meta.tag.key.xml.plist"
-												26450-26452: "punctuation.definition.tag.xml.plist" - Data: "</"
-												26452-26455: "entity.name.tag.xml.plist"
-													26452-26455: "entity.name.tag.localname.xml.plist" - Data: "key"
-												26455-26456: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26464-26517: ""
-											26464-26472: "meta.tag.string.xml.plist"
-												26464-26465: "punctuation.definition.tag.xml.plist" - Data: "<"
-												26465-26471: "entity.name.tag.xml.plist"
-													26465-26471: "entity.name.tag.localname.xml.plist" - Data: "string"
-												26471-26472: "punctuation.definition.tag.xml.plist" - Data: ">"
-											26508-26517: "meta.tag.string.xml.plist"
-												26508-26510: "punctuation.definition.tag.xml.plist" - Data: "</"
-												26510-26516: "entity.name.tag.xml.plist"
-													26510-26516: "entity.name.tag.localname.xml.plist" - Data: "string"
-												26516-26517: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26524-26531: "meta.tag.dict.xml.plist"
-											26524-26526: "punctuation.definition.tag.xml.plist" - Data: "</"
-											26526-26530: "entity.name.tag.xml.plist"
-												26526-26530: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											26530-26531: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26537-26544: "meta.tag.dict.xml.plist"
-										26537-26539: "punctuation.definition.tag.xml.plist" - Data: "</"
-										26539-26543: "entity.name.tag.xml.plist"
-											26539-26543: "entity.name.tag.localname.xml.plist" - Data: "dict"
-										26543-26544: "punctuation.definition.tag.xml.plist" - Data: ">"
-								26550-26568: ""
-									26550-26555: "meta.tag.key.xml.plist"
-										26550-26551: "punctuation.definition.tag.xml.plist" - Data: "<"
-										26551-26554: "entity.name.tag.xml.plist"
-											26551-26554: "entity.name.tag.localname.xml.plist" - Data: "key"
-										26554-26555: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26562-26568: "meta.tag.key.xml.plist"
-										26562-26564: "punctuation.definition.tag.xml.plist" - Data: "</"
-										26564-26567: "entity.name.tag.xml.plist"
-											26564-26567: "entity.name.tag.localname.xml.plist" - Data: "key"
-										26567-26568: "punctuation.definition.tag.xml.plist" - Data: ">"
-								26574-26595: ""
-									26574-26582: "meta.tag.string.xml.plist"
-										26574-26575: "punctuation.definition.tag.xml.plist" - Data: "<"
-										26575-26581: "entity.name.tag.xml.plist"
-											26575-26581: "entity.name.tag.localname.xml.plist" - Data: "string"
-										26581-26582: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26586-26595: "meta.tag.string.xml.plist"
-										26586-26588: "punctuation.definition.tag.xml.plist" - Data: "</"
-										26588-26594: "entity.name.tag.xml.plist"
-											26588-26594: "entity.name.tag.localname.xml.plist" - Data: "string"
-										26594-26595: "punctuation.definition.tag.xml.plist" - Data: ">"
-								26601-26615: ""
-									26601-26606: "meta.tag.key.xml.plist"
-										26601-26602: "punctuation.definition.tag.xml.plist" - Data: "<"
-										26602-26605: "entity.name.tag.xml.plist"
-											26602-26605: "entity.name.tag.localname.xml.plist" - Data: "key"
-										26605-26606: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26609-26615: "meta.tag.key.xml.plist"
-										26609-26611: "punctuation.definition.tag.xml.plist" - Data: "</"
-										26611-26614: "entity.name.tag.xml.plist"
-											26611-26614: "entity.name.tag.localname.xml.plist" - Data: "key"
-										26614-26615: "punctuation.definition.tag.xml.plist" - Data: ">"
-								26621-26661: ""
-									26621-26629: "meta.tag.string.xml.plist"
-										26621-26622: "punctuation.definition.tag.xml.plist" - Data: "<"
-										26622-26628: "entity.name.tag.xml.plist"
-											26622-26628: "entity.name.tag.localname.xml.plist" - Data: "string"
-										26628-26629: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26652-26661: "meta.tag.string.xml.plist"
-										26652-26654: "punctuation.definition.tag.xml.plist" - Data: "</"
-										26654-26660: "entity.name.tag.xml.plist"
-											26654-26660: "entity.name.tag.localname.xml.plist" - Data: "string"
-										26660-26661: "punctuation.definition.tag.xml.plist" - Data: ">"
-								26667-26686: ""
-									26667-26672: "meta.tag.key.xml.plist"
-										26667-26668: "punctuation.definition.tag.xml.plist" - Data: "<"
-										26668-26671: "entity.name.tag.xml.plist"
-											26668-26671: "entity.name.tag.localname.xml.plist" - Data: "key"
-										26671-26672: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26680-26686: "meta.tag.key.xml.plist"
-										26680-26682: "punctuation.definition.tag.xml.plist" - Data: "</"
-										26682-26685: "entity.name.tag.xml.plist"
-											26682-26685: "entity.name.tag.localname.xml.plist" - Data: "key"
-										26685-26686: "punctuation.definition.tag.xml.plist" - Data: ">"
-								26692-27281: ""
-									26692-26699: "meta.tag.array.xml.plist"
-										26692-26693: "punctuation.definition.tag.xml.plist" - Data: "<"
-										26693-26698: "entity.name.tag.xml.plist"
-											26693-26698: "entity.name.tag.localname.xml.plist" - Data: "array"
-										26698-26699: "punctuation.definition.tag.xml.plist" - Data: ">"
-									26706-27267: ""
-										26706-26712: "meta.tag.dict.xml.plist"
-											26706-26707: "punctuation.definition.tag.xml.plist" - Data: "<"
-											26707-26711: "entity.name.tag.xml.plist"
-												26707-26711: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											26711-26712: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26720-26736: ""
-											26720-26725: "meta.tag.key.xml.plist"
-												26720-26721: "punctuation.definition.tag.xml.plist" - Data: "<"
-												26721-26724: "entity.name.tag.xml.plist"
-													26721-26724: "entity.name.tag.localname.xml.plist" - Data: "key"
-												26724-26725: "punctuation.definition.tag.xml.plist" - Data: ">"
-											26730-26736: "meta.tag.key.xml.plist"
-												26730-26732: "punctuation.definition.tag.xml.plist" - Data: "</"
-												26732-26735: "entity.name.tag.xml.plist"
-													26732-26735: "entity.name.tag.localname.xml.plist" - Data: "key"
-												26735-26736: "punctuation.definition.tag.xml.plist" - Data: ">"
-										26744-27176: ""
-											26744-26752: "meta.tag.string.xml.plist"
-												26744-26745: "punctuation.definition.tag.xml.plist" - Data: "<"
-												26745-26751: "entity.name.tag.xml.plist"
-													26745-26751: "entity.name.tag.localname.xml.plist" - Data: "string"
-												26751-26752: "punctuation.definition.tag.xml.plist" - Data: ">"
-											27167-27176: "meta.tag.string.xml.plist"
-												27167-27169: "punctuation.definition.tag.xml.plist" - Data: "</"
-												27169-27175: "entity.name.tag.xml.plist"
-													27169-27175: "entity.name.tag.localname.xml.plist" - Data: "string"
-												27175-27176: "punctuation.definition.tag.xml.plist" - Data: ">"
-										27184-27199: ""
-											27184-27189: "meta.tag.key.xml.plist"
-												27184-27185: "punctuation.definition.tag.xml.plist" - Data: "<"
-												27185-27188: "entity.name.tag.xml.plist"
-													27185-27188: "entity.name.tag.localname.xml.plist" - Data: "key"
-												27188-27189: "punctuation.definition.tag.xml.plist" - Data: ">"
-											27193-27199: "meta.tag.key.xml.plist"
-												27193-27195: "punctuation.definition.tag.xml.plist" - Data: "</"
-												27195-27198: "entity.name.tag.xml.plist"
-													27195-27198: "entity.name.tag.localname.xml.plist" - Data: "key"
-												27198-27199: "punctuation.definition.tag.xml.plist" - Data: ">"
-										27207-27253: ""
-											27207-27215: "meta.tag.string.xml.plist"
-												27207-27208: "punctuation.definition.tag.xml.plist" - Data: "<"
-												27208-27214: "entity.name.tag.xml.plist"
-													27208-27214: "entity.name.tag.localname.xml.plist" - Data: "string"
-												27214-27215: "punctuation.definition.tag.xml.plist" - Data: ">"
-											27244-27253: "meta.tag.string.xml.plist"
-												27244-27246: "punctuation.definition.tag.xml.plist" - Data: "</"
-												27246-27252: "entity.name.tag.xml.plist"
-													27246-27252: "entity.name.tag.localname.xml.plist" - Data: "string"
-												27252-27253: "punctuation.definition.tag.xml.plist" - Data: ">"
-										27260-27267: "meta.tag.dict.xml.plist"
-											27260-27262: "punctuation.definition.tag.xml.plist" - Data: "</"
-											27262-27266: "entity.name.tag.xml.plist"
-												27262-27266: "entity.name.tag.localname.xml.plist" - Data: "dict"
-											27266-27267: "punctuation.definition.tag.xml.plist" - Data: ">"
-									27273-27281: "meta.tag.array.xml.plist"
-										27273-27275: "punctuation.definition.tag.xml.plist" - Data: "</"
-										27275-27280: "entity.name.tag.xml.plist"
-											27275-27280: "entity.name.tag.localname.xml.plist" - Data: "array"
-										27280-27281: "punctuation.definition.tag.xml.plist" - Data: ">"
-								27286-27293: "meta.tag.dict.xml.plist"
-									27286-27288: "punctuation.definition.tag.xml.plist" - Data: "</"
-									27288-27292: "entity.name.tag.xml.plist"
-										27288-27292: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									27292-27293: "punctuation.definition.tag.xml.plist" - Data: ">"
-							27298-27375: ""
-								27298-27304: "meta.tag.dict.xml.plist"
-									27298-27299: "punctuation.definition.tag.xml.plist" - Data: "<"
-									27299-27303: "entity.name.tag.xml.plist"
-										27299-27303: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									27303-27304: "punctuation.definition.tag.xml.plist" - Data: ">"
-								27310-27328: ""
-									27310-27315: "meta.tag.key.xml.plist"
-										27310-27311: "punctuation.definition.tag.xml.plist" - Data: "<"
-										27311-27314: "entity.name.tag.xml.plist"
-											27311-27314: "entity.name.tag.localname.xml.plist" - Data: "key"
-										27314-27315: "punctuation.definition.tag.xml.plist" - Data: ">"
-									27322-27328: "meta.tag.key.xml.plist"
-										27322-27324: "punctuation.definition.tag.xml.plist" - Data: "</"
-										27324-27327: "entity.name.tag.xml.plist"
-											27324-27327: "entity.name.tag.localname.xml.plist" - Data: "key"
-										27327-27328: "punctuation.definition.tag.xml.plist" - Data: ">"
-								27334-27363: ""
-									27334-27342: "meta.tag.string.xml.plist"
-										27334-27335: "punctuation.definition.tag.xml.plist" - Data: "<"
-										27335-27341: "entity.name.tag.xml.plist"
-											27335-27341: "entity.name.tag.localname.xml.plist" - Data: "string"
-										27341-27342: "punctuation.definition.tag.xml.plist" - Data: ">"
-									27354-27363: "meta.tag.string.xml.plist"
-										27354-27356: "punctuation.definition.tag.xml.plist" - Data: "</"
-										27356-27362: "entity.name.tag.xml.plist"
-											27356-27362: "entity.name.tag.localname.xml.plist" - Data: "string"
-										27362-27363: "punctuation.definition.tag.xml.plist" - Data: ">"
-								27368-27375: "meta.tag.dict.xml.plist"
-									27368-27370: "punctuation.definition.tag.xml.plist" - Data: "</"
-									27370-27374: "entity.name.tag.xml.plist"
-										27370-27374: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									27374-27375: "punctuation.definition.tag.xml.plist" - Data: ">"
-							27380-27457: ""
-								27380-27386: "meta.tag.dict.xml.plist"
-									27380-27381: "punctuation.definition.tag.xml.plist" - Data: "<"
-									27381-27385: "entity.name.tag.xml.plist"
-										27381-27385: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									27385-27386: "punctuation.definition.tag.xml.plist" - Data: ">"
-								27392-27410: ""
-									27392-27397: "meta.tag.key.xml.plist"
-										27392-27393: "punctuation.definition.tag.xml.plist" - Data: "<"
-										27393-27396: "entity.name.tag.xml.plist"
-											27393-27396: "entity.name.tag.localname.xml.plist" - Data: "key"
-										27396-27397: "punctuation.definition.tag.xml.plist" - Data: ">"
-									27404-27410: "meta.tag.key.xml.plist"
-										27404-27406: "punctuation.definition.tag.xml.plist" - Data: "</"
-										27406-27409: "entity.name.tag.xml.plist"
-											27406-27409: "entity.name.tag.localname.xml.plist" - Data: "key"
-										27409-27410: "punctuation.definition.tag.xml.plist" - Data: ">"
-								27416-27445: ""
-									27416-27424: "meta.tag.string.xml.plist"
-										27416-27417: "punctuation.definition.tag.xml.plist" - Data: "<"
-										27417-27423: "entity.name.tag.xml.plist"
-											27417-27423: "entity.name.tag.localname.xml.plist" - Data: "string"
-										27423-27424: "punctuation.definition.tag.xml.plist" - Data: ">"
-									27436-27445: "meta.tag.string.xml.plist"
-										27436-27438: "punctuation.definition.tag.xml.plist" - Data: "</"
-										27438-27444: "entity.name.tag.xml.plist"
-											27438-27444: "entity.name.tag.localname.xml.plist" - Data: "string"
-										27444-27445: "punctuation.definition.tag.xml.plist" - Data: ">"
-								27450-27457: "meta.tag.dict.xml.plist"
-									27450-27452: "punctuation.definition.tag.xml.plist" - Data: "</"
-									27452-27456: "entity.name.tag.xml.plist"
-										27452-27456: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									27456-27457: "punctuation.definition.tag.xml.plist" - Data: ">"
-							27462-27542: ""
-								27462-27468: "meta.tag.dict.xml.plist"
-									27462-27463: "punctuation.definition.tag.xml.plist" - Data: "<"
-									27463-27467: "entity.name.tag.xml.plist"
-										27463-27467: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									27467-27468: "punctuation.definition.tag.xml.plist" - Data: ">"
-								27474-27492: ""
-									27474-27479: "meta.tag.key.xml.plist"
-										27474-27475: "punctuation.definition.tag.xml.plist" - Data: "<"
-										27475-27478: "entity.name.tag.xml.plist"
-											27475-27478: "entity.name.tag.localname.xml.plist" - Data: "key"
-										27478-27479: "punctuation.definition.tag.xml.plist" - Data: ">"
-									27486-27492: "meta.tag.key.xml.plist"
-										27486-27488: "punctuation.definition.tag.xml.plist" - Data: "</"
-										27488-27491: "entity.name.tag.xml.plist"
-											27488-27491: "entity.name.tag.localname.xml.plist" - Data: "key"
-										27491-27492: "punctuation.definition.tag.xml.plist" - Data: ">"
-								27498-27530: ""
-									27498-27506: "meta.tag.string.xml.plist"
-										27498-27499: "punctuation.definition.tag.xml.plist" - Data: "<"
-										27499-27505: "entity.name.tag.xml.plist"
-											27499-27505: "entity.name.tag.localname.xml.plist" - Data: "string"
-										27505-27506: "punctuation.definition.tag.xml.plist" - Data: ">"
-									27521-27530: "meta.tag.string.xml.plist"
-										27521-27523: "punctuation.definition.tag.xml.plist" - Data: "</"
-										27523-27529: "entity.name.tag.xml.plist"
-											27523-27529: "entity.name.tag.localname.xml.plist" - Data: "string"
-										27529-27530: "punctuation.definition.tag.xml.plist" - Data: ">"
-								27535-27542: "meta.tag.dict.xml.plist"
-									27535-27537: "punctuation.definition.tag.xml.plist" - Data: "</"
-									27537-27541: "entity.name.tag.xml.plist"
-										27537-27541: "entity.name.tag.localname.xml.plist" - Data: "dict"
-									27541-27542: "punctuation.definition.tag.xml.plist" - Data: ">"
-							27546-27554: "meta.tag.array.xml.plist"
-								27546-27548: "punctuation.definition.tag.xml.plist" - Data: "</"
-								27548-27553: "entity.name.tag.xml.plist"
-									27548-27553: "entity.name.tag.localname.xml.plist" - Data: "array"
-								27553-27554: "punctuation.definition.tag.xml.plist" - Data: ">"
-						27557-27564: "meta.tag.dict.xml.plist"
-							27557-27559: "punctuation.definition.tag.xml.plist" - Data: "</"
-							27559-27563: "entity.name.tag.xml.plist"
-								27559-27563: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							27563-27564: "punctuation.definition.tag.xml.plist" - Data: ">"
-					27566-27573: "meta.tag.dict.xml.plist"
-						27566-27568: "punctuation.definition.tag.xml.plist" - Data: "</"
-						27568-27572: "entity.name.tag.xml.plist"
-							27568-27572: "entity.name.tag.localname.xml.plist" - Data: "dict"
-						27572-27573: "punctuation.definition.tag.xml.plist" - Data: ">"
-				27575-27595: ""
-					27575-27580: "meta.tag.key.xml.plist"
-						27575-27576: "punctuation.definition.tag.xml.plist" - Data: "<"
-						27576-27579: "entity.name.tag.xml.plist"
-							27576-27579: "entity.name.tag.localname.xml.plist" - Data: "key"
-						27579-27580: "punctuation.definition.tag.xml.plist" - Data: ">"
-					27589-27595: "meta.tag.key.xml.plist"
-						27589-27591: "punctuation.definition.tag.xml.plist" - Data: "</"
-						27591-27594: "entity.name.tag.xml.plist"
-							27591-27594: "entity.name.tag.localname.xml.plist" - Data: "key"
-						27594-27595: "punctuation.definition.tag.xml.plist" - Data: ">"
-				27597-27628: ""
-					27597-27605: "meta.tag.string.xml.plist"
-						27597-27598: "punctuation.definition.tag.xml.plist" - Data: "<"
-						27598-27604: "entity.name.tag.xml.plist"
-							27598-27604: "entity.name.tag.localname.xml.plist" - Data: "string"
-						27604-27605: "punctuation.definition.tag.xml.plist" - Data: ">"
-					27619-27628: "meta.tag.string.xml.plist"
-						27619-27621: "punctuation.definition.tag.xml.plist" - Data: "</"
-						27621-27627: "entity.name.tag.xml.plist"
-							27621-27627: "entity.name.tag.localname.xml.plist" - Data: "string"
-						27627-27628: "punctuation.definition.tag.xml.plist" - Data: ">"
-				27630-27645: ""
-					27630-27635: "meta.tag.key.xml.plist"
-						27630-27631: "punctuation.definition.tag.xml.plist" - Data: "<"
-						27631-27634: "entity.name.tag.xml.plist"
-							27631-27634: "entity.name.tag.localname.xml.plist" - Data: "key"
-						27634-27635: "punctuation.definition.tag.xml.plist" - Data: ">"
-					27639-27645: "meta.tag.key.xml.plist"
-						27639-27641: "punctuation.definition.tag.xml.plist" - Data: "</"
-						27641-27644: "entity.name.tag.xml.plist"
-							27641-27644: "entity.name.tag.localname.xml.plist" - Data: "key"
-						27644-27645: "punctuation.definition.tag.xml.plist" - Data: ">"
-				27647-27700: ""
-					27647-27655: "meta.tag.string.xml.plist"
-						27647-27648: "punctuation.definition.tag.xml.plist" - Data: "<"
-						27648-27654: "entity.name.tag.xml.plist"
-							27648-27654: "entity.name.tag.localname.xml.plist" - Data: "string"
-						27654-27655: "punctuation.definition.tag.xml.plist" - Data: ">"
-					27691-27700: "meta.tag.string.xml.plist"
-						27691-27693: "punctuation.definition.tag.xml.plist" - Data: "</"
-						27693-27699: "entity.name.tag.xml.plist"
-							27693-27699: "entity.name.tag.localname.xml.plist" - Data: "string"
-						27699-27700: "punctuation.definition.tag.xml.plist" - Data: ">"
-				27701-27708: "meta.tag.dict.xml.plist"
-					27701-27703: "punctuation.definition.tag.xml.plist" - Data: "</"
-					27703-27707: "entity.name.tag.xml.plist"
-						27703-27707: "entity.name.tag.localname.xml.plist" - Data: "dict"
-					27707-27708: "punctuation.definition.tag.xml.plist" - Data: ">"
-			27709-27710: "meta.tag.plist.xml.plist"
-				27709-27710: "punctuation.definition.tag.xml.plist" - Data: "<"
-		27710-27717: "meta.tag.plist.xml.plist"
-			27710-27711: "punctuation.definition.tag.xml.plist" - Data: "/"
-			27711-27716: "entity.name.tag.xml.plist"
-				27711-27716: "entity.name.tag.localname.xml.plist" - Data: "plist"
-			27716-27717: "punctuation.definition.tag.xml.plist" - Data: ">"
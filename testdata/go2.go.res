@@ -1,15 +0,0 @@
-0-71: "source.go"
-	0-8: ""
-		0-8: "comment.line.double-slash.go"
-			0-2: "punctuation.definition.comment.go" - Data: "//"
-	9-16: "keyword.control.go" - Data: "package"
-	23-29: "keyword.control.go" - Data: "import"
-	30-31: "meta.parens.go" - Data: "("
-	33-59: "string.quoted.double.go"
-		33-34: "punctuation.definition.string.begin.go" - Data: """
-		58-59: "punctuation.definition.string.end.go" - Data: """
-	61-62: ""
-		61-62: "punctuation.whitespace.comment.leading.go" - Data: "	"
-	62-71: ""
-		62-71: "comment.line.double-slash.go"
-			62-64: "punctuation.definition.comment.go" - Data: "//"
@@ -0,0 +1,23 @@
+<svg xmlns="http://www.w3.org/2000/svg" width="294" height="215.6" viewBox="0 0 294 215.6">
+<rect width="100%" height="100%" fill="#272822"/>
+<rect width="25.2" height="100%" fill="#49483E"/>
+<g font-family="monospace" font-size="14" xml:space="preserve">
+<text x="8.4" y="34.3" fill="#75715E">1</text>
+<text x="33.6" y="34.3" textLength="58.8" lengthAdjust="spacingAndGlyphs" fill="#75715E">// test</text>
+<text x="8.4" y="53.9" fill="#75715E">2</text>
+<text x="8.4" y="73.5" fill="#75715E">3</text>
+<text x="33.6" y="73.5" textLength="58.8" lengthAdjust="spacingAndGlyphs" fill="#F92672">package</text>
+<text x="92.4" y="73.5" textLength="42" lengthAdjust="spacingAndGlyphs" fill="#F8F8F2"> main</text>
+<text x="8.4" y="93.1" fill="#75715E">4</text>
+<text x="8.4" y="112.7" fill="#75715E">5</text>
+<text x="33.6" y="112.7" textLength="50.4" lengthAdjust="spacingAndGlyphs" fill="#F92672">import</text>
+<text x="84" y="112.7" textLength="16.8" lengthAdjust="spacingAndGlyphs" fill="#F8F8F2"> (</text>
+<text x="8.4" y="132.3" fill="#75715E">6</text>
+<text x="67.2" y="132.3" textLength="218.4" lengthAdjust="spacingAndGlyphs" fill="#E6DB74">&#34;code.google.com/p/log4go&#34;</text>
+<text x="8.4" y="151.9" fill="#75715E">7</text>
+<text x="8.4" y="171.5" fill="#75715E">8</text>
+<text x="67.2" y="171.5" textLength="75.6" lengthAdjust="spacingAndGlyphs" fill="#75715E">//  &#34;fmt&#34;</text>
+<text x="8.4" y="191.1" fill="#75715E">9</text>
+<text x="33.6" y="191.1" textLength="8.4" lengthAdjust="spacingAndGlyphs" fill="#F8F8F2">)</text>
+</g>
+</svg>
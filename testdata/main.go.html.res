@@ -0,0 +1,442 @@
+<pre class="tm-code"><code><span class="tm-line"><span class="tm-ln">  1</span><span class="tm-fg-f92672">package</span> main</span>
+<span class="tm-line"><span class="tm-ln">  2</span></span>
+<span class="tm-line"><span class="tm-ln">  3</span><span class="tm-fg-f92672">import</span> (</span>
+<span class="tm-line"><span class="tm-ln">  4</span>	<span class="tm-fg-e6db74">&#34;code.google.com/p/log4go&#34;</span></span>
+<span class="tm-line"><span class="tm-ln">  5</span>	<span class="tm-fg-e6db74">&#34;fmt&#34;</span></span>
+<span class="tm-line"><span class="tm-ln">  6</span>	<span class="tm-fg-e6db74">&#34;io/ioutil&#34;</span></span>
+<span class="tm-line"><span class="tm-ln">  7</span>	<span class="tm-fg-e6db74">&#34;lime/3rdparty/libs/termbox-go&#34;</span></span>
+<span class="tm-line"><span class="tm-ln">  8</span>	<span class="tm-fg-e6db74">&#34;lime/backend&#34;</span></span>
+<span class="tm-line"><span class="tm-ln">  9</span>	<span class="tm-fg-e6db74">&#34;lime/backend/loaders&#34;</span></span>
+<span class="tm-line"><span class="tm-ln"> 10</span>	<span class="tm-fg-e6db74">&#34;lime/backend/primitives&#34;</span></span>
+<span class="tm-line"><span class="tm-ln"> 11</span>	<span class="tm-fg-e6db74">&#34;lime/backend/sublime&#34;</span></span>
+<span class="tm-line"><span class="tm-ln"> 12</span>	<span class="tm-fg-e6db74">&#34;lime/backend/textmate&#34;</span></span>
+<span class="tm-line"><span class="tm-ln"> 13</span>	<span class="tm-fg-e6db74">&#34;strings&#34;</span></span>
+<span class="tm-line"><span class="tm-ln"> 14</span>	<span class="tm-fg-e6db74">&#34;time&#34;</span></span>
+<span class="tm-line"><span class="tm-ln"> 15</span>)</span>
+<span class="tm-line"><span class="tm-ln"> 16</span></span>
+<span class="tm-line"><span class="tm-ln"> 17</span><span class="tm-fg-f92672">var</span> (</span>
+<span class="tm-line"><span class="tm-ln"> 18</span>	lut = <span class="tm-fg-f92672">map</span>[termbox.Key]backend.KeyPress{</span>
+<span class="tm-line"><span class="tm-ln"> 19</span>		termbox.KeyCtrlA:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;a&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 20</span>		termbox.KeyCtrlB:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;b&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 21</span>		termbox.KeyCtrlC:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;c&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 22</span>		termbox.KeyCtrlD:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;d&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 23</span>		termbox.KeyCtrlE:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;e&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 24</span>		termbox.KeyCtrlF:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;f&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 25</span>		termbox.KeyCtrlG:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;g&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 26</span>		termbox.KeyCtrlH:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;h&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 27</span>		termbox.KeyCtrlJ:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;j&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 28</span>		termbox.KeyCtrlK:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;k&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 29</span>		termbox.KeyCtrlL:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;l&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 30</span>		termbox.KeyCtrlN:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;n&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 31</span>		termbox.KeyCtrlO:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;o&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 32</span>		termbox.KeyCtrlP:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;p&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 33</span>		termbox.KeyCtrlQ:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;q&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 34</span>		termbox.KeyCtrlR:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;r&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 35</span>		termbox.KeyCtrlS:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;s&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 36</span>		termbox.KeyCtrlT:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;t&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 37</span>		termbox.KeyCtrlU:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;u&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 38</span>		termbox.KeyCtrlV:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;v&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 39</span>		termbox.KeyCtrlW:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;w&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 40</span>		termbox.KeyCtrlX:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;x&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 41</span>		termbox.KeyCtrlY:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;y&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 42</span>		termbox.KeyCtrlZ:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;z&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 43</span>		termbox.KeyCtrl2:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;2&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 44</span>		termbox.KeyCtrl4:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;4&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 45</span>		termbox.KeyCtrl5:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;5&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 46</span>		termbox.KeyCtrl6:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;6&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 47</span>		termbox.KeyCtrl7:      backend.KeyPress{Ctrl: <span class="tm-fg-ae81ff">true</span>, Key: <span class="tm-fg-e6db74">&#39;7&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 48</span>		termbox.KeyEnter:      backend.KeyPress{Key: backend.Enter},</span>
+<span class="tm-line"><span class="tm-ln"> 49</span>		termbox.KeySpace:      backend.KeyPress{Key: <span class="tm-fg-e6db74">&#39; &#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 50</span>		termbox.KeyBackspace2: backend.KeyPress{Key: backend.Backspace},</span>
+<span class="tm-line"><span class="tm-ln"> 51</span>		termbox.KeyArrowUp:    backend.KeyPress{Key: backend.Up},</span>
+<span class="tm-line"><span class="tm-ln"> 52</span>		termbox.KeyArrowDown:  backend.KeyPress{Key: backend.Down},</span>
+<span class="tm-line"><span class="tm-ln"> 53</span>		termbox.KeyArrowLeft:  backend.KeyPress{Key: backend.Left},</span>
+<span class="tm-line"><span class="tm-ln"> 54</span>		termbox.KeyArrowRight: backend.KeyPress{Key: backend.Right},</span>
+<span class="tm-line"><span class="tm-ln"> 55</span>		termbox.KeyDelete:     backend.KeyPress{Key: backend.Delete},</span>
+<span class="tm-line"><span class="tm-ln"> 56</span>		termbox.KeyEsc:        backend.KeyPress{Key: backend.Escape},</span>
+<span class="tm-line"><span class="tm-ln"> 57</span>		termbox.KeyTab:        backend.KeyPress{Key: <span class="tm-fg-e6db74">&#39;</span><span class="tm-fg-ae81ff">\t</span><span class="tm-fg-e6db74">&#39;</span>},</span>
+<span class="tm-line"><span class="tm-ln"> 58</span>	}</span>
+<span class="tm-line"><span class="tm-ln"> 59</span>	schemelut = <span class="tm-fg-66d9ef">make</span>(<span class="tm-fg-f92672">map</span>[<span class="tm-fg-66d9ef tm-i">string</span>][<span class="tm-fg-ae81ff">2</span>]termbox.Attribute)</span>
+<span class="tm-line"><span class="tm-ln"> 60</span>	defaultBg = termbox.ColorBlack</span>
+<span class="tm-line"><span class="tm-ln"> 61</span>	defaultFg = termbox.ColorWhite</span>
+<span class="tm-line"><span class="tm-ln"> 62</span>	blink     <span class="tm-fg-66d9ef tm-i">bool</span></span>
+<span class="tm-line"><span class="tm-ln"> 63</span>)</span>
+<span class="tm-line"><span class="tm-ln"> 64</span></span>
+<span class="tm-line"><span class="tm-ln"> 65</span><span class="tm-fg-f92672">const</span> console_height = <span class="tm-fg-ae81ff">20</span></span>
+<span class="tm-line"><span class="tm-ln"> 66</span></span>
+<span class="tm-line"><span class="tm-ln"> 67</span><span class="tm-fg-f92672">type</span> tbfe <span class="tm-fg-f92672">struct</span> {</span>
+<span class="tm-line"><span class="tm-ln"> 68</span>	visibleregion  <span class="tm-fg-f92672">map</span>[*backend.View]primitives.Region</span>
+<span class="tm-line"><span class="tm-ln"> 69</span>	status_message <span class="tm-fg-66d9ef tm-i">string</span></span>
+<span class="tm-line"><span class="tm-ln"> 70</span>	active_window  *backend.Window</span>
+<span class="tm-line"><span class="tm-ln"> 71</span>}</span>
+<span class="tm-line"><span class="tm-ln"> 72</span></span>
+<span class="tm-line"><span class="tm-ln"> 73</span><span class="tm-fg-f92672">func</span> <span class="tm-fg-a6e22e">(t *tbfe) ActiveWindow</span>() *backend.Window {</span>
+<span class="tm-line"><span class="tm-ln"> 74</span>	<span class="tm-fg-f92672">return</span> t.active_window</span>
+<span class="tm-line"><span class="tm-ln"> 75</span>}</span>
+<span class="tm-line"><span class="tm-ln"> 76</span></span>
+<span class="tm-line"><span class="tm-ln"> 77</span><span class="tm-fg-f92672">func</span> <span class="tm-fg-a6e22e">(t *tbfe) ActiveView</span>(w *backend.Window) *backend.View {</span>
+<span class="tm-line"><span class="tm-ln"> 78</span>	<span class="tm-fg-f92672">if</span> w == <span class="tm-fg-ae81ff">nil</span> {</span>
+<span class="tm-line"><span class="tm-ln"> 79</span>		<span class="tm-fg-f92672">return</span> <span class="tm-fg-ae81ff">nil</span></span>
+<span class="tm-line"><span class="tm-ln"> 80</span>	}</span>
+<span class="tm-line"><span class="tm-ln"> 81</span>	<span class="tm-fg-f92672">if</span> v <span class="tm-fg-f92672">:=</span> w.<span class="tm-fg-66d9ef">Views</span>(); <span class="tm-fg-66d9ef">len</span>(v) &gt; <span class="tm-fg-ae81ff">0</span> {</span>
+<span class="tm-line"><span class="tm-ln"> 82</span>		<span class="tm-fg-f92672">return</span> v[<span class="tm-fg-ae81ff">0</span>]</span>
+<span class="tm-line"><span class="tm-ln"> 83</span>	}</span>
+<span class="tm-line"><span class="tm-ln"> 84</span>	<span class="tm-fg-f92672">return</span> <span class="tm-fg-ae81ff">nil</span></span>
+<span class="tm-line"><span class="tm-ln"> 85</span>}</span>
+<span class="tm-line"><span class="tm-ln"> 86</span></span>
+<span class="tm-line"><span class="tm-ln"> 87</span><span class="tm-fg-f92672">func</span> <span class="tm-fg-a6e22e">(t *tbfe) renderView</span>(sx, sy, w, h int, v *backend.View) {</span>
+<span class="tm-line"><span class="tm-ln"> 88</span>	sel <span class="tm-fg-f92672">:=</span> v.<span class="tm-fg-66d9ef">Sel</span>()</span>
+<span class="tm-line"><span class="tm-ln"> 89</span>	substr <span class="tm-fg-f92672">:=</span> v.<span class="tm-fg-66d9ef">Buffer</span>().<span class="tm-fg-66d9ef">Data</span>()</span>
+<span class="tm-line"><span class="tm-ln"> 90</span>	vr <span class="tm-fg-f92672">:=</span> t.<span class="tm-fg-66d9ef">VisibleRegion</span>(v)</span>
+<span class="tm-line"><span class="tm-ln"> 91</span>	lines <span class="tm-fg-f92672">:=</span> strings.<span class="tm-fg-66d9ef">Split</span>(substr, <span class="tm-fg-e6db74">&#34;</span><span class="tm-fg-ae81ff">\n</span><span class="tm-fg-e6db74">&#34;</span>)</span>
+<span class="tm-line"><span class="tm-ln"> 92</span>	s, _ <span class="tm-fg-f92672">:=</span> v.<span class="tm-fg-66d9ef">Buffer</span>().<span class="tm-fg-66d9ef">RowCol</span>(vr.<span class="tm-fg-66d9ef">Begin</span>())</span>
+<span class="tm-line"><span class="tm-ln"> 93</span>	e, _ <span class="tm-fg-f92672">:=</span> v.<span class="tm-fg-66d9ef">Buffer</span>().<span class="tm-fg-66d9ef">RowCol</span>(vr.<span class="tm-fg-66d9ef">End</span>())</span>
+<span class="tm-line"><span class="tm-ln"> 94</span>	<span class="tm-fg-f92672">if</span> e &gt; <span class="tm-fg-ae81ff">1</span> {</span>
+<span class="tm-line"><span class="tm-ln"> 95</span>		e = e - <span class="tm-fg-ae81ff">1</span></span>
+<span class="tm-line"><span class="tm-ln"> 96</span>		<span class="tm-fg-f92672">if</span> e &gt; h {</span>
+<span class="tm-line"><span class="tm-ln"> 97</span>			s = e - h</span>
+<span class="tm-line"><span class="tm-ln"> 98</span>		}</span>
+<span class="tm-line"><span class="tm-ln"> 99</span>	}</span>
+<span class="tm-line"><span class="tm-ln">100</span>	off <span class="tm-fg-f92672">:=</span> <span class="tm-fg-66d9ef">len</span>(strings.<span class="tm-fg-66d9ef">Join</span>(lines[:s], <span class="tm-fg-e6db74">&#34;</span><span class="tm-fg-ae81ff">\n</span><span class="tm-fg-e6db74">&#34;</span>))</span>
+<span class="tm-line"><span class="tm-ln">101</span>	lines = lines[s:e]</span>
+<span class="tm-line"><span class="tm-ln">102</span>	runes <span class="tm-fg-f92672">:=</span> []<span class="tm-fg-66d9ef">rune</span>(strings.<span class="tm-fg-66d9ef">Join</span>(lines, <span class="tm-fg-e6db74">&#34;</span><span class="tm-fg-ae81ff">\n</span><span class="tm-fg-e6db74">&#34;</span>))</span>
+<span class="tm-line"><span class="tm-ln">103</span>	x, y <span class="tm-fg-f92672">:=</span> sx, sy</span>
+<span class="tm-line"><span class="tm-ln">104</span>	ex, ey <span class="tm-fg-f92672">:=</span> sx+w, sy+h</span>
+<span class="tm-line"><span class="tm-ln">105</span></span>
+<span class="tm-line"><span class="tm-ln">106</span>	sub2 <span class="tm-fg-f92672">:=</span> <span class="tm-fg-e6db74">&#34;&#34;</span></span>
+<span class="tm-line"><span class="tm-ln">107</span>	<span class="tm-fg-66d9ef">var</span> (</span>
+<span class="tm-line"><span class="tm-ln">108</span>		lastScope <span class="tm-fg-66d9ef tm-i">string</span></span>
+<span class="tm-line"><span class="tm-ln">109</span>		lfg, lbg  = defaultFg, defaultBg</span>
+<span class="tm-line"><span class="tm-ln">110</span>	)</span>
+<span class="tm-line"><span class="tm-ln">111</span></span>
+<span class="tm-line"><span class="tm-ln">112</span>	tab_size, ok <span class="tm-fg-f92672">:=</span> v.<span class="tm-fg-66d9ef">Settings</span>().<span class="tm-fg-66d9ef">Get</span>(<span class="tm-fg-e6db74">&#34;tab_size&#34;</span>, <span class="tm-fg-ae81ff">4</span>).(<span class="tm-fg-66d9ef tm-i">int</span>)</span>
+<span class="tm-line"><span class="tm-ln">113</span>	<span class="tm-fg-f92672">if</span> !ok {</span>
+<span class="tm-line"><span class="tm-ln">114</span>		tab_size = <span class="tm-fg-ae81ff">4</span></span>
+<span class="tm-line"><span class="tm-ln">115</span>	}</span>
+<span class="tm-line"><span class="tm-ln">116</span>	caret_style <span class="tm-fg-f92672">:=</span> termbox.AttrUnderline</span>
+<span class="tm-line"><span class="tm-ln">117</span>	<span class="tm-fg-f92672">if</span> b, ok <span class="tm-fg-f92672">:=</span> v.<span class="tm-fg-66d9ef">Settings</span>().<span class="tm-fg-66d9ef">Get</span>(<span class="tm-fg-e6db74">&#34;caret_style&#34;</span>, <span class="tm-fg-e6db74">&#34;underline&#34;</span>).(<span class="tm-fg-66d9ef tm-i">string</span>); ok {</span>
+<span class="tm-line"><span class="tm-ln">118</span>		<span class="tm-fg-f92672">if</span> b == <span class="tm-fg-e6db74">&#34;block&#34;</span> {</span>
+<span class="tm-line"><span class="tm-ln">119</span>			caret_style = termbox.AttrReverse</span>
+<span class="tm-line"><span class="tm-ln">120</span>		}</span>
+<span class="tm-line"><span class="tm-ln">121</span>	}</span>
+<span class="tm-line"><span class="tm-ln">122</span>	<span class="tm-fg-f92672">if</span> b, ok <span class="tm-fg-f92672">:=</span> v.<span class="tm-fg-66d9ef">Settings</span>().<span class="tm-fg-66d9ef">Get</span>(<span class="tm-fg-e6db74">&#34;inverse_caret_state&#34;</span>, <span class="tm-fg-ae81ff">false</span>).(<span class="tm-fg-66d9ef tm-i">bool</span>); !b &amp;&amp; ok {</span>
+<span class="tm-line"><span class="tm-ln">123</span>		<span class="tm-fg-f92672">if</span> caret_style == termbox.AttrReverse {</span>
+<span class="tm-line"><span class="tm-ln">124</span>			caret_style = termbox.AttrUnderline</span>
+<span class="tm-line"><span class="tm-ln">125</span>		} <span class="tm-fg-f92672">else</span> {</span>
+<span class="tm-line"><span class="tm-ln">126</span>			caret_style = termbox.AttrReverse</span>
+<span class="tm-line"><span class="tm-ln">127</span>		}</span>
+<span class="tm-line"><span class="tm-ln">128</span>	}</span>
+<span class="tm-line"><span class="tm-ln">129</span>	caret_blink <span class="tm-fg-f92672">:=</span> <span class="tm-fg-ae81ff">true</span></span>
+<span class="tm-line"><span class="tm-ln">130</span>	<span class="tm-fg-f92672">if</span> b, ok <span class="tm-fg-f92672">:=</span> v.<span class="tm-fg-66d9ef">Settings</span>().<span class="tm-fg-66d9ef">Get</span>(<span class="tm-fg-e6db74">&#34;caret_blink&#34;</span>, <span class="tm-fg-ae81ff">true</span>).(<span class="tm-fg-66d9ef tm-i">bool</span>); ok {</span>
+<span class="tm-line"><span class="tm-ln">131</span>		caret_blink = b</span>
+<span class="tm-line"><span class="tm-ln">132</span>	}</span>
+<span class="tm-line"><span class="tm-ln">133</span></span>
+<span class="tm-line"><span class="tm-ln">134</span>	<span class="tm-fg-f92672">for</span> i <span class="tm-fg-f92672">:=</span> <span class="tm-fg-f92672">range</span> runes {</span>
+<span class="tm-line"><span class="tm-ln">135</span>		sub2 += <span class="tm-fg-66d9ef">string</span>(runes[i])</span>
+<span class="tm-line"><span class="tm-ln">136</span></span>
+<span class="tm-line"><span class="tm-ln">137</span>		<span class="tm-fg-f92672">if</span> x &lt; ex {</span>
+<span class="tm-line"><span class="tm-ln">138</span>			o <span class="tm-fg-f92672">:=</span> off + <span class="tm-fg-66d9ef">len</span>(sub2)</span>
+<span class="tm-line"><span class="tm-ln">139</span>			r <span class="tm-fg-f92672">:=</span> primitives.Region{o, o}</span>
+<span class="tm-line"><span class="tm-ln">140</span>			fg, bg <span class="tm-fg-f92672">:=</span> lfg, lbg</span>
+<span class="tm-line"><span class="tm-ln">141</span>			scope <span class="tm-fg-f92672">:=</span> v.<span class="tm-fg-66d9ef">ScopeName</span>(o)</span>
+<span class="tm-line"><span class="tm-ln">142</span>			<span class="tm-fg-f92672">if</span> scope != lastScope {</span>
+<span class="tm-line"><span class="tm-ln">143</span>				fg, bg = defaultFg, defaultBg</span>
+<span class="tm-line"><span class="tm-ln">144</span>				lastScope = scope</span>
+<span class="tm-line"><span class="tm-ln">145</span>				na <span class="tm-fg-f92672">:=</span> scope</span>
+<span class="tm-line"><span class="tm-ln">146</span>				<span class="tm-fg-f92672">for</span> <span class="tm-fg-66d9ef">len</span>(na) &gt; <span class="tm-fg-ae81ff">0</span> {</span>
+<span class="tm-line"><span class="tm-ln">147</span>					sn <span class="tm-fg-f92672">:=</span> na</span>
+<span class="tm-line"><span class="tm-ln">148</span>					i <span class="tm-fg-f92672">:=</span> strings.<span class="tm-fg-66d9ef">LastIndex</span>(sn, <span class="tm-fg-e6db74">&#34; &#34;</span>)</span>
+<span class="tm-line"><span class="tm-ln">149</span>					<span class="tm-fg-f92672">if</span> i != -<span class="tm-fg-ae81ff">1</span> {</span>
+<span class="tm-line"><span class="tm-ln">150</span>						sn = sn[i+<span class="tm-fg-ae81ff">1</span>:]</span>
+<span class="tm-line"><span class="tm-ln">151</span>					}</span>
+<span class="tm-line"><span class="tm-ln">152</span>					<span class="tm-fg-f92672">if</span> c, ok <span class="tm-fg-f92672">:=</span> schemelut[sn]; ok {</span>
+<span class="tm-line"><span class="tm-ln">153</span>						fg, bg = c[<span class="tm-fg-ae81ff">0</span>], c[<span class="tm-fg-ae81ff">1</span>]</span>
+<span class="tm-line"><span class="tm-ln">154</span>						<span class="tm-fg-f92672">break</span></span>
+<span class="tm-line"><span class="tm-ln">155</span>					}</span>
+<span class="tm-line"><span class="tm-ln">156</span>					<span class="tm-fg-f92672">if</span> i2 <span class="tm-fg-f92672">:=</span> strings.<span class="tm-fg-66d9ef">LastIndex</span>(na, <span class="tm-fg-e6db74">&#34;.&#34;</span>); i2 == -<span class="tm-fg-ae81ff">1</span> {</span>
+<span class="tm-line"><span class="tm-ln">157</span>						<span class="tm-fg-f92672">break</span></span>
+<span class="tm-line"><span class="tm-ln">158</span>					} <span class="tm-fg-f92672">else</span> <span class="tm-fg-f92672">if</span> i &gt; i2 {</span>
+<span class="tm-line"><span class="tm-ln">159</span>						na = na[:i]</span>
+<span class="tm-line"><span class="tm-ln">160</span>					} <span class="tm-fg-f92672">else</span> {</span>
+<span class="tm-line"><span class="tm-ln">161</span>						na = strings.<span class="tm-fg-66d9ef">TrimSpace</span>(na[:i2])</span>
+<span class="tm-line"><span class="tm-ln">162</span>					}</span>
+<span class="tm-line"><span class="tm-ln">163</span>				}</span>
+<span class="tm-line"><span class="tm-ln">164</span>				lfg, lbg = fg, bg</span>
+<span class="tm-line"><span class="tm-ln">165</span>			} <span class="tm-fg-f92672">else</span> {</span>
+<span class="tm-line"><span class="tm-ln">166</span>				fg, bg = lfg, lbg</span>
+<span class="tm-line"><span class="tm-ln">167</span>			}</span>
+<span class="tm-line"><span class="tm-ln">168</span>			<span class="tm-fg-f92672">for</span> _, r2 <span class="tm-fg-f92672">:=</span> <span class="tm-fg-f92672">range</span> sel.<span class="tm-fg-66d9ef">Regions</span>() {</span>
+<span class="tm-line"><span class="tm-ln">169</span>				<span class="tm-fg-f92672">if</span> r2.B == r.B {</span>
+<span class="tm-line"><span class="tm-ln">170</span>					<span class="tm-fg-f92672">if</span> !caret_blink || blink {</span>
+<span class="tm-line"><span class="tm-ln">171</span>						<span class="tm-fg-f92672">if</span> r2.<span class="tm-fg-66d9ef">Contains</span>(o) {</span>
+<span class="tm-line"><span class="tm-ln">172</span>							fg |= termbox.AttrReverse</span>
+<span class="tm-line"><span class="tm-ln">173</span>						} <span class="tm-fg-f92672">else</span> {</span>
+<span class="tm-line"><span class="tm-ln">174</span>							fg |= caret_style</span>
+<span class="tm-line"><span class="tm-ln">175</span>						}</span>
+<span class="tm-line"><span class="tm-ln">176</span>					}</span>
+<span class="tm-line"><span class="tm-ln">177</span>					<span class="tm-fg-f92672">break</span></span>
+<span class="tm-line"><span class="tm-ln">178</span>				} <span class="tm-fg-f92672">else</span> <span class="tm-fg-f92672">if</span> r2.<span class="tm-fg-66d9ef">Contains</span>(o) {</span>
+<span class="tm-line"><span class="tm-ln">179</span>					fg |= termbox.AttrReverse</span>
+<span class="tm-line"><span class="tm-ln">180</span>					<span class="tm-fg-f92672">break</span></span>
+<span class="tm-line"><span class="tm-ln">181</span>				}</span>
+<span class="tm-line"><span class="tm-ln">182</span>			}</span>
+<span class="tm-line"><span class="tm-ln">183</span>			<span class="tm-fg-f92672">if</span> runes[i] == <span class="tm-fg-e6db74">&#39;</span><span class="tm-fg-ae81ff">\t</span><span class="tm-fg-e6db74">&#39;</span> {</span>
+<span class="tm-line"><span class="tm-ln">184</span>				add <span class="tm-fg-f92672">:=</span> (x + <span class="tm-fg-ae81ff">1</span> + (tab_size - <span class="tm-fg-ae81ff">1</span>)) &amp;^ (tab_size - <span class="tm-fg-ae81ff">1</span>)</span>
+<span class="tm-line"><span class="tm-ln">185</span></span>
+<span class="tm-line"><span class="tm-ln">186</span>				<span class="tm-fg-f92672">for</span> x &lt; add {</span>
+<span class="tm-line"><span class="tm-ln">187</span>					termbox.<span class="tm-fg-66d9ef">SetCell</span>(x, y, <span class="tm-fg-e6db74">&#39; &#39;</span>, fg, bg)</span>
+<span class="tm-line"><span class="tm-ln">188</span>					x++</span>
+<span class="tm-line"><span class="tm-ln">189</span>				}</span>
+<span class="tm-line"><span class="tm-ln">190</span>				<span class="tm-fg-f92672">continue</span></span>
+<span class="tm-line"><span class="tm-ln">191</span>			} <span class="tm-fg-f92672">else</span> <span class="tm-fg-f92672">if</span> runes[i] == <span class="tm-fg-e6db74">&#39;</span><span class="tm-fg-ae81ff">\n</span><span class="tm-fg-e6db74">&#39;</span> {</span>
+<span class="tm-line"><span class="tm-ln">192</span>				termbox.<span class="tm-fg-66d9ef">SetCell</span>(x, y, <span class="tm-fg-e6db74">&#39; &#39;</span>, fg, bg)</span>
+<span class="tm-line"><span class="tm-ln">193</span>				x = sx</span>
+<span class="tm-line"><span class="tm-ln">194</span>				y++</span>
+<span class="tm-line"><span class="tm-ln">195</span>				<span class="tm-fg-f92672">if</span> y &gt; ey {</span>
+<span class="tm-line"><span class="tm-ln">196</span>					<span class="tm-fg-f92672">break</span></span>
+<span class="tm-line"><span class="tm-ln">197</span>				}</span>
+<span class="tm-line"><span class="tm-ln">198</span>				<span class="tm-fg-f92672">continue</span></span>
+<span class="tm-line"><span class="tm-ln">199</span>			}</span>
+<span class="tm-line"><span class="tm-ln">200</span>			termbox.<span class="tm-fg-66d9ef">SetCell</span>(x, y, runes[i], fg, bg)</span>
+<span class="tm-line"><span class="tm-ln">201</span>		}</span>
+<span class="tm-line"><span class="tm-ln">202</span>		x++</span>
+<span class="tm-line"><span class="tm-ln">203</span>	}</span>
+<span class="tm-line"><span class="tm-ln">204</span>}</span>
+<span class="tm-line"><span class="tm-ln">205</span></span>
+<span class="tm-line"><span class="tm-ln">206</span><span class="tm-fg-f92672">func</span> <span class="tm-fg-a6e22e">(t *tbfe) clip</span>(v *backend.View, r primitives.Region) primitives.Region {</span>
+<span class="tm-line"><span class="tm-ln">207</span>	s, _ <span class="tm-fg-f92672">:=</span> v.<span class="tm-fg-66d9ef">Buffer</span>().<span class="tm-fg-66d9ef">RowCol</span>(r.<span class="tm-fg-66d9ef">Begin</span>())</span>
+<span class="tm-line"><span class="tm-ln">208</span>	e, _ <span class="tm-fg-f92672">:=</span> v.<span class="tm-fg-66d9ef">Buffer</span>().<span class="tm-fg-66d9ef">RowCol</span>(r.<span class="tm-fg-66d9ef">End</span>())</span>
+<span class="tm-line"><span class="tm-ln">209</span></span>
+<span class="tm-line"><span class="tm-ln">210</span>	_, h <span class="tm-fg-f92672">:=</span> termbox.<span class="tm-fg-66d9ef">Size</span>()</span>
+<span class="tm-line"><span class="tm-ln">211</span>	h -= console_height</span>
+<span class="tm-line"><span class="tm-ln">212</span>	<span class="tm-fg-f92672">if</span> e-s &gt; h {</span>
+<span class="tm-line"><span class="tm-ln">213</span>		e = s + h</span>
+<span class="tm-line"><span class="tm-ln">214</span>	}</span>
+<span class="tm-line"><span class="tm-ln">215</span>	<span class="tm-fg-f92672">if</span> e2, _ <span class="tm-fg-f92672">:=</span> v.<span class="tm-fg-66d9ef">Buffer</span>().<span class="tm-fg-66d9ef">RowCol</span>(v.<span class="tm-fg-66d9ef">Buffer</span>().<span class="tm-fg-66d9ef">TextPoint</span>(e, <span class="tm-fg-ae81ff">1</span>)); e2 &lt; e {</span>
+<span class="tm-line"><span class="tm-ln">216</span>		e = e2</span>
+<span class="tm-line"><span class="tm-ln">217</span>	}</span>
+<span class="tm-line"><span class="tm-ln">218</span>	<span class="tm-fg-f92672">if</span> e-s &lt; h {</span>
+<span class="tm-line"><span class="tm-ln">219</span>		s = e - h</span>
+<span class="tm-line"><span class="tm-ln">220</span>	}</span>
+<span class="tm-line"><span class="tm-ln">221</span>	<span class="tm-fg-f92672">if</span> s &lt; <span class="tm-fg-ae81ff">1</span> {</span>
+<span class="tm-line"><span class="tm-ln">222</span>		s = <span class="tm-fg-ae81ff">1</span></span>
+<span class="tm-line"><span class="tm-ln">223</span>	}</span>
+<span class="tm-line"><span class="tm-ln">224</span>	r.A = v.<span class="tm-fg-66d9ef">Buffer</span>().<span class="tm-fg-66d9ef">Line</span>(v.<span class="tm-fg-66d9ef">Buffer</span>().<span class="tm-fg-66d9ef">TextPoint</span>(s, <span class="tm-fg-ae81ff">1</span>)).A</span>
+<span class="tm-line"><span class="tm-ln">225</span>	r.B = v.<span class="tm-fg-66d9ef">Buffer</span>().<span class="tm-fg-66d9ef">Line</span>(v.<span class="tm-fg-66d9ef">Buffer</span>().<span class="tm-fg-66d9ef">TextPoint</span>(e, <span class="tm-fg-ae81ff">1</span>)).B</span>
+<span class="tm-line"><span class="tm-ln">226</span>	<span class="tm-fg-f92672">return</span> r</span>
+<span class="tm-line"><span class="tm-ln">227</span>}</span>
+<span class="tm-line"><span class="tm-ln">228</span></span>
+<span class="tm-line"><span class="tm-ln">229</span><span class="tm-fg-f92672">func</span> <span class="tm-fg-a6e22e">(t *tbfe) Show</span>(v *backend.View, r primitives.Region) {</span>
+<span class="tm-line"><span class="tm-ln">230</span>	t.visibleregion[v] = t.<span class="tm-fg-66d9ef">clip</span>(v, primitives.Region{r.<span class="tm-fg-66d9ef">Begin</span>(), v.<span class="tm-fg-66d9ef">Buffer</span>().<span class="tm-fg-66d9ef">Size</span>()})</span>
+<span class="tm-line"><span class="tm-ln">231</span>}</span>
+<span class="tm-line"><span class="tm-ln">232</span></span>
+<span class="tm-line"><span class="tm-ln">233</span><span class="tm-fg-f92672">func</span> <span class="tm-fg-a6e22e">(t *tbfe) VisibleRegion</span>(v *backend.View) primitives.Region {</span>
+<span class="tm-line"><span class="tm-ln">234</span>	<span class="tm-fg-f92672">if</span> r, ok <span class="tm-fg-f92672">:=</span> t.visibleregion[v]; ok {</span>
+<span class="tm-line"><span class="tm-ln">235</span>		<span class="tm-fg-f92672">return</span> r</span>
+<span class="tm-line"><span class="tm-ln">236</span>	} <span class="tm-fg-f92672">else</span> {</span>
+<span class="tm-line"><span class="tm-ln">237</span>		t.<span class="tm-fg-66d9ef">Show</span>(v, primitives.Region{<span class="tm-fg-ae81ff">0</span>, <span class="tm-fg-ae81ff">0</span>})</span>
+<span class="tm-line"><span class="tm-ln">238</span>		<span class="tm-fg-f92672">return</span> t.visibleregion[v]</span>
+<span class="tm-line"><span class="tm-ln">239</span>	}</span>
+<span class="tm-line"><span class="tm-ln">240</span>}</span>
+<span class="tm-line"><span class="tm-ln">241</span></span>
+<span class="tm-line"><span class="tm-ln">242</span><span class="tm-fg-f92672">func</span> <span class="tm-fg-a6e22e">(t *tbfe) StatusMessage</span>(msg string) {</span>
+<span class="tm-line"><span class="tm-ln">243</span>	t.status_message = msg</span>
+<span class="tm-line"><span class="tm-ln">244</span>}</span>
+<span class="tm-line"><span class="tm-ln">245</span></span>
+<span class="tm-line"><span class="tm-ln">246</span><span class="tm-fg-f92672">func</span> <span class="tm-fg-a6e22e">(t *tbfe) ErrorMessage</span>(msg string) {</span>
+<span class="tm-line"><span class="tm-ln">247</span>	log4go.<span class="tm-fg-66d9ef">Error</span>(msg)</span>
+<span class="tm-line"><span class="tm-ln">248</span>}</span>
+<span class="tm-line"><span class="tm-ln">249</span></span>
+<span class="tm-line"><span class="tm-ln">250</span><span class="tm-fg-75715e">// TODO(q): Actually show a dialog</span></span>
+<span class="tm-line"><span class="tm-ln">251</span><span class="tm-fg-f92672">func</span> <span class="tm-fg-a6e22e">(t *tbfe) MessageDialog</span>(msg string) {</span>
+<span class="tm-line"><span class="tm-ln">252</span>	log4go.<span class="tm-fg-66d9ef">Info</span>(msg)</span>
+<span class="tm-line"><span class="tm-ln">253</span>}</span>
+<span class="tm-line"><span class="tm-ln">254</span></span>
+<span class="tm-line"><span class="tm-ln">255</span><span class="tm-fg-75715e">// TODO(q): Actually show a dialog</span></span>
+<span class="tm-line"><span class="tm-ln">256</span><span class="tm-fg-f92672">func</span> <span class="tm-fg-a6e22e">(t *tbfe) OkCancelDialog</span>(msg, ok string) {</span>
+<span class="tm-line"><span class="tm-ln">257</span>	log4go.<span class="tm-fg-66d9ef">Info</span>(msg, ok)</span>
+<span class="tm-line"><span class="tm-ln">258</span>}</span>
+<span class="tm-line"><span class="tm-ln">259</span></span>
+<span class="tm-line"><span class="tm-ln">260</span><span class="tm-fg-f92672">func</span> <span class="tm-fg-a6e22e">(t *tbfe) scroll</span>(b *primitives.Buffer, pos, delta int) {</span>
+<span class="tm-line"><span class="tm-ln">261</span>	t.<span class="tm-fg-66d9ef">Show</span>(backend.<span class="tm-fg-66d9ef">GetEditor</span>().<span class="tm-fg-66d9ef">Console</span>(), primitives.Region{b.<span class="tm-fg-66d9ef">Size</span>(), b.<span class="tm-fg-66d9ef">Size</span>()})</span>
+<span class="tm-line"><span class="tm-ln">262</span>}</span>
+<span class="tm-line"><span class="tm-ln">263</span></span>
+<span class="tm-line"><span class="tm-ln">264</span><span class="tm-fg-f92672">func</span> <span class="tm-fg-a6e22e">(t *tbfe) loop</span>() {</span>
+<span class="tm-line"><span class="tm-ln">265</span>	ed <span class="tm-fg-f92672">:=</span> backend.<span class="tm-fg-66d9ef">GetEditor</span>()</span>
+<span class="tm-line"><span class="tm-ln">266</span>	ed.<span class="tm-fg-66d9ef">SetFrontend</span>(t)</span>
+<span class="tm-line"><span class="tm-ln">267</span>	<span class="tm-fg-75715e">//ed.LogInput(true)</span></span>
+<span class="tm-line"><span class="tm-ln">268</span>	<span class="tm-fg-75715e">//ed.LogCommands(true)</span></span>
+<span class="tm-line"><span class="tm-ln">269</span>	c <span class="tm-fg-f92672">:=</span> ed.<span class="tm-fg-66d9ef">Console</span>()</span>
+<span class="tm-line"><span class="tm-ln">270</span>	<span class="tm-fg-66d9ef">var</span> (</span>
+<span class="tm-line"><span class="tm-ln">271</span>		scheme textmate.Theme</span>
+<span class="tm-line"><span class="tm-ln">272</span>	)</span>
+<span class="tm-line"><span class="tm-ln">273</span></span>
+<span class="tm-line"><span class="tm-ln">274</span>	<span class="tm-fg-f92672">if</span> d, err <span class="tm-fg-f92672">:=</span> ioutil.<span class="tm-fg-66d9ef">ReadFile</span>(<span class="tm-fg-e6db74">&#34;../../3rdparty/bundles/TextMate-Themes/GlitterBomb.tmTheme&#34;</span>); err != <span class="tm-fg-ae81ff">nil</span> {</span>
+<span class="tm-line"><span class="tm-ln">275</span>		log4go.<span class="tm-fg-66d9ef">Error</span>(<span class="tm-fg-e6db74">&#34;Unable to load colorscheme definition: </span><span class="tm-fg-ae81ff">%s</span><span class="tm-fg-e6db74">&#34;</span>, err)</span>
+<span class="tm-line"><span class="tm-ln">276</span>	} <span class="tm-fg-f92672">else</span> <span class="tm-fg-f92672">if</span> err <span class="tm-fg-f92672">:=</span> loaders.<span class="tm-fg-66d9ef">LoadPlist</span>(d, &amp;scheme); err != <span class="tm-fg-ae81ff">nil</span> {</span>
+<span class="tm-line"><span class="tm-ln">277</span>		log4go.<span class="tm-fg-66d9ef">Error</span>(<span class="tm-fg-e6db74">&#34;Unable to load colorscheme definition: </span><span class="tm-fg-ae81ff">%s</span><span class="tm-fg-e6db74">&#34;</span>, err)</span>
+<span class="tm-line"><span class="tm-ln">278</span>	}</span>
+<span class="tm-line"><span class="tm-ln">279</span></span>
+<span class="tm-line"><span class="tm-ln">280</span>	<span class="tm-fg-66d9ef">var</span> (</span>
+<span class="tm-line"><span class="tm-ln">281</span>		palLut  <span class="tm-fg-66d9ef">func</span>(col textmate.Color) termbox.Attribute</span>
+<span class="tm-line"><span class="tm-ln">282</span>		pal     = <span class="tm-fg-66d9ef">make</span>([]termbox.RGB, <span class="tm-fg-ae81ff">0</span>, <span class="tm-fg-ae81ff">256</span>)</span>
+<span class="tm-line"><span class="tm-ln">283</span>		mode256 <span class="tm-fg-66d9ef tm-i">bool</span></span>
+<span class="tm-line"><span class="tm-ln">284</span>	)</span>
+<span class="tm-line"><span class="tm-ln">285</span></span>
+<span class="tm-line"><span class="tm-ln">286</span>	<span class="tm-fg-f92672">if</span> err <span class="tm-fg-f92672">:=</span> termbox.<span class="tm-fg-66d9ef">SetColorMode</span>(termbox.ColorMode256); err != <span class="tm-fg-ae81ff">nil</span> {</span>
+<span class="tm-line"><span class="tm-ln">287</span>		log4go.<span class="tm-fg-66d9ef">Error</span>(<span class="tm-fg-e6db74">&#34;Unable to use 256 color mode: </span><span class="tm-fg-ae81ff">%s</span><span class="tm-fg-e6db74">&#34;</span>, err)</span>
+<span class="tm-line"><span class="tm-ln">288</span>	} <span class="tm-fg-f92672">else</span> {</span>
+<span class="tm-line"><span class="tm-ln">289</span>		log4go.<span class="tm-fg-66d9ef">Debug</span>(<span class="tm-fg-e6db74">&#34;Using 256 color mode&#34;</span>)</span>
+<span class="tm-line"><span class="tm-ln">290</span>		mode256 = <span class="tm-fg-ae81ff">true</span></span>
+<span class="tm-line"><span class="tm-ln">291</span>	}</span>
+<span class="tm-line"><span class="tm-ln">292</span></span>
+<span class="tm-line"><span class="tm-ln">293</span>	<span class="tm-fg-f92672">if</span> !mode256 {</span>
+<span class="tm-line"><span class="tm-ln">294</span>		pal = pal[:<span class="tm-fg-ae81ff">10</span>] <span class="tm-fg-75715e">// Not correct, but whatever</span></span>
+<span class="tm-line"><span class="tm-ln">295</span>		pal[termbox.ColorBlack] = termbox.RGB{<span class="tm-fg-ae81ff">0</span>, <span class="tm-fg-ae81ff">0</span>, <span class="tm-fg-ae81ff">0</span>}</span>
+<span class="tm-line"><span class="tm-ln">296</span>		pal[termbox.ColorWhite] = termbox.RGB{<span class="tm-fg-ae81ff">255</span>, <span class="tm-fg-ae81ff">255</span>, <span class="tm-fg-ae81ff">255</span>}</span>
+<span class="tm-line"><span class="tm-ln">297</span>		pal[termbox.ColorRed] = termbox.RGB{<span class="tm-fg-ae81ff">255</span>, <span class="tm-fg-ae81ff">0</span>, <span class="tm-fg-ae81ff">0</span>}</span>
+<span class="tm-line"><span class="tm-ln">298</span>		pal[termbox.ColorGreen] = termbox.RGB{<span class="tm-fg-ae81ff">0</span>, <span class="tm-fg-ae81ff">255</span>, <span class="tm-fg-ae81ff">0</span>}</span>
+<span class="tm-line"><span class="tm-ln">299</span>		pal[termbox.ColorBlue] = termbox.RGB{<span class="tm-fg-ae81ff">0</span>, <span class="tm-fg-ae81ff">0</span>, <span class="tm-fg-ae81ff">255</span>}</span>
+<span class="tm-line"><span class="tm-ln">300</span>		pal[termbox.ColorMagenta] = termbox.RGB{<span class="tm-fg-ae81ff">255</span>, <span class="tm-fg-ae81ff">0</span>, <span class="tm-fg-ae81ff">255</span>}</span>
+<span class="tm-line"><span class="tm-ln">301</span>		pal[termbox.ColorYellow] = termbox.RGB{<span class="tm-fg-ae81ff">255</span>, <span class="tm-fg-ae81ff">255</span>, <span class="tm-fg-ae81ff">0</span>}</span>
+<span class="tm-line"><span class="tm-ln">302</span>		pal[termbox.ColorCyan] = termbox.RGB{<span class="tm-fg-ae81ff">0</span>, <span class="tm-fg-ae81ff">255</span>, <span class="tm-fg-ae81ff">255</span>}</span>
+<span class="tm-line"><span class="tm-ln">303</span></span>
+<span class="tm-line"><span class="tm-ln">304</span>		diff <span class="tm-fg-f92672">:=</span> <span class="tm-fg-66d9ef">func</span>(i, j <span class="tm-fg-66d9ef tm-i">byte</span>) <span class="tm-fg-66d9ef tm-i">int</span> {</span>
+<span class="tm-line"><span class="tm-ln">305</span>			v <span class="tm-fg-f92672">:=</span> <span class="tm-fg-66d9ef">int</span>(i) - <span class="tm-fg-66d9ef">int</span>(j)</span>
+<span class="tm-line"><span class="tm-ln">306</span>			<span class="tm-fg-f92672">if</span> v &lt; <span class="tm-fg-ae81ff">0</span> {</span>
+<span class="tm-line"><span class="tm-ln">307</span>				<span class="tm-fg-f92672">return</span> -v</span>
+<span class="tm-line"><span class="tm-ln">308</span>			}</span>
+<span class="tm-line"><span class="tm-ln">309</span>			<span class="tm-fg-f92672">return</span> v</span>
+<span class="tm-line"><span class="tm-ln">310</span>		}</span>
+<span class="tm-line"><span class="tm-ln">311</span>		palLut = <span class="tm-fg-66d9ef">func</span>(col textmate.Color) termbox.Attribute {</span>
+<span class="tm-line"><span class="tm-ln">312</span>			mindist <span class="tm-fg-f92672">:=</span> <span class="tm-fg-ae81ff">10000000</span></span>
+<span class="tm-line"><span class="tm-ln">313</span>			mini <span class="tm-fg-f92672">:=</span> <span class="tm-fg-ae81ff">0</span></span>
+<span class="tm-line"><span class="tm-ln">314</span>			<span class="tm-fg-f92672">for</span> i, c <span class="tm-fg-f92672">:=</span> <span class="tm-fg-f92672">range</span> pal {</span>
+<span class="tm-line"><span class="tm-ln">315</span>				<span class="tm-fg-f92672">if</span> dist <span class="tm-fg-f92672">:=</span> <span class="tm-fg-66d9ef">diff</span>(c.R, col.R) + <span class="tm-fg-66d9ef">diff</span>(c.G, col.G) + <span class="tm-fg-66d9ef">diff</span>(c.B, col.B); dist &lt; mindist {</span>
+<span class="tm-line"><span class="tm-ln">316</span>					mindist = dist</span>
+<span class="tm-line"><span class="tm-ln">317</span>					mini = i</span>
+<span class="tm-line"><span class="tm-ln">318</span>				}</span>
+<span class="tm-line"><span class="tm-ln">319</span>			}</span>
+<span class="tm-line"><span class="tm-ln">320</span>			<span class="tm-fg-f92672">return</span> termbox.<span class="tm-fg-66d9ef">Attribute</span>(mini)</span>
+<span class="tm-line"><span class="tm-ln">321</span>		}</span>
+<span class="tm-line"><span class="tm-ln">322</span>	} <span class="tm-fg-f92672">else</span> {</span>
+<span class="tm-line"><span class="tm-ln">323</span>		palLut = <span class="tm-fg-66d9ef">func</span>(col textmate.Color) termbox.Attribute {</span>
+<span class="tm-line"><span class="tm-ln">324</span>			tc <span class="tm-fg-f92672">:=</span> termbox.RGB{col.R, col.G, col.B}</span>
+<span class="tm-line"><span class="tm-ln">325</span>			<span class="tm-fg-f92672">for</span> i, c <span class="tm-fg-f92672">:=</span> <span class="tm-fg-f92672">range</span> pal {</span>
+<span class="tm-line"><span class="tm-ln">326</span>				<span class="tm-fg-f92672">if</span> c == tc {</span>
+<span class="tm-line"><span class="tm-ln">327</span>					<span class="tm-fg-f92672">return</span> termbox.<span class="tm-fg-66d9ef">Attribute</span>(i)</span>
+<span class="tm-line"><span class="tm-ln">328</span>				}</span>
+<span class="tm-line"><span class="tm-ln">329</span>			}</span>
+<span class="tm-line"><span class="tm-ln">330</span>			l <span class="tm-fg-f92672">:=</span> <span class="tm-fg-66d9ef">len</span>(pal)</span>
+<span class="tm-line"><span class="tm-ln">331</span>			pal = <span class="tm-fg-66d9ef">append</span>(pal, tc)</span>
+<span class="tm-line"><span class="tm-ln">332</span>			<span class="tm-fg-f92672">return</span> termbox.<span class="tm-fg-66d9ef">Attribute</span>(l)</span>
+<span class="tm-line"><span class="tm-ln">333</span>		}</span>
+<span class="tm-line"><span class="tm-ln">334</span>	}</span>
+<span class="tm-line"><span class="tm-ln">335</span>	<span class="tm-fg-f92672">for</span> i, s <span class="tm-fg-f92672">:=</span> <span class="tm-fg-f92672">range</span> scheme.Settings {</span>
+<span class="tm-line"><span class="tm-ln">336</span>		<span class="tm-fg-66d9ef">var</span> (</span>
+<span class="tm-line"><span class="tm-ln">337</span>			fi = defaultFg</span>
+<span class="tm-line"><span class="tm-ln">338</span>			bi = defaultBg</span>
+<span class="tm-line"><span class="tm-ln">339</span>		)</span>
+<span class="tm-line"><span class="tm-ln">340</span>		<span class="tm-fg-f92672">if</span> fg, ok <span class="tm-fg-f92672">:=</span> s.Settings[<span class="tm-fg-e6db74">&#34;foreground&#34;</span>]; ok {</span>
+<span class="tm-line"><span class="tm-ln">341</span>			fi = <span class="tm-fg-66d9ef">palLut</span>(fg)</span>
+<span class="tm-line"><span class="tm-ln">342</span>			<span class="tm-fg-f92672">if</span> i == <span class="tm-fg-ae81ff">0</span> {</span>
+<span class="tm-line"><span class="tm-ln">343</span>				defaultFg = fi</span>
+<span class="tm-line"><span class="tm-ln">344</span>			}</span>
+<span class="tm-line"><span class="tm-ln">345</span>		}</span>
+<span class="tm-line"><span class="tm-ln">346</span>		<span class="tm-fg-f92672">if</span> bg, ok <span class="tm-fg-f92672">:=</span> s.Settings[<span class="tm-fg-e6db74">&#34;background&#34;</span>]; ok {</span>
+<span class="tm-line"><span class="tm-ln">347</span>			bi = <span class="tm-fg-66d9ef">palLut</span>(bg)</span>
+<span class="tm-line"><span class="tm-ln">348</span>			<span class="tm-fg-f92672">if</span> i == <span class="tm-fg-ae81ff">0</span> {</span>
+<span class="tm-line"><span class="tm-ln">349</span>				defaultBg = bi</span>
+<span class="tm-line"><span class="tm-ln">350</span>			}</span>
+<span class="tm-line"><span class="tm-ln">351</span>		}</span>
+<span class="tm-line"><span class="tm-ln">352</span>		schemelut[s.Scope] = [<span class="tm-fg-ae81ff">2</span>]termbox.Attribute{fi, bi}</span>
+<span class="tm-line"><span class="tm-ln">353</span>	}</span>
+<span class="tm-line"><span class="tm-ln">354</span>	<span class="tm-fg-f92672">if</span> mode256 {</span>
+<span class="tm-line"><span class="tm-ln">355</span>		termbox.<span class="tm-fg-66d9ef">SetColorPalette</span>(pal)</span>
+<span class="tm-line"><span class="tm-ln">356</span>	}</span>
+<span class="tm-line"><span class="tm-ln">357</span>	<span class="tm-fg-f92672">defer</span> <span class="tm-fg-f92672">func</span>() {</span>
+<span class="tm-line"><span class="tm-ln">358</span>		termbox.<span class="tm-fg-66d9ef">Close</span>()</span>
+<span class="tm-line"><span class="tm-ln">359</span>		fmt.<span class="tm-fg-66d9ef">Println</span>(c.<span class="tm-fg-66d9ef">Buffer</span>().<span class="tm-fg-66d9ef">Data</span>())</span>
+<span class="tm-line"><span class="tm-ln">360</span>	}()</span>
+<span class="tm-line"><span class="tm-ln">361</span></span>
+<span class="tm-line"><span class="tm-ln">362</span>	w <span class="tm-fg-f92672">:=</span> ed.<span class="tm-fg-66d9ef">NewWindow</span>()</span>
+<span class="tm-line"><span class="tm-ln">363</span>	t.active_window = w</span>
+<span class="tm-line"><span class="tm-ln">364</span>	v <span class="tm-fg-f92672">:=</span> w.<span class="tm-fg-66d9ef">OpenFile</span>(<span class="tm-fg-e6db74">&#34;main.go&#34;</span>, <span class="tm-fg-ae81ff">0</span>)</span>
+<span class="tm-line"><span class="tm-ln">365</span>	v.<span class="tm-fg-66d9ef">Settings</span>().<span class="tm-fg-66d9ef">Set</span>(<span class="tm-fg-e6db74">&#34;trace&#34;</span>, <span class="tm-fg-ae81ff">true</span>)</span>
+<span class="tm-line"><span class="tm-ln">366</span>	c.<span class="tm-fg-66d9ef">Buffer</span>().<span class="tm-fg-66d9ef">AddCallback</span>(t.scroll)</span>
+<span class="tm-line"><span class="tm-ln">367</span></span>
+<span class="tm-line"><span class="tm-ln">368</span>	<span class="tm-fg-f92672">if</span> err <span class="tm-fg-f92672">:=</span> v.<span class="tm-fg-66d9ef">SetSyntaxFile</span>(<span class="tm-fg-e6db74">&#34;../../3rdparty/bundles/GoSublime/GoSublime.tmLanguage&#34;</span>); err != <span class="tm-fg-ae81ff">nil</span> {</span>
+<span class="tm-line"><span class="tm-ln">369</span>		log4go.<span class="tm-fg-66d9ef">Error</span>(<span class="tm-fg-e6db74">&#34;Unable to set syntax file: </span><span class="tm-fg-ae81ff">%s</span><span class="tm-fg-e6db74">&#34;</span>, err)</span>
+<span class="tm-line"><span class="tm-ln">370</span>	}</span>
+<span class="tm-line"><span class="tm-ln">371</span>	sel <span class="tm-fg-f92672">:=</span> v.<span class="tm-fg-66d9ef">Sel</span>()</span>
+<span class="tm-line"><span class="tm-ln">372</span>	sel.<span class="tm-fg-66d9ef">Clear</span>()</span>
+<span class="tm-line"><span class="tm-ln">373</span>	<span class="tm-fg-75715e">//	end := v.Buffer().Size() - 2</span></span>
+<span class="tm-line"><span class="tm-ln">374</span>	sel.<span class="tm-fg-66d9ef">Add</span>(primitives.Region{<span class="tm-fg-ae81ff">0</span>, <span class="tm-fg-ae81ff">0</span>})</span>
+<span class="tm-line"><span class="tm-ln">375</span>	<span class="tm-fg-75715e">// sel.Add(primitives.Region{end - 22, end - 22})</span></span>
+<span class="tm-line"><span class="tm-ln">376</span>	<span class="tm-fg-75715e">// sel.Add(primitives.Region{end - 16, end - 20})</span></span>
+<span class="tm-line"><span class="tm-ln">377</span>	<span class="tm-fg-75715e">// sel.Add(primitives.Region{end - 13, end - 10})</span></span>
+<span class="tm-line"><span class="tm-ln">378</span></span>
+<span class="tm-line"><span class="tm-ln">379</span>	evchan <span class="tm-fg-f92672">:=</span> <span class="tm-fg-66d9ef">make</span>(<span class="tm-fg-f92672">chan</span> termbox.Event)</span>
+<span class="tm-line"><span class="tm-ln">380</span></span>
+<span class="tm-line"><span class="tm-ln">381</span>	<span class="tm-fg-f92672">go</span> <span class="tm-fg-f92672">func</span>() {</span>
+<span class="tm-line"><span class="tm-ln">382</span>		<span class="tm-fg-f92672">for</span> {</span>
+<span class="tm-line"><span class="tm-ln">383</span>			evchan &lt;- termbox.<span class="tm-fg-66d9ef">PollEvent</span>()</span>
+<span class="tm-line"><span class="tm-ln">384</span>		}</span>
+<span class="tm-line"><span class="tm-ln">385</span>	}()</span>
+<span class="tm-line"><span class="tm-ln">386</span></span>
+<span class="tm-line"><span class="tm-ln">387</span>	sublime.<span class="tm-fg-66d9ef">Init</span>()</span>
+<span class="tm-line"><span class="tm-ln">388</span>	<span class="tm-fg-f92672">for</span> {</span>
+<span class="tm-line"><span class="tm-ln">389</span>		blink = !blink</span>
+<span class="tm-line"><span class="tm-ln">390</span>		termbox.<span class="tm-fg-66d9ef">Clear</span>(defaultFg, defaultBg)</span>
+<span class="tm-line"><span class="tm-ln">391</span>		w, h <span class="tm-fg-f92672">:=</span> termbox.<span class="tm-fg-66d9ef">Size</span>()</span>
+<span class="tm-line"><span class="tm-ln">392</span></span>
+<span class="tm-line"><span class="tm-ln">393</span>		t.<span class="tm-fg-66d9ef">renderView</span>(<span class="tm-fg-ae81ff">0</span>, <span class="tm-fg-ae81ff">0</span>, w, h-console_height, v)</span>
+<span class="tm-line"><span class="tm-ln">394</span>		t.<span class="tm-fg-66d9ef">renderView</span>(<span class="tm-fg-ae81ff">0</span>, h-(console_height), w, (console_height - <span class="tm-fg-ae81ff">1</span>), c)</span>
+<span class="tm-line"><span class="tm-ln">395</span>		runes <span class="tm-fg-f92672">:=</span> []<span class="tm-fg-66d9ef">rune</span>(t.status_message)</span>
+<span class="tm-line"><span class="tm-ln">396</span>		<span class="tm-fg-f92672">for</span> i <span class="tm-fg-f92672">:=</span> <span class="tm-fg-ae81ff">0</span>; i &lt; w &amp;&amp; i &lt; <span class="tm-fg-66d9ef">len</span>(runes); i++ {</span>
+<span class="tm-line"><span class="tm-ln">397</span>			termbox.<span class="tm-fg-66d9ef">SetCell</span>(i, h-<span class="tm-fg-ae81ff">1</span>, runes[i], defaultFg, defaultBg)</span>
+<span class="tm-line"><span class="tm-ln">398</span>		}</span>
+<span class="tm-line"><span class="tm-ln">399</span>		termbox.<span class="tm-fg-66d9ef">Flush</span>()</span>
+<span class="tm-line"><span class="tm-ln">400</span></span>
+<span class="tm-line"><span class="tm-ln">401</span>		blink_phase <span class="tm-fg-f92672">:=</span> time.Second</span>
+<span class="tm-line"><span class="tm-ln">402</span>		<span class="tm-fg-f92672">if</span> p, ok <span class="tm-fg-f92672">:=</span> ed.<span class="tm-fg-66d9ef">Settings</span>().<span class="tm-fg-66d9ef">Get</span>(<span class="tm-fg-e6db74">&#34;caret_blink_phase&#34;</span>, <span class="tm-fg-ae81ff">1.0</span>).(<span class="tm-fg-66d9ef tm-i">float64</span>); ok {</span>
+<span class="tm-line"><span class="tm-ln">403</span>			blink_phase = time.<span class="tm-fg-66d9ef">Duration</span>(<span class="tm-fg-66d9ef tm-i">float64</span>(time.Second) * p)</span>
+<span class="tm-line"><span class="tm-ln">404</span>		}</span>
+<span class="tm-line"><span class="tm-ln">405</span></span>
+<span class="tm-line"><span class="tm-ln">406</span>		<span class="tm-fg-f92672">select</span> {</span>
+<span class="tm-line"><span class="tm-ln">407</span>		<span class="tm-fg-f92672">case</span> ev <span class="tm-fg-f92672">:=</span> &lt;-evchan:</span>
+<span class="tm-line"><span class="tm-ln">408</span>			<span class="tm-fg-f92672">switch</span> ev.Type {</span>
+<span class="tm-line"><span class="tm-ln">409</span>			<span class="tm-fg-f92672">case</span> termbox.EventKey:</span>
+<span class="tm-line"><span class="tm-ln">410</span>				<span class="tm-fg-f92672">var</span> kp backend.KeyPress</span>
+<span class="tm-line"><span class="tm-ln">411</span></span>
+<span class="tm-line"><span class="tm-ln">412</span>				<span class="tm-fg-f92672">if</span> ev.Ch != <span class="tm-fg-ae81ff">0</span> {</span>
+<span class="tm-line"><span class="tm-ln">413</span>					kp.Key = backend.<span class="tm-fg-66d9ef">Key</span>(ev.Ch)</span>
+<span class="tm-line"><span class="tm-ln">414</span>				} <span class="tm-fg-f92672">else</span> <span class="tm-fg-f92672">if</span> v2, ok <span class="tm-fg-f92672">:=</span> lut[ev.Key]; ok {</span>
+<span class="tm-line"><span class="tm-ln">415</span>					kp = v2</span>
+<span class="tm-line"><span class="tm-ln">416</span>				} <span class="tm-fg-f92672">else</span> {</span>
+<span class="tm-line"><span class="tm-ln">417</span>					<span class="tm-fg-f92672">break</span></span>
+<span class="tm-line"><span class="tm-ln">418</span>				}</span>
+<span class="tm-line"><span class="tm-ln">419</span></span>
+<span class="tm-line"><span class="tm-ln">420</span>				<span class="tm-fg-f92672">if</span> ev.Key == termbox.KeyCtrlQ {</span>
+<span class="tm-line"><span class="tm-ln">421</span>					<span class="tm-fg-f92672">return</span></span>
+<span class="tm-line"><span class="tm-ln">422</span>				}</span>
+<span class="tm-line"><span class="tm-ln">423</span>				ed.<span class="tm-fg-66d9ef">HandleInput</span>(kp)</span>
+<span class="tm-line"><span class="tm-ln">424</span>				blink = <span class="tm-fg-ae81ff">false</span></span>
+<span class="tm-line"><span class="tm-ln">425</span>			}</span>
+<span class="tm-line"><span class="tm-ln">426</span>		<span class="tm-fg-f92672">case</span> &lt;-time.<span class="tm-fg-66d9ef">After</span>(blink_phase / <span class="tm-fg-ae81ff">2</span>):</span>
+<span class="tm-line"><span class="tm-ln">427</span>			<span class="tm-fg-75715e">// Divided by two since we&#39;re only doing a simple toggle blink</span></span>
+<span class="tm-line"><span class="tm-ln">428</span>			<span class="tm-fg-75715e">// TODO(q): Shouldn&#39;t redraw if blink is disabled...</span></span>
+<span class="tm-line"><span class="tm-ln">429</span>		}</span>
+<span class="tm-line"><span class="tm-ln">430</span>	}</span>
+<span class="tm-line"><span class="tm-ln">431</span>}</span>
+<span class="tm-line"><span class="tm-ln">432</span></span>
+<span class="tm-line"><span class="tm-ln">433</span><span class="tm-fg-f92672">func</span> <span class="tm-fg-a6e22e">main</span>() {</span>
+<span class="tm-line"><span class="tm-ln">434</span>	<span class="tm-fg-f92672">if</span> err <span class="tm-fg-f92672">:=</span> termbox.<span class="tm-fg-66d9ef">Init</span>(); err != <span class="tm-fg-ae81ff">nil</span> {</span>
+<span class="tm-line"><span class="tm-ln">435</span>		log4go.<span class="tm-fg-66d9ef">Exit</span>(err)</span>
+<span class="tm-line"><span class="tm-ln">436</span>	}</span>
+<span class="tm-line"><span class="tm-ln">437</span></span>
+<span class="tm-line"><span class="tm-ln">438</span>	<span class="tm-fg-f92672">var</span> t tbfe</span>
+<span class="tm-line"><span class="tm-ln">439</span>	t.visibleregion = <span class="tm-fg-66d9ef">make</span>(<span class="tm-fg-f92672">map</span>[*backend.View]primitives.Region)</span>
+<span class="tm-line"><span class="tm-ln">440</span>	t.<span class="tm-fg-66d9ef">loop</span>()</span>
+<span class="tm-line"><span class="tm-ln">441</span>}</span>
+</code></pre>
@@ -1,1792 +0,0 @@
-0-11368: "source.go"
-	0-7: "keyword.control.go" - Data: "package"
-	14-20: "keyword.control.go" - Data: "import"
-	21-22: "meta.parens.go" - Data: "("
-	24-50: "string.quoted.double.go"
-		24-25: "punctuation.definition.string.begin.go" - Data: """
-		49-50: "punctuation.definition.string.end.go" - Data: """
-	52-57: "string.quoted.double.go"
-		52-53: "punctuation.definition.string.begin.go" - Data: """
-		56-57: "punctuation.definition.string.end.go" - Data: """
-	59-70: "string.quoted.double.go"
-		59-60: "punctuation.definition.string.begin.go" - Data: """
-		69-70: "punctuation.definition.string.end.go" - Data: """
-	72-103: "string.quoted.double.go"
-		72-73: "punctuation.definition.string.begin.go" - Data: """
-		102-103: "punctuation.definition.string.end.go" - Data: """
-	105-119: "string.quoted.double.go"
-		105-106: "punctuation.definition.string.begin.go" - Data: """
-		118-119: "punctuation.definition.string.end.go" - Data: """
-	121-143: "string.quoted.double.go"
-		121-122: "punctuation.definition.string.begin.go" - Data: """
-		142-143: "punctuation.definition.string.end.go" - Data: """
-	145-170: "string.quoted.double.go"
-		145-146: "punctuation.definition.string.begin.go" - Data: """
-		169-170: "punctuation.definition.string.end.go" - Data: """
-	172-194: "string.quoted.double.go"
-		172-173: "punctuation.definition.string.begin.go" - Data: """
-		193-194: "punctuation.definition.string.end.go" - Data: """
-	196-219: "string.quoted.double.go"
-		196-197: "punctuation.definition.string.begin.go" - Data: """
-		218-219: "punctuation.definition.string.end.go" - Data: """
-	221-230: "string.quoted.double.go"
-		221-222: "punctuation.definition.string.begin.go" - Data: """
-		229-230: "punctuation.definition.string.end.go" - Data: """
-	232-238: "string.quoted.double.go"
-		232-233: "punctuation.definition.string.begin.go" - Data: """
-		237-238: "punctuation.definition.string.end.go" - Data: """
-	242-245: "keyword.control.go" - Data: "var"
-	246-247: "meta.parens.go" - Data: "("
-	255-258: "storage.modifier.go" - Data: "map"
-	267-270: "variable.other.dot-access.go" - Data: "Key"
-	279-287: "variable.other.dot-access.go" - Data: "KeyPress"
-	287-2788: "meta.block.go"
-		287-288: "punctuation.section.block.begin.go" - Data: "{"
-		299-307: "variable.other.dot-access.go" - Data: "KeyCtrlA"
-		322-330: "variable.other.dot-access.go" - Data: "KeyPress"
-		330-352: "meta.block.go"
-			330-331: "punctuation.section.function-block.begin.go" - Data: "{"
-			337-341: "constant.language.go" - Data: "true"
-			348-351: "string.quoted.single.go"
-				348-349: "punctuation.definition.string.begin.go" - Data: "'"
-				350-351: "punctuation.definition.string.end.go" - Data: "'"
-			351-352: "punctuation.section.function-block.end.go" - Data: "}"
-		364-372: "variable.other.dot-access.go" - Data: "KeyCtrlB"
-		387-395: "variable.other.dot-access.go" - Data: "KeyPress"
-		395-417: "meta.block.go"
-			395-396: "punctuation.section.function-block.begin.go" - Data: "{"
-			402-406: "constant.language.go" - Data: "true"
-			413-416: "string.quoted.single.go"
-				413-414: "punctuation.definition.string.begin.go" - Data: "'"
-				415-416: "punctuation.definition.string.end.go" - Data: "'"
-			416-417: "punctuation.section.function-block.end.go" - Data: "}"
-		429-437: "variable.other.dot-access.go" - Data: "KeyCtrlC"
-		452-460: "variable.other.dot-access.go" - Data: "KeyPress"
-		460-482: "meta.block.go"
-			460-461: "punctuation.section.function-block.begin.go" - Data: "{"
-			467-471: "constant.language.go" - Data: "true"
-			478-481: "string.quoted.single.go"
-				478-479: "punctuation.definition.string.begin.go" - Data: "'"
-				480-481: "punctuation.definition.string.end.go" - Data: "'"
-			481-482: "punctuation.section.function-block.end.go" - Data: "}"
-		494-502: "variable.other.dot-access.go" - Data: "KeyCtrlD"
-		517-525: "variable.other.dot-access.go" - Data: "KeyPress"
-		525-547: "meta.block.go"
-			525-526: "punctuation.section.function-block.begin.go" - Data: "{"
-			532-536: "constant.language.go" - Data: "true"
-			543-546: "string.quoted.single.go"
-				543-544: "punctuation.definition.string.begin.go" - Data: "'"
-				545-546: "punctuation.definition.string.end.go" - Data: "'"
-			546-547: "punctuation.section.function-block.end.go" - Data: "}"
-		559-567: "variable.other.dot-access.go" - Data: "KeyCtrlE"
-		582-590: "variable.other.dot-access.go" - Data: "KeyPress"
-		590-612: "meta.block.go"
-			590-591: "punctuation.section.function-block.begin.go" - Data: "{"
-			597-601: "constant.language.go" - Data: "true"
-			608-611: "string.quoted.single.go"
-				608-609: "punctuation.definition.string.begin.go" - Data: "'"
-				610-611: "punctuation.definition.string.end.go" - Data: "'"
-			611-612: "punctuation.section.function-block.end.go" - Data: "}"
-		624-632: "variable.other.dot-access.go" - Data: "KeyCtrlF"
-		647-655: "variable.other.dot-access.go" - Data: "KeyPress"
-		655-677: "meta.block.go"
-			655-656: "punctuation.section.function-block.begin.go" - Data: "{"
-			662-666: "constant.language.go" - Data: "true"
-			673-676: "string.quoted.single.go"
-				673-674: "punctuation.definition.string.begin.go" - Data: "'"
-				675-676: "punctuation.definition.string.end.go" - Data: "'"
-			676-677: "punctuation.section.function-block.end.go" - Data: "}"
-		689-697: "variable.other.dot-access.go" - Data: "KeyCtrlG"
-		712-720: "variable.other.dot-access.go" - Data: "KeyPress"
-		720-742: "meta.block.go"
-			720-721: "punctuation.section.function-block.begin.go" - Data: "{"
-			727-731: "constant.language.go" - Data: "true"
-			738-741: "string.quoted.single.go"
-				738-739: "punctuation.definition.string.begin.go" - Data: "'"
-				740-741: "punctuation.definition.string.end.go" - Data: "'"
-			741-742: "punctuation.section.function-block.end.go" - Data: "}"
-		754-762: "variable.other.dot-access.go" - Data: "KeyCtrlH"
-		777-785: "variable.other.dot-access.go" - Data: "KeyPress"
-		785-807: "meta.block.go"
-			785-786: "punctuation.section.function-block.begin.go" - Data: "{"
-			792-796: "constant.language.go" - Data: "true"
-			803-806: "string.quoted.single.go"
-				803-804: "punctuation.definition.string.begin.go" - Data: "'"
-				805-806: "punctuation.definition.string.end.go" - Data: "'"
-			806-807: "punctuation.section.function-block.end.go" - Data: "}"
-		819-827: "variable.other.dot-access.go" - Data: "KeyCtrlJ"
-		842-850: "variable.other.dot-access.go" - Data: "KeyPress"
-		850-872: "meta.block.go"
-			850-851: "punctuation.section.function-block.begin.go" - Data: "{"
-			857-861: "constant.language.go" - Data: "true"
-			868-871: "string.quoted.single.go"
-				868-869: "punctuation.definition.string.begin.go" - Data: "'"
-				870-871: "punctuation.definition.string.end.go" - Data: "'"
-			871-872: "punctuation.section.function-block.end.go" - Data: "}"
-		884-892: "variable.other.dot-access.go" - Data: "KeyCtrlK"
-		907-915: "variable.other.dot-access.go" - Data: "KeyPress"
-		915-937: "meta.block.go"
-			915-916: "punctuation.section.function-block.begin.go" - Data: "{"
-			922-926: "constant.language.go" - Data: "true"
-			933-936: "string.quoted.single.go"
-				933-934: "punctuation.definition.string.begin.go" - Data: "'"
-				935-936: "punctuation.definition.string.end.go" - Data: "'"
-			936-937: "punctuation.section.function-block.end.go" - Data: "}"
-		949-957: "variable.other.dot-access.go" - Data: "KeyCtrlL"
-		972-980: "variable.other.dot-access.go" - Data: "KeyPress"
-		980-1002: "meta.block.go"
-			980-981: "punctuation.section.function-block.begin.go" - Data: "{"
-			987-991: "constant.language.go" - Data: "true"
-			998-1001: "string.quoted.single.go"
-				998-999: "punctuation.definition.string.begin.go" - Data: "'"
-				1000-1001: "punctuation.definition.string.end.go" - Data: "'"
-			1001-1002: "punctuation.section.function-block.end.go" - Data: "}"
-		1014-1022: "variable.other.dot-access.go" - Data: "KeyCtrlN"
-		1037-1045: "variable.other.dot-access.go" - Data: "KeyPress"
-		1045-1067: "meta.block.go"
-			1045-1046: "punctuation.section.function-block.begin.go" - Data: "{"
-			1052-1056: "constant.language.go" - Data: "true"
-			1063-1066: "string.quoted.single.go"
-				1063-1064: "punctuation.definition.string.begin.go" - Data: "'"
-				1065-1066: "punctuation.definition.string.end.go" - Data: "'"
-			1066-1067: "punctuation.section.function-block.end.go" - Data: "}"
-		1079-1087: "variable.other.dot-access.go" - Data: "KeyCtrlO"
-		1102-1110: "variable.other.dot-access.go" - Data: "KeyPress"
-		1110-1132: "meta.block.go"
-			1110-1111: "punctuation.section.function-block.begin.go" - Data: "{"
-			1117-1121: "constant.language.go" - Data: "true"
-			1128-1131: "string.quoted.single.go"
-				1128-1129: "punctuation.definition.string.begin.go" - Data: "'"
-				1130-1131: "punctuation.definition.string.end.go" - Data: "'"
-			1131-1132: "punctuation.section.function-block.end.go" - Data: "}"
-		1144-1152: "variable.other.dot-access.go" - Data: "KeyCtrlP"
-		1167-1175: "variable.other.dot-access.go" - Data: "KeyPress"
-		1175-1197: "meta.block.go"
-			1175-1176: "punctuation.section.function-block.begin.go" - Data: "{"
-			1182-1186: "constant.language.go" - Data: "true"
-			1193-1196: "string.quoted.single.go"
-				1193-1194: "punctuation.definition.string.begin.go" - Data: "'"
-				1195-1196: "punctuation.definition.string.end.go" - Data: "'"
-			1196-1197: "punctuation.section.function-block.end.go" - Data: "}"
-		1209-1217: "variable.other.dot-access.go" - Data: "KeyCtrlQ"
-		1232-1240: "variable.other.dot-access.go" - Data: "KeyPress"
-		1240-1262: "meta.block.go"
-			1240-1241: "punctuation.section.function-block.begin.go" - Data: "{"
-			1247-1251: "constant.language.go" - Data: "true"
-			1258-1261: "string.quoted.single.go"
-				1258-1259: "punctuation.definition.string.begin.go" - Data: "'"
-				1260-1261: "punctuation.definition.string.end.go" - Data: "'"
-			1261-1262: "punctuation.section.function-block.end.go" - Data: "}"
-		1274-1282: "variable.other.dot-access.go" - Data: "KeyCtrlR"
-		1297-1305: "variable.other.dot-access.go" - Data: "KeyPress"
-		1305-1327: "meta.block.go"
-			1305-1306: "punctuation.section.function-block.begin.go" - Data: "{"
-			1312-1316: "constant.language.go" - Data: "true"
-			1323-1326: "string.quoted.single.go"
-				1323-1324: "punctuation.definition.string.begin.go" - Data: "'"
-				1325-1326: "punctuation.definition.string.end.go" - Data: "'"
-			1326-1327: "punctuation.section.function-block.end.go" - Data: "}"
-		1339-1347: "variable.other.dot-access.go" - Data: "KeyCtrlS"
-		1362-1370: "variable.other.dot-access.go" - Data: "KeyPress"
-		1370-1392: "meta.block.go"
-			1370-1371: "punctuation.section.function-block.begin.go" - Data: "{"
-			1377-1381: "constant.language.go" - Data: "true"
-			1388-1391: "string.quoted.single.go"
-				1388-1389: "punctuation.definition.string.begin.go" - Data: "'"
-				1390-1391: "punctuation.definition.string.end.go" - Data: "'"
-			1391-1392: "punctuation.section.function-block.end.go" - Data: "}"
-		1404-1412: "variable.other.dot-access.go" - Data: "KeyCtrlT"
-		1427-1435: "variable.other.dot-access.go" - Data: "KeyPress"
-		1435-1457: "meta.block.go"
-			1435-1436: "punctuation.section.function-block.begin.go" - Data: "{"
-			1442-1446: "constant.language.go" - Data: "true"
-			1453-1456: "string.quoted.single.go"
-				1453-1454: "punctuation.definition.string.begin.go" - Data: "'"
-				1455-1456: "punctuation.definition.string.end.go" - Data: "'"
-			1456-1457: "punctuation.section.function-block.end.go" - Data: "}"
-		1469-1477: "variable.other.dot-access.go" - Data: "KeyCtrlU"
-		1492-1500: "variable.other.dot-access.go" - Data: "KeyPress"
-		1500-1522: "meta.block.go"
-			1500-1501: "punctuation.section.function-block.begin.go" - Data: "{"
-			1507-1511: "constant.language.go" - Data: "true"
-			1518-1521: "string.quoted.single.go"
-				1518-1519: "punctuation.definition.string.begin.go" - Data: "'"
-				1520-1521: "punctuation.definition.string.end.go" - Data: "'"
-			1521-1522: "punctuation.section.function-block.end.go" - Data: "}"
-		1534-1542: "variable.other.dot-access.go" - Data: "KeyCtrlV"
-		1557-1565: "variable.other.dot-access.go" - Data: "KeyPress"
-		1565-1587: "meta.block.go"
-			1565-1566: "punctuation.section.function-block.begin.go" - Data: "{"
-			1572-1576: "constant.language.go" - Data: "true"
-			1583-1586: "string.quoted.single.go"
-				1583-1584: "punctuation.definition.string.begin.go" - Data: "'"
-				1585-1586: "punctuation.definition.string.end.go" - Data: "'"
-			1586-1587: "punctuation.section.function-block.end.go" - Data: "}"
-		1599-1607: "variable.other.dot-access.go" - Data: "KeyCtrlW"
-		1622-1630: "variable.other.dot-access.go" - Data: "KeyPress"
-		1630-1652: "meta.block.go"
-			1630-1631: "punctuation.section.function-block.begin.go" - Data: "{"
-			1637-1641: "constant.language.go" - Data: "true"
-			1648-1651: "string.quoted.single.go"
-				1648-1649: "punctuation.definition.string.begin.go" - Data: "'"
-				1650-1651: "punctuation.definition.string.end.go" - Data: "'"
-			1651-1652: "punctuation.section.function-block.end.go" - Data: "}"
-		1664-1672: "variable.other.dot-access.go" - Data: "KeyCtrlX"
-		1687-1695: "variable.other.dot-access.go" - Data: "KeyPress"
-		1695-1717: "meta.block.go"
-			1695-1696: "punctuation.section.function-block.begin.go" - Data: "{"
-			1702-1706: "constant.language.go" - Data: "true"
-			1713-1716: "string.quoted.single.go"
-				1713-1714: "punctuation.definition.string.begin.go" - Data: "'"
-				1715-1716: "punctuation.definition.string.end.go" - Data: "'"
-			1716-1717: "punctuation.section.function-block.end.go" - Data: "}"
-		1729-1737: "variable.other.dot-access.go" - Data: "KeyCtrlY"
-		1752-1760: "variable.other.dot-access.go" - Data: "KeyPress"
-		1760-1782: "meta.block.go"
-			1760-1761: "punctuation.section.function-block.begin.go" - Data: "{"
-			1767-1771: "constant.language.go" - Data: "true"
-			1778-1781: "string.quoted.single.go"
-				1778-1779: "punctuation.definition.string.begin.go" - Data: "'"
-				1780-1781: "punctuation.definition.string.end.go" - Data: "'"
-			1781-1782: "punctuation.section.function-block.end.go" - Data: "}"
-		1794-1802: "variable.other.dot-access.go" - Data: "KeyCtrlZ"
-		1817-1825: "variable.other.dot-access.go" - Data: "KeyPress"
-		1825-1847: "meta.block.go"
-			1825-1826: "punctuation.section.function-block.begin.go" - Data: "{"
-			1832-1836: "constant.language.go" - Data: "true"
-			1843-1846: "string.quoted.single.go"
-				1843-1844: "punctuation.definition.string.begin.go" - Data: "'"
-				1845-1846: "punctuation.definition.string.end.go" - Data: "'"
-			1846-1847: "punctuation.section.function-block.end.go" - Data: "}"
-		1859-1867: "variable.other.dot-access.go" - Data: "KeyCtrl2"
-		1882-1890: "variable.other.dot-access.go" - Data: "KeyPress"
-		1890-1912: "meta.block.go"
-			1890-1891: "punctuation.section.function-block.begin.go" - Data: "{"
-			1897-1901: "constant.language.go" - Data: "true"
-			1908-1911: "string.quoted.single.go"
-				1908-1909: "punctuation.definition.string.begin.go" - Data: "'"
-				1910-1911: "punctuation.definition.string.end.go" - Data: "'"
-			1911-1912: "punctuation.section.function-block.end.go" - Data: "}"
-		1924-1932: "variable.other.dot-access.go" - Data: "KeyCtrl4"
-		1947-1955: "variable.other.dot-access.go" - Data: "KeyPress"
-		1955-1977: "meta.block.go"
-			1955-1956: "punctuation.section.function-block.begin.go" - Data: "{"
-			1962-1966: "constant.language.go" - Data: "true"
-			1973-1976: "string.quoted.single.go"
-				1973-1974: "punctuation.definition.string.begin.go" - Data: "'"
-				1975-1976: "punctuation.definition.string.end.go" - Data: "'"
-			1976-1977: "punctuation.section.function-block.end.go" - Data: "}"
-		1989-1997: "variable.other.dot-access.go" - Data: "KeyCtrl5"
-		2012-2020: "variable.other.dot-access.go" - Data: "KeyPress"
-		2020-2042: "meta.block.go"
-			2020-2021: "punctuation.section.function-block.begin.go" - Data: "{"
-			2027-2031: "constant.language.go" - Data: "true"
-			2038-2041: "string.quoted.single.go"
-				2038-2039: "punctuation.definition.string.begin.go" - Data: "'"
-				2040-2041: "punctuation.definition.string.end.go" - Data: "'"
-			2041-2042: "punctuation.section.function-block.end.go" - Data: "}"
-		2054-2062: "variable.other.dot-access.go" - Data: "KeyCtrl6"
-		2077-2085: "variable.other.dot-access.go" - Data: "KeyPress"
-		2085-2107: "meta.block.go"
-			2085-2086: "punctuation.section.function-block.begin.go" - Data: "{"
-			2092-2096: "constant.language.go" - Data: "true"
-			2103-2106: "string.quoted.single.go"
-				2103-2104: "punctuation.definition.string.begin.go" - Data: "'"
-				2105-2106: "punctuation.definition.string.end.go" - Data: "'"
-			2106-2107: "punctuation.section.function-block.end.go" - Data: "}"
-		2119-2127: "variable.other.dot-access.go" - Data: "KeyCtrl7"
-		2142-2150: "variable.other.dot-access.go" - Data: "KeyPress"
-		2150-2172: "meta.block.go"
-			2150-2151: "punctuation.section.function-block.begin.go" - Data: "{"
-			2157-2161: "constant.language.go" - Data: "true"
-			2168-2171: "string.quoted.single.go"
-				2168-2169: "punctuation.definition.string.begin.go" - Data: "'"
-				2170-2171: "punctuation.definition.string.end.go" - Data: "'"
-			2171-2172: "punctuation.section.function-block.end.go" - Data: "}"
-		2184-2192: "variable.other.dot-access.go" - Data: "KeyEnter"
-		2207-2215: "variable.other.dot-access.go" - Data: "KeyPress"
-		2215-2235: "meta.block.go"
-			2215-2216: "punctuation.section.function-block.begin.go" - Data: "{"
-			2229-2234: "variable.other.dot-access.go" - Data: "Enter"
-			2234-2235: "punctuation.section.function-block.end.go" - Data: "}"
-		2247-2255: "variable.other.dot-access.go" - Data: "KeySpace"
-		2270-2278: "variable.other.dot-access.go" - Data: "KeyPress"
-		2278-2288: "meta.block.go"
-			2278-2279: "punctuation.section.function-block.begin.go" - Data: "{"
-			2284-2287: "string.quoted.single.go"
-				2284-2285: "punctuation.definition.string.begin.go" - Data: "'"
-				2286-2287: "punctuation.definition.string.end.go" - Data: "'"
-			2287-2288: "punctuation.section.function-block.end.go" - Data: "}"
-		2300-2313: "variable.other.dot-access.go" - Data: "KeyBackspace2"
-		2323-2331: "variable.other.dot-access.go" - Data: "KeyPress"
-		2331-2355: "meta.block.go"
-			2331-2332: "punctuation.section.function-block.begin.go" - Data: "{"
-			2345-2354: "variable.other.dot-access.go" - Data: "Backspace"
-			2354-2355: "punctuation.section.function-block.end.go" - Data: "}"
-		2367-2377: "variable.other.dot-access.go" - Data: "KeyArrowUp"
-		2390-2398: "variable.other.dot-access.go" - Data: "KeyPress"
-		2398-2415: "meta.block.go"
-			2398-2399: "punctuation.section.function-block.begin.go" - Data: "{"
-			2412-2414: "variable.other.dot-access.go" - Data: "Up"
-			2414-2415: "punctuation.section.function-block.end.go" - Data: "}"
-		2427-2439: "variable.other.dot-access.go" - Data: "KeyArrowDown"
-		2450-2458: "variable.other.dot-access.go" - Data: "KeyPress"
-		2458-2477: "meta.block.go"
-			2458-2459: "punctuation.section.function-block.begin.go" - Data: "{"
-			2472-2476: "variable.other.dot-access.go" - Data: "Down"
-			2476-2477: "punctuation.section.function-block.end.go" - Data: "}"
-		2489-2501: "variable.other.dot-access.go" - Data: "KeyArrowLeft"
-		2512-2520: "variable.other.dot-access.go" - Data: "KeyPress"
-		2520-2539: "meta.block.go"
-			2520-2521: "punctuation.section.function-block.begin.go" - Data: "{"
-			2534-2538: "variable.other.dot-access.go" - Data: "Left"
-			2538-2539: "punctuation.section.function-block.end.go" - Data: "}"
-		2551-2564: "variable.other.dot-access.go" - Data: "KeyArrowRight"
-		2574-2582: "variable.other.dot-access.go" - Data: "KeyPress"
-		2582-2602: "meta.block.go"
-			2582-2583: "punctuation.section.function-block.begin.go" - Data: "{"
-			2596-2601: "variable.other.dot-access.go" - Data: "Right"
-			2601-2602: "punctuation.section.function-block.end.go" - Data: "}"
-		2614-2623: "variable.other.dot-access.go" - Data: "KeyDelete"
-		2637-2645: "variable.other.dot-access.go" - Data: "KeyPress"
-		2645-2666: "meta.block.go"
-			2645-2646: "punctuation.section.function-block.begin.go" - Data: "{"
-			2659-2665: "variable.other.dot-access.go" - Data: "Delete"
-			2665-2666: "punctuation.section.function-block.end.go" - Data: "}"
-		2678-2684: "variable.other.dot-access.go" - Data: "KeyEsc"
-		2701-2709: "variable.other.dot-access.go" - Data: "KeyPress"
-		2709-2730: "meta.block.go"
-			2709-2710: "punctuation.section.function-block.begin.go" - Data: "{"
-			2723-2729: "variable.other.dot-access.go" - Data: "Escape"
-			2729-2730: "punctuation.section.function-block.end.go" - Data: "}"
-		2742-2748: "variable.other.dot-access.go" - Data: "KeyTab"
-		2765-2773: "variable.other.dot-access.go" - Data: "KeyPress"
-		2773-2784: "meta.block.go"
-			2773-2774: "punctuation.section.function-block.begin.go" - Data: "{"
-			2779-2783: "string.quoted.single.go"
-				2779-2780: "punctuation.definition.string.begin.go" - Data: "'"
-				2780-2782: "constant.character.escape.go" - Data: "\t"
-				2782-2783: "punctuation.definition.string.end.go" - Data: "'"
-			2783-2784: "punctuation.section.function-block.end.go" - Data: "}"
-		2787-2788: "punctuation.section.block.end.go" - Data: "}"
-	2801-2807: "meta.function-call.go"
-		2801-2802: "punctuation.whitespace.function-call.leading.go" - Data: " "
-		2802-2806: "support.function.any-method.go" - Data: "make"
-	2807-2810: "storage.modifier.go" - Data: "map"
-	2811-2817: "storage.type.go" - Data: "string"
-	2819-2820: "constant.numeric.go" - Data: "2"
-	2829-2838: "variable.other.dot-access.go" - Data: "Attribute"
-	2861-2871: "variable.other.dot-access.go" - Data: "ColorBlack"
-	2893-2903: "variable.other.dot-access.go" - Data: "ColorWhite"
-	2915-2919: "storage.type.go" - Data: "bool"
-	2923-2928: "storage.modifier.go" - Data: "const"
-	2946-2948: "constant.numeric.go" - Data: "20"
-	2950-2954: "keyword.control.go" - Data: "type"
-	2960-2966: "keyword.control.go" - Data: "struct"
-	2967-3077: "meta.block.go"
-		2967-2968: "punctuation.section.block.begin.go" - Data: "{"
-		2985-2988: "storage.modifier.go" - Data: "map"
-		2998-3002: "variable.other.dot-access.go" - Data: "View"
-		3014-3020: "variable.other.dot-access.go" - Data: "Region"
-		3037-3043: "storage.type.go" - Data: "string"
-		3069-3075: "variable.other.dot-access.go" - Data: "Window"
-		3076-3077: "punctuation.section.block.end.go" - Data: "}"
-	3079-3152: "meta.function.receiver.go"
-		3079-3125: "meta.function.receiver.declaration.go"
-			3079-3083: "keyword.control.go" - Data: "func"
-			3084-3106: "entity.name.function.go.full-name"
-				3085-3092: "variable.receiver.go" - Data: "t *tbfe"
-				3094-3106: "entity.name.function.go.name" - Data: "ActiveWindow"
-			3107-3107: "variable.parameters.go" - Data: ""
-			3109-3125: "variable.return-types.go" - Data: "*backend.Window "
-		3125-3152: "meta.block.go"
-			3125-3126: "punctuation.section.function-block.begin.go" - Data: "{"
-			3128-3134: "keyword.control.go" - Data: "return"
-			3137-3150: "variable.other.dot-access.go" - Data: "active_window"
-			3151-3152: "punctuation.section.function-block.end.go" - Data: "}"
-	3154-3309: "meta.function.receiver.go"
-		3154-3213: "meta.function.receiver.declaration.go"
-			3154-3158: "keyword.control.go" - Data: "func"
-			3159-3179: "entity.name.function.go.full-name"
-				3160-3167: "variable.receiver.go" - Data: "t *tbfe"
-				3169-3179: "entity.name.function.go.name" - Data: "ActiveView"
-			3180-3197: "variable.parameters.go" - Data: "w *backend.Window"
-			3199-3213: "variable.return-types.go" - Data: "*backend.View "
-		3213-3309: "meta.block.go"
-			3213-3214: "punctuation.section.function-block.begin.go" - Data: "{"
-			3216-3218: "keyword.control.go" - Data: "if"
-			3224-3227: "constant.language.go" - Data: "nil"
-			3228-3245: "meta.block.go"
-				3228-3229: "punctuation.section.function-block.begin.go" - Data: "{"
-				3232-3238: "keyword.control.go" - Data: "return"
-				3239-3242: "constant.language.go" - Data: "nil"
-				3244-3245: "punctuation.section.function-block.end.go" - Data: "}"
-			3247-3249: "keyword.control.go" - Data: "if"
-			3250-3254: "meta.initialization.short.go"
-				3250-3254: "variable.other.go"
-					3252-3254: "keyword.operator.initialize.go" - Data: ":="
-			3257-3263: "meta.function-call.go"
-				3257-3262: "support.function.any-method.go" - Data: "Views"
-			3265-3269: ""
-				3265-3266: "punctuation.whitespace.support.function.leading.go" - Data: " "
-				3266-3269: "support.function.builtin.go" - Data: "len"
-			3269-3272: "meta.parens.go"
-				3269-3270: "punctuation.section.parens.begin.go" - Data: "("
-				3271-3272: "punctuation.section.parens.end.go" - Data: ")"
-			3275-3276: "constant.numeric.go" - Data: "0"
-			3277-3295: "meta.block.go"
-				3277-3278: "punctuation.section.function-block.begin.go" - Data: "{"
-				3281-3287: "keyword.control.go" - Data: "return"
-				3290-3291: "constant.numeric.go" - Data: "0"
-				3294-3295: "punctuation.section.function-block.end.go" - Data: "}"
-			3297-3303: "keyword.control.go" - Data: "return"
-			3304-3307: "constant.language.go" - Data: "nil"
-			3308-3309: "punctuation.section.function-block.end.go" - Data: "}"
-	3311-5786: "meta.function.receiver.go"
-		3311-3372: "meta.function.receiver.declaration.go"
-			3311-3315: "keyword.control.go" - Data: "func"
-			3316-3336: "entity.name.function.go.full-name"
-				3317-3324: "variable.receiver.go" - Data: "t *tbfe"
-				3326-3336: "entity.name.function.go.name" - Data: "renderView"
-			3337-3370: "variable.parameters.go" - Data: "sx, sy, w, h int, v *backend.View"
-			3372-3372: "variable.return-types.go" - Data: ""
-		3372-5786: "meta.block.go"
-			3372-3373: "punctuation.section.function-block.begin.go" - Data: "{"
-			3375-3381: "meta.initialization.short.go"
-				3375-3381: "variable.other.go"
-					3379-3381: "keyword.operator.initialize.go" - Data: ":="
-			3384-3388: "meta.function-call.go"
-				3384-3387: "support.function.any-method.go" - Data: "Sel"
-			3391-3400: "meta.initialization.short.go"
-				3391-3400: "variable.other.go"
-					3398-3400: "keyword.operator.initialize.go" - Data: ":="
-			3403-3410: "meta.function-call.go"
-				3403-3409: "support.function.any-method.go" - Data: "Buffer"
-			3412-3417: "meta.function-call.go"
-				3412-3416: "support.function.any-method.go" - Data: "Data"
-			3420-3425: "meta.initialization.short.go"
-				3420-3425: "variable.other.go"
-					3423-3425: "keyword.operator.initialize.go" - Data: ":="
-			3428-3442: "meta.function-call.go"
-				3428-3441: "support.function.any-method.go" - Data: "VisibleRegion"
-			3446-3454: "meta.initialization.short.go"
-				3446-3454: "variable.other.go"
-					3452-3454: "keyword.operator.initialize.go" - Data: ":="
-			3463-3469: "meta.function-call.go"
-				3463-3468: "support.function.any-method.go" - Data: "Split"
-			3477-3481: "string.quoted.double.go"
-				3477-3478: "punctuation.definition.string.begin.go" - Data: """
-				3478-3480: "constant.character.escape.go" - Data: "\n"
-				3480-3481: "punctuation.definition.string.end.go" - Data: """
-			3484-3491: "meta.initialization.short.go"
-				3484-3491: "variable.other.go"
-					3489-3491: "keyword.operator.initialize.go" - Data: ":="
-			3494-3501: "meta.function-call.go"
-				3494-3500: "support.function.any-method.go" - Data: "Buffer"
-			3503-3510: "meta.function-call.go"
-				3503-3509: "support.function.any-method.go" - Data: "RowCol"
-			3513-3519: "meta.function-call.go"
-				3513-3518: "support.function.any-method.go" - Data: "Begin"
-			3523-3530: "meta.initialization.short.go"
-				3523-3530: "variable.other.go"
-					3528-3530: "keyword.operator.initialize.go" - Data: ":="
-			3533-3540: "meta.function-call.go"
-				3533-3539: "support.function.any-method.go" - Data: "Buffer"
-			3542-3549: "meta.function-call.go"
-				3542-3548: "support.function.any-method.go" - Data: "RowCol"
-			3552-3556: "meta.function-call.go"
-				3552-3555: "support.function.any-method.go" - Data: "End"
-			3560-3562: "keyword.control.go" - Data: "if"
-			3567-3568: "constant.numeric.go" - Data: "1"
-			3569-3615: "meta.block.go"
-				3569-3570: "punctuation.section.function-block.begin.go" - Data: "{"
-				3581-3582: "constant.numeric.go" - Data: "1"
-				3585-3587: "keyword.control.go" - Data: "if"
-				3594-3612: "meta.block.go"
-					3594-3595: "punctuation.section.function-block.begin.go" - Data: "{"
-					3611-3612: "punctuation.section.function-block.end.go" - Data: "}"
-				3614-3615: "punctuation.section.function-block.end.go" - Data: "}"
-			3617-3623: "meta.initialization.short.go"
-				3617-3623: "variable.other.go"
-					3621-3623: "keyword.operator.initialize.go" - Data: ":="
-			3623-3627: ""
-				3623-3624: "punctuation.whitespace.support.function.leading.go" - Data: " "
-				3624-3627: "support.function.builtin.go" - Data: "len"
-			3627-3657: "meta.parens.go"
-				3627-3628: "punctuation.section.parens.begin.go" - Data: "("
-				3636-3641: "meta.function-call.go"
-					3636-3640: "support.function.any-method.go" - Data: "Join"
-				3652-3656: "string.quoted.double.go"
-					3652-3653: "punctuation.definition.string.begin.go" - Data: """
-					3653-3655: "constant.character.escape.go" - Data: "\n"
-					3655-3656: "punctuation.definition.string.end.go" - Data: """
-				3656-3657: "punctuation.section.parens.end.go" - Data: ")"
-			3680-3688: "meta.initialization.short.go"
-				3680-3688: "variable.other.go"
-					3686-3688: "keyword.operator.initialize.go" - Data: ":="
-			3691-3696: "meta.function-call.go"
-				3691-3695: "support.function.any-method.go" - Data: "rune"
-			3704-3709: "meta.function-call.go"
-				3704-3708: "support.function.any-method.go" - Data: "Join"
-			3716-3720: "string.quoted.double.go"
-				3716-3717: "punctuation.definition.string.begin.go" - Data: """
-				3717-3719: "constant.character.escape.go" - Data: "\n"
-				3719-3720: "punctuation.definition.string.end.go" - Data: """
-			3724-3731: "meta.initialization.short.go"
-				3724-3731: "variable.other.go"
-					3729-3731: "keyword.operator.initialize.go" - Data: ":="
-			3740-3749: "meta.initialization.short.go"
-				3740-3749: "variable.other.go"
-					3747-3749: "keyword.operator.initialize.go" - Data: ":="
-			3763-3770: "meta.initialization.short.go"
-				3763-3770: "variable.other.go"
-					3768-3770: "keyword.operator.initialize.go" - Data: ":="
-			3771-3773: "string.quoted.double.go"
-				3771-3772: "punctuation.definition.string.begin.go" - Data: """
-				3772-3773: "punctuation.definition.string.end.go" - Data: """
-			3773-3780: "meta.function-call.go"
-				3773-3775: "punctuation.whitespace.function-call.leading.go" - Data: "
-	"
-				3775-3778: "support.function.any-method.go" - Data: "var"
-			3793-3799: "storage.type.go" - Data: "string"
-			3840-3855: "meta.initialization.short.go"
-				3840-3855: "variable.other.go"
-					3853-3855: "keyword.operator.initialize.go" - Data: ":="
-			3858-3867: "meta.function-call.go"
-				3858-3866: "support.function.any-method.go" - Data: "Settings"
-			3869-3873: "meta.function-call.go"
-				3869-3872: "support.function.any-method.go" - Data: "Get"
-			3873-3883: "string.quoted.double.go"
-				3873-3874: "punctuation.definition.string.begin.go" - Data: """
-				3882-3883: "punctuation.definition.string.end.go" - Data: """
-			3885-3886: "constant.numeric.go" - Data: "4"
-			3888-3893: "meta.parens.go"
-				3888-3889: "punctuation.section.parens.begin.go" - Data: "("
-				3889-3892: "storage.type.go" - Data: "int"
-				3892-3893: "punctuation.section.parens.end.go" - Data: ")"
-			3895-3897: "keyword.control.go" - Data: "if"
-			3902-3921: "meta.block.go"
-				3902-3903: "punctuation.section.function-block.begin.go" - Data: "{"
-				3917-3918: "constant.numeric.go" - Data: "4"
-				3920-3921: "punctuation.section.function-block.end.go" - Data: "}"
-			3923-3937: "meta.initialization.short.go"
-				3923-3937: "variable.other.go"
-					3935-3937: "keyword.operator.initialize.go" - Data: ":="
-			3946-3959: "variable.other.dot-access.go" - Data: "AttrUnderline"
-			3961-3963: "keyword.control.go" - Data: "if"
-			3964-3972: "meta.initialization.short.go"
-				3964-3972: "variable.other.go"
-					3970-3972: "keyword.operator.initialize.go" - Data: ":="
-			3975-3984: "meta.function-call.go"
-				3975-3983: "support.function.any-method.go" - Data: "Settings"
-			3986-3990: "meta.function-call.go"
-				3986-3989: "support.function.any-method.go" - Data: "Get"
-			3990-4003: "string.quoted.double.go"
-				3990-3991: "punctuation.definition.string.begin.go" - Data: """
-				4002-4003: "punctuation.definition.string.end.go" - Data: """
-			4005-4016: "string.quoted.double.go"
-				4005-4006: "punctuation.definition.string.begin.go" - Data: """
-				4015-4016: "punctuation.definition.string.end.go" - Data: """
-			4018-4026: "meta.parens.go"
-				4018-4019: "punctuation.section.parens.begin.go" - Data: "("
-				4019-4025: "storage.type.go" - Data: "string"
-				4025-4026: "punctuation.section.parens.end.go" - Data: ")"
-			4031-4096: "meta.block.go"
-				4031-4032: "punctuation.section.function-block.begin.go" - Data: "{"
-				4035-4037: "keyword.control.go" - Data: "if"
-				4043-4050: "string.quoted.double.go"
-					4043-4044: "punctuation.definition.string.begin.go" - Data: """
-					4049-4050: "punctuation.definition.string.end.go" - Data: """
-				4051-4093: "meta.block.go"
-					4051-4052: "punctuation.section.function-block.begin.go" - Data: "{"
-					4078-4089: "variable.other.dot-access.go" - Data: "AttrReverse"
-					4092-4093: "punctuation.section.function-block.end.go" - Data: "}"
-				4095-4096: "punctuation.section.function-block.end.go" - Data: "}"
-			4098-4100: "keyword.control.go" - Data: "if"
-			4101-4109: "meta.initialization.short.go"
-				4101-4109: "variable.other.go"
-					4107-4109: "keyword.operator.initialize.go" - Data: ":="
-			4112-4121: "meta.function-call.go"
-				4112-4120: "support.function.any-method.go" - Data: "Settings"
-			4123-4127: "meta.function-call.go"
-				4123-4126: "support.function.any-method.go" - Data: "Get"
-			4127-4148: "string.quoted.double.go"
-				4127-4128: "punctuation.definition.string.begin.go" - Data: """
-				4147-4148: "punctuation.definition.string.end.go" - Data: """
-			4150-4155: "constant.language.go" - Data: "false"
-			4157-4163: "meta.parens.go"
-				4157-4158: "punctuation.section.parens.begin.go" - Data: "("
-				4158-4162: "storage.type.go" - Data: "bool"
-				4162-4163: "punctuation.section.parens.end.go" - Data: ")"
-			4174-4311: "meta.block.go"
-				4174-4175: "punctuation.section.function-block.begin.go" - Data: "{"
-				4178-4180: "keyword.control.go" - Data: "if"
-				4204-4215: "variable.other.dot-access.go" - Data: "AttrReverse"
-				4216-4260: "meta.block.go"
-					4216-4217: "punctuation.section.function-block.begin.go" - Data: "{"
-					4243-4256: "variable.other.dot-access.go" - Data: "AttrUnderline"
-					4259-4260: "punctuation.section.function-block.end.go" - Data: "}"
-				4261-4265: "keyword.control.go" - Data: "else"
-				4266-4308: "meta.block.go"
-					4266-4267: "punctuation.section.function-block.begin.go" - Data: "{"
-					4293-4304: "variable.other.dot-access.go" - Data: "AttrReverse"
-					4307-4308: "punctuation.section.function-block.end.go" - Data: "}"
-				4310-4311: "punctuation.section.function-block.end.go" - Data: "}"
-			4313-4327: "meta.initialization.short.go"
-				4313-4327: "variable.other.go"
-					4325-4327: "keyword.operator.initialize.go" - Data: ":="
-			4328-4332: "constant.language.go" - Data: "true"
-			4334-4336: "keyword.control.go" - Data: "if"
-			4337-4345: "meta.initialization.short.go"
-				4337-4345: "variable.other.go"
-					4343-4345: "keyword.operator.initialize.go" - Data: ":="
-			4348-4357: "meta.function-call.go"
-				4348-4356: "support.function.any-method.go" - Data: "Settings"
-			4359-4363: "meta.function-call.go"
-				4359-4362: "support.function.any-method.go" - Data: "Get"
-			4363-4376: "string.quoted.double.go"
-				4363-4364: "punctuation.definition.string.begin.go" - Data: """
-				4375-4376: "punctuation.definition.string.end.go" - Data: """
-			4378-4382: "constant.language.go" - Data: "true"
-			4384-4390: "meta.parens.go"
-				4384-4385: "punctuation.section.parens.begin.go" - Data: "("
-				4385-4389: "storage.type.go" - Data: "bool"
-				4389-4390: "punctuation.section.parens.end.go" - Data: ")"
-			4395-4417: "meta.block.go"
-				4395-4396: "punctuation.section.function-block.begin.go" - Data: "{"
-				4416-4417: "punctuation.section.function-block.end.go" - Data: "}"
-			4420-4423: "keyword.control.go" - Data: "for"
-			4424-4428: "meta.initialization.short.go"
-				4424-4428: "variable.other.go"
-					4426-4428: "keyword.operator.initialize.go" - Data: ":="
-			4429-4434: "keyword.control.go" - Data: "range"
-			4441-5784: "meta.block.go"
-				4441-4442: "punctuation.section.function-block.begin.go" - Data: "{"
-				4452-4460: "meta.function-call.go"
-					4452-4453: "punctuation.whitespace.function-call.leading.go" - Data: " "
-					4453-4459: "support.function.any-method.go" - Data: "string"
-				4473-4475: "keyword.control.go" - Data: "if"
-				4483-5775: "meta.block.go"
-					4483-4484: "punctuation.section.function-block.begin.go" - Data: "{"
-					4488-4492: "meta.initialization.short.go"
-						4488-4492: "variable.other.go"
-							4490-4492: "keyword.operator.initialize.go" - Data: ":="
-					4498-4502: ""
-						4498-4499: "punctuation.whitespace.support.function.leading.go" - Data: " "
-						4499-4502: "support.function.builtin.go" - Data: "len"
-					4502-4508: "meta.parens.go"
-						4502-4503: "punctuation.section.parens.begin.go" - Data: "("
-						4507-4508: "punctuation.section.parens.end.go" - Data: ")"
-					4512-4516: "meta.initialization.short.go"
-						4512-4516: "variable.other.go"
-							4514-4516: "keyword.operator.initialize.go" - Data: ":="
-					4528-4534: "variable.other.dot-access.go" - Data: "Region"
-					4534-4540: "meta.block.go"
-						4534-4535: "punctuation.section.function-block.begin.go" - Data: "{"
-						4539-4540: "punctuation.section.function-block.end.go" - Data: "}"
-					4544-4553: "meta.initialization.short.go"
-						4544-4553: "variable.other.go"
-							4551-4553: "keyword.operator.initialize.go" - Data: ":="
-					4566-4574: "meta.initialization.short.go"
-						4566-4574: "variable.other.go"
-							4572-4574: "keyword.operator.initialize.go" - Data: ":="
-					4577-4587: "meta.function-call.go"
-						4577-4586: "support.function.any-method.go" - Data: "ScopeName"
-					4593-4595: "keyword.control.go" - Data: "if"
-					4615-5087: "meta.block.go"
-						4615-4616: "punctuation.section.function-block.begin.go" - Data: "{"
-						4677-4682: "meta.initialization.short.go"
-							4677-4682: "variable.other.go"
-								4680-4682: "keyword.operator.initialize.go" - Data: ":="
-						4693-4696: "keyword.control.go" - Data: "for"
-						4696-4700: ""
-							4696-4697: "punctuation.whitespace.support.function.leading.go" - Data: " "
-							4697-4700: "support.function.builtin.go" - Data: "len"
-						4700-4704: "meta.parens.go"
-							4700-4701: "punctuation.section.parens.begin.go" - Data: "("
-							4703-4704: "punctuation.section.parens.end.go" - Data: ")"
-						4707-4708: "constant.numeric.go" - Data: "0"
-						4709-5060: "meta.block.go"
-							4709-4710: "punctuation.section.function-block.begin.go" - Data: "{"
-							4716-4721: "meta.initialization.short.go"
-								4716-4721: "variable.other.go"
-									4719-4721: "keyword.operator.initialize.go" - Data: ":="
-							4730-4734: "meta.initialization.short.go"
-								4730-4734: "variable.other.go"
-									4732-4734: "keyword.operator.initialize.go" - Data: ":="
-							4743-4753: "meta.function-call.go"
-								4743-4752: "support.function.any-method.go" - Data: "LastIndex"
-							4757-4760: "string.quoted.double.go"
-								4757-4758: "punctuation.definition.string.begin.go" - Data: """
-								4759-4760: "punctuation.definition.string.end.go" - Data: """
-							4767-4769: "keyword.control.go" - Data: "if"
-							4776-4777: "constant.numeric.go" - Data: "1"
-							4778-4806: "meta.block.go"
-								4778-4779: "punctuation.section.function-block.begin.go" - Data: "{"
-								4796-4797: "constant.numeric.go" - Data: "1"
-								4805-4806: "punctuation.section.function-block.end.go" - Data: "}"
-							4812-4814: "keyword.control.go" - Data: "if"
-							4815-4823: "meta.initialization.short.go"
-								4815-4823: "variable.other.go"
-									4821-4823: "keyword.operator.initialize.go" - Data: ":="
-							4842-4888: "meta.block.go"
-								4842-4843: "punctuation.section.function-block.begin.go" - Data: "{"
-								4861-4862: "constant.numeric.go" - Data: "0"
-								4867-4868: "constant.numeric.go" - Data: "1"
-								4876-4881: "keyword.control.go" - Data: "break"
-								4887-4888: "punctuation.section.function-block.end.go" - Data: "}"
-							4894-4896: "keyword.control.go" - Data: "if"
-							4897-4902: "meta.initialization.short.go"
-								4897-4902: "variable.other.go"
-									4900-4902: "keyword.operator.initialize.go" - Data: ":="
-							4911-4921: "meta.function-call.go"
-								4911-4920: "support.function.any-method.go" - Data: "LastIndex"
-							4925-4928: "string.quoted.double.go"
-								4925-4926: "punctuation.definition.string.begin.go" - Data: """
-								4927-4928: "punctuation.definition.string.end.go" - Data: """
-							4938-4939: "constant.numeric.go" - Data: "1"
-							4940-4960: "meta.block.go"
-								4940-4941: "punctuation.section.function-block.begin.go" - Data: "{"
-								4948-4953: "keyword.control.go" - Data: "break"
-								4959-4960: "punctuation.section.function-block.end.go" - Data: "}"
-							4961-4965: "keyword.control.go" - Data: "else"
-							4966-4968: "keyword.control.go" - Data: "if"
-							4976-5002: "meta.block.go"
-								4976-4977: "punctuation.section.function-block.begin.go" - Data: "{"
-								5001-5002: "punctuation.section.function-block.end.go" - Data: "}"
-							5003-5007: "keyword.control.go" - Data: "else"
-							5008-5054: "meta.block.go"
-								5008-5009: "punctuation.section.function-block.begin.go" - Data: "{"
-								5029-5039: "meta.function-call.go"
-									5029-5038: "support.function.any-method.go" - Data: "TrimSpace"
-								5053-5054: "punctuation.section.function-block.end.go" - Data: "}"
-							5059-5060: "punctuation.section.function-block.end.go" - Data: "}"
-						5086-5087: "punctuation.section.function-block.end.go" - Data: "}"
-					5088-5092: "keyword.control.go" - Data: "else"
-					5093-5121: "meta.block.go"
-						5093-5094: "punctuation.section.function-block.begin.go" - Data: "{"
-						5120-5121: "punctuation.section.function-block.end.go" - Data: "}"
-					5125-5128: "keyword.control.go" - Data: "for"
-					5129-5137: "meta.initialization.short.go"
-						5129-5137: "variable.other.go"
-							5135-5137: "keyword.operator.initialize.go" - Data: ":="
-					5138-5143: "keyword.control.go" - Data: "range"
-					5148-5156: "meta.function-call.go"
-						5148-5155: "support.function.any-method.go" - Data: "Regions"
-					5158-5421: "meta.block.go"
-						5158-5159: "punctuation.section.function-block.begin.go" - Data: "{"
-						5164-5166: "keyword.control.go" - Data: "if"
-						5170-5171: "variable.other.dot-access.go" - Data: "B"
-						5177-5178: "variable.other.dot-access.go" - Data: "B"
-						5179-5343: "meta.block.go"
-							5179-5180: "punctuation.section.function-block.begin.go" - Data: "{"
-							5186-5188: "keyword.control.go" - Data: "if"
-							5211-5326: "meta.block.go"
-								5211-5212: "punctuation.section.function-block.begin.go" - Data: "{"
-								5219-5221: "keyword.control.go" - Data: "if"
-								5225-5234: "meta.function-call.go"
-									5225-5233: "support.function.any-method.go" - Data: "Contains"
-								5237-5279: "meta.block.go"
-									5237-5238: "punctuation.section.function-block.begin.go" - Data: "{"
-									5260-5271: "variable.other.dot-access.go" - Data: "AttrReverse"
-									5278-5279: "punctuation.section.function-block.end.go" - Data: "}"
-								5280-5284: "keyword.control.go" - Data: "else"
-								5285-5319: "meta.block.go"
-									5285-5286: "punctuation.section.function-block.begin.go" - Data: "{"
-									5318-5319: "punctuation.section.function-block.end.go" - Data: "}"
-								5325-5326: "punctuation.section.function-block.end.go" - Data: "}"
-							5332-5337: "keyword.control.go" - Data: "break"
-							5342-5343: "punctuation.section.function-block.end.go" - Data: "}"
-						5344-5348: "keyword.control.go" - Data: "else"
-						5349-5351: "keyword.control.go" - Data: "if"
-						5355-5364: "meta.function-call.go"
-							5355-5363: "support.function.any-method.go" - Data: "Contains"
-						5367-5416: "meta.block.go"
-							5367-5368: "punctuation.section.function-block.begin.go" - Data: "{"
-							5388-5399: "variable.other.dot-access.go" - Data: "AttrReverse"
-							5405-5410: "keyword.control.go" - Data: "break"
-							5415-5416: "punctuation.section.function-block.end.go" - Data: "}"
-						5420-5421: "punctuation.section.function-block.end.go" - Data: "}"
-					5425-5427: "keyword.control.go" - Data: "if"
-					5440-5444: "string.quoted.single.go"
-						5440-5441: "punctuation.definition.string.begin.go" - Data: "'"
-						5441-5443: "constant.character.escape.go" - Data: "\t"
-						5443-5444: "punctuation.definition.string.end.go" - Data: "'"
-					5445-5592: "meta.block.go"
-						5445-5446: "punctuation.section.function-block.begin.go" - Data: "{"
-						5451-5457: "meta.initialization.short.go"
-							5451-5457: "variable.other.go"
-								5455-5457: "keyword.operator.initialize.go" - Data: ":="
-						5458-5481: "meta.parens.go"
-							5458-5459: "punctuation.section.parens.begin.go" - Data: "("
-							5463-5464: "constant.numeric.go" - Data: "1"
-							5479-5480: "constant.numeric.go" - Data: "1"
-							5480-5481: "punctuation.section.parens.end.go" - Data: ")"
-						5486-5500: "meta.parens.go"
-							5486-5487: "punctuation.section.parens.begin.go" - Data: "("
-							5498-5499: "constant.numeric.go" - Data: "1"
-							5499-5500: "punctuation.section.parens.end.go" - Data: ")"
-						5506-5509: "keyword.control.go" - Data: "for"
-						5518-5574: "meta.block.go"
-							5518-5519: "punctuation.section.function-block.begin.go" - Data: "{"
-							5533-5541: "meta.function-call.go"
-								5533-5540: "support.function.any-method.go" - Data: "SetCell"
-							5547-5550: "string.quoted.single.go"
-								5547-5548: "punctuation.definition.string.begin.go" - Data: "'"
-								5549-5550: "punctuation.definition.string.end.go" - Data: "'"
-							5573-5574: "punctuation.section.function-block.end.go" - Data: "}"
-						5579-5587: "keyword.control.go" - Data: "continue"
-						5591-5592: "punctuation.section.function-block.end.go" - Data: "}"
-					5593-5597: "keyword.control.go" - Data: "else"
-					5598-5600: "keyword.control.go" - Data: "if"
-					5613-5617: "string.quoted.single.go"
-						5613-5614: "punctuation.definition.string.begin.go" - Data: "'"
-						5614-5616: "constant.character.escape.go" - Data: "\n"
-						5616-5617: "punctuation.definition.string.end.go" - Data: "'"
-					5618-5728: "meta.block.go"
-						5618-5619: "punctuation.section.function-block.begin.go" - Data: "{"
-						5632-5640: "meta.function-call.go"
-							5632-5639: "support.function.any-method.go" - Data: "SetCell"
-						5646-5649: "string.quoted.single.go"
-							5646-5647: "punctuation.definition.string.begin.go" - Data: "'"
-							5648-5649: "punctuation.definition.string.end.go" - Data: "'"
-						5682-5684: "keyword.control.go" - Data: "if"
-						5692-5710: "meta.block.go"
-							5692-5693: "punctuation.section.function-block.begin.go" - Data: "{"
-							5699-5704: "keyword.control.go" - Data: "break"
-							5709-5710: "punctuation.section.function-block.end.go" - Data: "}"
-						5715-5723: "keyword.control.go" - Data: "continue"
-						5727-5728: "punctuation.section.function-block.end.go" - Data: "}"
-					5740-5748: "meta.function-call.go"
-						5740-5747: "support.function.any-method.go" - Data: "SetCell"
-					5774-5775: "punctuation.section.function-block.end.go" - Data: "}"
-				5783-5784: "punctuation.section.function-block.end.go" - Data: "}"
-			5785-5786: "punctuation.section.function-block.end.go" - Data: "}"
-	5788-6265: "meta.function.receiver.go"
-		5788-5864: "meta.function.receiver.declaration.go"
-			5788-5792: "keyword.control.go" - Data: "func"
-			5793-5807: "entity.name.function.go.full-name"
-				5794-5801: "variable.receiver.go" - Data: "t *tbfe"
-				5803-5807: "entity.name.function.go.name" - Data: "clip"
-			5808-5844: "variable.parameters.go" - Data: "v *backend.View, r primitives.Region"
-			5846-5864: "variable.return-types.go" - Data: "primitives.Region "
-		5864-6265: "meta.block.go"
-			5864-5865: "punctuation.section.function-block.begin.go" - Data: "{"
-			5867-5874: "meta.initialization.short.go"
-				5867-5874: "variable.other.go"
-					5872-5874: "keyword.operator.initialize.go" - Data: ":="
-			5877-5884: "meta.function-call.go"
-				5877-5883: "support.function.any-method.go" - Data: "Buffer"
-			5886-5893: "meta.function-call.go"
-				5886-5892: "support.function.any-method.go" - Data: "RowCol"
-			5895-5901: "meta.function-call.go"
-				5895-5900: "support.function.any-method.go" - Data: "Begin"
-			5905-5912: "meta.initialization.short.go"
-				5905-5912: "variable.other.go"
-					5910-5912: "keyword.operator.initialize.go" - Data: ":="
-			5915-5922: "meta.function-call.go"
-				5915-5921: "support.function.any-method.go" - Data: "Buffer"
-			5924-5931: "meta.function-call.go"
-				5924-5930: "support.function.any-method.go" - Data: "RowCol"
-			5933-5937: "meta.function-call.go"
-				5933-5936: "support.function.any-method.go" - Data: "End"
-			5942-5949: "meta.initialization.short.go"
-				5942-5949: "variable.other.go"
-					5947-5949: "keyword.operator.initialize.go" - Data: ":="
-			5958-5963: "meta.function-call.go"
-				5958-5962: "support.function.any-method.go" - Data: "Size"
-			5987-5989: "keyword.control.go" - Data: "if"
-			5998-6014: "meta.block.go"
-				5998-5999: "punctuation.section.function-block.begin.go" - Data: "{"
-				6013-6014: "punctuation.section.function-block.end.go" - Data: "}"
-			6016-6018: "keyword.control.go" - Data: "if"
-			6019-6027: "meta.initialization.short.go"
-				6019-6027: "variable.other.go"
-					6025-6027: "keyword.operator.initialize.go" - Data: ":="
-			6030-6037: "meta.function-call.go"
-				6030-6036: "support.function.any-method.go" - Data: "Buffer"
-			6039-6046: "meta.function-call.go"
-				6039-6045: "support.function.any-method.go" - Data: "RowCol"
-			6048-6055: "meta.function-call.go"
-				6048-6054: "support.function.any-method.go" - Data: "Buffer"
-			6057-6067: "meta.function-call.go"
-				6057-6066: "support.function.any-method.go" - Data: "TextPoint"
-			6070-6071: "constant.numeric.go" - Data: "1"
-			6082-6095: "meta.block.go"
-				6082-6083: "punctuation.section.function-block.begin.go" - Data: "{"
-				6094-6095: "punctuation.section.function-block.end.go" - Data: "}"
-			6097-6099: "keyword.control.go" - Data: "if"
-			6108-6124: "meta.block.go"
-				6108-6109: "punctuation.section.function-block.begin.go" - Data: "{"
-				6123-6124: "punctuation.section.function-block.end.go" - Data: "}"
-			6126-6128: "keyword.control.go" - Data: "if"
-			6133-6134: "constant.numeric.go" - Data: "1"
-			6135-6147: "meta.block.go"
-				6135-6136: "punctuation.section.function-block.begin.go" - Data: "{"
-				6143-6144: "constant.numeric.go" - Data: "1"
-				6146-6147: "punctuation.section.function-block.end.go" - Data: "}"
-			6151-6152: "variable.other.dot-access.go" - Data: "A"
-			6157-6164: "meta.function-call.go"
-				6157-6163: "support.function.any-method.go" - Data: "Buffer"
-			6166-6171: "meta.function-call.go"
-				6166-6170: "support.function.any-method.go" - Data: "Line"
-			6173-6180: "meta.function-call.go"
-				6173-6179: "support.function.any-method.go" - Data: "Buffer"
-			6182-6192: "meta.function-call.go"
-				6182-6191: "support.function.any-method.go" - Data: "TextPoint"
-			6195-6196: "constant.numeric.go" - Data: "1"
-			6199-6200: "variable.other.dot-access.go" - Data: "A"
-			6204-6205: "variable.other.dot-access.go" - Data: "B"
-			6210-6217: "meta.function-call.go"
-				6210-6216: "support.function.any-method.go" - Data: "Buffer"
-			6219-6224: "meta.function-call.go"
-				6219-6223: "support.function.any-method.go" - Data: "Line"
-			6226-6233: "meta.function-call.go"
-				6226-6232: "support.function.any-method.go" - Data: "Buffer"
-			6235-6245: "meta.function-call.go"
-				6235-6244: "support.function.any-method.go" - Data: "TextPoint"
-			6248-6249: "constant.numeric.go" - Data: "1"
-			6252-6253: "variable.other.dot-access.go" - Data: "B"
-			6255-6261: "keyword.control.go" - Data: "return"
-			6264-6265: "punctuation.section.function-block.end.go" - Data: "}"
-	6267-6409: "meta.function.receiver.go"
-		6267-6325: "meta.function.receiver.declaration.go"
-			6267-6271: "keyword.control.go" - Data: "func"
-			6272-6286: "entity.name.function.go.full-name"
-				6273-6280: "variable.receiver.go" - Data: "t *tbfe"
-				6282-6286: "entity.name.function.go.name" - Data: "Show"
-			6287-6323: "variable.parameters.go" - Data: "v *backend.View, r primitives.Region"
-			6325-6325: "variable.return-types.go" - Data: ""
-		6325-6409: "meta.block.go"
-			6325-6326: "punctuation.section.function-block.begin.go" - Data: "{"
-			6330-6343: "variable.other.dot-access.go" - Data: "visibleregion"
-			6351-6356: "meta.function-call.go"
-				6351-6355: "support.function.any-method.go" - Data: "clip"
-			6370-6376: "variable.other.dot-access.go" - Data: "Region"
-			6376-6406: "meta.block.go"
-				6376-6377: "punctuation.section.function-block.begin.go" - Data: "{"
-				6379-6385: "meta.function-call.go"
-					6379-6384: "support.function.any-method.go" - Data: "Begin"
-				6390-6397: "meta.function-call.go"
-					6390-6396: "support.function.any-method.go" - Data: "Buffer"
-				6399-6404: "meta.function-call.go"
-					6399-6403: "support.function.any-method.go" - Data: "Size"
-				6405-6406: "punctuation.section.function-block.end.go" - Data: "}"
-			6408-6409: "punctuation.section.function-block.end.go" - Data: "}"
-	6411-6605: "meta.function.receiver.go"
-		6411-6475: "meta.function.receiver.declaration.go"
-			6411-6415: "keyword.control.go" - Data: "func"
-			6416-6439: "entity.name.function.go.full-name"
-				6417-6424: "variable.receiver.go" - Data: "t *tbfe"
-				6426-6439: "entity.name.function.go.name" - Data: "VisibleRegion"
-			6440-6455: "variable.parameters.go" - Data: "v *backend.View"
-			6457-6475: "variable.return-types.go" - Data: "primitives.Region "
-		6475-6605: "meta.block.go"
-			6475-6476: "punctuation.section.function-block.begin.go" - Data: "{"
-			6478-6480: "keyword.control.go" - Data: "if"
-			6481-6489: "meta.initialization.short.go"
-				6481-6489: "variable.other.go"
-					6487-6489: "keyword.operator.initialize.go" - Data: ":="
-			6492-6505: "variable.other.dot-access.go" - Data: "visibleregion"
-			6513-6528: "meta.block.go"
-				6513-6514: "punctuation.section.function-block.begin.go" - Data: "{"
-				6517-6523: "keyword.control.go" - Data: "return"
-				6527-6528: "punctuation.section.function-block.end.go" - Data: "}"
-			6529-6533: "keyword.control.go" - Data: "else"
-			6534-6603: "meta.block.go"
-				6534-6535: "punctuation.section.function-block.begin.go" - Data: "{"
-				6540-6545: "meta.function-call.go"
-					6540-6544: "support.function.any-method.go" - Data: "Show"
-				6559-6565: "variable.other.dot-access.go" - Data: "Region"
-				6565-6571: "meta.block.go"
-					6565-6566: "punctuation.section.function-block.begin.go" - Data: "{"
-					6566-6567: "constant.numeric.go" - Data: "0"
-					6569-6570: "constant.numeric.go" - Data: "0"
-					6570-6571: "punctuation.section.function-block.end.go" - Data: "}"
-				6575-6581: "keyword.control.go" - Data: "return"
-				6584-6597: "variable.other.dot-access.go" - Data: "visibleregion"
-				6602-6603: "punctuation.section.function-block.end.go" - Data: "}"
-			6604-6605: "punctuation.section.function-block.end.go" - Data: "}"
-	6607-6675: "meta.function.receiver.go"
-		6607-6648: "meta.function.receiver.declaration.go"
-			6607-6611: "keyword.control.go" - Data: "func"
-			6612-6635: "entity.name.function.go.full-name"
-				6613-6620: "variable.receiver.go" - Data: "t *tbfe"
-				6622-6635: "entity.name.function.go.name" - Data: "StatusMessage"
-			6636-6646: "variable.parameters.go" - Data: "msg string"
-			6648-6648: "variable.return-types.go" - Data: ""
-		6648-6675: "meta.block.go"
-			6648-6649: "punctuation.section.function-block.begin.go" - Data: "{"
-			6653-6667: "variable.other.dot-access.go" - Data: "status_message"
-			6674-6675: "punctuation.section.function-block.end.go" - Data: "}"
-	6677-6739: "meta.function.receiver.go"
-		6677-6717: "meta.function.receiver.declaration.go"
-			6677-6681: "keyword.control.go" - Data: "func"
-			6682-6704: "entity.name.function.go.full-name"
-				6683-6690: "variable.receiver.go" - Data: "t *tbfe"
-				6692-6704: "entity.name.function.go.name" - Data: "ErrorMessage"
-			6705-6715: "variable.parameters.go" - Data: "msg string"
-			6717-6717: "variable.return-types.go" - Data: ""
-		6717-6739: "meta.block.go"
-			6717-6718: "punctuation.section.function-block.begin.go" - Data: "{"
-			6727-6733: "meta.function-call.go"
-				6727-6732: "support.function.any-method.go" - Data: "Error"
-			6738-6739: "punctuation.section.function-block.end.go" - Data: "}"
-	6741-6776: ""
-		6741-6776: "comment.line.double-slash.go"
-			6741-6743: "punctuation.definition.comment.go" - Data: "//"
-	6776-6838: "meta.function.receiver.go"
-		6776-6817: "meta.function.receiver.declaration.go"
-			6776-6780: "keyword.control.go" - Data: "func"
-			6781-6804: "entity.name.function.go.full-name"
-				6782-6789: "variable.receiver.go" - Data: "t *tbfe"
-				6791-6804: "entity.name.function.go.name" - Data: "MessageDialog"
-			6805-6815: "variable.parameters.go" - Data: "msg string"
-			6817-6817: "variable.return-types.go" - Data: ""
-		6817-6838: "meta.block.go"
-			6817-6818: "punctuation.section.function-block.begin.go" - Data: "{"
-			6827-6832: "meta.function-call.go"
-				6827-6831: "support.function.any-method.go" - Data: "Info"
-			6837-6838: "punctuation.section.function-block.end.go" - Data: "}"
-	6840-6875: ""
-		6840-6875: "comment.line.double-slash.go"
-			6840-6842: "punctuation.definition.comment.go" - Data: "//"
-	6875-6946: "meta.function.receiver.go"
-		6875-6921: "meta.function.receiver.declaration.go"
-			6875-6879: "keyword.control.go" - Data: "func"
-			6880-6904: "entity.name.function.go.full-name"
-				6881-6888: "variable.receiver.go" - Data: "t *tbfe"
-				6890-6904: "entity.name.function.go.name" - Data: "OkCancelDialog"
-			6905-6919: "variable.parameters.go" - Data: "msg, ok string"
-			6921-6921: "variable.return-types.go" - Data: ""
-		6921-6946: "meta.block.go"
-			6921-6922: "punctuation.section.function-block.begin.go" - Data: "{"
-			6931-6936: "meta.function-call.go"
-				6931-6935: "support.function.any-method.go" - Data: "Info"
-			6945-6946: "punctuation.section.function-block.end.go" - Data: "}"
-	6948-7089: "meta.function.receiver.go"
-		6948-7008: "meta.function.receiver.declaration.go"
-			6948-6952: "keyword.control.go" - Data: "func"
-			6953-6969: "entity.name.function.go.full-name"
-				6954-6961: "variable.receiver.go" - Data: "t *tbfe"
-				6963-6969: "entity.name.function.go.name" - Data: "scroll"
-			6970-7006: "variable.parameters.go" - Data: "b *primitives.Buffer, pos, delta int"
-			7008-7008: "variable.return-types.go" - Data: ""
-		7008-7089: "meta.block.go"
-			7008-7009: "punctuation.section.function-block.begin.go" - Data: "{"
-			7013-7018: "meta.function-call.go"
-				7013-7017: "support.function.any-method.go" - Data: "Show"
-			7026-7036: "meta.function-call.go"
-				7026-7035: "support.function.any-method.go" - Data: "GetEditor"
-			7038-7046: "meta.function-call.go"
-				7038-7045: "support.function.any-method.go" - Data: "Console"
-			7060-7066: "variable.other.dot-access.go" - Data: "Region"
-			7066-7086: "meta.block.go"
-				7066-7067: "punctuation.section.function-block.begin.go" - Data: "{"
-				7069-7074: "meta.function-call.go"
-					7069-7073: "support.function.any-method.go" - Data: "Size"
-				7079-7084: "meta.function-call.go"
-					7079-7083: "support.function.any-method.go" - Data: "Size"
-				7085-7086: "punctuation.section.function-block.end.go" - Data: "}"
-			7088-7089: "punctuation.section.function-block.end.go" - Data: "}"
-	7091-11205: "meta.function.receiver.go"
-		7091-7113: "meta.function.receiver.declaration.go"
-			7091-7095: "keyword.control.go" - Data: "func"
-			7096-7110: "entity.name.function.go.full-name"
-				7097-7104: "variable.receiver.go" - Data: "t *tbfe"
-				7106-7110: "entity.name.function.go.name" - Data: "loop"
-			7111-7111: "variable.parameters.go" - Data: ""
-			7113-7113: "variable.return-types.go" - Data: ""
-		7113-11205: "meta.block.go"
-			7113-7114: "punctuation.section.function-block.begin.go" - Data: "{"
-			7116-7121: "meta.initialization.short.go"
-				7116-7121: "variable.other.go"
-					7119-7121: "keyword.operator.initialize.go" - Data: ":="
-			7130-7140: "meta.function-call.go"
-				7130-7139: "support.function.any-method.go" - Data: "GetEditor"
-			7146-7158: "meta.function-call.go"
-				7146-7157: "support.function.any-method.go" - Data: "SetFrontend"
-			7161-7162: ""
-				7161-7162: "punctuation.whitespace.comment.leading.go" - Data: "	"
-			7162-7182: ""
-				7162-7182: "comment.line.double-slash.go"
-					7162-7164: "punctuation.definition.comment.go" - Data: "//"
-			7182-7183: ""
-				7182-7183: "punctuation.whitespace.comment.leading.go" - Data: "	"
-			7183-7206: ""
-				7183-7206: "comment.line.double-slash.go"
-					7183-7185: "punctuation.definition.comment.go" - Data: "//"
-			7207-7211: "meta.initialization.short.go"
-				7207-7211: "variable.other.go"
-					7209-7211: "keyword.operator.initialize.go" - Data: ":="
-			7215-7223: "meta.function-call.go"
-				7215-7222: "support.function.any-method.go" - Data: "Console"
-			7224-7231: "meta.function-call.go"
-				7224-7226: "punctuation.whitespace.function-call.leading.go" - Data: "
-	"
-				7226-7229: "support.function.any-method.go" - Data: "var"
-			7250-7255: "variable.other.dot-access.go" - Data: "Theme"
-			7261-7263: "keyword.control.go" - Data: "if"
-			7264-7273: "meta.initialization.short.go"
-				7264-7273: "variable.other.go"
-					7271-7273: "keyword.operator.initialize.go" - Data: ":="
-			7281-7290: "meta.function-call.go"
-				7281-7289: "support.function.any-method.go" - Data: "ReadFile"
-			7290-7350: "string.quoted.double.go"
-				7290-7291: "punctuation.definition.string.begin.go" - Data: """
-				7349-7350: "punctuation.definition.string.end.go" - Data: """
-			7360-7363: "constant.language.go" - Data: "nil"
-			7364-7433: "meta.block.go"
-				7364-7365: "punctuation.section.function-block.begin.go" - Data: "{"
-				7375-7381: "meta.function-call.go"
-					7375-7380: "support.function.any-method.go" - Data: "Error"
-				7381-7424: "string.quoted.double.go"
-					7381-7382: "punctuation.definition.string.begin.go" - Data: """
-					7421-7423: "constant.other.placeholder.go" - Data: "%s"
-					7423-7424: "punctuation.definition.string.end.go" - Data: """
-				7432-7433: "punctuation.section.function-block.end.go" - Data: "}"
-			7434-7438: "keyword.control.go" - Data: "else"
-			7439-7441: "keyword.control.go" - Data: "if"
-			7442-7448: "meta.initialization.short.go"
-				7442-7448: "variable.other.go"
-					7446-7448: "keyword.operator.initialize.go" - Data: ":="
-			7457-7467: "meta.function-call.go"
-				7457-7466: "support.function.any-method.go" - Data: "LoadPlist"
-			7487-7490: "constant.language.go" - Data: "nil"
-			7491-7560: "meta.block.go"
-				7491-7492: "punctuation.section.function-block.begin.go" - Data: "{"
-				7502-7508: "meta.function-call.go"
-					7502-7507: "support.function.any-method.go" - Data: "Error"
-				7508-7551: "string.quoted.double.go"
-					7508-7509: "punctuation.definition.string.begin.go" - Data: """
-					7548-7550: "constant.other.placeholder.go" - Data: "%s"
-					7550-7551: "punctuation.definition.string.end.go" - Data: """
-				7559-7560: "punctuation.section.function-block.end.go" - Data: "}"
-			7560-7568: "meta.function-call.go"
-				7560-7563: "punctuation.whitespace.function-call.leading.go" - Data: "
-
-	"
-				7563-7566: "support.function.any-method.go" - Data: "var"
-			7578-7584: "meta.function-call.go"
-				7578-7579: "punctuation.whitespace.function-call.leading.go" - Data: " "
-				7579-7583: "support.function.any-method.go" - Data: "func"
-			7597-7602: "variable.other.dot-access.go" - Data: "Color"
-			7612-7621: "variable.other.dot-access.go" - Data: "Attribute"
-			7633-7638: ""
-				7633-7634: "punctuation.whitespace.support.function.leading.go" - Data: " "
-				7634-7638: "support.function.builtin.go" - Data: "make"
-			7638-7661: "meta.parens.go"
-				7638-7639: "punctuation.section.parens.begin.go" - Data: "("
-				7649-7652: "variable.other.dot-access.go" - Data: "RGB"
-				7654-7655: "constant.numeric.go" - Data: "0"
-				7657-7660: "constant.numeric.go" - Data: "256"
-				7660-7661: "punctuation.section.parens.end.go" - Data: ")"
-			7672-7676: "storage.type.go" - Data: "bool"
-			7682-7684: "keyword.control.go" - Data: "if"
-			7685-7691: "meta.initialization.short.go"
-				7685-7691: "variable.other.go"
-					7689-7691: "keyword.operator.initialize.go" - Data: ":="
-			7700-7713: "meta.function-call.go"
-				7700-7712: "support.function.any-method.go" - Data: "SetColorMode"
-			7721-7733: "variable.other.dot-access.go" - Data: "ColorMode256"
-			7743-7746: "constant.language.go" - Data: "nil"
-			7747-7807: "meta.block.go"
-				7747-7748: "punctuation.section.function-block.begin.go" - Data: "{"
-				7758-7764: "meta.function-call.go"
-					7758-7763: "support.function.any-method.go" - Data: "Error"
-				7764-7798: "string.quoted.double.go"
-					7764-7765: "punctuation.definition.string.begin.go" - Data: """
-					7795-7797: "constant.other.placeholder.go" - Data: "%s"
-					7797-7798: "punctuation.definition.string.end.go" - Data: """
-				7806-7807: "punctuation.section.function-block.end.go" - Data: "}"
-			7808-7812: "keyword.control.go" - Data: "else"
-			7813-7873: "meta.block.go"
-				7813-7814: "punctuation.section.function-block.begin.go" - Data: "{"
-				7824-7830: "meta.function-call.go"
-					7824-7829: "support.function.any-method.go" - Data: "Debug"
-				7830-7852: "string.quoted.double.go"
-					7830-7831: "punctuation.definition.string.begin.go" - Data: """
-					7851-7852: "punctuation.definition.string.end.go" - Data: """
-				7866-7870: "constant.language.go" - Data: "true"
-				7872-7873: "punctuation.section.function-block.end.go" - Data: "}"
-			7876-7878: "keyword.control.go" - Data: "if"
-			7888-8749: "meta.block.go"
-				7888-7889: "punctuation.section.function-block.begin.go" - Data: "{"
-				7903-7905: "constant.numeric.go" - Data: "10"
-				7907-7936: ""
-					7907-7936: "comment.line.double-slash.go"
-						7907-7909: "punctuation.definition.comment.go" - Data: "//"
-				7950-7960: "variable.other.dot-access.go" - Data: "ColorBlack"
-				7972-7975: "variable.other.dot-access.go" - Data: "RGB"
-				7975-7984: "meta.block.go"
-					7975-7976: "punctuation.section.function-block.begin.go" - Data: "{"
-					7976-7977: "constant.numeric.go" - Data: "0"
-					7979-7980: "constant.numeric.go" - Data: "0"
-					7982-7983: "constant.numeric.go" - Data: "0"
-					7983-7984: "punctuation.section.function-block.end.go" - Data: "}"
-				7999-8009: "variable.other.dot-access.go" - Data: "ColorWhite"
-				8021-8024: "variable.other.dot-access.go" - Data: "RGB"
-				8024-8039: "meta.block.go"
-					8024-8025: "punctuation.section.function-block.begin.go" - Data: "{"
-					8025-8028: "constant.numeric.go" - Data: "255"
-					8030-8033: "constant.numeric.go" - Data: "255"
-					8035-8038: "constant.numeric.go" - Data: "255"
-					8038-8039: "punctuation.section.function-block.end.go" - Data: "}"
-				8054-8062: "variable.other.dot-access.go" - Data: "ColorRed"
-				8074-8077: "variable.other.dot-access.go" - Data: "RGB"
-				8077-8088: "meta.block.go"
-					8077-8078: "punctuation.section.function-block.begin.go" - Data: "{"
-					8078-8081: "constant.numeric.go" - Data: "255"
-					8083-8084: "constant.numeric.go" - Data: "0"
-					8086-8087: "constant.numeric.go" - Data: "0"
-					8087-8088: "punctuation.section.function-block.end.go" - Data: "}"
-				8103-8113: "variable.other.dot-access.go" - Data: "ColorGreen"
-				8125-8128: "variable.other.dot-access.go" - Data: "RGB"
-				8128-8139: "meta.block.go"
-					8128-8129: "punctuation.section.function-block.begin.go" - Data: "{"
-					8129-8130: "constant.numeric.go" - Data: "0"
-					8132-8135: "constant.numeric.go" - Data: "255"
-					8137-8138: "constant.numeric.go" - Data: "0"
-					8138-8139: "punctuation.section.function-block.end.go" - Data: "}"
-				8154-8163: "variable.other.dot-access.go" - Data: "ColorBlue"
-				8175-8178: "variable.other.dot-access.go" - Data: "RGB"
-				8178-8189: "meta.block.go"
-					8178-8179: "punctuation.section.function-block.begin.go" - Data: "{"
-					8179-8180: "constant.numeric.go" - Data: "0"
-					8182-8183: "constant.numeric.go" - Data: "0"
-					8185-8188: "constant.numeric.go" - Data: "255"
-					8188-8189: "punctuation.section.function-block.end.go" - Data: "}"
-				8204-8216: "variable.other.dot-access.go" - Data: "ColorMagenta"
-				8228-8231: "variable.other.dot-access.go" - Data: "RGB"
-				8231-8244: "meta.block.go"
-					8231-8232: "punctuation.section.function-block.begin.go" - Data: "{"
-					8232-8235: "constant.numeric.go" - Data: "255"
-					8237-8238: "constant.numeric.go" - Data: "0"
-					8240-8243: "constant.numeric.go" - Data: "255"
-					8243-8244: "punctuation.section.function-block.end.go" - Data: "}"
-				8259-8270: "variable.other.dot-access.go" - Data: "ColorYellow"
-				8282-8285: "variable.other.dot-access.go" - Data: "RGB"
-				8285-8298: "meta.block.go"
-					8285-8286: "punctuation.section.function-block.begin.go" - Data: "{"
-					8286-8289: "constant.numeric.go" - Data: "255"
-					8291-8294: "constant.numeric.go" - Data: "255"
-					8296-8297: "constant.numeric.go" - Data: "0"
-					8297-8298: "punctuation.section.function-block.end.go" - Data: "}"
-				8313-8322: "variable.other.dot-access.go" - Data: "ColorCyan"
-				8334-8337: "variable.other.dot-access.go" - Data: "RGB"
-				8337-8350: "meta.block.go"
-					8337-8338: "punctuation.section.function-block.begin.go" - Data: "{"
-					8338-8339: "constant.numeric.go" - Data: "0"
-					8341-8344: "constant.numeric.go" - Data: "255"
-					8346-8349: "constant.numeric.go" - Data: "255"
-					8349-8350: "punctuation.section.function-block.end.go" - Data: "}"
-				8354-8361: "meta.initialization.short.go"
-					8354-8361: "variable.other.go"
-						8359-8361: "keyword.operator.initialize.go" - Data: ":="
-				8361-8367: "meta.function-call.go"
-					8361-8362: "punctuation.whitespace.function-call.leading.go" - Data: " "
-					8362-8366: "support.function.any-method.go" - Data: "func"
-				8372-8376: "storage.type.go" - Data: "byte"
-				8378-8381: "storage.type.go" - Data: "int"
-				8382-8456: "meta.block.go"
-					8382-8383: "punctuation.section.function-block.begin.go" - Data: "{"
-					8387-8391: "meta.initialization.short.go"
-						8387-8391: "variable.other.go"
-							8389-8391: "keyword.operator.initialize.go" - Data: ":="
-					8391-8396: "meta.function-call.go"
-						8391-8392: "punctuation.whitespace.function-call.leading.go" - Data: " "
-						8392-8395: "support.function.any-method.go" - Data: "int"
-					8400-8405: "meta.function-call.go"
-						8400-8401: "punctuation.whitespace.function-call.leading.go" - Data: " "
-						8401-8404: "support.function.any-method.go" - Data: "int"
-					8411-8413: "keyword.control.go" - Data: "if"
-					8418-8419: "constant.numeric.go" - Data: "0"
-					8420-8440: "meta.block.go"
-						8420-8421: "punctuation.section.function-block.begin.go" - Data: "{"
-						8426-8432: "keyword.control.go" - Data: "return"
-						8439-8440: "punctuation.section.function-block.end.go" - Data: "}"
-					8444-8450: "keyword.control.go" - Data: "return"
-					8455-8456: "punctuation.section.function-block.end.go" - Data: "}"
-				8467-8473: "meta.function-call.go"
-					8467-8468: "punctuation.whitespace.function-call.leading.go" - Data: " "
-					8468-8472: "support.function.any-method.go" - Data: "func"
-				8486-8491: "variable.other.dot-access.go" - Data: "Color"
-				8501-8510: "variable.other.dot-access.go" - Data: "Attribute"
-				8511-8746: "meta.block.go"
-					8511-8512: "punctuation.section.function-block.begin.go" - Data: "{"
-					8516-8526: "meta.initialization.short.go"
-						8516-8526: "variable.other.go"
-							8524-8526: "keyword.operator.initialize.go" - Data: ":="
-					8527-8535: "constant.numeric.go" - Data: "10000000"
-					8539-8546: "meta.initialization.short.go"
-						8539-8546: "variable.other.go"
-							8544-8546: "keyword.operator.initialize.go" - Data: ":="
-					8547-8548: "constant.numeric.go" - Data: "0"
-					8552-8555: "keyword.control.go" - Data: "for"
-					8556-8563: "meta.initialization.short.go"
-						8556-8563: "variable.other.go"
-							8561-8563: "keyword.operator.initialize.go" - Data: ":="
-					8564-8569: "keyword.control.go" - Data: "range"
-					8574-8708: "meta.block.go"
-						8574-8575: "punctuation.section.function-block.begin.go" - Data: "{"
-						8580-8582: "keyword.control.go" - Data: "if"
-						8583-8590: "meta.initialization.short.go"
-							8583-8590: "variable.other.go"
-								8588-8590: "keyword.operator.initialize.go" - Data: ":="
-						8590-8596: "meta.function-call.go"
-							8590-8591: "punctuation.whitespace.function-call.leading.go" - Data: " "
-							8591-8595: "support.function.any-method.go" - Data: "diff"
-						8598-8599: "variable.other.dot-access.go" - Data: "R"
-						8605-8606: "variable.other.dot-access.go" - Data: "R"
-						8609-8615: "meta.function-call.go"
-							8609-8610: "punctuation.whitespace.function-call.leading.go" - Data: " "
-							8610-8614: "support.function.any-method.go" - Data: "diff"
-						8617-8618: "variable.other.dot-access.go" - Data: "G"
-						8624-8625: "variable.other.dot-access.go" - Data: "G"
-						8628-8634: "meta.function-call.go"
-							8628-8629: "punctuation.whitespace.function-call.leading.go" - Data: " "
-							8629-8633: "support.function.any-method.go" - Data: "diff"
-						8636-8637: "variable.other.dot-access.go" - Data: "B"
-						8643-8644: "variable.other.dot-access.go" - Data: "B"
-						8662-8703: "meta.block.go"
-							8662-8663: "punctuation.section.function-block.begin.go" - Data: "{"
-							8702-8703: "punctuation.section.function-block.end.go" - Data: "}"
-						8707-8708: "punctuation.section.function-block.end.go" - Data: "}"
-					8712-8718: "keyword.control.go" - Data: "return"
-					8727-8737: "meta.function-call.go"
-						8727-8736: "support.function.any-method.go" - Data: "Attribute"
-					8745-8746: "punctuation.section.function-block.end.go" - Data: "}"
-				8748-8749: "punctuation.section.function-block.end.go" - Data: "}"
-			8750-8754: "keyword.control.go" - Data: "else"
-			8755-9022: "meta.block.go"
-				8755-8756: "punctuation.section.function-block.begin.go" - Data: "{"
-				8767-8773: "meta.function-call.go"
-					8767-8768: "punctuation.whitespace.function-call.leading.go" - Data: " "
-					8768-8772: "support.function.any-method.go" - Data: "func"
-				8786-8791: "variable.other.dot-access.go" - Data: "Color"
-				8801-8810: "variable.other.dot-access.go" - Data: "Attribute"
-				8811-9019: "meta.block.go"
-					8811-8812: "punctuation.section.function-block.begin.go" - Data: "{"
-					8816-8821: "meta.initialization.short.go"
-						8816-8821: "variable.other.go"
-							8819-8821: "keyword.operator.initialize.go" - Data: ":="
-					8830-8833: "variable.other.dot-access.go" - Data: "RGB"
-					8833-8854: "meta.block.go"
-						8833-8834: "punctuation.section.function-block.begin.go" - Data: "{"
-						8838-8839: "variable.other.dot-access.go" - Data: "R"
-						8845-8846: "variable.other.dot-access.go" - Data: "G"
-						8852-8853: "variable.other.dot-access.go" - Data: "B"
-						8853-8854: "punctuation.section.function-block.end.go" - Data: "}"
-					8858-8861: "keyword.control.go" - Data: "for"
-					8862-8869: "meta.initialization.short.go"
-						8862-8869: "variable.other.go"
-							8867-8869: "keyword.operator.initialize.go" - Data: ":="
-					8870-8875: "keyword.control.go" - Data: "range"
-					8880-8942: "meta.block.go"
-						8880-8881: "punctuation.section.function-block.begin.go" - Data: "{"
-						8886-8888: "keyword.control.go" - Data: "if"
-						8897-8937: "meta.block.go"
-							8897-8898: "punctuation.section.function-block.begin.go" - Data: "{"
-							8904-8910: "keyword.control.go" - Data: "return"
-							8919-8929: "meta.function-call.go"
-								8919-8928: "support.function.any-method.go" - Data: "Attribute"
-							8936-8937: "punctuation.section.function-block.end.go" - Data: "}"
-						8941-8942: "punctuation.section.function-block.end.go" - Data: "}"
-					8946-8950: "meta.initialization.short.go"
-						8946-8950: "variable.other.go"
-							8948-8950: "keyword.operator.initialize.go" - Data: ":="
-					8950-8954: ""
-						8950-8951: "punctuation.whitespace.support.function.leading.go" - Data: " "
-						8951-8954: "support.function.builtin.go" - Data: "len"
-					8954-8959: "meta.parens.go"
-						8954-8955: "punctuation.section.parens.begin.go" - Data: "("
-						8958-8959: "punctuation.section.parens.end.go" - Data: ")"
-					8968-8976: "meta.function-call.go"
-						8968-8969: "punctuation.whitespace.function-call.leading.go" - Data: " "
-						8969-8975: "support.function.any-method.go" - Data: "append"
-					8988-8994: "keyword.control.go" - Data: "return"
-					9003-9013: "meta.function-call.go"
-						9003-9012: "support.function.any-method.go" - Data: "Attribute"
-					9018-9019: "punctuation.section.function-block.end.go" - Data: "}"
-				9021-9022: "punctuation.section.function-block.end.go" - Data: "}"
-			9024-9027: "keyword.control.go" - Data: "for"
-			9028-9035: "meta.initialization.short.go"
-				9028-9035: "variable.other.go"
-					9033-9035: "keyword.operator.initialize.go" - Data: ":="
-			9036-9041: "keyword.control.go" - Data: "range"
-			9049-9057: "variable.other.dot-access.go" - Data: "Settings"
-			9058-9378: "meta.block.go"
-				9058-9059: "punctuation.section.function-block.begin.go" - Data: "{"
-				9059-9067: "meta.function-call.go"
-					9059-9062: "punctuation.whitespace.function-call.leading.go" - Data: "
-		"
-					9062-9065: "support.function.any-method.go" - Data: "var"
-				9110-9112: "keyword.control.go" - Data: "if"
-				9113-9122: "meta.initialization.short.go"
-					9113-9122: "variable.other.go"
-						9120-9122: "keyword.operator.initialize.go" - Data: ":="
-				9125-9133: "variable.other.dot-access.go" - Data: "Settings"
-				9134-9146: "string.quoted.double.go"
-					9134-9135: "punctuation.definition.string.begin.go" - Data: """
-					9145-9146: "punctuation.definition.string.end.go" - Data: """
-				9152-9215: "meta.block.go"
-					9152-9153: "punctuation.section.function-block.begin.go" - Data: "{"
-					9161-9169: "meta.function-call.go"
-						9161-9162: "punctuation.whitespace.function-call.leading.go" - Data: " "
-						9162-9168: "support.function.any-method.go" - Data: "palLut"
-					9176-9178: "keyword.control.go" - Data: "if"
-					9184-9185: "constant.numeric.go" - Data: "0"
-					9186-9211: "meta.block.go"
-						9186-9187: "punctuation.section.function-block.begin.go" - Data: "{"
-						9210-9211: "punctuation.section.function-block.end.go" - Data: "}"
-					9214-9215: "punctuation.section.function-block.end.go" - Data: "}"
-				9218-9220: "keyword.control.go" - Data: "if"
-				9221-9230: "meta.initialization.short.go"
-					9221-9230: "variable.other.go"
-						9228-9230: "keyword.operator.initialize.go" - Data: ":="
-				9233-9241: "variable.other.dot-access.go" - Data: "Settings"
-				9242-9254: "string.quoted.double.go"
-					9242-9243: "punctuation.definition.string.begin.go" - Data: """
-					9253-9254: "punctuation.definition.string.end.go" - Data: """
-				9260-9323: "meta.block.go"
-					9260-9261: "punctuation.section.function-block.begin.go" - Data: "{"
-					9269-9277: "meta.function-call.go"
-						9269-9270: "punctuation.whitespace.function-call.leading.go" - Data: " "
-						9270-9276: "support.function.any-method.go" - Data: "palLut"
-					9284-9286: "keyword.control.go" - Data: "if"
-					9292-9293: "constant.numeric.go" - Data: "0"
-					9294-9319: "meta.block.go"
-						9294-9295: "punctuation.section.function-block.begin.go" - Data: "{"
-						9318-9319: "punctuation.section.function-block.end.go" - Data: "}"
-					9322-9323: "punctuation.section.function-block.end.go" - Data: "}"
-				9338-9343: "variable.other.dot-access.go" - Data: "Scope"
-				9348-9349: "constant.numeric.go" - Data: "2"
-				9358-9367: "variable.other.dot-access.go" - Data: "Attribute"
-				9367-9375: "meta.block.go"
-					9367-9368: "punctuation.section.function-block.begin.go" - Data: "{"
-					9374-9375: "punctuation.section.function-block.end.go" - Data: "}"
-				9377-9378: "punctuation.section.function-block.end.go" - Data: "}"
-			9380-9382: "keyword.control.go" - Data: "if"
-			9391-9426: "meta.block.go"
-				9391-9392: "punctuation.section.function-block.begin.go" - Data: "{"
-				9403-9419: "meta.function-call.go"
-					9403-9418: "support.function.any-method.go" - Data: "SetColorPalette"
-				9425-9426: "punctuation.section.function-block.end.go" - Data: "}"
-			9428-9433: "keyword.control.go" - Data: "defer"
-			9434-9438: "keyword.control.go" - Data: "func"
-			9438-9440: "meta.parens.go"
-				9438-9439: "punctuation.section.parens.begin.go" - Data: "("
-				9439-9440: "punctuation.section.parens.end.go" - Data: ")"
-			9441-9496: "meta.block.go"
-				9441-9442: "punctuation.section.function-block.begin.go" - Data: "{"
-				9453-9459: "meta.function-call.go"
-					9453-9458: "support.function.any-method.go" - Data: "Close"
-				9467-9475: "meta.function-call.go"
-					9467-9474: "support.function.any-method.go" - Data: "Println"
-				9477-9484: "meta.function-call.go"
-					9477-9483: "support.function.any-method.go" - Data: "Buffer"
-				9486-9491: "meta.function-call.go"
-					9486-9490: "support.function.any-method.go" - Data: "Data"
-				9495-9496: "punctuation.section.function-block.end.go" - Data: "}"
-			9496-9498: "meta.parens.go"
-				9496-9497: "punctuation.section.parens.begin.go" - Data: "("
-				9497-9498: "punctuation.section.parens.end.go" - Data: ")"
-			9501-9505: "meta.initialization.short.go"
-				9501-9505: "variable.other.go"
-					9503-9505: "keyword.operator.initialize.go" - Data: ":="
-			9509-9519: "meta.function-call.go"
-				9509-9518: "support.function.any-method.go" - Data: "NewWindow"
-			9524-9537: "variable.other.dot-access.go" - Data: "active_window"
-			9543-9547: "meta.initialization.short.go"
-				9543-9547: "variable.other.go"
-					9545-9547: "keyword.operator.initialize.go" - Data: ":="
-			9550-9559: "meta.function-call.go"
-				9550-9558: "support.function.any-method.go" - Data: "OpenFile"
-			9559-9568: "string.quoted.double.go"
-				9559-9560: "punctuation.definition.string.begin.go" - Data: """
-				9567-9568: "punctuation.definition.string.end.go" - Data: """
-			9570-9571: "constant.numeric.go" - Data: "0"
-			9576-9585: "meta.function-call.go"
-				9576-9584: "support.function.any-method.go" - Data: "Settings"
-			9587-9591: "meta.function-call.go"
-				9587-9590: "support.function.any-method.go" - Data: "Set"
-			9591-9598: "string.quoted.double.go"
-				9591-9592: "punctuation.definition.string.begin.go" - Data: """
-				9597-9598: "punctuation.definition.string.end.go" - Data: """
-			9600-9604: "constant.language.go" - Data: "true"
-			9609-9616: "meta.function-call.go"
-				9609-9615: "support.function.any-method.go" - Data: "Buffer"
-			9618-9630: "meta.function-call.go"
-				9618-9629: "support.function.any-method.go" - Data: "AddCallback"
-			9632-9638: "variable.other.dot-access.go" - Data: "scroll"
-			9642-9644: "keyword.control.go" - Data: "if"
-			9645-9651: "meta.initialization.short.go"
-				9645-9651: "variable.other.go"
-					9649-9651: "keyword.operator.initialize.go" - Data: ":="
-			9654-9668: "meta.function-call.go"
-				9654-9667: "support.function.any-method.go" - Data: "SetSyntaxFile"
-			9668-9723: "string.quoted.double.go"
-				9668-9669: "punctuation.definition.string.begin.go" - Data: """
-				9722-9723: "punctuation.definition.string.end.go" - Data: """
-			9733-9736: "constant.language.go" - Data: "nil"
-			9737-9794: "meta.block.go"
-				9737-9738: "punctuation.section.function-block.begin.go" - Data: "{"
-				9748-9754: "meta.function-call.go"
-					9748-9753: "support.function.any-method.go" - Data: "Error"
-				9754-9785: "string.quoted.double.go"
-					9754-9755: "punctuation.definition.string.begin.go" - Data: """
-					9782-9784: "constant.other.placeholder.go" - Data: "%s"
-					9784-9785: "punctuation.definition.string.end.go" - Data: """
-				9793-9794: "punctuation.section.function-block.end.go" - Data: "}"
-			9796-9802: "meta.initialization.short.go"
-				9796-9802: "variable.other.go"
-					9800-9802: "keyword.operator.initialize.go" - Data: ":="
-			9805-9809: "meta.function-call.go"
-				9805-9808: "support.function.any-method.go" - Data: "Sel"
-			9816-9822: "meta.function-call.go"
-				9816-9821: "support.function.any-method.go" - Data: "Clear"
-			9824-9825: ""
-				9824-9825: "punctuation.whitespace.comment.leading.go" - Data: "	"
-			9825-9857: ""
-				9825-9857: "comment.line.double-slash.go"
-					9825-9827: "punctuation.definition.comment.go" - Data: "//"
-			9862-9866: "meta.function-call.go"
-				9862-9865: "support.function.any-method.go" - Data: "Add"
-			9877-9883: "variable.other.dot-access.go" - Data: "Region"
-			9883-9889: "meta.block.go"
-				9883-9884: "punctuation.section.function-block.begin.go" - Data: "{"
-				9884-9885: "constant.numeric.go" - Data: "0"
-				9887-9888: "constant.numeric.go" - Data: "0"
-				9888-9889: "punctuation.section.function-block.end.go" - Data: "}"
-			9891-9892: ""
-				9891-9892: "punctuation.whitespace.comment.leading.go" - Data: "	"
-			9892-9942: ""
-				9892-9942: "comment.line.double-slash.go"
-					9892-9894: "punctuation.definition.comment.go" - Data: "//"
-			9942-9943: ""
-				9942-9943: "punctuation.whitespace.comment.leading.go" - Data: "	"
-			9943-9993: ""
-				9943-9993: "comment.line.double-slash.go"
-					9943-9945: "punctuation.definition.comment.go" - Data: "//"
-			9993-9994: ""
-				9993-9994: "punctuation.whitespace.comment.leading.go" - Data: "	"
-			9994-10044: ""
-				9994-10044: "comment.line.double-slash.go"
-					9994-9996: "punctuation.definition.comment.go" - Data: "//"
-			10046-10055: "meta.initialization.short.go"
-				10046-10055: "variable.other.go"
-					10053-10055: "keyword.operator.initialize.go" - Data: ":="
-			10055-10060: ""
-				10055-10056: "punctuation.whitespace.support.function.leading.go" - Data: " "
-				10056-10060: "support.function.builtin.go" - Data: "make"
-			10060-10080: "meta.parens.go"
-				10060-10061: "punctuation.section.parens.begin.go" - Data: "("
-				10061-10065: "storage.modifier.go" - Data: "chan"
-				10074-10079: "variable.other.dot-access.go" - Data: "Event"
-				10079-10080: "punctuation.section.parens.end.go" - Data: ")"
-			10083-10085: "keyword.control.go" - Data: "go"
-			10086-10090: "keyword.control.go" - Data: "func"
-			10090-10092: "meta.parens.go"
-				10090-10091: "punctuation.section.parens.begin.go" - Data: "("
-				10091-10092: "punctuation.section.parens.end.go" - Data: ")"
-			10093-10142: "meta.block.go"
-				10093-10094: "punctuation.section.function-block.begin.go" - Data: "{"
-				10097-10100: "keyword.control.go" - Data: "for"
-				10101-10139: "meta.block.go"
-					10101-10102: "punctuation.section.function-block.begin.go" - Data: "{"
-					10113-10115: "support.channel-operator.go" - Data: "<-"
-					10124-10134: "meta.function-call.go"
-						10124-10133: "support.function.any-method.go" - Data: "PollEvent"
-					10138-10139: "punctuation.section.function-block.end.go" - Data: "}"
-				10141-10142: "punctuation.section.function-block.end.go" - Data: "}"
-			10142-10144: "meta.parens.go"
-				10142-10143: "punctuation.section.parens.begin.go" - Data: "("
-				10143-10144: "punctuation.section.parens.end.go" - Data: ")"
-			10155-10160: "meta.function-call.go"
-				10155-10159: "support.function.any-method.go" - Data: "Init"
-			10163-10166: "keyword.control.go" - Data: "for"
-			10167-11203: "meta.block.go"
-				10167-10168: "punctuation.section.function-block.begin.go" - Data: "{"
-				10196-10202: "meta.function-call.go"
-					10196-10201: "support.function.any-method.go" - Data: "Clear"
-				10226-10233: "meta.initialization.short.go"
-					10226-10233: "variable.other.go"
-						10231-10233: "keyword.operator.initialize.go" - Data: ":="
-				10242-10247: "meta.function-call.go"
-					10242-10246: "support.function.any-method.go" - Data: "Size"
-				10254-10265: "meta.function-call.go"
-					10254-10264: "support.function.any-method.go" - Data: "renderView"
-				10265-10266: "constant.numeric.go" - Data: "0"
-				10268-10269: "constant.numeric.go" - Data: "0"
-				10299-10310: "meta.function-call.go"
-					10299-10309: "support.function.any-method.go" - Data: "renderView"
-				10310-10311: "constant.numeric.go" - Data: "0"
-				10315-10331: "meta.parens.go"
-					10315-10316: "punctuation.section.parens.begin.go" - Data: "("
-					10330-10331: "punctuation.section.parens.end.go" - Data: ")"
-				10336-10356: "meta.parens.go"
-					10336-10337: "punctuation.section.parens.begin.go" - Data: "("
-					10354-10355: "constant.numeric.go" - Data: "1"
-					10355-10356: "punctuation.section.parens.end.go" - Data: ")"
-				10363-10371: "meta.initialization.short.go"
-					10363-10371: "variable.other.go"
-						10369-10371: "keyword.operator.initialize.go" - Data: ":="
-				10374-10379: "meta.function-call.go"
-					10374-10378: "support.function.any-method.go" - Data: "rune"
-				10381-10395: "variable.other.dot-access.go" - Data: "status_message"
-				10399-10402: "keyword.control.go" - Data: "for"
-				10403-10407: "meta.initialization.short.go"
-					10403-10407: "variable.other.go"
-						10405-10407: "keyword.operator.initialize.go" - Data: ":="
-				10408-10409: "constant.numeric.go" - Data: "0"
-				10423-10427: ""
-					10423-10424: "punctuation.whitespace.support.function.leading.go" - Data: " "
-					10424-10427: "support.function.builtin.go" - Data: "len"
-				10427-10434: "meta.parens.go"
-					10427-10428: "punctuation.section.parens.begin.go" - Data: "("
-					10433-10434: "punctuation.section.parens.end.go" - Data: ")"
-				10440-10504: "meta.block.go"
-					10440-10441: "punctuation.section.function-block.begin.go" - Data: "{"
-					10453-10461: "meta.function-call.go"
-						10453-10460: "support.function.any-method.go" - Data: "SetCell"
-					10466-10467: "constant.numeric.go" - Data: "1"
-					10503-10504: "punctuation.section.function-block.end.go" - Data: "}"
-				10515-10521: "meta.function-call.go"
-					10515-10520: "support.function.any-method.go" - Data: "Flush"
-				10526-10540: "meta.initialization.short.go"
-					10526-10540: "variable.other.go"
-						10538-10540: "keyword.operator.initialize.go" - Data: ":="
-				10546-10552: "variable.other.dot-access.go" - Data: "Second"
-				10555-10557: "keyword.control.go" - Data: "if"
-				10558-10566: "meta.initialization.short.go"
-					10558-10566: "variable.other.go"
-						10564-10566: "keyword.operator.initialize.go" - Data: ":="
-				10570-10579: "meta.function-call.go"
-					10570-10578: "support.function.any-method.go" - Data: "Settings"
-				10581-10585: "meta.function-call.go"
-					10581-10584: "support.function.any-method.go" - Data: "Get"
-				10585-10604: "string.quoted.double.go"
-					10585-10586: "punctuation.definition.string.begin.go" - Data: """
-					10603-10604: "punctuation.definition.string.end.go" - Data: """
-				10606-10609: "constant.numeric.go" - Data: "1.0"
-				10611-10620: "meta.parens.go"
-					10611-10612: "punctuation.section.parens.begin.go" - Data: "("
-					10612-10619: "storage.type.go" - Data: "float64"
-					10619-10620: "punctuation.section.parens.end.go" - Data: ")"
-				10625-10687: "meta.block.go"
-					10625-10626: "punctuation.section.function-block.begin.go" - Data: "{"
-					10649-10658: "meta.function-call.go"
-						10649-10657: "support.function.any-method.go" - Data: "Duration"
-					10658-10665: "storage.type.go" - Data: "float64"
-					10665-10678: "meta.parens.go"
-						10665-10666: "punctuation.section.parens.begin.go" - Data: "("
-						10671-10677: "variable.other.dot-access.go" - Data: "Second"
-						10677-10678: "punctuation.section.parens.end.go" - Data: ")"
-					10686-10687: "punctuation.section.function-block.end.go" - Data: "}"
-				10691-10697: "keyword.control.go" - Data: "select"
-				10698-11200: "meta.block.go"
-					10698-10699: "punctuation.section.function-block.begin.go" - Data: "{"
-					10702-10706: "keyword.control.go" - Data: "case"
-					10707-10712: "meta.initialization.short.go"
-						10707-10712: "variable.other.go"
-							10710-10712: "keyword.operator.initialize.go" - Data: ":="
-					10713-10715: "support.channel-operator.go" - Data: "<-"
-					10726-10732: "keyword.control.go" - Data: "switch"
-					10736-10740: "variable.other.dot-access.go" - Data: "Type"
-					10741-11036: "meta.block.go"
-						10741-10742: "punctuation.section.function-block.begin.go" - Data: "{"
-						10746-10750: "keyword.control.go" - Data: "case"
-						10759-10767: "variable.other.dot-access.go" - Data: "EventKey"
-						10769-10779: "meta.initialization.explicit.go"
-							10769-10779: "variable.other.go"
-								10773-10776: "keyword.control.go" - Data: "var"
-						10788-10796: "variable.other.dot-access.go" - Data: "KeyPress"
-						10802-10804: "keyword.control.go" - Data: "if"
-						10808-10810: "variable.other.dot-access.go" - Data: "Ch"
-						10814-10815: "constant.numeric.go" - Data: "0"
-						10816-10856: "meta.block.go"
-							10816-10817: "punctuation.section.function-block.begin.go" - Data: "{"
-							10826-10829: "variable.other.dot-access.go" - Data: "Key"
-							10840-10844: "meta.function-call.go"
-								10840-10843: "support.function.any-method.go" - Data: "Key"
-							10847-10849: "variable.other.dot-access.go" - Data: "Ch"
-							10855-10856: "punctuation.section.function-block.end.go" - Data: "}"
-						10857-10861: "keyword.control.go" - Data: "else"
-						10862-10864: "keyword.control.go" - Data: "if"
-						10865-10874: "meta.initialization.short.go"
-							10865-10874: "variable.other.go"
-								10872-10874: "keyword.operator.initialize.go" - Data: ":="
-						10882-10885: "variable.other.dot-access.go" - Data: "Key"
-						10891-10911: "meta.block.go"
-							10891-10892: "punctuation.section.function-block.begin.go" - Data: "{"
-							10910-10911: "punctuation.section.function-block.end.go" - Data: "}"
-						10912-10916: "keyword.control.go" - Data: "else"
-						10917-10935: "meta.block.go"
-							10917-10918: "punctuation.section.function-block.begin.go" - Data: "{"
-							10924-10929: "keyword.control.go" - Data: "break"
-							10934-10935: "punctuation.section.function-block.end.go" - Data: "}"
-						10941-10943: "keyword.control.go" - Data: "if"
-						10947-10950: "variable.other.dot-access.go" - Data: "Key"
-						10962-10970: "variable.other.dot-access.go" - Data: "KeyCtrlQ"
-						10971-10990: "meta.block.go"
-							10971-10972: "punctuation.section.function-block.begin.go" - Data: "{"
-							10978-10984: "keyword.control.go" - Data: "return"
-							10989-10990: "punctuation.section.function-block.end.go" - Data: "}"
-						10998-11010: "meta.function-call.go"
-							10998-11009: "support.function.any-method.go" - Data: "HandleInput"
-						11026-11031: "constant.language.go" - Data: "false"
-						11035-11036: "punctuation.section.function-block.end.go" - Data: "}"
-					11039-11043: "keyword.control.go" - Data: "case"
-					11044-11046: "support.channel-operator.go" - Data: "<-"
-					11051-11057: "meta.function-call.go"
-						11051-11056: "support.function.any-method.go" - Data: "After"
-					11071-11072: "constant.numeric.go" - Data: "2"
-					11075-11078: ""
-						11075-11078: "punctuation.whitespace.comment.leading.go" - Data: "			"
-					11078-11141: ""
-						11078-11141: "comment.line.double-slash.go"
-							11078-11080: "punctuation.definition.comment.go" - Data: "//"
-					11141-11144: ""
-						11141-11144: "punctuation.whitespace.comment.leading.go" - Data: "			"
-					11144-11197: ""
-						11144-11197: "comment.line.double-slash.go"
-							11144-11146: "punctuation.definition.comment.go" - Data: "//"
-					11199-11200: "punctuation.section.function-block.end.go" - Data: "}"
-				11202-11203: "punctuation.section.function-block.end.go" - Data: "}"
-			11204-11205: "punctuation.section.function-block.end.go" - Data: "}"
-	11207-11368: "meta.function.plain.go"
-		11207-11219: "meta.function.declaration.go"
-			11207-11211: "keyword.control.go" - Data: "func"
-			11212-11216: "entity.name.function.go" - Data: "main"
-			11217-11217: "variable.parameters.go" - Data: ""
-			11219-11219: "variable.return-types.go" - Data: ""
-		11219-11368: "meta.block.go"
-			11219-11220: "punctuation.section.function-block.begin.go" - Data: "{"
-			11222-11224: "keyword.control.go" - Data: "if"
-			11225-11231: "meta.initialization.short.go"
-				11225-11231: "variable.other.go"
-					11229-11231: "keyword.operator.initialize.go" - Data: ":="
-			11240-11245: "meta.function-call.go"
-				11240-11244: "support.function.any-method.go" - Data: "Init"
-			11255-11258: "constant.language.go" - Data: "nil"
-			11259-11282: "meta.block.go"
-				11259-11260: "punctuation.section.function-block.begin.go" - Data: "{"
-				11270-11275: "meta.function-call.go"
-					11270-11274: "support.function.any-method.go" - Data: "Exit"
-				11281-11282: "punctuation.section.function-block.end.go" - Data: "}"
-			11284-11290: "meta.initialization.explicit.go"
-				11284-11290: "variable.other.go"
-					11285-11288: "keyword.control.go" - Data: "var"
-			11299-11312: "variable.other.dot-access.go" - Data: "visibleregion"
-			11314-11319: ""
-				11314-11315: "punctuation.whitespace.support.function.leading.go" - Data: " "
-				11315-11319: "support.function.builtin.go" - Data: "make"
-			11319-11356: "meta.parens.go"
-				11319-11320: "punctuation.section.parens.begin.go" - Data: "("
-				11320-11323: "storage.modifier.go" - Data: "map"
-				11333-11337: "variable.other.dot-access.go" - Data: "View"
-				11349-11355: "variable.other.dot-access.go" - Data: "Region"
-				11355-11356: "punctuation.section.parens.end.go" - Data: ")"
-			11360-11365: "meta.function-call.go"
-				11360-11364: "support.function.any-method.go" - Data: "loop"
-			11367-11368: "punctuation.section.function-block.end.go" - Data: "}"
@@ -1,166 +0,0 @@
-0-473: "text.xml.plist"
-	0-38: "meta.tag.preprocessor.xml"
-		0-2: "punctuation.definition.tag.xml" - Data: "<?"
-		2-5: "entity.name.tag.xml" - Data: "xml"
-		5-13: "entity.other.attribute-name.xml" - Data: " version"
-		14-19: "string.quoted.double.xml"
-			14-15: "punctuation.definition.string.begin.xml" - Data: """
-			18-19: "punctuation.definition.string.end.xml" - Data: """
-		19-28: "entity.other.attribute-name.xml" - Data: " encoding"
-		29-36: "string.quoted.double.xml"
-			29-30: "punctuation.definition.string.begin.xml" - Data: """
-			35-36: "punctuation.definition.string.end.xml" - Data: """
-		36-38: "punctuation.definition.tag.xml" - Data: "?>"
-	39-141: "meta.tag.sgml.doctype.xml"
-		39-41: "punctuation.definition.tag.xml" - Data: "<!"
-		41-48: "keyword.other.doctype.xml" - Data: "DOCTYPE"
-		49-54: "variable.language.documentroot.xml" - Data: "plist"
-		140-141: "punctuation.definition.tag.xml" - Data: ">"
-	142-473: ""
-		142-148: "meta.tag.plist.xml.plist"
-			142-143: "punctuation.definition.tag.xml.plist" - Data: "<"
-			143-148: "entity.name.tag.xml.plist"
-				143-148: "entity.name.tag.localname.xml.plist" - Data: "plist"
-		148-162: "meta.tag.plist.xml.plist"
-			149-156: "entity.other.attribute-name.version.xml.plist" - Data: "version"
-			156-157: "punctuation.separator.key-value.xml.plist" - Data: "="
-			157-162: "string.quoted.double.xml.plist"
-				157-158: "punctuation.definition.string.begin.xml.plist" - Data: """
-				161-162: "punctuation.definition.string.end.xml.plist" - Data: """
-		162-466: ""
-			162-163: "meta.tag.plist.xml.plist"
-				162-163: "punctuation.definition.tag.xml.plist" - Data: ">"
-			164-464: ""
-				164-170: "meta.tag.dict.xml.plist"
-					164-165: "punctuation.definition.tag.xml.plist" - Data: "<"
-					165-169: "entity.name.tag.xml.plist"
-						165-169: "entity.name.tag.localname.xml.plist" - Data: "dict"
-					169-170: "punctuation.definition.tag.xml.plist" - Data: ">"
-				172-192: ""
-					172-177: "meta.tag.key.xml.plist"
-						172-173: "punctuation.definition.tag.xml.plist" - Data: "<"
-						173-176: "entity.name.tag.xml.plist"
-							173-176: "entity.name.tag.localname.xml.plist" - Data: "key"
-						176-177: "punctuation.definition.tag.xml.plist" - Data: ">"
-					186-192: "meta.tag.key.xml.plist"
-						186-188: "punctuation.definition.tag.xml.plist" - Data: "</"
-						188-191: "entity.name.tag.xml.plist"
-							188-191: "entity.name.tag.localname.xml.plist" - Data: "key"
-						191-192: "punctuation.definition.tag.xml.plist" - Data: ">"
-				194-202: ""
-					194-202: "meta.tag.array.xml.plist"
-						194-195: "punctuation.definition.tag.xml.plist" - Data: "<"
-						195-200: "entity.name.tag.xml.plist"
-							195-200: "entity.name.tag.localname.xml.plist" - Data: "array"
-						201-202: "punctuation.definition.tag.xml.plist" - Data: ">"
-				204-229: ""
-					204-209: "meta.tag.key.xml.plist"
-						204-205: "punctuation.definition.tag.xml.plist" - Data: "<"
-						205-208: "entity.name.tag.xml.plist"
-							205-208: "entity.name.tag.localname.xml.plist" - Data: "key"
-						208-209: "punctuation.definition.tag.xml.plist" - Data: ">"
-					223-229: "meta.tag.key.xml.plist"
-						223-225: "punctuation.definition.tag.xml.plist" - Data: "</"
-						225-228: "entity.name.tag.xml.plist"
-							225-228: "entity.name.tag.localname.xml.plist" - Data: "key"
-						228-229: "punctuation.definition.tag.xml.plist" - Data: ">"
-				231-295: ""
-					231-239: "meta.tag.string.xml.plist"
-						231-232: "punctuation.definition.tag.xml.plist" - Data: "<"
-						232-238: "entity.name.tag.xml.plist"
-							232-238: "entity.name.tag.localname.xml.plist" - Data: "string"
-						238-239: "punctuation.definition.tag.xml.plist" - Data: ">"
-					286-295: "meta.tag.string.xml.plist"
-						286-288: "punctuation.definition.tag.xml.plist" - Data: "</"
-						288-294: "entity.name.tag.xml.plist"
-							288-294: "entity.name.tag.localname.xml.plist" - Data: "string"
-						294-295: "punctuation.definition.tag.xml.plist" - Data: ">"
-				297-312: ""
-					297-302: "meta.tag.key.xml.plist"
-						297-298: "punctuation.definition.tag.xml.plist" - Data: "<"
-						298-301: "entity.name.tag.xml.plist"
-							298-301: "entity.name.tag.localname.xml.plist" - Data: "key"
-						301-302: "punctuation.definition.tag.xml.plist" - Data: ">"
-					306-312: "meta.tag.key.xml.plist"
-						306-308: "punctuation.definition.tag.xml.plist" - Data: "</"
-						308-311: "entity.name.tag.xml.plist"
-							308-311: "entity.name.tag.localname.xml.plist" - Data: "key"
-						311-312: "punctuation.definition.tag.xml.plist" - Data: ">"
-				314-350: ""
-					314-322: "meta.tag.string.xml.plist"
-						314-315: "punctuation.definition.tag.xml.plist" - Data: "<"
-						315-321: "entity.name.tag.xml.plist"
-							315-321: "entity.name.tag.localname.xml.plist" - Data: "string"
-						321-322: "punctuation.definition.tag.xml.plist" - Data: ">"
-					341-350: "meta.tag.string.xml.plist"
-						341-343: "punctuation.definition.tag.xml.plist" - Data: "</"
-						343-349: "entity.name.tag.xml.plist"
-							343-349: "entity.name.tag.localname.xml.plist" - Data: "string"
-						349-350: "punctuation.definition.tag.xml.plist" - Data: ">"
-				352-371: ""
-					352-357: "meta.tag.key.xml.plist"
-						352-353: "punctuation.definition.tag.xml.plist" - Data: "<"
-						353-356: "entity.name.tag.xml.plist"
-							353-356: "entity.name.tag.localname.xml.plist" - Data: "key"
-						356-357: "punctuation.definition.tag.xml.plist" - Data: ">"
-					365-371: "meta.tag.key.xml.plist"
-						365-367: "punctuation.definition.tag.xml.plist" - Data: "</"
-						367-370: "entity.name.tag.xml.plist"
-							367-370: "entity.name.tag.localname.xml.plist" - Data: "key"
-						370-371: "punctuation.definition.tag.xml.plist" - Data: ">"
-				373-456: ""
-					373-380: "meta.tag.array.xml.plist"
-						373-374: "punctuation.definition.tag.xml.plist" - Data: "<"
-						374-379: "entity.name.tag.xml.plist"
-							374-379: "entity.name.tag.localname.xml.plist" - Data: "array"
-						379-380: "punctuation.definition.tag.xml.plist" - Data: ">"
-					383-446: ""
-						383-389: "meta.tag.dict.xml.plist"
-							383-384: "punctuation.definition.tag.xml.plist" - Data: "<"
-							384-388: "entity.name.tag.xml.plist"
-								384-388: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							388-389: "punctuation.definition.tag.xml.plist" - Data: ">"
-						393-411: ""
-							393-398: "meta.tag.key.xml.plist"
-								393-394: "punctuation.definition.tag.xml.plist" - Data: "<"
-								394-397: "entity.name.tag.xml.plist"
-									394-397: "entity.name.tag.localname.xml.plist" - Data: "key"
-								397-398: "punctuation.definition.tag.xml.plist" - Data: ">"
-							405-411: "meta.tag.key.xml.plist"
-								405-407: "punctuation.definition.tag.xml.plist" - Data: "</"
-								407-410: "entity.name.tag.xml.plist"
-									407-410: "entity.name.tag.localname.xml.plist" - Data: "key"
-								410-411: "punctuation.definition.tag.xml.plist" - Data: ">"
-						415-436: ""
-							415-423: "meta.tag.string.xml.plist"
-								415-416: "punctuation.definition.tag.xml.plist" - Data: "<"
-								416-422: "entity.name.tag.xml.plist"
-									416-422: "entity.name.tag.localname.xml.plist" - Data: "string"
-								422-423: "punctuation.definition.tag.xml.plist" - Data: ">"
-							427-436: "meta.tag.string.xml.plist"
-								427-429: "punctuation.definition.tag.xml.plist" - Data: "</"
-								429-435: "entity.name.tag.xml.plist"
-									429-435: "entity.name.tag.localname.xml.plist" - Data: "string"
-								435-436: "punctuation.definition.tag.xml.plist" - Data: ">"
-						439-446: "meta.tag.dict.xml.plist"
-							439-441: "punctuation.definition.tag.xml.plist" - Data: "</"
-							441-445: "entity.name.tag.xml.plist"
-								441-445: "entity.name.tag.localname.xml.plist" - Data: "dict"
-							445-446: "punctuation.definition.tag.xml.plist" - Data: ">"
-					448-456: "meta.tag.array.xml.plist"
-						448-450: "punctuation.definition.tag.xml.plist" - Data: "</"
-						450-455: "entity.name.tag.xml.plist"
-							450-455: "entity.name.tag.localname.xml.plist" - Data: "array"
-						455-456: "punctuation.definition.tag.xml.plist" - Data: ">"
-				457-464: "meta.tag.dict.xml.plist"
-					457-459: "punctuation.definition.tag.xml.plist" - Data: "</"
-					459-463: "entity.name.tag.xml.plist"
-						459-463: "entity.name.tag.localname.xml.plist" - Data: "dict"
-					463-464: "punctuation.definition.tag.xml.plist" - Data: ">"
-			465-466: "meta.tag.plist.xml.plist"
-				465-466: "punctuation.definition.tag.xml.plist" - Data: "<"
-		466-473: "meta.tag.plist.xml.plist"
-			466-467: "punctuation.definition.tag.xml.plist" - Data: "/"
-			467-472: "entity.name.tag.xml.plist"
-				467-472: "entity.name.tag.localname.xml.plist" - Data: "plist"
-			472-473: "punctuation.definition.tag.xml.plist" - Data: ">"
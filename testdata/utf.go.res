@@ -1,202 +0,0 @@
-0-1063: "source.go"
-	0-7: "keyword.control.go" - Data: "package"
-	20-26: "keyword.control.go" - Data: "import"
-	27-28: "meta.parens.go" - Data: "("
-	30-39: "string.quoted.double.go"
-		30-31: "punctuation.definition.string.begin.go" - Data: """
-		38-39: "punctuation.definition.string.end.go" - Data: """
-	43-1063: "meta.function.plain.go"
-		43-77: "meta.function.declaration.go"
-			43-47: "keyword.control.go" - Data: "func"
-			48-62: "entity.name.function.go" - Data: "TestActionsUtf"
-			63-75: "variable.parameters.go" - Data: "t *testing.T"
-			77-77: "variable.return-types.go" - Data: ""
-		77-1063: "meta.block.go"
-			77-78: "punctuation.section.function-block.begin.go" - Data: "{"
-			80-84: "keyword.control.go" - Data: "type"
-			90-96: "keyword.control.go" - Data: "struct"
-			97-137: "meta.block.go"
-				97-98: "punctuation.section.function-block.begin.go" - Data: "{"
-				128-134: "storage.type.go" - Data: "string"
-				136-137: "punctuation.section.function-block.end.go" - Data: "}"
-			139-144: "storage.modifier.go" - Data: "const"
-			152-170: "string.quoted.double.go"
-				152-153: "punctuation.definition.string.begin.go" - Data: """
-				169-170: "punctuation.definition.string.end.go" - Data: """
-			173-182: "meta.initialization.short.go"
-				173-182: "variable.other.go"
-					180-182: "keyword.operator.initialize.go" - Data: ":="
-			189-209: "meta.block.go"
-				189-190: "punctuation.section.function-block.begin.go" - Data: "{"
-				198-203: "meta.function-call.go"
-					198-202: "support.function.any-method.go" - Data: "rune"
-				208-209: "punctuation.section.function-block.end.go" - Data: "}"
-			211-219: "meta.initialization.short.go"
-				211-219: "variable.other.go"
-					217-219: "keyword.operator.initialize.go" - Data: ":="
-			226-739: "meta.block.go"
-				226-227: "punctuation.section.function-block.begin.go" - Data: "{"
-				230-289: "meta.block.go"
-					230-231: "punctuation.section.function-block.begin.go" - Data: "{"
-					231-247: "meta.function-call.go"
-						231-246: "support.function.any-method.go" - Data: "NewInsertAction"
-					256-257: "constant.numeric.go" - Data: "0"
-					259-264: "string.quoted.double.go"
-						259-260: "punctuation.definition.string.begin.go" - Data: """
-						263-264: "punctuation.definition.string.end.go" - Data: """
-					267-288: "string.quoted.double.go"
-						267-268: "punctuation.definition.string.begin.go" - Data: """
-						287-288: "punctuation.definition.string.end.go" - Data: """
-					288-289: "punctuation.section.function-block.end.go" - Data: "}"
-				293-352: "meta.block.go"
-					293-294: "punctuation.section.function-block.begin.go" - Data: "{"
-					294-310: "meta.function-call.go"
-						294-309: "support.function.any-method.go" - Data: "NewInsertAction"
-					319-320: "constant.numeric.go" - Data: "1"
-					322-327: "string.quoted.double.go"
-						322-323: "punctuation.definition.string.begin.go" - Data: """
-						326-327: "punctuation.definition.string.end.go" - Data: """
-					330-351: "string.quoted.double.go"
-						330-331: "punctuation.definition.string.begin.go" - Data: """
-						350-351: "punctuation.definition.string.end.go" - Data: """
-					351-352: "punctuation.section.function-block.end.go" - Data: "}"
-				356-416: "meta.block.go"
-					356-357: "punctuation.section.function-block.begin.go" - Data: "{"
-					357-373: "meta.function-call.go"
-						357-372: "support.function.any-method.go" - Data: "NewInsertAction"
-					382-384: "constant.numeric.go" - Data: "10"
-					386-391: "string.quoted.double.go"
-						386-387: "punctuation.definition.string.begin.go" - Data: """
-						390-391: "punctuation.definition.string.end.go" - Data: """
-					394-415: "string.quoted.double.go"
-						394-395: "punctuation.definition.string.begin.go" - Data: """
-						414-415: "punctuation.definition.string.end.go" - Data: """
-					415-416: "punctuation.section.function-block.end.go" - Data: "}"
-				420-479: "meta.block.go"
-					420-421: "punctuation.section.function-block.begin.go" - Data: "{"
-					421-436: "meta.function-call.go"
-						421-435: "support.function.any-method.go" - Data: "NewEraseAction"
-					451-457: "meta.block.go"
-						451-452: "punctuation.section.function-block.begin.go" - Data: "{"
-						452-453: "constant.numeric.go" - Data: "0"
-						455-456: "constant.numeric.go" - Data: "0"
-						456-457: "punctuation.section.function-block.end.go" - Data: "}"
-					460-478: "string.quoted.double.go"
-						460-461: "punctuation.definition.string.begin.go" - Data: """
-						477-478: "punctuation.definition.string.end.go" - Data: """
-					478-479: "punctuation.section.function-block.end.go" - Data: "}"
-				483-541: "meta.block.go"
-					483-484: "punctuation.section.function-block.begin.go" - Data: "{"
-					484-499: "meta.function-call.go"
-						484-498: "support.function.any-method.go" - Data: "NewEraseAction"
-					514-520: "meta.block.go"
-						514-515: "punctuation.section.function-block.begin.go" - Data: "{"
-						515-516: "constant.numeric.go" - Data: "0"
-						518-519: "constant.numeric.go" - Data: "1"
-						519-520: "punctuation.section.function-block.end.go" - Data: "}"
-					523-540: "string.quoted.double.go"
-						523-524: "punctuation.definition.string.begin.go" - Data: """
-						539-540: "punctuation.definition.string.end.go" - Data: """
-					540-541: "punctuation.section.function-block.end.go" - Data: "}"
-				545-601: "meta.block.go"
-					545-546: "punctuation.section.function-block.begin.go" - Data: "{"
-					546-561: "meta.function-call.go"
-						546-560: "support.function.any-method.go" - Data: "NewEraseAction"
-					576-582: "meta.block.go"
-						576-577: "punctuation.section.function-block.begin.go" - Data: "{"
-						577-578: "constant.numeric.go" - Data: "0"
-						580-581: "constant.numeric.go" - Data: "3"
-						581-582: "punctuation.section.function-block.end.go" - Data: "}"
-					585-600: "string.quoted.double.go"
-						585-586: "punctuation.definition.string.begin.go" - Data: """
-						599-600: "punctuation.definition.string.end.go" - Data: """
-					600-601: "punctuation.section.function-block.end.go" - Data: "}"
-				605-661: "meta.block.go"
-					605-606: "punctuation.section.function-block.begin.go" - Data: "{"
-					606-621: "meta.function-call.go"
-						606-620: "support.function.any-method.go" - Data: "NewEraseAction"
-					636-642: "meta.block.go"
-						636-637: "punctuation.section.function-block.begin.go" - Data: "{"
-						637-638: "constant.numeric.go" - Data: "6"
-						640-641: "constant.numeric.go" - Data: "9"
-						641-642: "punctuation.section.function-block.end.go" - Data: "}"
-					645-660: "string.quoted.double.go"
-						645-646: "punctuation.definition.string.begin.go" - Data: """
-						659-660: "punctuation.definition.string.end.go" - Data: """
-					660-661: "punctuation.section.function-block.end.go" - Data: "}"
-				665-735: "meta.block.go"
-					665-666: "punctuation.section.function-block.begin.go" - Data: "{"
-					666-683: "meta.function-call.go"
-						666-682: "support.function.any-method.go" - Data: "NewReplaceAction"
-					698-704: "meta.block.go"
-						698-699: "punctuation.section.function-block.begin.go" - Data: "{"
-						699-700: "constant.numeric.go" - Data: "3"
-						702-703: "constant.numeric.go" - Data: "6"
-						703-704: "punctuation.section.function-block.end.go" - Data: "}"
-					706-712: "string.quoted.double.go"
-						706-707: "punctuation.definition.string.begin.go" - Data: """
-						711-712: "punctuation.definition.string.end.go" - Data: """
-					715-734: "string.quoted.double.go"
-						715-716: "punctuation.definition.string.begin.go" - Data: """
-						733-734: "punctuation.definition.string.end.go" - Data: """
-					734-735: "punctuation.section.function-block.end.go" - Data: "}"
-				738-739: "punctuation.section.function-block.end.go" - Data: "}"
-			741-744: "keyword.control.go" - Data: "for"
-			745-755: "meta.initialization.short.go"
-				745-755: "variable.other.go"
-					753-755: "keyword.operator.initialize.go" - Data: ":="
-			756-761: "keyword.control.go" - Data: "range"
-			768-1061: "meta.block.go"
-				768-769: "punctuation.section.function-block.begin.go" - Data: "{"
-				777-783: "variable.other.dot-access.go" - Data: "action"
-				784-790: "meta.function-call.go"
-					784-789: "support.function.any-method.go" - Data: "Apply"
-				794-796: "keyword.control.go" - Data: "if"
-				797-801: "meta.initialization.short.go"
-					797-801: "variable.other.go"
-						799-801: "keyword.operator.initialize.go" - Data: ":="
-				809-816: "meta.function-call.go"
-					809-815: "support.function.any-method.go" - Data: "String"
-				829-837: "variable.other.dot-access.go" - Data: "expected"
-				838-934: "meta.block.go"
-					838-839: "punctuation.section.function-block.begin.go" - Data: "{"
-					845-852: "meta.function-call.go"
-						845-851: "support.function.any-method.go" - Data: "Errorf"
-					852-892: "string.quoted.double.go"
-						852-853: "punctuation.definition.string.begin.go" - Data: """
-						859-861: "constant.other.placeholder.go" - Data: "%d"
-						861-863: "constant.character.escape.go" - Data: "\n"
-						863-865: "constant.character.escape.go" - Data: "\t"
-						874-876: "constant.other.placeholder.go" - Data: "%v"
-						876-878: "constant.character.escape.go" - Data: "\n"
-						878-880: "constant.character.escape.go" - Data: "\t"
-						889-891: "constant.other.placeholder.go" - Data: "%v"
-						891-892: "punctuation.definition.string.end.go" - Data: """
-					899-904: "meta.function-call.go"
-						899-903: "support.function.any-method.go" - Data: "rune"
-					909-917: "variable.other.dot-access.go" - Data: "expected"
-					922-927: "meta.function-call.go"
-						922-926: "support.function.any-method.go" - Data: "rune"
-					933-934: "punctuation.section.function-block.end.go" - Data: "}"
-				942-948: "variable.other.dot-access.go" - Data: "action"
-				949-954: "meta.function-call.go"
-					949-953: "support.function.any-method.go" - Data: "Undo"
-				958-960: "keyword.control.go" - Data: "if"
-				961-965: "meta.initialization.short.go"
-					961-965: "variable.other.go"
-						963-965: "keyword.operator.initialize.go" - Data: ":="
-				973-980: "meta.function-call.go"
-					973-979: "support.function.any-method.go" - Data: "String"
-				993-1058: "meta.block.go"
-					993-994: "punctuation.section.function-block.begin.go" - Data: "{"
-					1000-1007: "meta.function-call.go"
-						1000-1006: "support.function.any-method.go" - Data: "Errorf"
-					1007-1041: "string.quoted.double.go"
-						1007-1008: "punctuation.definition.string.begin.go" - Data: """
-						1013-1015: "constant.other.placeholder.go" - Data: "%d"
-						1026-1028: "constant.other.placeholder.go" - Data: "%s"
-						1038-1040: "constant.other.placeholder.go" - Data: "%s"
-						1040-1041: "punctuation.definition.string.end.go" - Data: """
-					1057-1058: "punctuation.section.function-block.end.go" - Data: "}"
-				1060-1061: "punctuation.section.function-block.end.go" - Data: "}"
-			1062-1063: "punctuation.section.function-block.end.go" - Data: "}"
@@ -0,0 +1,581 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gbbr/textmate/vendor/limetext/lime-backend/lib/loaders"
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+// The font styles of a Style. They have the same values as those of
+// lime-backend's render.FontStyle.
+const (
+	Italic FontStyle = 1 << iota
+	Bold
+	Underline
+	Strikethrough
+)
+
+type (
+	FontStyle int
+
+	// Settings are the values a rule of a theme sets.
+	Settings struct {
+		Colors map[string]Color
+		// The fontStyle, or nil if it isn't set. An empty fontStyle
+		// sets it to 0, resetting the style of the enclosing scopes.
+		FontStyle *FontStyle
+		// Every other value, as the string it's set to, or as JSON if
+		// it isn't a string
+		Other map[string]string
+	}
+
+	ScopeSetting struct {
+		Name     string
+		Scope    string
+		Settings Settings
+		selector *Selector
+	}
+
+	// GlobalSettings are the settings of a theme that apply to the whole
+	// of a view rather than to a scope, which a tmTheme has as the rule
+	// without a scope. Colours the theme doesn't set are nil.
+	GlobalSettings struct {
+		Foreground                *Color
+		Background                *Color
+		Caret                     *Color
+		LineHighlight             *Color
+		Invisibles                *Color
+		Selection                 *Color
+		SelectionForeground       *Color
+		SelectionBorder           *Color
+		InactiveSelection         *Color
+		FindHighlight             *Color
+		FindHighlightForeground   *Color
+		Gutter                    *Color
+		GutterForeground          *Color
+		Guide                     *Color
+		ActiveGuide               *Color
+		StackGuide                *Color
+		BracketsForeground        *Color
+		BracketContentsForeground *Color
+		TagsForeground            *Color
+		Highlight                 *Color
+		Shadow                    *Color
+		FontStyle                 *FontStyle
+	}
+
+	// A Theme is a TextMate colour scheme (tmTheme).
+	Theme struct {
+		GutterSettings Settings
+		Name           string
+		Settings       []ScopeSetting
+		UUID           UUID
+		// The other values of the theme, such as its author, as the
+		// string they're set to, or as JSON if they aren't strings
+		Other  map[string]string `json:"-"`
+		Global GlobalSettings    `json:"-"`
+		cache  *styleCache
+	}
+
+	styleCache struct {
+		sync.Mutex
+		styles map[string]Style
+	}
+
+	// An Explanation tells how a Theme styles a scope stack, as a scope
+	// inspector shows it.
+	Explanation struct {
+		// The space separated scope stack
+		Scope string
+		Style Style
+		// The rules whose selectors match the scope stack, in the order
+		// of the theme
+		Candidates []Candidate
+		// The rules the attributes of Style come from, which are the
+		// theme's global settings if no matching rule sets them, or nil
+		// if those don't either
+		Foreground, Background, Font *ScopeSetting
+	}
+
+	// A Candidate is a rule matching a scope stack, and the score of its
+	// selector's match. Higher scores are more specific.
+	Candidate struct {
+		Setting *ScopeSetting
+		Score   float64
+	}
+
+	// A Style is how text of a scope is displayed by a Theme.
+	Style struct {
+		Foreground Color
+		Background Color
+		Font       FontStyle
+	}
+)
+
+// Guards the creation of the style caches of themes
+var cacheMu sync.Mutex
+
+var fontStyles = []struct {
+	name string
+	f    FontStyle
+}{
+	{"bold", Bold},
+	{"italic", Italic},
+	{"underline", Underline},
+	{"strikethrough", Strikethrough},
+}
+
+// SaveTheme writes t to the file filename, in the format LoadTheme would
+// load it in.
+func SaveTheme(t *Theme, filename string) error {
+	var buf bytes.Buffer
+	var err error
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		err = t.WriteVSCodeTheme(&buf)
+	case ".sublime-color-scheme":
+		err = t.WriteSublimeColorScheme(&buf)
+	default:
+		err = t.WriteTmTheme(&buf)
+	}
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, buf.Bytes(), 0644)
+}
+
+// ConvertTheme converts the theme file from into the file to, choosing
+// their formats by their names like LoadTheme does.
+func ConvertTheme(from, to string) error {
+	t, err := LoadTheme(from)
+	if err != nil {
+		return err
+	}
+	return SaveTheme(t, to)
+}
+
+// LoadTheme loads the theme file filename, which is a VS Code theme if its
+// name ends in ".json", a Sublime Text colour scheme if it ends in
+// ".sublime-color-scheme", and a tmTheme otherwise.
+func LoadTheme(filename string) (*Theme, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		return LoadVSCodeTheme(filename)
+	case ".sublime-color-scheme":
+		return LoadSublimeColorScheme(filename)
+	}
+	var scheme Theme
+	if d, err := ioutil.ReadFile(filename); err != nil {
+		return nil, fmt.Errorf("Unable to load colorscheme definition: %s", err)
+	} else if err := loaders.LoadPlist([]byte(decode(d).text), &scheme); err != nil {
+		return nil, fmt.Errorf("Unable to load colorscheme definition: %s", err)
+	}
+	return &scheme, nil
+}
+
+func (s ScopeSetting) String() (ret string) {
+	ret = fmt.Sprintf("%s - %s\n", s.Name, s.Scope)
+	var keys []string
+	vals := make(map[string]string)
+	for k, c := range s.Settings.Colors {
+		vals[k] = c.String()
+	}
+	if f := s.Settings.FontStyle; f != nil {
+		vals["fontStyle"] = f.String()
+	}
+	for k, v := range s.Settings.Other {
+		vals[k] = v
+	}
+	for k := range vals {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		ret += fmt.Sprintf("\t\t%s: %s\n", k, vals[k])
+	}
+	return
+}
+
+func (t Theme) String() (ret string) {
+	ret = fmt.Sprintf("%s - %s\n", t.Name, t.UUID)
+	for i := range t.Settings {
+		ret += fmt.Sprintf("\t%s", t.Settings[i])
+	}
+	return
+}
+
+// WriteTmTheme writes t as a tmTheme.
+func (t *Theme) WriteTmTheme(w io.Writer) error {
+	top := map[string]interface{}{
+		"name":     t.Name,
+		"settings": t.rules(),
+	}
+	if !t.GutterSettings.empty() {
+		top["gutterSettings"] = t.GutterSettings.values()
+	}
+	if t.UUID != "" {
+		top["uuid"] = string(t.UUID)
+	}
+	for k, v := range t.Other {
+		top[k] = jsonValue(v)
+	}
+	return writePlist(w, top)
+}
+
+// rules returns the rules of t in the generic form encoders work with.
+func (t *Theme) rules() []interface{} {
+	ret := make([]interface{}, 0, len(t.Settings))
+	for _, s := range t.Settings {
+		r := map[string]interface{}{"settings": s.Settings.values()}
+		if s.Name != "" {
+			r["name"] = s.Name
+		}
+		if s.Scope != "" {
+			r["scope"] = s.Scope
+		}
+		ret = append(ret, r)
+	}
+	return ret
+}
+
+// empty reports whether s sets nothing.
+func (s Settings) empty() bool {
+	return len(s.Colors) == 0 && len(s.Other) == 0 && s.FontStyle == nil
+}
+
+// values returns s in the generic form encoders work with.
+func (s Settings) values() map[string]interface{} {
+	ret := make(map[string]interface{})
+	for k, v := range s.Other {
+		ret[k] = jsonValue(v)
+	}
+	for k, c := range s.Colors {
+		ret[k] = c.String()
+	}
+	if s.FontStyle != nil {
+		ret["fontStyle"] = s.FontStyle.String()
+	}
+	return ret
+}
+
+// UnmarshalJSON also parses the scope selectors of the rules, and fills
+// in t.Global from the rule without a scope.
+func (t *Theme) UnmarshalJSON(data []byte) error {
+	type theme Theme
+	if err := json.Unmarshal(data, (*theme)(t)); err != nil {
+		return err
+	}
+	other, err := otherValues(data, "gutterSettings", "name", "settings", "uuid")
+	if err != nil {
+		return err
+	}
+	t.Other = other
+	t.prepare()
+	return nil
+}
+
+// prepare parses the scope selectors of t's rules, and fills in t.Global.
+func (t *Theme) prepare() {
+	global := false
+	for i := range t.Settings {
+		s := &t.Settings[i]
+		if strings.TrimSpace(s.Scope) != "" {
+			sel, err := ParseSelector(s.Scope)
+			if err != nil {
+				log.Printf("Couldn't parse theme selector %s: %s", s.Scope, err)
+			}
+			s.selector = sel
+		} else if !global {
+			t.Global = newGlobalSettings(s.Settings)
+			global = true
+		}
+	}
+}
+
+func newGlobalSettings(s Settings) (g GlobalSettings) {
+	keys := map[string]**Color{
+		"foreground":                &g.Foreground,
+		"background":                &g.Background,
+		"caret":                     &g.Caret,
+		"lineHighlight":             &g.LineHighlight,
+		"invisibles":                &g.Invisibles,
+		"selection":                 &g.Selection,
+		"selectionForeground":       &g.SelectionForeground,
+		"selectionBorder":           &g.SelectionBorder,
+		"inactiveSelection":         &g.InactiveSelection,
+		"findHighlight":             &g.FindHighlight,
+		"findHighlightForeground":   &g.FindHighlightForeground,
+		"gutter":                    &g.Gutter,
+		"gutterForeground":          &g.GutterForeground,
+		"guide":                     &g.Guide,
+		"activeGuide":               &g.ActiveGuide,
+		"stackGuide":                &g.StackGuide,
+		"bracketsForeground":        &g.BracketsForeground,
+		"bracketContentsForeground": &g.BracketContentsForeground,
+		"tagsForeground":            &g.TagsForeground,
+		"highlight":                 &g.Highlight,
+		"shadow":                    &g.Shadow,
+	}
+	for k, p := range keys {
+		if c, ok := s.Colors[k]; ok {
+			*p = &c
+		}
+	}
+	g.FontStyle = s.FontStyle
+	return
+}
+
+// ParseFontStyle parses a space separated list of the font styles bold,
+// italic, underline and strikethrough. Other words are ignored.
+func ParseFontStyle(s string) (ret FontStyle) {
+	for _, w := range strings.Fields(s) {
+		for _, f := range fontStyles {
+			if w == f.name {
+				ret |= f.f
+			}
+		}
+	}
+	return
+}
+
+func (f FontStyle) String() string {
+	var names []string
+	for _, s := range fontStyles {
+		if f&s.f != 0 {
+			names = append(names, s.name)
+		}
+	}
+	return strings.Join(names, " ")
+}
+
+func (s *Settings) UnmarshalJSON(data []byte) error {
+	*s = Settings{Colors: make(map[string]Color), Other: make(map[string]string)}
+	tmp := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	for k, v := range tmp {
+		var str string
+		if err := json.Unmarshal(v, &str); err != nil {
+			s.Other[k] = string(v)
+			continue
+		}
+		if k == "fontStyle" {
+			f := ParseFontStyle(str)
+			s.FontStyle = &f
+		} else if _, named := namedColors[strings.ToLower(str)]; named || strings.HasPrefix(str, "#") {
+			c, err := ParseColor(str)
+			if err != nil {
+				return fmt.Errorf("%s: %s", k, err)
+			}
+			s.Colors[k] = c
+		} else {
+			s.Other[k] = str
+		}
+	}
+	return nil
+}
+
+// ClosestMatchingSetting returns the setting whose scope selector best
+// matches the space separated scope stack scope. If none of them do, it
+// returns the theme's global settings, or nil if the theme has none. Of
+// equally good matches the last one wins.
+func (t *Theme) ClosestMatchingSetting(scope string) *ScopeSetting {
+	var (
+		stack       = strings.Fields(scope)
+		ret, global *ScopeSetting
+		best        = -1.0
+	)
+	for i := range t.Settings {
+		s := &t.Settings[i]
+		if s.selector == nil {
+			if global == nil && strings.TrimSpace(s.Scope) == "" {
+				global = s
+			}
+		} else if score, ok := s.selector.Match(stack); ok && score >= best {
+			ret, best = s, score
+		}
+	}
+	if ret == nil {
+		return global
+	}
+	return ret
+}
+
+// Style returns the style of text with the space separated scope stack
+// scope, such as "source.go string.quoted.double.go".
+//
+// The foreground, background and font style are resolved separately. Each
+// is taken from the rule with the most specific selector matching scope
+// that sets it, where of equally specific rules the last one wins, and
+// from the theme's global settings if no rule sets it. The styles are
+// cached by scope, so changes to the theme's settings after the first call
+// aren't seen.
+func (t *Theme) Style(scope string) Style {
+	c := t.styleCache()
+	c.Lock()
+	ret, ok := c.styles[scope]
+	c.Unlock()
+	if !ok {
+		ret = t.resolve(scope)
+		c.Lock()
+		c.styles[scope] = ret
+		c.Unlock()
+	}
+	return ret
+}
+
+// OpaqueStyle is like Style, but for output that can't show translucent
+// colours. The background is composited onto the theme's background, and
+// the foreground onto the result. A translucent theme background is
+// composited onto black.
+func (t *Theme) OpaqueStyle(scope string) Style {
+	var bg Color
+	if g := t.Global.Background; g != nil {
+		bg = *g
+	}
+	bg = bg.Over(Color{A: 0xff})
+	s := t.Style(scope)
+	s.Background = s.Background.Over(bg)
+	s.Foreground = s.Foreground.Over(s.Background)
+	return s
+}
+
+func (t *Theme) styleCache() *styleCache {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	if t.cache == nil {
+		t.cache = &styleCache{styles: make(map[string]Style)}
+	}
+	return t.cache
+}
+
+func (t *Theme) resolve(scope string) Style {
+	return t.Explain(scope).Style
+}
+
+// Explain returns how t styles text with the space separated scope stack
+// scope, as Style does, but without caching.
+func (t *Theme) Explain(scope string) *Explanation {
+	ret := &Explanation{Scope: scope}
+	for i := range t.Settings {
+		s := &t.Settings[i]
+		if s.selector == nil && strings.TrimSpace(s.Scope) == "" {
+			if c, ok := s.Settings.Colors["foreground"]; ok {
+				ret.Style.Foreground, ret.Foreground = c, s
+			}
+			if c, ok := s.Settings.Colors["background"]; ok {
+				ret.Style.Background, ret.Background = c, s
+			}
+			if f := s.Settings.FontStyle; f != nil {
+				ret.Style.Font, ret.Font = *f, s
+			}
+			break
+		}
+	}
+	var (
+		stack         = strings.Fields(scope)
+		fg, bg, style = -1.0, -1.0, -1.0
+	)
+	for i := range t.Settings {
+		s := &t.Settings[i]
+		if s.selector == nil {
+			continue
+		}
+		score, ok := s.selector.Match(stack)
+		if !ok {
+			continue
+		}
+		ret.Candidates = append(ret.Candidates, Candidate{s, score})
+		if c, ok := s.Settings.Colors["foreground"]; ok && score >= fg {
+			ret.Style.Foreground, ret.Foreground, fg = c, s, score
+		}
+		if c, ok := s.Settings.Colors["background"]; ok && score >= bg {
+			ret.Style.Background, ret.Background, bg = c, s, score
+		}
+		if f := s.Settings.FontStyle; f != nil && score >= style {
+			ret.Style.Font, ret.Font, style = *f, s, score
+		}
+	}
+	return ret
+}
+
+func (e *Explanation) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s\n", e.Scope)
+	for _, a := range []struct {
+		name  string
+		value string
+		from  *ScopeSetting
+	}{
+		{"foreground", e.Style.Foreground.String(), e.Foreground},
+		{"background", e.Style.Background.String(), e.Background},
+		{"fontStyle", e.Style.Font.String(), e.Font},
+	} {
+		switch {
+		case a.from == nil:
+			fmt.Fprintf(&buf, "\t%s: unset\n", a.name)
+		case a.from.selector == nil:
+			fmt.Fprintf(&buf, "\t%s: %s from the global settings\n", a.name, a.value)
+		default:
+			fmt.Fprintf(&buf, "\t%s: %s from %s - %s\n", a.name, a.value, a.from.Name, a.from.Scope)
+		}
+	}
+	for _, c := range e.Candidates {
+		fmt.Fprintf(&buf, "\t%g %s - %s\n", c.Score, c.Setting.Name, c.Setting.Scope)
+	}
+	return buf.String()
+}
+
+// NodeStyle returns the style of the text of the innermost node of stack,
+// which holds a node of a parse tree and the nodes containing it, from the
+// root down.
+func (t *Theme) NodeStyle(stack []*parser.Node) Style {
+	return t.Style(ScopeName(stack))
+}
+
+// ScopeName returns the scope stack of the innermost node of stack as a
+// space separated string, skipping nodes without a name.
+func ScopeName(stack []*parser.Node) string {
+	names := make([]string, 0, len(stack))
+	for _, n := range stack {
+		if n.Name != "" {
+			names = append(names, n.Name)
+		}
+	}
+	return strings.Join(names, " ")
+}
+
+// NodeStack returns the node of the parse tree root that's innermost at the
+// offset pos and the nodes containing it, from root down.
+func NodeStack(root *parser.Node, pos int) []*parser.Node {
+	stack := []*parser.Node{root}
+	for n := root; ; {
+		var next *parser.Node
+		for _, c := range n.Children {
+			if pos >= c.Range.Begin() && pos < c.Range.End() {
+				next = c
+				break
+			}
+		}
+		if next == nil {
+			return stack
+		}
+		stack = append(stack, next)
+		n = next
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/gbbr/textmate/vendor/limetext/lime-backend/lib/render"
+	"github.com/gbbr/textmate/vendor/limetext/lime-backend/lib/util"
+)
+
+func TestLoadTheme(t *testing.T) {
+	const (
+		in  = "testdata/Monokai.tmTheme"
+		out = "testdata/Monokai.tmTheme.res"
+	)
+	th, err := LoadTheme(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	str := fmt.Sprintf("%s", th)
+	if d, err := ioutil.ReadFile(out); err != nil {
+		if err := ioutil.WriteFile(out, []byte(str), 0644); err != nil {
+			t.Error(err)
+		}
+	} else if diff := util.Diff(string(d), str); diff != "" {
+		t.Error(diff)
+	}
+
+	for _, fn := range []string{"testdata/Monokai.tmTheme.res", "testdata/MissingFile"} {
+		if _, err := LoadTheme(fn); err == nil {
+			t.Errorf("Tried to load %s, expecting an error, but didn't get one", fn)
+		}
+	}
+}
+
+func TestThemeStyle(t *testing.T) {
+	th, err := LoadTheme("testdata/Monokai.tmTheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		scope  string
+		fg, bg string
+		font   FontStyle
+	}{
+		{"source.go", "#F8F8F2", "#272822", 0},
+		{"source.go comment.line.double-slash.go", "#75715E", "#272822", 0},
+		{"source.go keyword.control.go", "#F92672", "#272822", 0},
+		{"source.go storage.type.go", "#66D9EF", "#272822", Italic},
+		{"source.go constant.other.placeholder.go", "#AE81FF", "#272822", 0},
+		{"source.go support.class.go", "#66D9EF", "#272822", Italic},
+		{"source.go entity.other.inherited-class.go", "#A6E22E", "#272822", Italic | Underline},
+		{"text.xml invalid.deprecated.xml", "#F8F8F0", "#AE81FF", 0},
+	}
+	for _, test := range tests {
+		s := th.Style(test.scope)
+		if fg, bg := s.Foreground.String(), s.Background.String(); fg != test.fg || bg != test.bg {
+			t.Errorf("%s: Expected %s on %s, but got %s on %s", test.scope, test.fg, test.bg, fg, bg)
+		}
+		if s.Font != test.font {
+			t.Errorf("%s: Expected font style %q, but got %q", test.scope, test.font, s.Font)
+		}
+	}
+}
+
+func TestFontStyle(t *testing.T) {
+	tests := []struct {
+		in  string
+		exp FontStyle
+		str string
+	}{
+		{"", 0, ""},
+		{"bold", Bold, "bold"},
+		{" italic  bold ", Bold | Italic, "bold italic"},
+		{"underline strikethrough", Underline | Strikethrough, "underline strikethrough"},
+		{"italic oblique", Italic, "italic"},
+	}
+	for _, test := range tests {
+		if f := ParseFontStyle(test.in); f != test.exp || f.String() != test.str {
+			t.Errorf("%q: Expected %d (%q), but got %d (%q)", test.in, test.exp, test.str, f, f.String())
+		}
+	}
+	if FontStyle(render.Italic) != Italic || FontStyle(render.Bold) != Bold ||
+		FontStyle(render.Underline) != Underline || FontStyle(render.Strikethrough) != Strikethrough {
+		t.Error("Expected the font styles to match render.FontStyle")
+	}
+}
+
+func TestThemeGlobal(t *testing.T) {
+	th, err := LoadTheme("testdata/Monokai.tmTheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := th.Global
+	for _, test := range []struct {
+		name string
+		c    *Color
+		exp  string
+	}{
+		{"foreground", g.Foreground, "#F8F8F2"},
+		{"background", g.Background, "#272822"},
+		{"caret", g.Caret, "#F8F8F0"},
+		{"lineHighlight", g.LineHighlight, "#49483E"},
+		{"invisibles", g.Invisibles, "#49483E"},
+		{"selection", g.Selection, "#49483E"},
+	} {
+		if test.c == nil {
+			t.Errorf("Expected %s to be set", test.name)
+		} else if test.c.String() != test.exp {
+			t.Errorf("Expected %s to be %s, but got %s", test.name, test.exp, test.c)
+		}
+	}
+	if g.Gutter != nil || g.FontStyle != nil {
+		t.Errorf("Expected the gutter colour and font style to be unset, but got %v and %v", g.Gutter, g.FontStyle)
+	}
+}
+
+func TestThemeNodeStyle(t *testing.T) {
+	th, err := LoadTheme("testdata/Monokai.tmTheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const src = "package main\n\nvar s = \"x\"\n"
+	lp, err := NewLanguageParser("testdata/Go.tmLanguage", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := lp.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stack := NodeStack(root, strings.Index(src, "x"))
+	if exp, got := "source.go string.quoted.double.go", ScopeName(stack); got != exp {
+		t.Errorf("Expected %q, but got %q", exp, got)
+	}
+	if exp, got := "#E6DB74", th.NodeStyle(stack).Foreground.String(); got != exp {
+		t.Errorf("Expected %s, but got %s", exp, got)
+	}
+}
+
+func TestThemeCascade(t *testing.T) {
+	const js = `{
+		"name": "Cascade",
+		"settings": [
+			{"settings": {"foreground": "#000000", "background": "#FFFFFF"}},
+			{"scope": "string", "settings": {"foreground": "#111111"}},
+			{"scope": "string.quoted", "settings": {"fontStyle": "italic"}},
+			{"scope": "meta.embedded", "settings": {"background": "#222222"}},
+			{"scope": "source string.quoted.double", "settings": {"foreground": "#333333"}},
+			{"scope": "keyword", "settings": {"foreground": "#444444", "fontStyle": "bold"}},
+			{"scope": "keyword", "settings": {"foreground": "#555555"}},
+			{"scope": "keyword.control", "settings": {"fontStyle": ""}}
+		]
+	}`
+	var th Theme
+	if err := json.Unmarshal([]byte(js), &th); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		scope  string
+		fg, bg string
+		font   FontStyle
+	}{
+		{"source", "#000000", "#FFFFFF", 0},
+		{"source string.unquoted", "#111111", "#FFFFFF", 0},
+		{"source string.quoted.single", "#111111", "#FFFFFF", Italic},
+		{"source meta.embedded string.quoted.single", "#111111", "#222222", Italic},
+		{"source meta.embedded string.quoted.double", "#333333", "#222222", Italic},
+		{"text string.quoted.double", "#111111", "#FFFFFF", Italic},
+		{"source keyword.operator", "#555555", "#FFFFFF", Bold},
+		{"source keyword.control", "#555555", "#FFFFFF", 0},
+	}
+	for i := 0; i < 2; i++ {
+		// The second time around the styles come from the cache
+		for _, test := range tests {
+			s := th.Style(test.scope)
+			if fg, bg := s.Foreground.String(), s.Background.String(); fg != test.fg || bg != test.bg || s.Font != test.font {
+				t.Errorf("%s: Expected %s on %s (%q), but got %s on %s (%q)", test.scope, test.fg, test.bg, test.font, fg, bg, s.Font)
+			}
+		}
+	}
+	if exp, got := &th.Settings[0], th.ClosestMatchingSetting("text.plain"); got != exp {
+		t.Errorf("Expected the global settings, but got %v", got)
+	}
+	if exp, got := &th.Settings[6], th.ClosestMatchingSetting("source keyword.operator"); got != exp {
+		t.Errorf("Expected %v, but got %v", exp, got)
+	}
+
+	var empty Theme
+	if s := empty.Style("source"); s != (Style{}) {
+		t.Errorf("Expected the zero style, but got %v", s)
+	}
+	if s := empty.ClosestMatchingSetting("source"); s != nil {
+		t.Errorf("Expected no setting, but got %v", s)
+	}
+}
+
+func TestThemeExplain(t *testing.T) {
+	const js = `{
+		"name": "Explain",
+		"settings": [
+			{"settings": {"foreground": "#000000"}},
+			{"name": "String", "scope": "string", "settings": {"foreground": "#111111"}},
+			{"name": "Quoted", "scope": "string.quoted", "settings": {"fontStyle": "italic"}},
+			{"name": "Embedded", "scope": "meta.embedded", "settings": {"background": "#222222"}},
+			{"name": "Keyword", "scope": "keyword", "settings": {"foreground": "#333333"}}
+		]
+	}`
+	var th Theme
+	if err := json.Unmarshal([]byte(js), &th); err != nil {
+		t.Fatal(err)
+	}
+	e := th.Explain("source meta.embedded string.quoted.double")
+	if e.Style != th.Style(e.Scope) {
+		t.Errorf("Expected the style %v, but got %v", th.Style(e.Scope), e.Style)
+	}
+	if e.Foreground != &th.Settings[1] || e.Background != &th.Settings[3] || e.Font != &th.Settings[2] {
+		t.Errorf("Expected the attributes from String, Embedded and Quoted, but got %v, %v and %v", e.Foreground, e.Background, e.Font)
+	}
+	if len(e.Candidates) != 3 {
+		t.Fatalf("Expected 3 candidates, but got %d", len(e.Candidates))
+	}
+	for i, exp := range []*ScopeSetting{&th.Settings[1], &th.Settings[2], &th.Settings[3]} {
+		if c := e.Candidates[i]; c.Setting != exp {
+			t.Errorf("Expected candidate %d to be %s, but got %s", i, exp.Name, c.Setting.Name)
+		}
+	}
+	if s := e.Candidates; s[1].Score <= s[0].Score || s[1].Score <= s[2].Score {
+		t.Errorf("Expected the deeper, longer match to score higher, but got %v", s)
+	}
+
+	const exp = `source keyword.operator
+	foreground: #333333 from Keyword - keyword
+	background: unset
+	fontStyle: unset
+	0.25 Keyword - keyword
+`
+	e = th.Explain("source keyword.operator")
+	if e.Background != nil || e.Font != nil || e.Foreground != &th.Settings[4] {
+		t.Errorf("Expected only the foreground to be set, but got %v", e)
+	}
+	if diff := util.Diff(exp, e.String()); diff != "" {
+		t.Error(diff)
+	}
+	if e := th.Explain("text"); e.Foreground != &th.Settings[0] || !strings.Contains(e.String(), "from the global settings") {
+		t.Errorf("Expected the foreground from the global settings, but got %v", e)
+	}
+}
@@ -0,0 +1,320 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gbbr/textmate/vendor/limetext/text"
+	"github.com/gbbr/textmate/vendor/quarnster/parser"
+)
+
+type (
+	// A Token is a run of text on a single line sharing the same scopes.
+	Token struct {
+		Start, End int
+		// The scope names containing the token, outermost first.
+		Scopes []string
+	}
+
+	// A Tokenizer splits text into tokens one line at a time.
+	//
+	// Unlike LanguageParser, it never looks beyond the line it is working on,
+	// and the only state it keeps between lines is the stack of begin/end
+	// rules that are still open. This makes it suitable for very large inputs.
+	Tokenizer struct {
+		// Offsets selects the unit used for the Start and End of tokens,
+		// which are relative to the start of their line.
+		Offsets  OffsetUnit
+		l        *Language
+		stack    []frame
+		scanners map[*Pattern]*Scanner
+	}
+
+	// A frame is a begin/end rule which hasn't been closed yet.
+	frame struct {
+		pat    *Pattern
+		scopes []string
+	}
+)
+
+// NewTokenizer creates a Tokenizer for the language identified by scope,
+// which is resolved the same way as for NewLanguageParser.
+func NewTokenizer(scope string) (*Tokenizer, error) {
+	l, err := Provider.GetLanguage(scope)
+	if err != nil {
+		return nil, err
+	}
+	t := &Tokenizer{l: l, scanners: make(map[*Pattern]*Scanner)}
+	t.Reset()
+	return t, nil
+}
+
+// Reset discards the open rules so that the next line is
+// tokenized as if it was the first line of a document.
+func (t *Tokenizer) Reset() {
+	t.stack = append(t.stack[:0], frame{
+		pat:    &t.l.RootPattern.Pattern,
+		scopes: []string{t.l.ScopeName},
+	})
+}
+
+// Tokenize reads r until EOF and calls fn with the tokens of every
+// line, numbered from zero. Line terminators, "\n" or "\r\n", are not
+// part of any token. Tokenizing stops at the first error returned by fn.
+//
+// Lines are decoded like the input of NewLanguageParserBytes, and the
+// offsets of their tokens are those of the decoded lines, as with Tokens:
+// a UTF-8 byte order mark is removed and every byte of invalid UTF-8 is
+// replaced by utf8.RuneError. UTF-16 input can't be split into lines
+// before it's decoded, so it's rejected; NewLanguageParserBytes and Tokens
+// handle it.
+func (t *Tokenizer) Tokenize(r io.Reader, fn func(line int, tokens []Token) error) error {
+	br := bufio.NewReader(r)
+	if head, _ := br.Peek(512); len(head) != 0 {
+		if enc, _ := detect(head); enc != encUTF8 {
+			return errors.New("UTF-16 input can't be tokenized a line at a time")
+		}
+	}
+	for line := 0; ; line++ {
+		s, err := br.ReadString('\n')
+		if len(s) == 0 && err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		s = strings.TrimSuffix(s, "\n")
+		s = strings.TrimSuffix(s, "\r")
+		bom := 0
+		if line == 0 && strings.HasPrefix(s, string(bomUTF8)) {
+			bom = len(bomUTF8)
+		}
+		s = decodeAs([]byte(s), encUTF8, bom).text
+		if err := fn(line, t.TokenizeLine(s)); err != nil {
+			return err
+		}
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// TokenizeLine returns the tokens of line, which should not include its
+// line terminator, and updates the rule stack for the next line.
+func (t *Tokenizer) TokenizeLine(line string) []Token {
+	var (
+		toks []Token
+		data = line + "\n"
+		pos  = 0
+		// Where the text without tokens starts, before pos once an
+		// empty match has been stepped over
+		from = 0
+		// The rules begun at stalledAt without moving past it. One of
+		// them beginning there again, whatever was pushed and popped
+		// in between, would repeat itself forever.
+		stalled   []*Pattern
+		stalledAt = -1
+	)
+loop:
+	for iter := maxiter; iter > 0; iter-- {
+		top := &t.stack[len(t.stack)-1]
+		pat, mo := t.firstMatch(top.pat, data, pos)
+		var end MatchObject
+		if top.pat.End.re != nil {
+			end = top.pat.End.cached(data, pos)
+		}
+		if end != nil && (mo == nil || end[0] <= mo[0]) {
+			toks = appendToken(toks, top.scopes, from, end[0])
+			capt := top.pat.EndCaptures
+			if len(capt) == 0 {
+				capt = top.pat.Captures
+			}
+			toks = captures(toks, top.scopes, "", top.pat, data, end, capt)
+			pos, from = end[1], end[1]
+			if len(t.stack) > 1 {
+				t.stack = t.stack[:len(t.stack)-1]
+			}
+			continue
+		}
+		if mo == nil {
+			break
+		}
+		if pat.Begin.re == nil && mo[0] == mo[1] {
+			// An empty match makes no progress, so it would just be
+			// found again and again. The rune after it is left to
+			// the rule around it instead
+			if mo[0] >= len(line) {
+				break
+			}
+			_, size := utf8.DecodeRuneInString(data[mo[0]:])
+			pos = mo[0] + size
+			continue
+		}
+		toks = appendToken(toks, top.scopes, from, mo[0])
+		if pat.Begin.re == nil {
+			toks = captures(toks, top.scopes, pat.Name, pat, data, mo, pat.Captures)
+			pos, from = mo[1], mo[1]
+			continue
+		}
+		if mo[1] == pos {
+			if stalledAt != pos {
+				stalled, stalledAt = stalled[:0], pos
+			}
+			for _, p := range stalled {
+				if p == pat {
+					break loop
+				}
+			}
+			stalled = append(stalled, pat)
+		}
+		capt := pat.BeginCaptures
+		if len(capt) == 0 {
+			capt = pat.Captures
+		}
+		toks = captures(toks, top.scopes, pat.Name, pat, data, mo, capt)
+		scopes := top.scopes
+		if pat.Name != "" {
+			scopes = append(scopes[:len(scopes):len(scopes)], pat.Name)
+		}
+		t.stack = append(t.stack, frame{pat: pat, scopes: scopes})
+		pos, from = mo[1], mo[1]
+	}
+	toks = appendToken(toks, t.stack[len(t.stack)-1].scopes, from, len(line))
+	return t.clip(toks, line)
+}
+
+// clip removes the parts of toks that are beyond the end of
+// line and converts the remaining offsets to t.Offsets.
+func (t *Tokenizer) clip(toks []Token, line string) []Token {
+	lut := offsetTable(line, t.Offsets)
+	ret := toks[:0]
+	for _, tok := range toks {
+		if tok.Start >= len(line) {
+			break
+		}
+		if tok.End > len(line) {
+			tok.End = len(line)
+		}
+		if lut != nil {
+			tok.Start, tok.End = lut[tok.Start], lut[tok.End]
+		}
+		ret = append(ret, tok)
+	}
+	return ret
+}
+
+// captures appends the tokens of the match mo of pattern p. The match is
+// scoped by name, if not empty, and its captures capt are nested inside it.
+func captures(toks []Token, scopes []string, name string, p *Pattern, data string, mo MatchObject, capt Captures) []Token {
+	n := &parser.Node{Name: name, Range: text.Region{A: mo[0], B: mo[1]}}
+	p.CreateCaptureNodes(data, mo[0], nil, mo, n, capt)
+	toks, _ = flatten(toks, scopes, n, mo[0], mo[1])
+	return toks
+}
+
+// firstMatch returns the earliest match at or after pos of the
+// patterns that can be applied inside of p.
+func (t *Tokenizer) firstMatch(p *Pattern, data string, pos int) (*Pattern, MatchObject) {
+	s, ok := t.scanners[p]
+	if !ok {
+		s = p.newScanner(t.l)
+		t.scanners[p] = s
+	}
+	if i, mo := s.Scan(data, pos); i != -1 {
+		return s.Patterns()[i], mo
+	}
+	return nil, nil
+}
+
+// Tokens returns the tokens of every line of the text of the parse tree
+// root, as returned by LanguageParser.Parse, like Tokenizer does.
+//
+// The tokens don't overlap, are in document order and cover the whole of
+// every line but its terminator. Parts of a node not covered by its
+// children get the scopes of the node, and text outside of root those of
+// root. Their offsets are relative to the start of their line, in the
+// unit the parser's Offsets selects.
+func Tokens(root *parser.Node) [][]Token {
+	unit := ByteOffsets
+	if lp, ok := root.P.(*LanguageParser); ok {
+		unit = lp.Offsets
+	}
+	return tokensIn(root, unit)
+}
+
+// tokensIn is like Tokens, but with offsets in unit u.
+func tokensIn(root *parser.Node, u OffsetUnit) [][]Token {
+	h := highlight(root)
+	for i, line := range h.lines {
+		lut := offsetTable(line, u)
+		if lut == nil {
+			continue
+		}
+		for j := range h.tokens[i] {
+			tok := &h.tokens[i][j]
+			tok.Start, tok.End = lut[tok.Start], lut[tok.End]
+		}
+	}
+	return h.tokens
+}
+
+// TokenizeLines parses the text of lp, and returns the tokens of its lines
+// like Tokens does.
+func (lp *LanguageParser) TokenizeLines() ([][]Token, error) {
+	root, err := lp.Parse()
+	if err != nil {
+		return nil, err
+	}
+	return Tokens(root), nil
+}
+
+func appendToken(toks []Token, scopes []string, a, b int) []Token {
+	if a >= b {
+		return toks
+	}
+	return append(toks, Token{Start: a, End: b, Scopes: scopes})
+}
+
+// flatten appends tokens for the parts of the range [pos, end) covered by n,
+// and returns the position up to which tokens were appended. Parts of n not
+// covered by any of its children are given the scope of n itself. Children
+// overlapping a previous sibling or the end of n are clipped.
+func flatten(toks []Token, scopes []string, n *parser.Node, pos, end int) ([]Token, int) {
+	if n.Range.B < end {
+		end = n.Range.B
+	}
+	if n.Range.A > pos {
+		pos = n.Range.A
+	}
+	if n.Name != "" {
+		scopes = append(scopes[:len(scopes):len(scopes)], n.Name)
+	}
+	for _, c := range n.Children {
+		if c.Range.B <= pos {
+			continue
+		} else if c.Range.A >= end {
+			break
+		}
+		toks = appendToken(toks, scopes, pos, c.Range.A)
+		toks, pos = flatten(toks, scopes, c, pos, end)
+	}
+	toks = appendToken(toks, scopes, pos, end)
+	if end > pos {
+		pos = end
+	}
+	return toks, pos
+}
+
+func (t Token) String() string {
+	return fmt.Sprintf("%d-%d: %s", t.Start, t.End, strings.Join(t.Scopes, " "))
+}
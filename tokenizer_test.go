@@ -0,0 +1,285 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTokenizerTokenize(t *testing.T) {
+	const in = "package main\r\n/* a\n😀 */ var x = \"é\"\n// end"
+	exp := [][]string{
+		{
+			"0-7: source.go keyword.control.go",
+			"7-12: source.go",
+		},
+		{
+			"0-2: source.go comment.block.go punctuation.definition.comment.go",
+			"2-4: source.go comment.block.go",
+		},
+		{
+			"0-2: source.go comment.block.go",
+			"2-4: source.go comment.block.go punctuation.definition.comment.go",
+			"4-5: source.go",
+			"5-8: source.go keyword.control.go",
+			"8-13: source.go",
+			"13-14: source.go string.quoted.double.go punctuation.definition.string.begin.go",
+			"14-15: source.go string.quoted.double.go",
+			"15-16: source.go string.quoted.double.go punctuation.definition.string.end.go",
+		},
+		{
+			"0-2: source.go comment.line.double-slash.go punctuation.definition.comment.go",
+			"2-6: source.go comment.line.double-slash.go",
+		},
+	}
+	tk, err := NewTokenizer("testdata/Go.tmLanguage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got [][]string
+	err = tk.Tokenize(strings.NewReader(in), func(line int, toks []Token) error {
+		if line != len(got) {
+			t.Errorf("Expected line %d, but got %d", len(got), line)
+		}
+		var strs []string
+		for _, tok := range toks {
+			strs = append(strs, tok.String())
+		}
+		got = append(got, strs)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf("Expected\n%q\nbut got\n%q", exp, got)
+	}
+}
+
+func TestTokenizerOffsets(t *testing.T) {
+	tk, err := NewTokenizer("testdata/Go.tmLanguage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const line = "\"😀\" // é"
+	tests := []struct {
+		unit OffsetUnit
+		exp  string
+	}{
+		{RuneOffsets, "[0-1 1-2 2-3 3-4 4-8]"},
+		{ByteOffsets, "[0-1 1-5 5-6 6-7 7-12]"},
+		{UTF16Offsets, "[0-1 1-3 3-4 4-5 5-9]"},
+	}
+	for _, test := range tests {
+		tk.Offsets = test.unit
+		tk.Reset()
+		var ranges []string
+		for _, tok := range tk.TokenizeLine(line) {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", tok.Start, tok.End))
+		}
+		if got := fmt.Sprint(ranges); got != test.exp {
+			t.Errorf("%s: Expected %s, but got %s", test.unit, test.exp, got)
+		}
+	}
+}
+
+// The tokens must cover every line completely, without overlapping.
+func TestTokenizerCoverage(t *testing.T) {
+	for _, fn := range []string{"testdata/main.go", "testdata/utf.go", "language.go"} {
+		f, err := os.Open(fn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, _ := ioutil.ReadFile(fn)
+		lines := strings.Split(string(d), "\n")
+
+		tk, err := NewTokenizer("testdata/Go.tmLanguage")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = tk.Tokenize(f, func(line int, toks []Token) error {
+			pos := 0
+			for _, tok := range toks {
+				if tok.Start != pos || tok.End <= tok.Start {
+					return fmt.Errorf("%s:%d: Bad token %s after %d", fn, line+1, tok, pos)
+				}
+				pos = tok.End
+			}
+			if n := len([]rune(lines[line])); pos != n {
+				return fmt.Errorf("%s:%d: Expected tokens up to %d, but got %d", fn, line+1, n, pos)
+			}
+			return nil
+		})
+		f.Close()
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestTokens(t *testing.T) {
+	const in = "package main\r\n/* a\n😀 */ var x = \"é\"\n// end"
+	tk, err := NewTokenizer("testdata/Go.tmLanguage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, unit := range []OffsetUnit{RuneOffsets, ByteOffsets, UTF16Offsets} {
+		var exp [][]Token
+		tk.Offsets = unit
+		tk.Reset()
+		if err := tk.Tokenize(strings.NewReader(in), func(line int, toks []Token) error {
+			exp = append(exp, toks)
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		lp, err := NewLanguageParser("testdata/Go.tmLanguage", in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lp.Offsets = unit
+		got, err := lp.TokenizeLines()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(exp, got) {
+			t.Errorf("%s: Expected\n%v\nbut got\n%v", unit, exp, got)
+		}
+	}
+}
+
+// Like the tokens of a Tokenizer, those of a parse must cover every line.
+func TestTokensCoverage(t *testing.T) {
+	for _, fn := range []string{"testdata/main.go", "testdata/utf.go", "language.go"} {
+		d, err := ioutil.ReadFile(fn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines := strings.Split(strings.TrimSuffix(string(d), "\n"), "\n")
+		lp, err := NewLanguageParserBytes("testdata/Go.tmLanguage", d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		toks, err := lp.TokenizeLines()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(toks) != len(lines) {
+			t.Errorf("%s: Expected %d lines, but got %d", fn, len(lines), len(toks))
+			continue
+		}
+	next:
+		for i, lt := range toks {
+			pos := 0
+			for _, tok := range lt {
+				if tok.Start != pos || tok.End <= tok.Start || len(tok.Scopes) == 0 || tok.Scopes[0] != "source.go" {
+					t.Errorf("%s:%d: Bad token %s after %d", fn, i+1, tok, pos)
+					continue next
+				}
+				pos = tok.End
+			}
+			if n := len([]rune(lines[i])); pos != n {
+				t.Errorf("%s:%d: Expected tokens up to %d, but got %d", fn, i+1, n, pos)
+			}
+		}
+	}
+}
+
+func TestTokenizerNoProgress(t *testing.T) {
+	// meta.b begins and ends where it is, again and again, inside
+	// meta.a, which doesn't end until the y
+	var l Language
+	if err := json.Unmarshal([]byte(`{
+		"scopeName": "source.stall",
+		"patterns": [{
+			"begin": "(?=x)", "end": "(?=y)", "name": "meta.a",
+			"patterns": [{"begin": "(?=x)", "end": "(?=x)", "name": "meta.b"}]
+		}]
+	}`), &l); err != nil {
+		t.Fatal(err)
+	}
+	tk := &Tokenizer{l: &l, scanners: make(map[*Pattern]*Scanner)}
+	tk.Reset()
+	var got []string
+	for _, tok := range tk.TokenizeLine("xy") {
+		got = append(got, tok.String())
+	}
+	if exp := []string{"0-2: source.stall meta.a"}; !reflect.DeepEqual(got, exp) {
+		t.Errorf("Expected %q, but got %q", exp, got)
+	}
+	if n := len(tk.stack); n != 2 {
+		t.Errorf("Expected meta.a to be left open, but got %d rules", n)
+	}
+}
+
+func TestTokenizerEmptyMatch(t *testing.T) {
+	// The empty match before the b is stepped over, and the c after it
+	// still found
+	var l Language
+	if err := json.Unmarshal([]byte(`{
+		"scopeName": "source.empty",
+		"patterns": [
+			{"match": "(?=b)", "name": "meta.empty"},
+			{"match": "c", "name": "keyword.c"}
+		]
+	}`), &l); err != nil {
+		t.Fatal(err)
+	}
+	tk := &Tokenizer{l: &l, scanners: make(map[*Pattern]*Scanner)}
+	tk.Reset()
+	var got []string
+	for _, tok := range tk.TokenizeLine("abcb") {
+		got = append(got, tok.String())
+	}
+	exp := []string{"0-2: source.empty", "2-3: source.empty keyword.c", "3-4: source.empty"}
+	if !reflect.DeepEqual(got, exp) {
+		t.Errorf("Expected %q, but got %q", exp, got)
+	}
+}
+
+func TestTokenizerDecode(t *testing.T) {
+	// Lines are decoded like the input of NewLanguageParserBytes
+	data := []byte("\xef\xbb\xbfpackage main\r\nvar x = \"\xff\" + \xfe\n")
+	lp, err := NewLanguageParserBytes("testdata/Go.tmLanguage", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lp.Offsets = ByteOffsets
+	root, err := lp.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := fmt.Sprint(Tokens(root))
+
+	tk, err := NewTokenizer("testdata/Go.tmLanguage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk.Offsets = ByteOffsets
+	var lines [][]Token
+	err = tk.Tokenize(bytes.NewReader(data), func(line int, toks []Token) error {
+		lines = append(lines, toks)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(lines); got != exp {
+		t.Errorf("Expected\n%s\nbut got\n%s", exp, got)
+	}
+
+	// UTF-16 can't be split into lines before it's decoded
+	err = tk.Tokenize(bytes.NewReader([]byte("\xff\xfep\x00\n\x00")), func(int, []Token) error { return nil })
+	if err == nil {
+		t.Error("Expected an error for UTF-16 input")
+	}
+}
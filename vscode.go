@@ -0,0 +1,295 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/gbbr/textmate/vendor/limetext/lime-backend/lib/loaders"
+)
+
+type (
+	// A VS Code colour theme, as found in *-color-theme.json files
+	vscodeTheme struct {
+		Name    string
+		Include string
+		Colors  map[string]string
+		// The rules, or the path of a tmTheme holding them
+		TokenColors json.RawMessage
+		// Not part of VS Code themes, but written by WriteVSCodeTheme
+		// to keep what a tmTheme has
+		UUID           UUID
+		GutterSettings *Settings
+	}
+
+	vscodeRule struct {
+		Name string
+		// A string or a list of strings
+		Scope    json.RawMessage
+		Settings Settings
+	}
+)
+
+// The workbench colours of VS Code themes that have a tmTheme equivalent.
+// Colours without one are kept with their VS Code name in the Other
+// values of the theme's global settings.
+var vscodeColors = map[string]string{
+	"editor.foreground":                   "foreground",
+	"editor.background":                   "background",
+	"editorCursor.foreground":             "caret",
+	"editor.lineHighlightBackground":      "lineHighlight",
+	"editorWhitespace.foreground":         "invisibles",
+	"editor.selectionBackground":          "selection",
+	"editor.selectionForeground":          "selectionForeground",
+	"editor.inactiveSelectionBackground":  "inactiveSelection",
+	"editor.findMatchHighlightBackground": "findHighlight",
+	"editorGutter.background":             "gutter",
+	"editorLineNumber.foreground":         "gutterForeground",
+	"editorIndentGuide.background":        "guide",
+	"editorIndentGuide.activeBackground":  "activeGuide",
+	"editorBracketMatch.border":           "bracketsForeground",
+	"editor.wordHighlightBackground":      "highlight",
+}
+
+// The keys of VS Code themes that aren't kept in Theme.Other.
+var vscodeKeys = []string{
+	"$schema", "name", "type", "include", "colors", "tokenColors",
+	"semanticHighlighting", "semanticTokenColors", "uuid", "gutterSettings",
+}
+
+// LoadVSCodeTheme loads the VS Code colour theme filename.
+//
+// The workbench colours of the theme become its global settings, and its
+// token colours its rules. The themes it includes are loaded relative to
+// it, with their rules applying before its own and their colours being
+// overridden by its own.
+func LoadVSCodeTheme(filename string) (*Theme, error) {
+	global := Settings{Colors: make(map[string]Color), Other: make(map[string]string)}
+	t := &Theme{Settings: []ScopeSetting{{Settings: global}}}
+	if err := t.loadVSCode(filename, make(map[string]bool)); err != nil {
+		return nil, fmt.Errorf("Unable to load colorscheme definition: %s", err)
+	}
+	t.prepare()
+	return t, nil
+}
+
+// loadVSCode adds the rules and colours of the theme filename and of those
+// it includes to t, whose first setting holds the global settings.
+func (t *Theme) loadVSCode(filename string, seen map[string]bool) error {
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return err
+	} else if seen[abs] {
+		return fmt.Errorf("%s includes itself", filename)
+	}
+	// Only the themes being loaded count, so that a theme can be included
+	// more than once
+	seen[abs] = true
+	defer delete(seen, abs)
+	d, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	var raw map[string]json.RawMessage
+	if err := loaders.LoadJSON([]byte(decode(d).text), &raw); err != nil {
+		return fmt.Errorf("%s: %s", filename, err)
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	var vt vscodeTheme
+	if err := json.Unmarshal(data, &vt); err != nil {
+		return fmt.Errorf("%s: %s", filename, err)
+	}
+	other, err := otherValues(data, vscodeKeys...)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(filename)
+	if vt.Include != "" {
+		if err := t.loadVSCode(filepath.Join(dir, vt.Include), seen); err != nil {
+			return err
+		}
+	}
+	if vt.Name != "" {
+		t.Name = vt.Name
+	}
+	if vt.UUID != "" {
+		t.UUID = vt.UUID
+	}
+	if vt.GutterSettings != nil {
+		t.GutterSettings.merge(*vt.GutterSettings)
+	}
+	if t.Other == nil {
+		t.Other = make(map[string]string)
+	}
+	for k, v := range other {
+		t.Other[k] = v
+	}
+
+	var (
+		global = &t.Settings[0].Settings
+		rules  []ScopeSetting
+		path   string
+	)
+	if err := json.Unmarshal(vt.TokenColors, &path); err == nil {
+		tm, err := t.loadTokenColors(filepath.Join(dir, path), seen)
+		if err != nil {
+			return err
+		}
+		for _, s := range tm.Settings {
+			if strings.TrimSpace(s.Scope) == "" {
+				global.merge(s.Settings)
+			} else {
+				rules = append(rules, ScopeSetting{Name: s.Name, Scope: s.Scope, Settings: s.Settings})
+			}
+		}
+	} else if len(vt.TokenColors) != 0 {
+		var vrules []vscodeRule
+		if err := json.Unmarshal(vt.TokenColors, &vrules); err != nil {
+			return fmt.Errorf("%s: %s", filename, err)
+		}
+		for _, r := range vrules {
+			scope, err := vscodeScope(r.Scope)
+			if err != nil {
+				return fmt.Errorf("%s: %s", filename, err)
+			}
+			if scope == "" {
+				global.merge(r.Settings)
+				if t.Settings[0].Name == "" {
+					t.Settings[0].Name = r.Name
+				}
+			} else {
+				rules = append(rules, ScopeSetting{Name: r.Name, Scope: scope, Settings: r.Settings})
+			}
+		}
+	}
+
+	for k, v := range vt.Colors {
+		if v == "" {
+			continue
+		}
+		c, err := ParseColor(v)
+		if err != nil {
+			return fmt.Errorf("%s: %s: %s", filename, k, err)
+		}
+		if tk, ok := vscodeColors[k]; ok {
+			global.Colors[tk] = c
+		} else {
+			global.Other[k] = v
+		}
+	}
+	t.Settings = append(t.Settings, rules...)
+	return nil
+}
+
+// loadTokenColors loads the theme filename that the token colours of a
+// theme are read from. A VS Code theme is loaded with the themes being
+// loaded, seen, so that it can't include the theme reading it.
+func (t *Theme) loadTokenColors(filename string, seen map[string]bool) (*Theme, error) {
+	if strings.ToLower(filepath.Ext(filename)) != ".json" {
+		return LoadTheme(filename)
+	}
+	global := Settings{Colors: make(map[string]Color), Other: make(map[string]string)}
+	tm := &Theme{Settings: []ScopeSetting{{Settings: global}}}
+	if err := tm.loadVSCode(filename, seen); err != nil {
+		return nil, err
+	}
+	return tm, nil
+}
+
+// WriteVSCodeTheme writes t as a VS Code colour theme.
+//
+// The global settings are written both as the workbench colours VS Code
+// shows, and as a token colour rule without a scope, which also keeps the
+// settings without a workbench colour. The UUID and gutter settings of the
+// theme are written as values VS Code ignores.
+func (t *Theme) WriteVSCodeTheme(w io.Writer) error {
+	var (
+		colors = make(map[string]interface{})
+		rules  = t.rules()
+		kind   = "dark"
+	)
+	for i, s := range t.Settings {
+		if strings.TrimSpace(s.Scope) != "" {
+			continue
+		}
+		for vk, tk := range vscodeColors {
+			if c, ok := s.Settings.Colors[tk]; ok {
+				colors[vk] = c.String()
+			}
+		}
+		settings := rules[i].(map[string]interface{})["settings"].(map[string]interface{})
+		for k, v := range s.Settings.Other {
+			// Workbench colours kept from a VS Code theme
+			if strings.Contains(k, ".") {
+				colors[k] = v
+				delete(settings, k)
+			}
+		}
+		if bg, ok := s.Settings.Colors["background"]; ok && 299*int(bg.R)+587*int(bg.G)+114*int(bg.B) > 128000 {
+			kind = "light"
+		}
+		break
+	}
+	top := map[string]interface{}{
+		"name":        t.Name,
+		"type":        kind,
+		"colors":      colors,
+		"tokenColors": rules,
+	}
+	if !t.GutterSettings.empty() {
+		top["gutterSettings"] = t.GutterSettings.values()
+	}
+	if t.UUID != "" {
+		top["uuid"] = string(t.UUID)
+	}
+	for k, v := range t.Other {
+		top[k] = jsonValue(v)
+	}
+	return writeJSON(w, top)
+}
+
+// vscodeScope returns the scope of a token colour rule, which can be a
+// list of selectors, as a single selector.
+func vscodeScope(data json.RawMessage) (string, error) {
+	if len(data) == 0 {
+		return "", nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return s, nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return "", fmt.Errorf("Invalid scope %s", data)
+	}
+	return strings.Join(list, ", "), nil
+}
+
+// merge sets the values o sets in s.
+func (s *Settings) merge(o Settings) {
+	if s.Colors == nil {
+		s.Colors = make(map[string]Color)
+	}
+	if s.Other == nil {
+		s.Other = make(map[string]string)
+	}
+	for k, c := range o.Colors {
+		s.Colors[k] = c
+	}
+	for k, v := range o.Other {
+		s.Other[k] = v
+	}
+	if o.FontStyle != nil {
+		s.FontStyle = o.FontStyle
+	}
+}
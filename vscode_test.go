@@ -0,0 +1,111 @@
+// Copyright 2026 The lime Authors.
+// Use of this source code is governed by a 2-clause
+// BSD-style license that can be found in the LICENSE file.
+
+package textmate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadVSCodeTheme(t *testing.T) {
+	th, err := LoadTheme("testdata/Derived-color-theme.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if th.Name != "Derived" {
+		t.Errorf("Expected the name Derived, but got %s", th.Name)
+	}
+	g := th.Global
+	if g.Background == nil || g.Background.String() != "#101010" {
+		t.Errorf("Expected the included background to be overridden, but got %v", g.Background)
+	}
+	if g.Foreground == nil || g.Foreground.String() != "#D4D4D4" {
+		t.Errorf("Expected the included foreground, but got %v", g.Foreground)
+	}
+	if g.Caret == nil || g.Caret.String() != "#FFCC00" {
+		t.Errorf("Expected the caret colour, but got %v", g.Caret)
+	}
+	if g.Selection == nil || g.Selection.String() != "#264F78" {
+		t.Errorf("Expected the selection colour, but got %v", g.Selection)
+	}
+	if v := th.Settings[0].Settings.Other["editorError.foreground"]; v != "#F44747" {
+		t.Errorf("Expected the workbench colour to be kept, but got %q", v)
+	}
+
+	tests := []struct {
+		scope string
+		fg    string
+		font  FontStyle
+	}{
+		{"source.go", "#D4D4D4", 0},
+		{"source.go comment.line.go", "#6A9955", Italic},
+		{"source.go string.quoted.go", "#CE9178", 0},
+		{"source.go constant.character.escape.go", "#CE9178", 0},
+		{"source.go keyword.operator.go", "#569CD6", 0},
+		{"source.go keyword.control.go", "#C586C0", Bold},
+	}
+	for _, test := range tests {
+		s := th.Style(test.scope)
+		if fg := s.Foreground.String(); fg != test.fg || s.Font != test.font {
+			t.Errorf("%s: Expected %s (%q), but got %s (%q)", test.scope, test.fg, test.font, fg, s.Font)
+		}
+		if bg := s.Background.String(); bg != "#101010" {
+			t.Errorf("%s: Expected the background #101010, but got %s", test.scope, bg)
+		}
+	}
+}
+
+func TestLoadVSCodeThemeTokenColorsFile(t *testing.T) {
+	th, err := LoadTheme("testdata/Monokai-color-theme.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mk, err := LoadTheme("testdata/Monokai.tmTheme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(th.Settings) != len(mk.Settings) {
+		t.Errorf("Expected %d settings, but got %d", len(mk.Settings), len(th.Settings))
+	}
+	if bg := th.Style("source.go").Background.String(); bg != "#1E1F1C" {
+		t.Errorf("Expected the background #1E1F1C, but got %s", bg)
+	}
+	for _, scope := range []string{"source.go comment", "source.go storage.type.go", "text.xml invalid.deprecated"} {
+		if exp, got := mk.Style(scope), th.Style(scope); exp.Foreground != got.Foreground || exp.Font != got.Font {
+			t.Errorf("%s: Expected %v, but got %v", scope, exp, got)
+		}
+	}
+}
+
+func TestLoadVSCodeThemeErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "textmate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"loop-color-theme.json":   `{"include": "./loop-color-theme.json"}`,
+		"self-color-theme.json":   `{"tokenColors": "self-color-theme.json"}`,
+		"a-color-theme.json":      `{"tokenColors": "b-color-theme.json"}`,
+		"b-color-theme.json":      `{"include": "a-color-theme.json"}`,
+		"colour-color-theme.json": `{"colors": {"editor.background": "#12345"}}`,
+		"scope-color-theme.json":  `{"tokenColors": [{"scope": 42, "settings": {}}]}`,
+		"syntax-color-theme.json": `{"name": `,
+	}
+	for fn, data := range files {
+		fn = filepath.Join(dir, fn)
+		if err := ioutil.WriteFile(fn, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadTheme(fn); err == nil {
+			t.Errorf("%s: Expected an error", fn)
+		} else if !strings.Contains(err.Error(), "Unable to load") {
+			t.Errorf("%s: Unexpected error %s", fn, err)
+		}
+	}
+}